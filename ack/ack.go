@@ -3,9 +3,13 @@ package ack
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/dshills/golevel7/controlid"
 	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/escape"
+	"github.com/dshills/golevel7/validate"
 )
 
 // Errors returned by the ACK builder.
@@ -47,24 +51,71 @@ type Builder interface {
 	//   - ERR segment with error details
 	Error(original hl7.Message, err error) (hl7.Message, error)
 
+	// CommitAccept creates an enhanced-mode commit ACK (CA) confirming that
+	// the original message was received and safely stored.
+	CommitAccept(original hl7.Message) (hl7.Message, error)
+
+	// CommitError creates an enhanced-mode commit ACK (CE) for a message that
+	// could not be stored, with err.Error() in MSA-3 and an ERR segment.
+	CommitError(original hl7.Message, err error) (hl7.Message, error)
+
+	// CommitReject creates an enhanced-mode commit ACK (CR) for a message
+	// that was refused, with the optional reason in MSA-3.
+	CommitReject(original hl7.Message, reason string) (hl7.Message, error)
+
 	// Custom creates an ACK with fully customized acknowledgment data.
 	// Use this for advanced scenarios requiring specific error codes,
 	// error locations, or non-standard acknowledgment handling.
+	// Application codes (AA, AE, AR) get the response for the original
+	// message type, like Accept; commit codes (CA, CE, CR) always get a
+	// general ACK.
 	Custom(original hl7.Message, ack ACK) (hl7.Message, error)
+
+	// General creates a general acknowledgment (ACK) with the acknowledgment
+	// data, even if the original message type has its own response, e.g.
+	// for negative acknowledgments sent before the message reaches the
+	// application.
+	General(original hl7.Message, ack ACK) (hl7.Message, error)
+
+	// ORR creates an ORR^O02 general order response for an ORM^O01 message
+	// with the acknowledgment data in MSA and ERR. PID, ORC and OBR segments
+	// of the original are echoed, with ORC-1 set to control, or to OK for
+	// accepts and UA otherwise if control is empty.
+	ORR(original hl7.Message, ack ACK, control OrderControl) (hl7.Message, error)
+
+	// ORL creates an ORL^O22 laboratory order response for an OML^O21
+	// message, echoing PID, ORC and OBR segments like ORR.
+	ORL(original hl7.Message, ack ACK, control OrderControl) (hl7.Message, error)
+
+	// Validation creates an ACK for a validation result. The ACK will have:
+	//   - MSA segment with code "AA" if the result is valid, otherwise the
+	//     code chosen by the builder's ValidationPolicy (AE by default)
+	//   - One ERR segment per validation failure, including warnings
+	Validation(original hl7.Message, result validate.ValidationResult) (hl7.Message, error)
 }
 
 // builder is the concrete implementation of Builder.
 type builder struct {
 	// messageFactory creates new messages and segments.
-	// If nil, a default implementation is used.
+	// If nil, hl7.NewMessageWithDelimiters and hl7.NewSegment are used.
 	messageFactory MessageFactory
 
 	// timeFunc returns the current time. Used for testing.
 	timeFunc func() time.Time
 
-	// controlIDFunc generates unique control IDs for ACK messages.
-	// If nil, uses timestamp-based generation.
-	controlIDFunc func() string
+	// controlIDs generates unique control IDs for ACK messages.
+	// If nil, controlid.Default is used with the MSH-7 time.
+	controlIDs controlid.Generator
+
+	// validationPolicy chooses the code of ACKs for invalid messages.
+	validationPolicy ValidationPolicy
+
+	// responses maps original message types to response message types.
+	// Unmapped types are acknowledged with ACK.
+	responses Responses
+
+	// messageStructure sets MSH-9.3 to ACK in general acknowledgments.
+	messageStructure bool
 }
 
 // MessageFactory creates HL7 messages and segments.
@@ -80,7 +131,8 @@ type MessageFactory interface {
 // Option configures a Builder.
 type Option func(*builder)
 
-// WithMessageFactory sets a custom message factory.
+// WithMessageFactory sets a custom message factory. By default ACKs are
+// built as hl7.Message values, the same as those returned by parse.Parser.
 func WithMessageFactory(factory MessageFactory) Option {
 	return func(b *builder) {
 		b.messageFactory = factory
@@ -94,29 +146,68 @@ func WithTimeFunc(fn func() time.Time) Option {
 	}
 }
 
-// WithControlIDFunc sets a custom control ID generator.
+// WithControlIDFunc sets a custom control ID generator. A nil fn keeps the
+// default.
 func WithControlIDFunc(fn func() string) Option {
 	return func(b *builder) {
-		b.controlIDFunc = fn
+		if fn != nil {
+			b.controlIDs = controlid.Func(fn)
+		}
+	}
+}
+
+// WithControlIDGenerator sets the generator of ACK control IDs (MSH-10),
+// e.g. a persistent controlid.Counter. By default control IDs are the UTC
+// timestamp followed by a six-digit sequence number, e.g.
+// "20240115103000000042". A nil gen keeps the default.
+func WithControlIDGenerator(gen controlid.Generator) Option {
+	return func(b *builder) {
+		if gen != nil {
+			b.controlIDs = gen
+		}
+	}
+}
+
+// WithValidationPolicy sets the policy that chooses between AE and AR for
+// messages that fail validation. The default is DefaultValidationPolicy.
+func WithValidationPolicy(policy ValidationPolicy) Option {
+	return func(b *builder) {
+		b.validationPolicy = policy
+	}
+}
+
+// WithResponses sets the responses used for message types that are not
+// acknowledged with ACK, e.g. ORR^O02 for ORM^O01. Builder methods other
+// than ORR and ORL use them for all messages. By default every message is
+// acknowledged with ACK; use DefaultResponses for the standard responses:
+//
+//	b := ack.NewBuilder(ack.WithResponses(ack.DefaultResponses()))
+func WithResponses(responses Responses) Option {
+	return func(b *builder) {
+		b.responses = responses
+	}
+}
+
+// WithMessageStructure sets MSH-9.3 (Message Structure) of general
+// acknowledgments to ACK, e.g. ACK^A01^ACK, as required by HL7 v2.5 and
+// later. By default MSH-9.3 is left empty.
+func WithMessageStructure(enabled bool) Option {
+	return func(b *builder) {
+		b.messageStructure = enabled
 	}
 }
 
 // NewBuilder creates a new ACK Builder with the given options.
 func NewBuilder(opts ...Option) Builder {
 	b := &builder{
-		timeFunc: time.Now,
+		timeFunc:         time.Now,
+		validationPolicy: DefaultValidationPolicy,
 	}
 
 	for _, opt := range opts {
 		opt(b)
 	}
 
-	if b.controlIDFunc == nil {
-		b.controlIDFunc = func() string {
-			return fmt.Sprintf("ACK%d", b.timeFunc().UnixNano())
-		}
-	}
-
 	return b
 }
 
@@ -168,8 +259,79 @@ func (b *builder) Error(original hl7.Message, err error) (hl7.Message, error) {
 	return b.Custom(original, ack)
 }
 
+// CommitAccept creates an enhanced-mode commit ACK (CA) for the original
+// message. Use NeedsCommitACK to check whether MSH-15 asks for one.
+func (b *builder) CommitAccept(original hl7.Message) (hl7.Message, error) {
+	if original == nil {
+		return nil, ErrNilMessage
+	}
+
+	controlID := original.ControlID()
+	if controlID == "" {
+		return nil, ErrMissingControlID
+	}
+
+	return b.General(original, ACK{Code: CommitAccept, ControlID: controlID})
+}
+
+// CommitError creates an enhanced-mode commit ACK (CE) for the original
+// message.
+func (b *builder) CommitError(original hl7.Message, err error) (hl7.Message, error) {
+	if original == nil {
+		return nil, ErrNilMessage
+	}
+
+	controlID := original.ControlID()
+	if controlID == "" {
+		return nil, ErrMissingControlID
+	}
+
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	}
+
+	ack := NewErrorACK(controlID, "207", errMsg) // 207 = Application internal error
+	ack.Code = CommitError
+	return b.General(original, ack)
+}
+
+// CommitReject creates an enhanced-mode commit ACK (CR) for the original
+// message.
+func (b *builder) CommitReject(original hl7.Message, reason string) (hl7.Message, error) {
+	if original == nil {
+		return nil, ErrNilMessage
+	}
+
+	controlID := original.ControlID()
+	if controlID == "" {
+		return nil, ErrMissingControlID
+	}
+
+	ack := NewRejectACK(controlID, reason)
+	ack.Code = CommitReject
+	return b.General(original, ack)
+}
+
 // Custom creates an ACK with fully customized acknowledgment data.
 func (b *builder) Custom(original hl7.Message, ack ACK) (hl7.Message, error) {
+	if ack.Code.IsCommit() {
+		return b.General(original, ack)
+	}
+	return b.respond(original, ack, nil, "")
+}
+
+// General creates a general acknowledgment (ACK) with the acknowledgment
+// data.
+func (b *builder) General(original hl7.Message, ack ACK) (hl7.Message, error) {
+	resp := b.generalResponse()
+	return b.respond(original, ack, &resp, "")
+}
+
+// respond creates the acknowledgment of original as resp, or as the response
+// for its message type if resp is nil. Echoed ORC segments get control in
+// ORC-1, or the code for the acknowledgment if control is empty.
+func (b *builder) respond(original hl7.Message, ack ACK, resp *Response, control OrderControl) (hl7.Message, error) {
 	if original == nil {
 		return nil, ErrNilMessage
 	}
@@ -190,23 +352,30 @@ func (b *builder) Custom(original hl7.Message, ack ACK) (hl7.Message, error) {
 		delims = hl7.DefaultDelimiters()
 	}
 
+	if resp == nil {
+		r := b.responseFor(msh)
+		resp = &r
+	}
+	if control == "" {
+		control = orderControlFor(ack.Code)
+	}
+
 	// Build the ACK message
-	return b.buildACKMessage(msh, delims, ack)
+	return b.buildACKMessage(original, msh, delims, ack, *resp, control)
 }
 
 // buildACKMessage constructs the complete ACK message.
-func (b *builder) buildACKMessage(originalMSH hl7.Segment, delims *hl7.Delimiters, ack ACK) (hl7.Message, error) {
+func (b *builder) buildACKMessage(original hl7.Message, originalMSH hl7.Segment, delims *hl7.Delimiters, ack ACK, resp Response, control OrderControl) (hl7.Message, error) {
 	// Create a new message using the factory if available
 	var msg hl7.Message
 	if b.messageFactory != nil {
 		msg = b.messageFactory.NewMessage(delims)
 	} else {
-		// Use default message implementation
-		msg = newSimpleMessage(delims)
+		msg = hl7.NewMessageWithDelimiters(delims)
 	}
 
 	// Build and add MSH segment
-	mshSeg, err := b.buildMSHSegment(originalMSH, delims, ack)
+	mshSeg, err := b.buildMSHSegment(originalMSH, delims, resp)
 	if err != nil {
 		return nil, fmt.Errorf("building MSH segment: %w", err)
 	}
@@ -223,14 +392,37 @@ func (b *builder) buildACKMessage(originalMSH hl7.Segment, delims *hl7.Delimiter
 		return nil, fmt.Errorf("adding MSA segment: %w", err)
 	}
 
-	// Build and add ERR segment if needed
-	if ack.NeedsERRSegment() {
-		errSeg, err := b.buildERRSegment(delims, ack)
+	// Build and add ERR segments in the layout of the acknowledged version
+	version, _ := originalMSH.Get("12.1")
+	errSegs, err := b.buildERRSegments(delims, version, errorDetails(ack))
+	if err != nil {
+		return nil, fmt.Errorf("building ERR segments: %w", err)
+	}
+	for i, errSeg := range errSegs {
+		if err := msg.AddSegment(errSeg); err != nil {
+			return nil, fmt.Errorf("adding ERR segment %d: %w", i+1, err)
+		}
+	}
+
+	// Build and add QAK segment for query responses
+	if resp.QueryAck {
+		qakSeg, err := b.buildQAKSegment(original, delims, ack)
 		if err != nil {
-			return nil, fmt.Errorf("building ERR segment: %w", err)
+			return nil, fmt.Errorf("building QAK segment: %w", err)
 		}
-		if err := msg.AddSegment(errSeg); err != nil {
-			return nil, fmt.Errorf("adding ERR segment: %w", err)
+		if err := msg.AddSegment(qakSeg); err != nil {
+			return nil, fmt.Errorf("adding QAK segment: %w", err)
+		}
+	}
+
+	// Add the segments the response repeats from the original
+	echoSegs, err := b.echoSegments(original, delims, resp.Echo, control)
+	if err != nil {
+		return nil, fmt.Errorf("echoing segments: %w", err)
+	}
+	for _, seg := range echoSegs {
+		if err := msg.AddSegment(seg); err != nil {
+			return nil, fmt.Errorf("adding %s segment: %w", seg.Name(), err)
 		}
 	}
 
@@ -239,16 +431,22 @@ func (b *builder) buildACKMessage(originalMSH hl7.Segment, delims *hl7.Delimiter
 
 // buildMSHSegment creates the MSH segment for the ACK message.
 // It swaps sending and receiving applications from the original MSH.
-func (b *builder) buildMSHSegment(originalMSH hl7.Segment, delims *hl7.Delimiters, _ ACK) (hl7.Segment, error) {
-	var seg hl7.Segment
-	if b.messageFactory != nil {
-		seg = b.messageFactory.NewSegment("MSH", delims)
-	} else {
-		seg = newSimpleSegment("MSH", delims)
+func (b *builder) buildMSHSegment(originalMSH hl7.Segment, delims *hl7.Delimiters, resp Response) (hl7.Segment, error) {
+	seg := b.newSegment("MSH", delims)
+
+	// MSH-1: Field separator
+	if err := seg.Set("1", delims.MSH1()); err != nil {
+		return nil, fmt.Errorf("setting MSH-1: %w", err)
 	}
 
-	// MSH-1: Field separator (set implicitly by segment)
-	// MSH-2: Encoding characters (set implicitly by segment)
+	// MSH-2: Encoding characters (as in the original if it has them)
+	encodingChars, _ := originalMSH.Get("2")
+	if encodingChars == "" {
+		encodingChars = delims.MSH2()
+	}
+	if err := seg.Set("2", encodingChars); err != nil {
+		return nil, fmt.Errorf("setting MSH-2: %w", err)
+	}
 
 	// Swap sending and receiving applications
 	// Original MSH-3 (Sending App) -> ACK MSH-5 (Receiving App)
@@ -256,77 +454,74 @@ func (b *builder) buildMSHSegment(originalMSH hl7.Segment, delims *hl7.Delimiter
 	// Original MSH-5 (Receiving App) -> ACK MSH-3 (Sending App)
 	// Original MSH-6 (Receiving Facility) -> ACK MSH-4 (Sending Facility)
 
-	originalSendingApp, _ := originalMSH.Get("3")
-	originalSendingFacility, _ := originalMSH.Get("4")
-	originalReceivingApp, _ := originalMSH.Get("5")
-	originalReceivingFacility, _ := originalMSH.Get("6")
+	originalSendingApp := encodedField(originalMSH, 3, delims)
+	originalSendingFacility := encodedField(originalMSH, 4, delims)
+	originalReceivingApp := encodedField(originalMSH, 5, delims)
+	originalReceivingFacility := encodedField(originalMSH, 6, delims)
 
 	// MSH-3: Sending Application (was receiving)
-	if err := seg.Set("3", originalReceivingApp); err != nil {
+	if err := setField(seg, 3, originalReceivingApp, delims); err != nil {
 		return nil, fmt.Errorf("setting MSH-3: %w", err)
 	}
 
 	// MSH-4: Sending Facility (was receiving)
-	if err := seg.Set("4", originalReceivingFacility); err != nil {
+	if err := setField(seg, 4, originalReceivingFacility, delims); err != nil {
 		return nil, fmt.Errorf("setting MSH-4: %w", err)
 	}
 
 	// MSH-5: Receiving Application (was sending)
-	if err := seg.Set("5", originalSendingApp); err != nil {
+	if err := setField(seg, 5, originalSendingApp, delims); err != nil {
 		return nil, fmt.Errorf("setting MSH-5: %w", err)
 	}
 
 	// MSH-6: Receiving Facility (was sending)
-	if err := seg.Set("6", originalSendingFacility); err != nil {
+	if err := setField(seg, 6, originalSendingFacility, delims); err != nil {
 		return nil, fmt.Errorf("setting MSH-6: %w", err)
 	}
 
 	// MSH-7: Date/Time of Message
-	timestamp := b.timeFunc().Format("20060102150405")
+	now := b.timeFunc()
+	timestamp := now.Format("20060102150405")
 	if err := seg.Set("7", timestamp); err != nil {
 		return nil, fmt.Errorf("setting MSH-7: %w", err)
 	}
 
-	// MSH-9: Message Type (ACK)
-	// Format: ACK^<trigger event from original>
-	originalMsgType, _ := originalMSH.Get("9")
-	ackMsgType := "ACK"
-	if originalMsgType != "" {
-		// Extract trigger event (second component)
-		// Parse using delimiters to get component
-		if field, ok := originalMSH.Field(9); ok {
-			if rep, ok := field.Repetition(0); ok {
-				if comp, ok := rep.Component(2); ok {
-					triggerEvent := comp.Value()
-					if triggerEvent != "" {
-						ackMsgType = fmt.Sprintf("ACK%c%s", delims.Component, triggerEvent)
-					}
-				}
-			}
-		}
+	// MSH-9: Message Type
+	// Format: <response type>^<trigger event>^<structure>, e.g. ACK^A01 or
+	// ORL^O22^ORL_O22; the trigger event defaults to the original's
+	triggerEvent := resp.TriggerEvent
+	if triggerEvent == "" {
+		triggerEvent, _ = originalMSH.Get("9.2")
+	}
+	msgType := []string{resp.MessageType, triggerEvent, resp.Structure}
+	for len(msgType) > 1 && msgType[len(msgType)-1] == "" {
+		msgType = msgType[:len(msgType)-1]
 	}
-	if err := seg.Set("9", ackMsgType); err != nil {
+	if err := setField(seg, 9, strings.Join(msgType, string(delims.Component)), delims); err != nil {
 		return nil, fmt.Errorf("setting MSH-9: %w", err)
 	}
 
 	// MSH-10: Message Control ID (unique for the ACK)
-	controlID := b.controlIDFunc()
+	controlID, err := b.controlID(now)
+	if err != nil {
+		return nil, fmt.Errorf("generating control ID: %w", err)
+	}
 	if err := seg.Set("10", controlID); err != nil {
 		return nil, fmt.Errorf("setting MSH-10: %w", err)
 	}
 
 	// MSH-11: Processing ID (copy from original)
-	processingID, _ := originalMSH.Get("11")
+	processingID := encodedField(originalMSH, 11, delims)
 	if processingID != "" {
-		if err := seg.Set("11", processingID); err != nil {
+		if err := setField(seg, 11, processingID, delims); err != nil {
 			return nil, fmt.Errorf("setting MSH-11: %w", err)
 		}
 	}
 
 	// MSH-12: Version ID (copy from original)
-	versionID, _ := originalMSH.Get("12")
+	versionID := encodedField(originalMSH, 12, delims)
 	if versionID != "" {
-		if err := seg.Set("12", versionID); err != nil {
+		if err := setField(seg, 12, versionID, delims); err != nil {
 			return nil, fmt.Errorf("setting MSH-12: %w", err)
 		}
 	}
@@ -334,14 +529,46 @@ func (b *builder) buildMSHSegment(originalMSH hl7.Segment, delims *hl7.Delimiter
 	return seg, nil
 }
 
-// buildMSASegment creates the MSA (Message Acknowledgment) segment.
-func (b *builder) buildMSASegment(delims *hl7.Delimiters, ack ACK) (hl7.Segment, error) {
-	var seg hl7.Segment
+// controlID returns the MSH-10 value of an ACK created at now.
+func (b *builder) controlID(now time.Time) (string, error) {
+	if b.controlIDs != nil {
+		return b.controlIDs.Next()
+	}
+	return controlid.Default().At(now), nil
+}
+
+// newSegment creates an empty segment using the message factory if set.
+func (b *builder) newSegment(name string, delims *hl7.Delimiters) hl7.Segment {
 	if b.messageFactory != nil {
-		seg = b.messageFactory.NewSegment("MSA", delims)
-	} else {
-		seg = newSimpleSegment("MSA", delims)
+		return b.messageFactory.NewSegment(name, delims)
 	}
+	return hl7.NewSegment(name)
+}
+
+// encodedField returns field seq of seg encoded with delims, or "" if the
+// segment does not have it.
+func encodedField(seg hl7.Segment, seq int, delims *hl7.Delimiters) string {
+	field, ok := seg.Field(seq)
+	if !ok || field == nil {
+		return ""
+	}
+	return string(field.Bytes(delims))
+}
+
+// setField sets field seq of seg to an encoded value, which may contain
+// repetition, component and subcomponent delimiters, so that its parts can
+// be read with Get.
+func setField(seg hl7.Segment, seq int, value string, delims *hl7.Delimiters) error {
+	field, err := hl7.ParseField(seq, []rune(value), delims)
+	if err != nil {
+		return err
+	}
+	return seg.SetField(seq, field)
+}
+
+// buildMSASegment creates the MSA (Message Acknowledgment) segment.
+func (b *builder) buildMSASegment(delims *hl7.Delimiters, ack ACK) (hl7.Segment, error) {
+	seg := b.newSegment("MSA", delims)
 
 	// MSA-1: Acknowledgment Code
 	if err := seg.Set("1", string(ack.Code)); err != nil {
@@ -353,61 +580,12 @@ func (b *builder) buildMSASegment(delims *hl7.Delimiters, ack ACK) (hl7.Segment,
 		return nil, fmt.Errorf("setting MSA-2: %w", err)
 	}
 
-	// MSA-3: Text Message (optional)
+	// MSA-3: Text Message (optional), escaped as it often holds error text
 	if ack.TextMessage != "" {
-		if err := seg.Set("3", ack.TextMessage); err != nil {
+		if err := seg.Set("3", escape.New(delims).Escape(ack.TextMessage)); err != nil {
 			return nil, fmt.Errorf("setting MSA-3: %w", err)
 		}
 	}
 
 	return seg, nil
 }
-
-// buildERRSegment creates the ERR (Error) segment for error/reject ACKs.
-func (b *builder) buildERRSegment(delims *hl7.Delimiters, ack ACK) (hl7.Segment, error) {
-	var seg hl7.Segment
-	if b.messageFactory != nil {
-		seg = b.messageFactory.NewSegment("ERR", delims)
-	} else {
-		seg = newSimpleSegment("ERR", delims)
-	}
-
-	// ERR-1: Error Code and Location (HL7 v2.3 and earlier)
-	// For backward compatibility, we set this if ErrorLocation is provided
-	if ack.ErrorLocation != "" {
-		if err := seg.Set("1", ack.ErrorLocation); err != nil {
-			return nil, fmt.Errorf("setting ERR-1: %w", err)
-		}
-	}
-
-	// ERR-2: Error Location (HL7 v2.4+)
-	// This is a more structured location in newer versions
-	if ack.ErrorLocation != "" {
-		if err := seg.Set("2", ack.ErrorLocation); err != nil {
-			return nil, fmt.Errorf("setting ERR-2: %w", err)
-		}
-	}
-
-	// ERR-3: HL7 Error Code (HL7 v2.5+)
-	if ack.ErrorCode != "" {
-		if err := seg.Set("3", ack.ErrorCode); err != nil {
-			return nil, fmt.Errorf("setting ERR-3: %w", err)
-		}
-	}
-
-	// ERR-4: Severity (HL7 v2.5+)
-	if ack.Severity != "" {
-		if err := seg.Set("4", ack.Severity); err != nil {
-			return nil, fmt.Errorf("setting ERR-4: %w", err)
-		}
-	}
-
-	// ERR-7: Diagnostic Information (HL7 v2.5+)
-	if ack.ErrorMessage != "" {
-		if err := seg.Set("7", ack.ErrorMessage); err != nil {
-			return nil, fmt.Errorf("setting ERR-7: %w", err)
-		}
-	}
-
-	return seg, nil
-}
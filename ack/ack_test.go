@@ -6,27 +6,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dshills/golevel7/controlid"
 	"github.com/dshills/golevel7/hl7"
 )
 
 // mockMessage creates a simple mock message for testing.
 func mockMessage(sendingApp, sendingFacility, receivingApp, receivingFacility, msgType, controlID, processingID, version string) hl7.Message {
 	delims := hl7.DefaultDelimiters()
-	msg := newSimpleMessage(delims)
-
-	msh := newSimpleSegment("MSH", delims)
-	msh.fields[3] = sendingApp
-	msh.fields[4] = sendingFacility
-	msh.fields[5] = receivingApp
-	msh.fields[6] = receivingFacility
-	msh.fields[7] = "20240101120000"
-	msh.fields[9] = msgType
-	msh.fields[10] = controlID
-	msh.fields[11] = processingID
-	msh.fields[12] = version
-
-	_ = msg.AddSegment(msh)
-	return msg
+	data := strings.Join([]string{
+		"MSH", "^~\\&", sendingApp, sendingFacility, receivingApp, receivingFacility,
+		"20240101120000", "", msgType, controlID, processingID, version,
+	}, "|")
+
+	msh, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		panic(err)
+	}
+	return hl7.NewMessage([]hl7.Segment{msh}, delims)
 }
 
 // mockADTMessage creates a mock ADT^A01 message for testing.
@@ -224,8 +220,8 @@ func TestBuilder_Error(t *testing.T) {
 
 	// ERR-3 should have error code
 	errCode, _ := errSeg.Get("3")
-	if errCode != "207" {
-		t.Errorf("ERR-3 = %q, want 207", errCode)
+	if errCode != "207^Application internal error^HL70357" {
+		t.Errorf("ERR-3 = %q, want 207^Application internal error^HL70357", errCode)
 	}
 
 	// ERR-4 should have severity
@@ -313,9 +309,9 @@ func TestBuilder_CustomWithERRSegment(t *testing.T) {
 		expected string
 		desc     string
 	}{
-		{"1", "PID-3-1", "ERR-1 should have error location"},
-		{"2", "PID-3-1", "ERR-2 should have error location"},
-		{"3", "101", "ERR-3 should have error code"},
+		{"1", "", "ERR-1 should be empty in v2.5"},
+		{"2", "PID^1^3^1^1", "ERR-2 should have error location"},
+		{"3", "101^Required field missing^HL70357", "ERR-3 should have error code"},
 		{"4", "E", "ERR-4 should have severity"},
 		{"7", "Patient ID is required", "ERR-7 should have diagnostic info"},
 	}
@@ -370,6 +366,73 @@ func TestBuilder_MissingControlID(t *testing.T) {
 	}
 }
 
+func TestBuilder_ControlIDGenerator(t *testing.T) {
+	counter, err := controlid.NewCounter(controlid.WithPrefix("ACK"), controlid.WithWidth(4))
+	if err != nil {
+		t.Fatalf("NewCounter() error = %v", err)
+	}
+	b := NewBuilder(WithControlIDGenerator(counter))
+
+	for _, want := range []string{"ACK0001", "ACK0002"} {
+		ackMsg, err := b.Accept(mockADTMessage())
+		if err != nil {
+			t.Fatalf("Accept() error = %v", err)
+		}
+		if got := ackMsg.ControlID(); got != want {
+			t.Errorf("MSH-10 = %q, want %q", got, want)
+		}
+	}
+
+	full, _ := controlid.NewCounter(controlid.WithMaxLength(1), controlid.WithStart(10))
+	b = NewBuilder(WithControlIDGenerator(full))
+	if _, err := b.Accept(mockADTMessage()); !errors.Is(err, controlid.ErrTooLong) {
+		t.Errorf("Accept() error = %v, want controlid.ErrTooLong", err)
+	}
+}
+
+func TestBuilder_NilControlIDOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{"nil func", WithControlIDFunc(nil)},
+		{"nil generator", WithControlIDGenerator(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ackMsg, err := NewBuilder(tt.opt).Accept(mockADTMessage())
+			if err != nil {
+				t.Fatalf("Accept() error = %v", err)
+			}
+			if ackMsg.ControlID() == "" {
+				t.Error("MSH-10 is empty, want the default control ID")
+			}
+		})
+	}
+}
+
+func TestBuilder_DefaultControlID(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	b := NewBuilder(WithTimeFunc(func() time.Time { return now }))
+
+	first, err := b.Accept(mockADTMessage())
+	if err != nil {
+		t.Fatalf("Accept() error = %v", err)
+	}
+	second, err := b.Accept(mockADTMessage())
+	if err != nil {
+		t.Fatalf("Accept() error = %v", err)
+	}
+
+	id := first.ControlID()
+	if len(id) != controlid.MaxLength || !strings.HasPrefix(id, "20240115103000") {
+		t.Errorf("MSH-10 = %q, want 20 characters starting with the MSH-7 time", id)
+	}
+	if id == second.ControlID() {
+		t.Errorf("MSH-10 = %q for both ACKs, want unique IDs", id)
+	}
+}
+
 func TestBuilder_InvalidACKCode(t *testing.T) {
 	b := NewBuilder()
 	original := mockADTMessage()
@@ -622,10 +685,10 @@ type testMessageFactory struct {
 
 func (f *testMessageFactory) NewMessage(delims *hl7.Delimiters) hl7.Message {
 	f.newMessageCalled = true
-	return newSimpleMessage(delims)
+	return hl7.NewMessageWithDelimiters(delims)
 }
 
 func (f *testMessageFactory) NewSegment(name string, delims *hl7.Delimiters) hl7.Segment {
 	f.newSegmentCalls++
-	return newSimpleSegment(name, delims)
+	return hl7.NewSegment(name)
 }
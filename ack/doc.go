@@ -96,39 +96,134 @@
 //	    }),
 //	)
 //
+// # Validation Results
+//
+// Builder.Validation acknowledges a validate.ValidationResult with one ERR
+// segment per failure: ERR-2 holds the location as an ERL
+// (segment^sequence^field^repetition^component^subcomponent), ERR-3 the
+// coded error (207 if the rule has none), ERR-4 the severity and ERR-7 the
+// diagnostic text. Valid results are answered with AA, which still carries
+// ERR segments for warnings. A ValidationPolicy chooses AE or AR otherwise:
+//
+//	b := ack.NewBuilder(ack.WithValidationPolicy(ack.RejectOnCodes("100", "200")))
+//	result := validator.Validate(msg)
+//	ackMsg, err := b.Validation(msg, result)
+//
+// FromValidation returns the same data as an ACK for use with Custom.
+//
+// # ERR Segment Layout
+//
+// ERR segments follow the version of the acknowledged message (MSH-12).
+// For v2.5 and later each error gets its own ERR segment: ERR-2 location,
+// ERR-3 error code as a CWE with coding system HL70357, ERR-4 severity,
+// ERR-5 application error code, ERR-7 diagnostics, ERR-8 user message and
+// ERR-12 help desk contact:
+//
+//	ERR||PID^1^3^1|101^Required field missing^HL70357|E||||Patient ID is required
+//
+// For v2.4 and earlier a single ERR segment carries one ERR-1 (Error Code
+// and Location) repetition per error:
+//
+//	ERR|PID^1^3^101&Required field missing&HL70357~OBX^2^5^102&Data type error&HL70357
+//
 // # Original Mode vs Enhanced Mode
 //
-// HL7 supports two acknowledgment modes:
+// HL7 supports two acknowledgment modes, chosen by the sender in MSH-15
+// (Accept Acknowledgment Type) and MSH-16 (Application Acknowledgment Type):
 //
-// Original Mode (default):
-//   - Single ACK for each message
-//   - MSA segment only
+// Original Mode (MSH-15 and MSH-16 empty):
+//   - Single application ACK (AA, AE or AR) for each message
 //
 // Enhanced Mode:
-//   - Separate Accept and Application acknowledgments
-//   - More detailed error reporting
-//   - Additional ERR segment details
+//   - Commit ACK (CA, CE or CR) once the message is safely stored, usually
+//     sent by the transport layer
+//   - Application ACK (AA, AE or AR) after processing
+//   - Each is sent according to its condition: AL (always), NE (never),
+//     ER (errors only) or SU (success only)
+//
+// ModeOf, AcceptCondition and ApplicationCondition read the mode and
+// conditions, and NeedsCommitACK and NeedsApplicationACK decide whether an
+// ACK with a given code must be sent:
+//
+//	if ack.NeedsCommitACK(msg, ack.CommitAccept) {
+//	    commit, err := b.CommitAccept(msg)
+//	    // send commit
+//	}
 //
-// Example enhanced mode:
+//	// later, after processing
+//	if ack.NeedsApplicationACK(msg, ack.ApplicationAccept) {
+//	    reply, err := b.Accept(msg)
+//	    // send reply
+//	}
 //
-//	ackMsg, err := ack.Generate(msg,
-//	    ack.Accept(),
-//	    ack.WithEnhancedMode(true),
-//	    ack.WithAcceptAckType("CA"),  // Commit Accept
-//	)
+// # Response Message Types
+//
+// By default every message is acknowledged with ACK and the original trigger
+// event (ACK^A01). WithMessageStructure adds MSH-9.3 (ACK^A01^ACK).
+// WithResponses maps message types to their own responses, which repeat
+// segments of the original after MSA and ERR. DefaultResponses covers order
+// (ORR, ORL), pharmacy (RRE, RRD, RRG, RRA) and query (RSP with QAK and
+// QPD) responses, and can be extended:
+//
+//	responses := ack.DefaultResponses()
+//	responses["OMG^O19"] = ack.Response{
+//	    MessageType:  "ORG",
+//	    TriggerEvent: "O20",
+//	    Structure:    "ORG_O20",
+//	    Echo:         []string{"PID", "ORC", "OBR"},
+//	}
+//	b := ack.NewBuilder(ack.WithResponses(responses))
 //
-// # Message Control ID
+// Responses only apply to application-level acknowledgments. Commit ACKs
+// (CA, CE, CR) are always general ACKs, and General builds one for any code,
+// e.g. for transport-level NAKs.
 //
-// The ACK message control ID can be auto-generated or specified:
+// ORR and ORL build ORR^O02 and ORL^O22 order responses directly. Echoed
+// ORC segments carry the order control code in ORC-1, OK or UA by default:
 //
-//	// Auto-generate (default)
-//	ackMsg, err := ack.Generate(msg, ack.Accept())
+//	resp, err := b.ORR(msg, ack.NewAcceptACK(msg.ControlID()), ack.CanceledAsRequested)
 //
-//	// Use specific control ID
-//	ackMsg, err := ack.Generate(msg,
-//	    ack.Accept(),
-//	    ack.WithControlID("ACK-12345"),
+// # Parsing Acknowledgments
+//
+// Parse decodes an ACK received from another system, e.g. the response of
+// mllp.Client.Send, into an ACK and its ErrorDetails from both ERR layouts,
+// and checks MSA-2 against the control ID of the message sent. Outcome tells
+// whether the message was accepted, may be sent again, or failed for good:
+//
+//	resp, err := client.Send(ctx, msg)
+//	if err != nil {
+//	    return err
+//	}
+//	result, details, err := ack.Parse(resp, ack.ExpectControlID(msg.ControlID()))
+//	if err != nil {
+//	    return err
+//	}
+//	switch result.Outcome() {
+//	case ack.Success:
+//	case ack.Retryable:
+//	    // queue for another attempt
+//	case ack.PermanentFailure:
+//	    for _, d := range details {
+//	        log.Printf("%s: %s %s", d.Location, d.Code, d.Message)
+//	    }
+//	}
+//
+// # Message Control ID
+//
+// Each ACK gets its own control ID in MSH-10. By default it is the UTC
+// timestamp followed by a six-digit sequence number, e.g.
+// "20240115103000000042". WithControlIDGenerator takes any generator from
+// the controlid package, e.g. a counter that survives restarts:
+//
+//	counter, err := controlid.NewCounter(
+//	    controlid.WithPrefix("ACK"),
+//	    controlid.WithWidth(10),
+//	    controlid.WithFile("/var/lib/myapp/ack.seq"),
 //	)
+//	b := ack.NewBuilder(ack.WithControlIDGenerator(counter))
+//
+// Builder methods return the generator's error if it fails, e.g.
+// controlid.ErrTooLong once a counter outgrows MSH-10.
 //
 // # Example: Complete ACK Workflow
 //
@@ -187,5 +282,5 @@
 //
 //	MSH|^~\&|RECEIVING_APP|RECEIVING_FAC|SENDING_APP|SENDING_FAC|20240115120000||ACK^A01|ACK12346|P|2.5.1
 //	MSA|AE|MSG12345|Patient ID not found
-//	ERR|||100|E||||Patient identifier is required in PID-3
+//	ERR||PID^1^3^1|100^Segment sequence error^HL70357|E||||Patient identifier is required in PID-3
 package ack
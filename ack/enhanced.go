@@ -0,0 +1,148 @@
+package ack
+
+import (
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// Mode is the acknowledgment mode requested by a message.
+type Mode int
+
+// Acknowledgment modes.
+const (
+	// OriginalMode acknowledges each message once with AA, AE or AR. It is
+	// used when MSH-15 and MSH-16 are both empty.
+	OriginalMode Mode = iota
+
+	// EnhancedMode acknowledges a message in two steps: a commit ACK (CA,
+	// CE or CR) when the receiver has safely stored it, typically sent by
+	// the transport layer, and an application ACK (AA, AE or AR) after
+	// processing. MSH-15 and MSH-16 say which of them are wanted.
+	EnhancedMode
+)
+
+// String returns "original" or "enhanced".
+func (m Mode) String() string {
+	if m == EnhancedMode {
+		return "enhanced"
+	}
+	return "original"
+}
+
+// Condition is an HL7 acknowledgment condition (table 0155), used in MSH-15
+// (Accept Acknowledgment Type) and MSH-16 (Application Acknowledgment Type).
+type Condition string
+
+// Acknowledgment conditions.
+const (
+	// Always requests an acknowledgment in all cases (AL).
+	Always Condition = "AL"
+
+	// Never requests no acknowledgment (NE).
+	Never Condition = "NE"
+
+	// OnError requests an acknowledgment only for errors and rejections (ER).
+	OnError Condition = "ER"
+
+	// OnSuccess requests an acknowledgment only for successful completion (SU).
+	OnSuccess Condition = "SU"
+)
+
+// IsValid returns true if the condition is one of AL, NE, ER and SU.
+func (c Condition) IsValid() bool {
+	switch c {
+	case Always, Never, OnError, OnSuccess:
+		return true
+	default:
+		return false
+	}
+}
+
+// Requires reports whether an acknowledgment with the given code must be
+// sent under the condition. Unknown conditions are treated as Always.
+func (c Condition) Requires(code Code) bool {
+	switch c {
+	case Never:
+		return false
+	case OnError:
+		return !code.IsAccept()
+	case OnSuccess:
+		return code.IsAccept()
+	default:
+		return true
+	}
+}
+
+// IsCommit returns true if the code is a commit-level code (CA, CE or CR)
+// used in enhanced mode.
+func (c Code) IsCommit() bool {
+	return c == CommitAccept || c == CommitError || c == CommitReject
+}
+
+// ModeOf returns the acknowledgment mode requested by a message: enhanced
+// if MSH-15 or MSH-16 is set, original otherwise.
+func ModeOf(msg hl7.Message) Mode {
+	accept, application := ackConditions(msg)
+	if accept != "" || application != "" {
+		return EnhancedMode
+	}
+	return OriginalMode
+}
+
+// AcceptCondition returns the condition for commit ACKs from MSH-15. It is
+// Never in original mode and Always if MSH-15 is empty in enhanced mode.
+func AcceptCondition(msg hl7.Message) Condition {
+	accept, application := ackConditions(msg)
+	switch {
+	case accept != "":
+		return accept
+	case application != "":
+		return Always
+	default:
+		return Never
+	}
+}
+
+// ApplicationCondition returns the condition for application ACKs from
+// MSH-16. It is Always in original mode and if MSH-16 is empty.
+func ApplicationCondition(msg hl7.Message) Condition {
+	if _, application := ackConditions(msg); application != "" {
+		return application
+	}
+	return Always
+}
+
+// NeedsCommitACK reports whether a commit ACK with the given code (CA, CE
+// or CR) must be sent for msg. Messages in original mode never get one.
+//
+// Example, in a transport handler:
+//
+//	if ack.NeedsCommitACK(msg, ack.CommitAccept) {
+//	    reply, err := builder.CommitAccept(msg)
+//	    ...
+//	}
+func NeedsCommitACK(msg hl7.Message, code Code) bool {
+	return AcceptCondition(msg).Requires(code)
+}
+
+// NeedsApplicationACK reports whether an application ACK with the given code
+// (AA, AE or AR) must be sent for msg. Messages in original mode always get
+// one.
+func NeedsApplicationACK(msg hl7.Message, code Code) bool {
+	return ApplicationCondition(msg).Requires(code)
+}
+
+// ackConditions returns MSH-15 and MSH-16 of msg, upper-cased.
+func ackConditions(msg hl7.Message) (accept, application Condition) {
+	if msg == nil {
+		return "", ""
+	}
+	msh, ok := msg.Segment("MSH")
+	if !ok {
+		return "", ""
+	}
+	a, _ := msh.Get("15")
+	b, _ := msh.Get("16")
+	return Condition(strings.ToUpper(strings.TrimSpace(a))), Condition(strings.ToUpper(strings.TrimSpace(b)))
+}
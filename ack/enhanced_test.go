@@ -0,0 +1,154 @@
+package ack
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// enhancedMessage returns an ADT^A01 message with MSH-15 and MSH-16 set.
+func enhancedMessage(t *testing.T, accept, application string) hl7.Message {
+	t.Helper()
+	msg := mockADTMessage()
+	msh, _ := msg.Segment("MSH")
+	if err := msh.Set("15", accept); err != nil {
+		t.Fatalf("Set(15) error = %v", err)
+	}
+	if err := msh.Set("16", application); err != nil {
+		t.Fatalf("Set(16) error = %v", err)
+	}
+	return msg
+}
+
+func TestModeOf(t *testing.T) {
+	if got := ModeOf(mockADTMessage()); got != OriginalMode {
+		t.Errorf("ModeOf(original) = %v, want original", got)
+	}
+	if got := ModeOf(enhancedMessage(t, "AL", "")); got != EnhancedMode {
+		t.Errorf("ModeOf(MSH-15=AL) = %v, want enhanced", got)
+	}
+	if got := ModeOf(nil); got != OriginalMode {
+		t.Errorf("ModeOf(nil) = %v, want original", got)
+	}
+}
+
+func TestConditions(t *testing.T) {
+	tests := []struct {
+		name        string
+		accept      string
+		application string
+		wantAccept  Condition
+		wantApp     Condition
+	}{
+		{"original mode", "", "", Never, Always},
+		{"both set", "AL", "ER", Always, OnError},
+		{"lower case", "ne", "su", Never, OnSuccess},
+		{"accept only", "ER", "", OnError, Always},
+		{"application only", "", "NE", Always, Never},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := enhancedMessage(t, tt.accept, tt.application)
+			if got := AcceptCondition(msg); got != tt.wantAccept {
+				t.Errorf("AcceptCondition() = %q, want %q", got, tt.wantAccept)
+			}
+			if got := ApplicationCondition(msg); got != tt.wantApp {
+				t.Errorf("ApplicationCondition() = %q, want %q", got, tt.wantApp)
+			}
+		})
+	}
+}
+
+func TestCondition_Requires(t *testing.T) {
+	tests := []struct {
+		cond Condition
+		code Code
+		want bool
+	}{
+		{Always, ApplicationAccept, true},
+		{Always, ApplicationError, true},
+		{Never, ApplicationAccept, false},
+		{Never, CommitReject, false},
+		{OnError, CommitAccept, false},
+		{OnError, CommitError, true},
+		{OnError, ApplicationReject, true},
+		{OnSuccess, ApplicationAccept, true},
+		{OnSuccess, ApplicationError, false},
+		{Condition("XX"), ApplicationError, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.cond.Requires(tt.code); got != tt.want {
+			t.Errorf("%s.Requires(%s) = %v, want %v", tt.cond, tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestNeedsACK(t *testing.T) {
+	original := mockADTMessage()
+	if NeedsCommitACK(original, CommitAccept) {
+		t.Error("NeedsCommitACK(original mode) = true, want false")
+	}
+	if !NeedsApplicationACK(original, ApplicationAccept) {
+		t.Error("NeedsApplicationACK(original mode) = false, want true")
+	}
+
+	enhanced := enhancedMessage(t, "AL", "ER")
+	if !NeedsCommitACK(enhanced, CommitAccept) {
+		t.Error("NeedsCommitACK(AL, CA) = false, want true")
+	}
+	if NeedsApplicationACK(enhanced, ApplicationAccept) {
+		t.Error("NeedsApplicationACK(ER, AA) = true, want false")
+	}
+	if !NeedsApplicationACK(enhanced, ApplicationError) {
+		t.Error("NeedsApplicationACK(ER, AE) = false, want true")
+	}
+}
+
+func TestBuilder_Commit(t *testing.T) {
+	b := NewBuilder(WithControlIDFunc(func() string { return "ACK001" }))
+	original := enhancedMessage(t, "AL", "AL")
+
+	tests := []struct {
+		name     string
+		build    func() (hl7.Message, error)
+		wantCode Code
+		wantText string
+		wantERR  bool
+	}{
+		{"accept", func() (hl7.Message, error) { return b.CommitAccept(original) }, CommitAccept, "", false},
+		{"error", func() (hl7.Message, error) { return b.CommitError(original, errors.New("disk full")) }, CommitError, "disk full", true},
+		{"reject", func() (hl7.Message, error) { return b.CommitReject(original, "queue closed") }, CommitReject, "queue closed", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ackMsg, err := tt.build()
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			msa, ok := ackMsg.Segment("MSA")
+			if !ok {
+				t.Fatal("ACK missing MSA segment")
+			}
+			if code, _ := msa.Get("1"); code != string(tt.wantCode) {
+				t.Errorf("MSA-1 = %q, want %q", code, tt.wantCode)
+			}
+			if id, _ := msa.Get("2"); id != "MSG001" {
+				t.Errorf("MSA-2 = %q, want MSG001", id)
+			}
+			if text, _ := msa.Get("3"); text != tt.wantText {
+				t.Errorf("MSA-3 = %q, want %q", text, tt.wantText)
+			}
+			if _, ok := ackMsg.Segment("ERR"); ok != tt.wantERR {
+				t.Errorf("ERR segment present = %v, want %v", ok, tt.wantERR)
+			}
+		})
+	}
+
+	if _, err := b.CommitAccept(nil); !errors.Is(err, ErrNilMessage) {
+		t.Errorf("CommitAccept(nil) error = %v, want ErrNilMessage", err)
+	}
+}
@@ -0,0 +1,230 @@
+package ack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/escape"
+)
+
+// errorCodeSystem is the coding system of HL7 error codes (table 0357).
+const errorCodeSystem = "HL70357"
+
+// errorCodeText holds the descriptions of the HL7 error codes (table 0357).
+var errorCodeText = map[string]string{
+	"0":   "Message accepted",
+	"100": "Segment sequence error",
+	"101": "Required field missing",
+	"102": "Data type error",
+	"103": "Table value not found",
+	"104": "Value too long",
+	"200": "Unsupported message type",
+	"201": "Unsupported event code",
+	"202": "Unsupported processing id",
+	"203": "Unsupported version id",
+	"204": "Unknown key identifier",
+	"205": "Duplicate key identifier",
+	"206": "Application record locked",
+	"207": "Application internal error",
+}
+
+// errorDetails returns the errors to report in ERR segments: the one built
+// from ErrorCode, ErrorLocation and ErrorMessage if NeedsERRSegment, then
+// Errors.
+func errorDetails(ack ACK) []ErrorDetail {
+	var details []ErrorDetail
+	if ack.NeedsERRSegment() {
+		details = append(details, ErrorDetail{
+			Location: ack.ErrorLocation,
+			Code:     ack.ErrorCode,
+			Severity: ack.Severity,
+			Message:  ack.ErrorMessage,
+		})
+	}
+	return append(details, ack.Errors...)
+}
+
+// usesErrorLocationField reports whether ACKs for version report errors in
+// ERR-1 (Error Code and Location), as in HL7 v2.4 and earlier, rather than
+// in ERR-2 to ERR-12. Empty and unknown versions use the v2.5 layout.
+func usesErrorLocationField(version string) bool {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	major, err1 := strconv.Atoi(parts[0])
+	minor, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return major < 2 || (major == 2 && minor < 5)
+}
+
+// buildERRSegments creates the ERR segments for the errors of an ACK in the
+// layout of the acknowledged version: a single ERR segment with one ERR-1
+// repetition per error for v2.4 and earlier, and one ERR segment per error
+// for v2.5 and later.
+func (b *builder) buildERRSegments(delims *hl7.Delimiters, version string, details []ErrorDetail) ([]hl7.Segment, error) {
+	if len(details) == 0 {
+		return nil, nil
+	}
+
+	if usesErrorLocationField(version) {
+		seg, err := b.buildLegacyERRSegment(delims, details)
+		if err != nil {
+			return nil, err
+		}
+		return []hl7.Segment{seg}, nil
+	}
+
+	segs := make([]hl7.Segment, 0, len(details))
+	for i, detail := range details {
+		seg, err := b.buildDetailERRSegment(delims, detail)
+		if err != nil {
+			return nil, fmt.Errorf("ERR segment %d: %w", i+1, err)
+		}
+		segs = append(segs, seg)
+	}
+	return segs, nil
+}
+
+// buildLegacyERRSegment creates a v2.3/v2.4 ERR segment with one ERR-1
+// repetition per error. ERR-1 is an ELD: segment ID, segment sequence, field
+// position and the error code as a CE in subcomponents.
+func (b *builder) buildLegacyERRSegment(delims *hl7.Delimiters, details []ErrorDetail) (hl7.Segment, error) {
+	seg := b.newSegment("ERR", delims)
+	esc := escape.New(delims)
+
+	reps := make([]string, len(details))
+	for i, detail := range details {
+		eld := make([]string, 4)
+		if erl := errorLocation(detail.Location); erl != nil {
+			copy(eld, erl[:min(len(erl), 3)])
+		}
+		code := codedError(errorCode(detail))
+		for j, v := range code {
+			code[j] = esc.Escape(v)
+		}
+		eld[3] = strings.Join(code, string(delims.SubComponent))
+		reps[i] = strings.TrimRight(strings.Join(eld, string(delims.Component)), string(delims.Component))
+	}
+
+	if err := setField(seg, 1, strings.Join(reps, string(delims.Repetition)), delims); err != nil {
+		return nil, fmt.Errorf("setting ERR-1: %w", err)
+	}
+	return seg, nil
+}
+
+// buildDetailERRSegment creates a v2.5+ ERR segment for an error with ERR-2
+// (location), ERR-3 (HL7 error code), ERR-4 (severity), ERR-5 (application
+// error code), ERR-7 (diagnostics), ERR-8 (user message) and ERR-12 (help
+// desk contact).
+func (b *builder) buildDetailERRSegment(delims *hl7.Delimiters, detail ErrorDetail) (hl7.Segment, error) {
+	seg := b.newSegment("ERR", delims)
+	esc := escape.New(delims)
+
+	// ERR-2: Error Location as ERL components
+	for i, value := range errorLocation(detail.Location) {
+		if value == "" {
+			continue
+		}
+		if err := seg.Set(fmt.Sprintf("2.%d", i+1), value); err != nil {
+			return nil, fmt.Errorf("setting ERR-2.%d: %w", i+1, err)
+		}
+	}
+
+	// ERR-3: HL7 Error Code as a CWE
+	for i, value := range codedError(errorCode(detail)) {
+		if err := seg.Set(fmt.Sprintf("3.%d", i+1), esc.Escape(value)); err != nil {
+			return nil, fmt.Errorf("setting ERR-3.%d: %w", i+1, err)
+		}
+	}
+
+	severity := detail.Severity
+	if severity == "" {
+		severity = "E"
+	}
+
+	fields := []struct {
+		seq   string
+		value string
+	}{
+		{"4", severity},
+		{"5", esc.Escape(detail.ApplicationCode)},
+		// Diagnostic text may contain delimiters, e.g. "expected ADT^A01"
+		{"7", esc.Escape(detail.Message)},
+		{"8", esc.Escape(detail.UserMessage)},
+		{"12", esc.Escape(detail.HelpDeskContact)},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		if err := seg.Set(f.seq, f.value); err != nil {
+			return nil, fmt.Errorf("setting ERR-%s: %w", f.seq, err)
+		}
+	}
+
+	return seg, nil
+}
+
+// errorCode returns the HL7 error code of a detail, 207 (application
+// internal error) if it has none.
+func errorCode(detail ErrorDetail) string {
+	if detail.Code == "" {
+		return "207"
+	}
+	return detail.Code
+}
+
+// codedError returns the components of an HL7 error code as a CWE or CE:
+// identifier, text and coding system. Codes not in table 0357 have only an
+// identifier.
+func codedError(code string) []string {
+	text, ok := errorCodeText[code]
+	if !ok {
+		return []string{code}
+	}
+	return []string{code, text, errorCodeSystem}
+}
+
+// errorLocation converts an HL7 location such as "OBX[1].5[0].2", or the
+// dash-separated form "PID-3-1", into ERL components: segment ID, segment
+// sequence, field position, field repetition, component and subcomponent,
+// with 1-based sequence and repetition numbers. It returns nil if the
+// location cannot be parsed.
+func errorLocation(location string) []string {
+	if location == "" {
+		return nil
+	}
+	loc, err := hl7.ParseLocation(location)
+	if err != nil {
+		if loc, err = hl7.ParseLocation(strings.ReplaceAll(location, "-", ".")); err != nil {
+			return nil
+		}
+	}
+
+	seq := 1
+	if loc.HasSegmentIndex() {
+		seq = loc.SegmentIndex + 1
+	}
+	erl := []string{loc.Segment, strconv.Itoa(seq)}
+	if !loc.HasField() {
+		return erl
+	}
+
+	rep := 1
+	if loc.HasRepetition() {
+		rep = loc.Repetition + 1
+	}
+	erl = append(erl, strconv.Itoa(loc.Field), strconv.Itoa(rep))
+	if loc.HasComponent() {
+		erl = append(erl, strconv.Itoa(loc.Component))
+	}
+	if loc.HasSubComponent() {
+		erl = append(erl, strconv.Itoa(loc.SubComponent))
+	}
+	return erl
+}
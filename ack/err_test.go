@@ -0,0 +1,109 @@
+package ack
+
+import (
+	"testing"
+)
+
+func TestUsesErrorLocationField(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"2.3", true},
+		{"2.3.1", true},
+		{"2.4", true},
+		{"2.5", false},
+		{"2.5.1", false},
+		{"2.8", false},
+		{"", false},
+		{"v2", false},
+	}
+
+	for _, tt := range tests {
+		if got := usesErrorLocationField(tt.version); got != tt.want {
+			t.Errorf("usesErrorLocationField(%q) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestBuilder_ERRSegments(t *testing.T) {
+	b := NewBuilder(WithControlIDFunc(func() string { return "ACK001" }))
+	customACK := ACK{
+		Code:          ApplicationError,
+		ControlID:     "MSG001",
+		ErrorCode:     "101",
+		ErrorLocation: "PID.3",
+		ErrorMessage:  "Patient ID is required",
+		Errors: []ErrorDetail{
+			{
+				Location:        "OBX[1].5",
+				Code:            "102",
+				Severity:        "W",
+				Message:         "expected NM",
+				ApplicationCode: "LAB42",
+				UserMessage:     "Check the result value",
+				HelpDeskContact: "x1234",
+			},
+			{Code: "999"},
+		},
+	}
+
+	t.Run("v2.5 one segment per error", func(t *testing.T) {
+		original := mockMessage("A", "B", "C", "D", "ADT^A01", "MSG001", "P", "2.5.1")
+		ackMsg, err := b.Custom(original, customACK)
+		if err != nil {
+			t.Fatalf("Custom() error = %v", err)
+		}
+
+		errSegs := ackMsg.Segments("ERR")
+		if len(errSegs) != 3 {
+			t.Fatalf("ERR segments = %d, want 3", len(errSegs))
+		}
+
+		tests := []struct {
+			seg   int
+			field string
+			want  string
+		}{
+			{0, "1", ""},
+			{0, "2", "PID^1^3^1"},
+			{0, "3", "101^Required field missing^HL70357"},
+			{0, "4", "E"},
+			{0, "7", "Patient ID is required"},
+			{1, "2", "OBX^2^5^1"},
+			{1, "3", "102^Data type error^HL70357"},
+			{1, "4", "W"},
+			{1, "5", "LAB42"},
+			{1, "8", "Check the result value"},
+			{1, "12", "x1234"},
+			{2, "2", ""},
+			{2, "3", "999"},
+		}
+		for _, tt := range tests {
+			if got, _ := errSegs[tt.seg].Get(tt.field); got != tt.want {
+				t.Errorf("ERR[%d]-%s = %q, want %q", tt.seg, tt.field, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("v2.4 single segment with ERR-1 repetitions", func(t *testing.T) {
+		original := mockMessage("A", "B", "C", "D", "ADT^A01", "MSG001", "P", "2.4")
+		ackMsg, err := b.Custom(original, customACK)
+		if err != nil {
+			t.Fatalf("Custom() error = %v", err)
+		}
+
+		errSegs := ackMsg.Segments("ERR")
+		if len(errSegs) != 1 {
+			t.Fatalf("ERR segments = %d, want 1", len(errSegs))
+		}
+
+		want := "PID^1^3^101&Required field missing&HL70357~OBX^2^5^102&Data type error&HL70357~^^^999"
+		if got, _ := errSegs[0].Get("1"); got != want {
+			t.Errorf("ERR-1 = %q, want %q", got, want)
+		}
+		if got, _ := errSegs[0].Get("2"); got != "" {
+			t.Errorf("ERR-2 = %q, want empty", got)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package ack
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/parse"
+)
+
+// TestParity checks that built ACKs encode to bytes that parse.Parser reads
+// back unchanged, and that values read from the built message match those
+// read from the parsed one.
+func TestParity(t *testing.T) {
+	b := NewBuilder(WithControlIDFunc(func() string { return "ACK001" }))
+	order := parseWire(t, ormMessage)
+
+	tests := []struct {
+		name      string
+		build     func() (hl7.Message, error)
+		locations []string
+	}{
+		{
+			name:      "accept",
+			build:     func() (hl7.Message, error) { return b.Accept(mockADTMessage()) },
+			locations: []string{"MSH.3", "MSH.9.1", "MSH.9.2", "MSH.10", "MSH.12", "MSA.1", "MSA.2"},
+		},
+		{
+			name: "error text with delimiters",
+			build: func() (hl7.Message, error) {
+				return b.Error(mockADTMessage(), errors.New("expected ADT^A01 | got ORU~R01 & more"))
+			},
+			locations: []string{"MSA.1", "MSA.3", "ERR.3.1", "ERR.3.3", "ERR.4", "ERR.7"},
+		},
+		{
+			name: "v2.5 error details",
+			build: func() (hl7.Message, error) {
+				return b.Custom(mockADTMessage(), ACK{
+					Code:      ApplicationError,
+					ControlID: "MSG001",
+					Errors: []ErrorDetail{
+						{Location: "PID.3.1", Code: "101", Severity: "E"},
+						{Location: "NK1[1].2", Code: "103", Severity: "W", UserMessage: "check \\ value"},
+					},
+				})
+			},
+			locations: []string{"ERR.2.1", "ERR.2.3", "ERR[1].2.2", "ERR[1].3.2", "ERR[1].8"},
+		},
+		{
+			name: "v2.4 error location field",
+			build: func() (hl7.Message, error) {
+				original := mockMessage("A", "B", "C", "D", "ADT^A01", "MSG001", "P", "2.4")
+				return b.Custom(original, ACK{
+					Code:      ApplicationReject,
+					ControlID: "MSG001",
+					Errors:    []ErrorDetail{{Location: "PID.3", Code: "101"}, {Code: "207"}},
+				})
+			},
+			locations: []string{"ERR.1.1", "ERR.1.4.1", "ERR.1[1].4.1", "ERR.1[1].4.3"},
+		},
+		{
+			name:      "order response",
+			build:     func() (hl7.Message, error) { return b.ORR(order, NewAcceptACK("ORD001"), "") },
+			locations: []string{"MSH.9.3", "PID.3.1", "PID.5.2", "ORC.1", "ORC[1].2", "OBR[1].4.2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			built, err := tt.build()
+			if err != nil {
+				t.Fatalf("build error = %v", err)
+			}
+
+			parsed, err := parse.New().Parse(built.Bytes())
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got, want := string(parsed.Bytes()), string(built.Bytes()); got != want {
+				t.Errorf("round trip bytes:\n got %q\nwant %q", got, want)
+			}
+
+			for _, loc := range tt.locations {
+				want, err := built.Get(loc)
+				if err != nil {
+					t.Errorf("built Get(%s) error = %v", loc, err)
+					continue
+				}
+				if want == "" {
+					t.Errorf("built Get(%s) is empty", loc)
+				}
+				if got, _ := parsed.Get(loc); got != want {
+					t.Errorf("Get(%s) = %q after parsing, want %q", loc, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParity_CustomDelimiters(t *testing.T) {
+	original := parseWire(t, "MSH#$*!%#APP$1.2$ISO#FAC#RCV#RFAC#20240115##ADT$A01#MSG001#P#2.5.1\r")
+
+	built, err := NewBuilder().Error(original, errors.New("bad $ value"))
+	if err != nil {
+		t.Fatalf("Error() error = %v", err)
+	}
+	parsed, err := parse.New().Parse(built.Bytes())
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if got, _ := parsed.Get("MSH.5.2"); got != "1.2" {
+		t.Errorf("MSH-5.2 = %q, want 1.2", got)
+	}
+	if !strings.Contains(string(built.Bytes()), "#ACK$A01#") {
+		t.Errorf("Bytes() = %q, want MSH-9 ACK$A01", built.Bytes())
+	}
+	result, _, err := Parse(parsed, ExpectControlID("MSG001"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if result.TextMessage != "bad $ value" {
+		t.Errorf("TextMessage = %q, want %q", result.TextMessage, "bad $ value")
+	}
+}
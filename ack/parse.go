@@ -0,0 +1,263 @@
+package ack
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/escape"
+)
+
+// Errors returned by Parse.
+var (
+	// ErrMissingMSA indicates the message has no MSA segment.
+	ErrMissingMSA = errors.New("message missing MSA segment")
+
+	// ErrControlIDMismatch indicates MSA-2 does not match the control ID
+	// of the message being acknowledged.
+	ErrControlIDMismatch = errors.New("acknowledged control ID mismatch")
+)
+
+// Outcome classifies an acknowledgment from the sender's point of view.
+type Outcome int
+
+// Acknowledgment outcomes.
+const (
+	// Success means the message was accepted (AA or CA).
+	Success Outcome = iota
+
+	// Retryable means the message was not accepted but may succeed if sent
+	// again, e.g. after a reject caused by a system or communication error.
+	Retryable
+
+	// PermanentFailure means the message was not accepted and sending it
+	// again unchanged will fail the same way.
+	PermanentFailure
+)
+
+// String returns "success", "retryable" or "permanent failure".
+func (o Outcome) String() string {
+	switch o {
+	case Success:
+		return "success"
+	case Retryable:
+		return "retryable"
+	case PermanentFailure:
+		return "permanent failure"
+	default:
+		return fmt.Sprintf("Outcome(%d)", int(o))
+	}
+}
+
+// Outcome classifies the acknowledgment:
+//   - AA and CA are a Success, even with warnings in Errors.
+//   - AE and CE are a PermanentFailure, the message has to be corrected,
+//     unless an error is 206 (application record locked), which is Retryable.
+//   - AR and CR are Retryable, the receiver could not process the message,
+//     unless an error is 200 to 203 (unsupported message type, event,
+//     processing ID or version), which is a PermanentFailure.
+//
+// Unknown codes are a PermanentFailure.
+func (a ACK) Outcome() Outcome {
+	switch {
+	case a.Code.IsAccept():
+		return Success
+	case a.Code.IsError():
+		if a.hasErrorCode("206") {
+			return Retryable
+		}
+		return PermanentFailure
+	case a.Code.IsReject():
+		if a.hasErrorCode("200", "201", "202", "203") {
+			return PermanentFailure
+		}
+		return Retryable
+	default:
+		return PermanentFailure
+	}
+}
+
+// hasErrorCode returns true if ErrorCode or one of Errors has one of codes.
+func (a ACK) hasErrorCode(codes ...string) bool {
+	for _, code := range codes {
+		if a.ErrorCode == code {
+			return true
+		}
+		for _, detail := range a.Errors {
+			if detail.Code == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParseOption configures Parse.
+type ParseOption func(*parseConfig)
+
+// parseConfig holds the Parse configuration.
+type parseConfig struct {
+	controlID string
+}
+
+// ExpectControlID makes Parse check that MSA-2 equals the control ID (MSH-10)
+// of the message that was sent. A mismatch is reported as
+// ErrControlIDMismatch.
+func ExpectControlID(controlID string) ParseOption {
+	return func(c *parseConfig) {
+		c.controlID = controlID
+	}
+}
+
+// Parse decodes a received acknowledgment message. The ACK holds MSA-1 (Code),
+// MSA-2 (ControlID) and MSA-3 (TextMessage), and its Errors, also returned
+// as the second value, hold one ErrorDetail per error in the ERR segments:
+// per ERR segment for v2.5 and later, and per ERR-1 repetition for v2.4 and
+// earlier. Locations are returned in the form used by hl7.ParseLocation,
+// e.g. "PID.3.1" or "OBX[1].5".
+//
+// Parse returns ErrMissingMSA if there is no MSA segment, ErrInvalidACKCode
+// if MSA-1 is not an acknowledgment code, and ErrControlIDMismatch if MSA-2
+// differs from ExpectControlID. The decoded ACK is returned with the last
+// two errors so callers can still inspect it.
+//
+// Example, with an MLLP client:
+//
+//	resp, err := client.Send(ctx, msg)
+//	...
+//	result, _, err := ack.Parse(resp, ack.ExpectControlID(msg.ControlID()))
+//	if err == nil && result.Outcome() == ack.Retryable {
+//	    // send again later
+//	}
+func Parse(msg hl7.Message, opts ...ParseOption) (ACK, []ErrorDetail, error) {
+	if msg == nil {
+		return ACK{}, nil, ErrNilMessage
+	}
+
+	cfg := &parseConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	msa, ok := msg.Segment("MSA")
+	if !ok {
+		return ACK{}, nil, ErrMissingMSA
+	}
+
+	delims := msg.Delimiters()
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+	esc := escape.New(delims)
+
+	code, _ := msa.Get("1")
+	controlID, _ := msa.Get("2")
+	text, _ := msa.Get("3")
+	result := ACK{
+		Code:        Code(strings.ToUpper(strings.TrimSpace(code))),
+		ControlID:   controlID,
+		TextMessage: esc.Unescape(text),
+	}
+
+	for _, seg := range msg.Segments("ERR") {
+		result.Errors = append(result.Errors, parseERRSegment(seg, delims, esc)...)
+	}
+
+	if !result.Code.IsValid() {
+		return result, result.Errors, fmt.Errorf("%w: MSA-1 %q", ErrInvalidACKCode, code)
+	}
+	if cfg.controlID != "" && controlID != cfg.controlID {
+		return result, result.Errors, fmt.Errorf("%w: MSA-2 is %q, want %q", ErrControlIDMismatch, controlID, cfg.controlID)
+	}
+	return result, result.Errors, nil
+}
+
+// parseERRSegment decodes the errors in an ERR segment: one per ERR-1
+// repetition if ERR-1 is set (v2.4 and earlier), one from ERR-2 to ERR-12
+// otherwise.
+func parseERRSegment(seg hl7.Segment, delims *hl7.Delimiters, esc *escape.Escaper) []ErrorDetail {
+	if details := parseErrorLocationField(seg, delims, esc); len(details) > 0 {
+		return details
+	}
+
+	field := func(seq string) string {
+		v, _ := seg.Get(seq)
+		return v
+	}
+	component := func(value string, i int) string {
+		parts := strings.Split(value, string(delims.Component))
+		if i > len(parts) {
+			return ""
+		}
+		return esc.Unescape(parts[i-1])
+	}
+
+	return []ErrorDetail{{
+		Location:        parsedLocation(strings.Split(field("2"), string(delims.Component))),
+		Code:            component(field("3"), 1),
+		Severity:        field("4"),
+		ApplicationCode: component(field("5"), 1),
+		Message:         esc.Unescape(field("7")),
+		UserMessage:     esc.Unescape(field("8")),
+		HelpDeskContact: esc.Unescape(field("12")),
+	}}
+}
+
+// parseErrorLocationField decodes the ELD repetitions of ERR-1: segment ID,
+// sequence, field position and the error code as a CE in subcomponents.
+func parseErrorLocationField(seg hl7.Segment, delims *hl7.Delimiters, esc *escape.Escaper) []ErrorDetail {
+	values, _ := seg.GetAll("1")
+
+	var details []ErrorDetail
+	for _, value := range values {
+		for _, rep := range strings.Split(value, string(delims.Repetition)) {
+			if rep == "" {
+				continue
+			}
+			eld := strings.Split(rep, string(delims.Component))
+			var code string
+			if len(eld) > 3 {
+				code = esc.Unescape(strings.Split(eld[3], string(delims.SubComponent))[0])
+			}
+			details = append(details, ErrorDetail{
+				Location: parsedLocation(eld[:min(len(eld), 3)]),
+				Code:     code,
+			})
+		}
+	}
+	return details
+}
+
+// parsedLocation converts ERL or ELD components (segment ID, segment
+// sequence, field position, field repetition, component, subcomponent) into
+// an HL7 location. A sequence or repetition of 1 is left out, so
+// errorLocation and parsedLocation round-trip "PID.3.1". It returns "" if
+// there is no segment ID.
+func parsedLocation(parts []string) string {
+	if len(parts) == 0 || parts[0] == "" {
+		return ""
+	}
+
+	// n returns the 1-based number at position i, -1 if missing
+	n := func(i int) int {
+		if i >= len(parts) {
+			return -1
+		}
+		v, err := strconv.Atoi(parts[i])
+		if err != nil || v < 1 {
+			return -1
+		}
+		return v
+	}
+	// first returns the 0-based index for a 1-based number, -1 for the first
+	first := func(v int) int {
+		if v <= 1 {
+			return -1
+		}
+		return v - 1
+	}
+
+	return hl7.NewLocationFull(parts[0], first(n(1)), n(2), first(n(3)), n(4), n(5)).String()
+}
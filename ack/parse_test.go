@@ -0,0 +1,193 @@
+package ack
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/parse"
+)
+
+func parseWire(t *testing.T, data string) hl7.Message {
+	t.Helper()
+	msg, err := parse.New().Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return msg
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantACK     ACK
+		wantOutcome Outcome
+	}{
+		{
+			name: "accept",
+			data: "MSH|^~\\&|RCV|FAC|SND|FAC|20240115||ACK^A01^ACK|ACK1|P|2.5.1\r" +
+				"MSA|AA|MSG001|Message accepted\r",
+			wantACK:     ACK{Code: ApplicationAccept, ControlID: "MSG001", TextMessage: "Message accepted"},
+			wantOutcome: Success,
+		},
+		{
+			name: "v2.5 errors",
+			data: "MSH|^~\\&|RCV|FAC|SND|FAC|20240115||ACK^A01^ACK|ACK1|P|2.5.1\r" +
+				"MSA|AE|MSG001|Validation \\T\\ checks failed\r" +
+				"ERR||PID^1^3^1^1|101^Required field missing^HL70357|E|||Patient ID is required\r" +
+				"ERR||OBX^2^5^1|102^Data type error^HL70357|W|LAB42^Lab||expected NM \\S\\ value|Check value||||x1234\r",
+			wantACK: ACK{
+				Code:        ApplicationError,
+				ControlID:   "MSG001",
+				TextMessage: "Validation & checks failed",
+				Errors: []ErrorDetail{
+					{Location: "PID.3.1", Code: "101", Severity: "E", Message: "Patient ID is required"},
+					{
+						Location:        "OBX[1].5",
+						Code:            "102",
+						Severity:        "W",
+						Message:         "expected NM ^ value",
+						ApplicationCode: "LAB42",
+						UserMessage:     "Check value",
+						HelpDeskContact: "x1234",
+					},
+				},
+			},
+			wantOutcome: PermanentFailure,
+		},
+		{
+			name: "v2.3 error location field",
+			data: "MSH|^~\\&|RCV|FAC|SND|FAC|20240115||ACK^A01|ACK1|P|2.3\r" +
+				"MSA|AR|MSG001\r" +
+				"ERR|PID^1^3^101&Required field missing&HL70357~^^^207\r",
+			wantACK: ACK{
+				Code:      ApplicationReject,
+				ControlID: "MSG001",
+				Errors: []ErrorDetail{
+					{Location: "PID.3", Code: "101"},
+					{Code: "207"},
+				},
+			},
+			wantOutcome: Retryable,
+		},
+		{
+			name: "unsupported version reject",
+			data: "MSH|^~\\&|RCV|FAC|SND|FAC|20240115||ACK|ACK1|P|2.5\r" +
+				"MSA|AR|MSG001\r" +
+				"ERR|||203^Unsupported version id^HL70357|E\r",
+			wantACK: ACK{
+				Code:      ApplicationReject,
+				ControlID: "MSG001",
+				Errors:    []ErrorDetail{{Code: "203", Severity: "E"}},
+			},
+			wantOutcome: PermanentFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, details, err := Parse(parseWire(t, tt.data), ExpectControlID("MSG001"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.wantACK) {
+				t.Errorf("Parse() ACK = %+v, want %+v", got, tt.wantACK)
+			}
+			if !reflect.DeepEqual(details, tt.wantACK.Errors) {
+				t.Errorf("Parse() details = %+v, want %+v", details, tt.wantACK.Errors)
+			}
+			if o := got.Outcome(); o != tt.wantOutcome {
+				t.Errorf("Outcome() = %v, want %v", o, tt.wantOutcome)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	const header = "MSH|^~\\&|RCV|FAC|SND|FAC|20240115||ACK|ACK1|P|2.5.1\r"
+
+	if _, _, err := Parse(nil); !errors.Is(err, ErrNilMessage) {
+		t.Errorf("Parse(nil) error = %v, want ErrNilMessage", err)
+	}
+	if _, _, err := Parse(parseWire(t, header)); !errors.Is(err, ErrMissingMSA) {
+		t.Errorf("Parse(no MSA) error = %v, want ErrMissingMSA", err)
+	}
+	if _, _, err := Parse(parseWire(t, header+"MSA|XX|MSG001\r")); !errors.Is(err, ErrInvalidACKCode) {
+		t.Errorf("Parse(MSA-1 XX) error = %v, want ErrInvalidACKCode", err)
+	}
+
+	got, _, err := Parse(parseWire(t, header+"MSA|AA|OTHER\r"), ExpectControlID("MSG001"))
+	if !errors.Is(err, ErrControlIDMismatch) {
+		t.Errorf("Parse(MSA-2 OTHER) error = %v, want ErrControlIDMismatch", err)
+	}
+	if got.ControlID != "OTHER" {
+		t.Errorf("Parse() ControlID = %q, want OTHER alongside the error", got.ControlID)
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	b := NewBuilder()
+	sent := ACK{
+		Code:      ApplicationError,
+		ControlID: "MSG001",
+		Errors: []ErrorDetail{
+			{Location: "PID.3.1", Code: "101", Severity: "E", Message: "expected ADT^A01"},
+			{Location: "NK1[1].2", Code: "103", Severity: "W"},
+		},
+	}
+
+	// ERR-1 in v2.4 and earlier locates errors down to the field only
+	tests := []struct {
+		version       string
+		wantLocations []string
+	}{
+		{"2.4", []string{"PID.3", "NK1[1].2"}},
+		{"2.5.1", []string{"PID.3.1", "NK1[1].2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			original := mockMessage("A", "B", "C", "D", "ADT^A01", "MSG001", "P", tt.version)
+			ackMsg, err := b.Custom(original, sent)
+			if err != nil {
+				t.Fatalf("Custom() error = %v", err)
+			}
+
+			got, details, err := Parse(parseWire(t, ackMsg.String()), ExpectControlID("MSG001"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got.Code != sent.Code || len(details) != len(sent.Errors) {
+				t.Fatalf("Parse() = %+v, want %+v", got, sent)
+			}
+			for i, d := range details {
+				if d.Location != tt.wantLocations[i] || d.Code != sent.Errors[i].Code {
+					t.Errorf("details[%d] = %+v, want location %q and code %q", i, d, tt.wantLocations[i], sent.Errors[i].Code)
+				}
+			}
+		})
+	}
+}
+
+func TestACK_Outcome(t *testing.T) {
+	tests := []struct {
+		ack  ACK
+		want Outcome
+	}{
+		{ACK{Code: ApplicationAccept}, Success},
+		{ACK{Code: CommitAccept, Errors: []ErrorDetail{{Code: "101", Severity: "W"}}}, Success},
+		{ACK{Code: ApplicationError}, PermanentFailure},
+		{ACK{Code: ApplicationError, ErrorCode: "206"}, Retryable},
+		{ACK{Code: CommitReject}, Retryable},
+		{ACK{Code: ApplicationReject, Errors: []ErrorDetail{{Code: "200"}}}, PermanentFailure},
+		{ACK{Code: "XX"}, PermanentFailure},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ack.Outcome(); got != tt.want {
+			t.Errorf("%+v.Outcome() = %v, want %v", tt.ack, got, tt.want)
+		}
+	}
+}
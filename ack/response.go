@@ -0,0 +1,226 @@
+package ack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// Response describes the message an original message is acknowledged with.
+// Most messages are acknowledged with a general ACK, but order, pharmacy
+// and query messages have their own responses that repeat parts of the
+// original, e.g. ORR^O02 for ORM^O01 and ORL^O22 for OML^O21.
+type Response struct {
+	// MessageType is the response message type placed in MSH-9.1, e.g. "ORL".
+	MessageType string
+
+	// TriggerEvent is the response trigger event placed in MSH-9.2, e.g.
+	// "O22". If empty, the trigger event of the original is used.
+	TriggerEvent string
+
+	// Structure is the message structure placed in MSH-9.3, e.g. "ORL_O22".
+	// If empty, MSH-9.3 is left unset.
+	Structure string
+
+	// Echo lists segments copied from the original after MSA and ERR, in
+	// the order they appear in the original. ORC-1 of echoed ORC segments
+	// holds the order control code of the response.
+	Echo []string
+
+	// QueryAck adds a QAK segment before the echoed segments, with the query
+	// tag from QPD-2 in QAK-1 and the query response status in QAK-2: NF
+	// (no data found) for accepts, AE or AR otherwise.
+	QueryAck bool
+}
+
+// Responses maps original message types to the responses they are
+// acknowledged with. Keys are a message type and trigger event such as
+// "OML^O21", or a message type alone such as "OML" for all its trigger
+// events. Message types without a response are acknowledged with ACK.
+type Responses map[string]Response
+
+// Order responses built by ORR and ORL.
+var (
+	orrO02 = Response{MessageType: "ORR", TriggerEvent: "O02", Structure: "ORR_O02", Echo: []string{"PID", "ORC", "OBR"}}
+	orlO22 = Response{MessageType: "ORL", TriggerEvent: "O22", Structure: "ORL_O22", Echo: []string{"PID", "ORC", "OBR"}}
+)
+
+// DefaultResponses returns the standard responses for order, pharmacy and
+// query messages:
+//
+//	ORM^O01 -> ORR^O02   RDE^O11 -> RRE^O12   QBP^Q11 -> RSP^K11
+//	OML^O21 -> ORL^O22   RDE^O25 -> RRE^O26   QBP^Q21 -> RSP^K21
+//	OML^O33 -> ORL^O34   RDS^O13 -> RRD^O14   QBP^Q22 -> RSP^K22
+//	OML^O35 -> ORL^O36   RGV^O15 -> RRG^O16   QBP^Q23 -> RSP^K23
+//	                     RAS^O17 -> RRA^O18
+//
+// Each call returns a new map, which may be changed before passing it to
+// WithResponses.
+func DefaultResponses() Responses {
+	specimen := []string{"PID", "SPM", "ORC", "OBR"}
+	return Responses{
+		"ORM^O01": orrO02,
+		"OML^O21": orlO22,
+		"OML^O33": {MessageType: "ORL", TriggerEvent: "O34", Structure: "ORL_O34", Echo: specimen},
+		"OML^O35": {MessageType: "ORL", TriggerEvent: "O36", Structure: "ORL_O36", Echo: specimen},
+		"RDE^O11": {MessageType: "RRE", TriggerEvent: "O12", Structure: "RRE_O12", Echo: []string{"PID", "ORC", "RXE"}},
+		"RDE^O25": {MessageType: "RRE", TriggerEvent: "O26", Structure: "RRE_O26", Echo: []string{"PID", "ORC", "RXE"}},
+		"RDS^O13": {MessageType: "RRD", TriggerEvent: "O14", Structure: "RRD_O14", Echo: []string{"PID", "ORC", "RXD"}},
+		"RGV^O15": {MessageType: "RRG", TriggerEvent: "O16", Structure: "RRG_O16", Echo: []string{"PID", "ORC", "RXG"}},
+		"RAS^O17": {MessageType: "RRA", TriggerEvent: "O18", Structure: "RRA_O18", Echo: []string{"PID", "ORC", "RXA"}},
+		"QBP^Q11": {MessageType: "RSP", TriggerEvent: "K11", Structure: "RSP_K11", Echo: []string{"QPD"}, QueryAck: true},
+		"QBP^Q21": {MessageType: "RSP", TriggerEvent: "K21", Structure: "RSP_K21", Echo: []string{"QPD"}, QueryAck: true},
+		"QBP^Q22": {MessageType: "RSP", TriggerEvent: "K22", Structure: "RSP_K21", Echo: []string{"QPD"}, QueryAck: true},
+		"QBP^Q23": {MessageType: "RSP", TriggerEvent: "K23", Structure: "RSP_K23", Echo: []string{"QPD"}, QueryAck: true},
+	}
+}
+
+// Lookup returns the response for a message type and trigger event, trying
+// "TYPE^TRIGGER" before "TYPE".
+func (r Responses) Lookup(messageType, triggerEvent string) (Response, bool) {
+	if resp, ok := r[messageType+"^"+triggerEvent]; ok {
+		return resp, true
+	}
+	resp, ok := r[messageType]
+	return resp, ok
+}
+
+// OrderControl is an HL7 order control code (table 0119) placed in ORC-1 of
+// order responses.
+type OrderControl string
+
+// Order control codes used in responses.
+const (
+	// OrderAccepted means the order was accepted and is OK (OK).
+	OrderAccepted OrderControl = "OK"
+
+	// UnableToAccept means the order was not accepted (UA).
+	UnableToAccept OrderControl = "UA"
+
+	// CanceledAsRequested confirms a cancel request (CR).
+	CanceledAsRequested OrderControl = "CR"
+
+	// UnableToCancel refuses a cancel request (UC).
+	UnableToCancel OrderControl = "UC"
+
+	// DiscontinuedAsRequested confirms a discontinue request (DR).
+	DiscontinuedAsRequested OrderControl = "DR"
+
+	// UnableToDiscontinue refuses a discontinue request (UD).
+	UnableToDiscontinue OrderControl = "UD"
+)
+
+// orderControlFor returns the order control code for an acknowledgment code
+// when none is given: OK for accepts and UA otherwise.
+func orderControlFor(code Code) OrderControl {
+	if code.IsAccept() {
+		return OrderAccepted
+	}
+	return UnableToAccept
+}
+
+// ORR creates an ORR^O02 general order response.
+func (b *builder) ORR(original hl7.Message, ack ACK, control OrderControl) (hl7.Message, error) {
+	return b.respond(original, ack, &orrO02, control)
+}
+
+// ORL creates an ORL^O22 laboratory order response.
+func (b *builder) ORL(original hl7.Message, ack ACK, control OrderControl) (hl7.Message, error) {
+	return b.respond(original, ack, &orlO22, control)
+}
+
+// responseFor returns the application-level response for an original
+// message: the one from the builder's Responses if its type is mapped, ACK
+// otherwise.
+func (b *builder) responseFor(originalMSH hl7.Segment) Response {
+	messageType, _ := originalMSH.Get("9.1")
+	triggerEvent, _ := originalMSH.Get("9.2")
+	if resp, ok := b.responses.Lookup(messageType, triggerEvent); ok {
+		return resp
+	}
+	return b.generalResponse()
+}
+
+// generalResponse returns the response for a general acknowledgment: ACK
+// with the original trigger event.
+func (b *builder) generalResponse() Response {
+	resp := Response{MessageType: "ACK"}
+	if b.messageStructure {
+		resp.Structure = "ACK"
+	}
+	return resp
+}
+
+// buildQAKSegment creates the QAK (Query Acknowledgment) segment of a query
+// response.
+func (b *builder) buildQAKSegment(original hl7.Message, delims *hl7.Delimiters, ack ACK) (hl7.Segment, error) {
+	seg := b.newSegment("QAK", delims)
+
+	// QAK-1: Query Tag (from QPD-2)
+	if qpd, ok := original.Segment("QPD"); ok {
+		if tag, _ := qpd.Get("2"); tag != "" {
+			if err := setField(seg, 1, tag, delims); err != nil {
+				return nil, fmt.Errorf("setting QAK-1: %w", err)
+			}
+		}
+	}
+
+	// QAK-2: Query Response Status
+	status := "NF"
+	switch {
+	case ack.Code.IsError():
+		status = "AE"
+	case ack.Code.IsReject():
+		status = "AR"
+	}
+	if err := seg.Set("2", status); err != nil {
+		return nil, fmt.Errorf("setting QAK-2: %w", err)
+	}
+
+	return seg, nil
+}
+
+// echoSegments copies the segments named in names from the original, with
+// ORC-1 of ORC segments set to control.
+func (b *builder) echoSegments(original hl7.Message, delims *hl7.Delimiters, names []string, control OrderControl) ([]hl7.Segment, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	var segs []hl7.Segment
+	for _, src := range original.AllSegments() {
+		if !containsName(names, src.Name()) {
+			continue
+		}
+
+		seg := b.newSegment(src.Name(), delims)
+		for i := 1; i <= src.FieldCount(); i++ {
+			value := encodedField(src, i, delims)
+			if value == "" {
+				continue
+			}
+			if err := setField(seg, i, value, delims); err != nil {
+				return nil, fmt.Errorf("copying %s-%d: %w", src.Name(), i, err)
+			}
+		}
+
+		if src.Name() == "ORC" {
+			if err := seg.Set("1", string(control)); err != nil {
+				return nil, fmt.Errorf("setting ORC-1: %w", err)
+			}
+		}
+		segs = append(segs, seg)
+	}
+	return segs, nil
+}
+
+// containsName returns true if names contains name, ignoring case.
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
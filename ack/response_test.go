@@ -0,0 +1,212 @@
+package ack
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+const ormMessage = "MSH|^~\\&|CPOE|HOSP|LAB|HOSP|20240115||ORM^O01^ORM_O01|ORD001|P|2.5.1\r" +
+	"PID|1||12345^^^HOSP^MR||Doe^John\r" +
+	"PV1|1|I\r" +
+	"ORC|NW|PLC1\r" +
+	"OBR|1|PLC1||GLU^Glucose\r" +
+	"ORC|NW|PLC2\r" +
+	"OBR|2|PLC2||HGB^Hemoglobin\r"
+
+func segmentNames(t *testing.T, data string) []string {
+	t.Helper()
+	var names []string
+	for _, seg := range parseWire(t, data).AllSegments() {
+		names = append(names, seg.Name())
+	}
+	return names
+}
+
+func TestBuilder_ORR(t *testing.T) {
+	b := NewBuilder(WithControlIDFunc(func() string { return "ACK001" }))
+	original := parseWire(t, ormMessage)
+
+	tests := []struct {
+		name        string
+		ack         ACK
+		control     OrderControl
+		wantControl string
+	}{
+		{"accept", NewAcceptACK("ORD001"), "", "OK"},
+		{"error", NewErrorACK("ORD001", "103", "unknown test"), "", "UA"},
+		{"cancel confirmed", NewAcceptACK("ORD001"), CanceledAsRequested, "CR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := b.ORR(original, tt.ack, tt.control)
+			if err != nil {
+				t.Fatalf("ORR() error = %v", err)
+			}
+			if got, _ := resp.Get("MSH.9"); got != "ORR^O02^ORR_O02" {
+				t.Errorf("MSH-9 = %q, want ORR^O02^ORR_O02", got)
+			}
+
+			orcs := resp.Segments("ORC")
+			if len(orcs) != 2 {
+				t.Fatalf("ORC segments = %d, want 2", len(orcs))
+			}
+			for i, orc := range orcs {
+				if got, _ := orc.Get("1"); got != tt.wantControl {
+					t.Errorf("ORC[%d]-1 = %q, want %q", i, got, tt.wantControl)
+				}
+			}
+			if got, _ := orcs[1].Get("2"); got != "PLC2" {
+				t.Errorf("ORC[1]-2 = %q, want PLC2", got)
+			}
+		})
+	}
+
+	resp, err := b.ORR(original, NewAcceptACK("ORD001"), "")
+	if err != nil {
+		t.Fatalf("ORR() error = %v", err)
+	}
+	want := "MSH MSA PID ORC OBR ORC OBR"
+	if got := strings.Join(segmentNames(t, resp.String()), " "); got != want {
+		t.Errorf("segments = %q, want %q", got, want)
+	}
+}
+
+func TestBuilder_ORL(t *testing.T) {
+	b := NewBuilder()
+	original := parseWire(t, strings.Replace(ormMessage, "ORM^O01^ORM_O01", "OML^O21^OML_O21", 1))
+
+	resp, err := b.ORL(original, NewAcceptACK("ORD001"), "")
+	if err != nil {
+		t.Fatalf("ORL() error = %v", err)
+	}
+	if got, _ := resp.Get("MSH.9"); got != "ORL^O22^ORL_O22" {
+		t.Errorf("MSH-9 = %q, want ORL^O22^ORL_O22", got)
+	}
+	if got := len(resp.Segments("OBR")); got != 2 {
+		t.Errorf("OBR segments = %d, want 2", got)
+	}
+}
+
+func TestBuilder_Responses(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		data     string
+		wantType string
+		wantSegs string
+	}{
+		{
+			name:     "ACK by default",
+			data:     ormMessage,
+			wantType: "ACK^O01",
+			wantSegs: "MSH MSA",
+		},
+		{
+			name:     "message structure",
+			opts:     []Option{WithMessageStructure(true)},
+			data:     ormMessage,
+			wantType: "ACK^O01^ACK",
+			wantSegs: "MSH MSA",
+		},
+		{
+			name:     "default order response",
+			opts:     []Option{WithResponses(DefaultResponses())},
+			data:     ormMessage,
+			wantType: "ORR^O02^ORR_O02",
+			wantSegs: "MSH MSA PID ORC OBR ORC OBR",
+		},
+		{
+			name:     "unmapped type",
+			opts:     []Option{WithResponses(DefaultResponses())},
+			data:     strings.Replace(ormMessage, "ORM^O01^ORM_O01", "ADT^A01^ADT_A01", 1),
+			wantType: "ACK^A01",
+			wantSegs: "MSH MSA",
+		},
+		{
+			name:     "message type key",
+			opts:     []Option{WithResponses(Responses{"ORM": {MessageType: "ORR", Structure: "ORR_O02"}})},
+			data:     ormMessage,
+			wantType: "ORR^O01^ORR_O02",
+			wantSegs: "MSH MSA",
+		},
+		{
+			name: "query response",
+			opts: []Option{WithResponses(DefaultResponses())},
+			data: "MSH|^~\\&|EHR|HOSP|MPI|HOSP|20240115||QBP^Q22^QBP_Q21|Q001|P|2.5.1\r" +
+				"QPD|IHE PDQ Query|TAG42|@PID.5.1^Doe\r" +
+				"RCP|I\r",
+			wantType: "RSP^K22^RSP_K21",
+			wantSegs: "MSH MSA QAK QPD",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := parseWire(t, tt.data)
+			resp, err := NewBuilder(tt.opts...).Accept(original)
+			if err != nil {
+				t.Fatalf("Accept() error = %v", err)
+			}
+			if got, _ := resp.Get("MSH.9"); got != tt.wantType {
+				t.Errorf("MSH-9 = %q, want %q", got, tt.wantType)
+			}
+			if got := strings.Join(segmentNames(t, resp.String()), " "); got != tt.wantSegs {
+				t.Errorf("segments = %q, want %q", got, tt.wantSegs)
+			}
+			if qak, ok := resp.Segment("QAK"); ok {
+				tag, _ := qak.Get("1")
+				status, _ := qak.Get("2")
+				if tag != "TAG42" || status != "NF" {
+					t.Errorf("QAK = %q^%q, want TAG42 and NF", tag, status)
+				}
+			}
+		})
+	}
+}
+
+func TestBuilder_GeneralACK(t *testing.T) {
+	qbp := "MSH|^~\\&|EHR|HOSP|MPI|HOSP|20240115||QBP^Q11^QBP_Q11|Q001|P|2.5.1\r" +
+		"QPD|Z44^Request Immunization History|TAG42|12345\r" +
+		"RCP|I\r"
+	b := NewBuilder(WithResponses(DefaultResponses()), WithMessageStructure(true))
+
+	tests := []struct {
+		name     string
+		data     string
+		build    func(original hl7.Message) (hl7.Message, error)
+		wantType string
+	}{
+		{"commit accept ORM^O01", ormMessage, b.CommitAccept, "ACK^O01^ACK"},
+		{"commit accept QBP^Q11", qbp, b.CommitAccept, "ACK^Q11^ACK"},
+		{"commit error ORM^O01", ormMessage, func(m hl7.Message) (hl7.Message, error) {
+			return b.CommitError(m, errors.New("disk full"))
+		}, "ACK^O01^ACK"},
+		{"custom commit reject QBP^Q11", qbp, func(m hl7.Message) (hl7.Message, error) {
+			return b.Custom(m, ACK{Code: CommitReject, ControlID: "Q001"})
+		}, "ACK^Q11^ACK"},
+		{"general error ORM^O01", ormMessage, func(m hl7.Message) (hl7.Message, error) {
+			return b.General(m, NewErrorACK("ORD001", "207", "failed"))
+		}, "ACK^O01^ACK"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.build(parseWire(t, tt.data))
+			if err != nil {
+				t.Fatalf("build error = %v", err)
+			}
+			if got, _ := resp.Get("MSH.9"); got != tt.wantType {
+				t.Errorf("MSH-9 = %q, want %q", got, tt.wantType)
+			}
+			for _, name := range []string{"PID", "ORC", "OBR", "QAK", "QPD"} {
+				if _, ok := resp.Segment(name); ok {
+					t.Errorf("response has echoed segment %s, want a general ACK", name)
+				}
+			}
+		})
+	}
+}
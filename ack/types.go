@@ -100,6 +100,7 @@ type ACK struct {
 	//   - "101" : Required field missing
 	//   - "102" : Data type error
 	//   - "103" : Table value not found
+	//   - "104" : Value too long
 	//   - "200" : Unsupported message type
 	//   - "201" : Unsupported event code
 	//   - "202" : Unsupported processing id
@@ -111,18 +112,55 @@ type ACK struct {
 	ErrorCode string
 
 	// ErrorLocation is the HL7 location path where the error occurred.
-	// Format: "SEG-Field-Component-SubComponent" (e.g., "PID-3-1").
-	// This is placed in ERR-2 (Error Location) in HL7 v2.4+ or ERR-1 in earlier versions.
+	// Format: "PID.3.1", "OBX[2].5" or "SEG-Field-Component-SubComponent"
+	// (e.g., "PID-3-1"). This is placed in ERR-2 (Error Location) in HL7
+	// v2.5+ or ERR-1 (Error Code and Location) in earlier versions.
 	ErrorLocation string
 
 	// ErrorMessage provides additional details about the error.
-	// This is placed in ERR-7 (Diagnostic Information) in HL7 v2.5+.
+	// This is placed in ERR-7 (Diagnostic Information) in HL7 v2.5+ and
+	// omitted in earlier versions.
 	ErrorMessage string
 
 	// Severity indicates the error severity for the ERR segment.
 	// Values: "E" (Error), "W" (Warning), "I" (Information).
 	// This is placed in ERR-4 in HL7 v2.5+.
 	Severity string
+
+	// Errors lists additional errors, each reported in its own ERR segment
+	// after the one built from ErrorCode, ErrorLocation and ErrorMessage.
+	// ERR segments for Errors are included regardless of Code, so an AA may
+	// carry warnings.
+	Errors []ErrorDetail
+}
+
+// ErrorDetail describes one error reported in an ERR segment.
+type ErrorDetail struct {
+	// Location is the HL7 location of the error, e.g. "PID.3.1" or
+	// "OBX[2].5". It is placed in ERR-2 as an ERL (segment, sequence, field,
+	// repetition, component, subcomponent), or in ERR-1 as an ELD for v2.4
+	// and earlier.
+	Location string
+
+	// Code is the HL7 error code (table 0357) placed in ERR-3 as a CWE,
+	// "207" if empty.
+	Code string
+
+	// Severity is "E" (Error), "W" (Warning) or "I" (Information), placed
+	// in ERR-4.
+	Severity string
+
+	// Message is the diagnostic information placed in ERR-7.
+	Message string
+
+	// ApplicationCode is an application-specific error code placed in ERR-5.
+	ApplicationCode string
+
+	// UserMessage is a message for the end user placed in ERR-8.
+	UserMessage string
+
+	// HelpDeskContact is the contact point for the error placed in ERR-12.
+	HelpDeskContact string
 }
 
 // NewAcceptACK creates an ACK struct for accepting a message.
@@ -157,12 +195,14 @@ func NewRejectACK(controlID string, reason string) ACK {
 
 // HasError returns true if the ACK includes error information.
 func (a ACK) HasError() bool {
-	return a.ErrorCode != "" || a.ErrorLocation != "" || a.ErrorMessage != ""
+	return a.ErrorCode != "" || a.ErrorLocation != "" || a.ErrorMessage != "" || len(a.Errors) > 0
 }
 
-// NeedsERRSegment returns true if the ACK should include an ERR segment.
-// An ERR segment is included when there is error information and the
-// acknowledgment code indicates an error or reject condition.
+// NeedsERRSegment returns true if the ACK should include an ERR segment
+// built from ErrorCode, ErrorLocation and ErrorMessage. It is included when
+// there is error information and the acknowledgment code indicates an error
+// or reject condition.
 func (a ACK) NeedsERRSegment() bool {
-	return a.HasError() && (a.Code.IsError() || a.Code.IsReject())
+	hasError := a.ErrorCode != "" || a.ErrorLocation != "" || a.ErrorMessage != ""
+	return hasError && (a.Code.IsError() || a.Code.IsReject())
 }
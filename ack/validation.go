@@ -0,0 +1,115 @@
+package ack
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/validate"
+)
+
+// ValidationPolicy chooses the acknowledgment code for a message that failed
+// validation, given its validation errors.
+type ValidationPolicy func(errs []validate.ValidationError) Code
+
+// DefaultValidationPolicy rejects (AR) messages with unsupported message
+// type, event, processing ID or version errors (codes 200-203) and reports
+// all other validation failures as application errors (AE).
+var DefaultValidationPolicy = RejectOnCodes("200", "201", "202", "203")
+
+// AlwaysError is a ValidationPolicy that answers every invalid message with
+// AE.
+func AlwaysError(_ []validate.ValidationError) Code {
+	return ApplicationError
+}
+
+// AlwaysReject is a ValidationPolicy that answers every invalid message with
+// AR.
+func AlwaysReject(_ []validate.ValidationError) Code {
+	return ApplicationReject
+}
+
+// RejectOnCodes returns a ValidationPolicy that answers with AR if any error
+// carries one of the given HL7 error codes, and with AE otherwise.
+func RejectOnCodes(codes ...string) ValidationPolicy {
+	reject := make(map[string]bool, len(codes))
+	for _, c := range codes {
+		reject[c] = true
+	}
+	return func(errs []validate.ValidationError) Code {
+		for _, e := range errs {
+			if reject[e.Code] {
+				return ApplicationReject
+			}
+		}
+		return ApplicationError
+	}
+}
+
+// FromValidation converts a validation result into ACK data for the message
+// with the given control ID. Valid results produce AA; otherwise policy
+// chooses the code (DefaultValidationPolicy if nil). Every failure, including
+// warnings and informational issues, becomes an ErrorDetail.
+func FromValidation(controlID string, result validate.ValidationResult, policy ValidationPolicy) ACK {
+	if policy == nil {
+		policy = DefaultValidationPolicy
+	}
+
+	ack := NewAcceptACK(controlID)
+	if result == nil {
+		return ack
+	}
+
+	for _, e := range result.Issues() {
+		ack.Errors = append(ack.Errors, errorDetail(e))
+	}
+
+	if !result.Valid() {
+		errs := result.Errors()
+		ack.Code = policy(errs)
+		ack.TextMessage = fmt.Sprintf("message failed validation with %d error(s)", len(errs))
+	}
+
+	return ack
+}
+
+// errorDetail converts a validation failure into an ERR segment description.
+// Failures without an HL7 error code are reported as 207 (application
+// internal error).
+func errorDetail(e validate.ValidationError) ErrorDetail {
+	code := e.Code
+	if code == "" {
+		code = "207"
+	}
+	return ErrorDetail{
+		Location: e.Location,
+		Code:     code,
+		Severity: severityCode(e.Severity),
+		Message:  e.Error(),
+	}
+}
+
+// severityCode returns the HL7 table 0516 code for a severity.
+func severityCode(s hl7.Severity) string {
+	switch s {
+	case hl7.SeverityWarning:
+		return "W"
+	case hl7.SeverityInfo:
+		return "I"
+	default:
+		return "E"
+	}
+}
+
+// Validation creates an ACK for a validation result.
+func (b *builder) Validation(original hl7.Message, result validate.ValidationResult) (hl7.Message, error) {
+	if original == nil {
+		return nil, ErrNilMessage
+	}
+
+	controlID := original.ControlID()
+	if controlID == "" {
+		return nil, ErrMissingControlID
+	}
+
+	return b.Custom(original, FromValidation(controlID, result, b.validationPolicy))
+}
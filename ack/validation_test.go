@@ -0,0 +1,182 @@
+package ack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/validate"
+)
+
+// newValidationResult validates a message with missing PID-3, a bad PID-8
+// and a missing PID-13 reported as a warning.
+func newValidationResult(t *testing.T) validate.ValidationResult {
+	t.Helper()
+	msg := hl7.NewEmptyMessage()
+	pid := hl7.NewSegment("PID")
+	_ = pid.Set("8", "X")
+	_ = msg.AddSegment(pid)
+
+	return validate.New(
+		validate.At("PID.3").Required().Build(),
+		validate.At("PID.8").Table("0001").Build(),
+		validate.At("PID[0].13").Required().AsWarning().Build(),
+	).Validate(msg)
+}
+
+func TestFromValidation(t *testing.T) {
+	result := newValidationResult(t)
+
+	ack := FromValidation("MSG001", result, nil)
+	if ack.Code != ApplicationError {
+		t.Errorf("Code = %s, want AE", ack.Code)
+	}
+	if ack.ControlID != "MSG001" {
+		t.Errorf("ControlID = %q, want MSG001", ack.ControlID)
+	}
+	if len(ack.Errors) != 3 {
+		t.Fatalf("Errors = %v, want 3", ack.Errors)
+	}
+
+	want := []ErrorDetail{
+		{Location: "PID.3", Code: "101", Severity: "E"},
+		{Location: "PID.8", Code: "103", Severity: "E"},
+		{Location: "PID[0].13", Code: "101", Severity: "W"},
+	}
+	for i, w := range want {
+		got := ack.Errors[i]
+		if got.Location != w.Location || got.Code != w.Code || got.Severity != w.Severity || got.Message == "" {
+			t.Errorf("Errors[%d] = %+v, want %+v with a message", i, got, w)
+		}
+	}
+}
+
+func TestFromValidation_LengthCode(t *testing.T) {
+	msg := hl7.NewEmptyMessage()
+	pid := hl7.NewSegment("PID")
+	_ = pid.Set("3", "1234567890")
+	_ = msg.AddSegment(pid)
+	result := validate.New(validate.At("PID.3").Length(0, 5).Build()).Validate(msg)
+
+	ack := FromValidation("MSG001", result, nil)
+	if len(ack.Errors) != 1 || ack.Errors[0].Code != "104" {
+		t.Errorf("Errors = %+v, want one with code 104 (value too long)", ack.Errors)
+	}
+}
+
+func TestFromValidation_Valid(t *testing.T) {
+	result := validate.New().Validate(hl7.NewEmptyMessage())
+
+	ack := FromValidation("MSG001", result, AlwaysReject)
+	if ack.Code != ApplicationAccept || len(ack.Errors) != 0 {
+		t.Errorf("FromValidation() = %+v, want AA without errors", ack)
+	}
+}
+
+func TestValidationPolicies(t *testing.T) {
+	errs := []validate.ValidationError{{Code: "101"}, {Code: "203"}}
+
+	tests := []struct {
+		name   string
+		policy ValidationPolicy
+		errs   []validate.ValidationError
+		want   Code
+	}{
+		{"default with unsupported version", DefaultValidationPolicy, errs, ApplicationReject},
+		{"default with content error", DefaultValidationPolicy, errs[:1], ApplicationError},
+		{"always error", AlwaysError, errs, ApplicationError},
+		{"always reject", AlwaysReject, errs[:1], ApplicationReject},
+		{"reject on codes", RejectOnCodes("101"), errs[:1], ApplicationReject},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy(tt.errs); got != tt.want {
+				t.Errorf("policy() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuilder_Validation(t *testing.T) {
+	b := NewBuilder(
+		WithControlIDFunc(func() string { return "ACK001" }),
+		WithValidationPolicy(AlwaysReject),
+	)
+
+	ackMsg, err := b.Validation(mockADTMessage(), newValidationResult(t))
+	if err != nil {
+		t.Fatalf("Validation() error = %v", err)
+	}
+
+	if code, _ := ackMsg.Get("MSA.1"); code != "AR" {
+		t.Errorf("MSA-1 = %q, want AR", code)
+	}
+
+	errSegs := ackMsg.Segments("ERR")
+	if len(errSegs) != 3 {
+		t.Fatalf("ERR segments = %d, want 3", len(errSegs))
+	}
+
+	tests := []struct {
+		seg   int
+		field string
+		want  string
+	}{
+		{0, "2.1", "PID"},
+		{0, "2.2", "1"},
+		{0, "2.3", "3"},
+		{0, "2.4", "1"},
+		{0, "3.1", "101"},
+		{0, "3.3", "HL70357"},
+		{0, "4", "E"},
+		{1, "3.1", "103"},
+		{2, "2.3", "13"},
+		{2, "4", "W"},
+	}
+	for _, tt := range tests {
+		if got, _ := errSegs[tt.seg].Get(tt.field); got != tt.want {
+			t.Errorf("ERR[%d]-%s = %q, want %q", tt.seg, tt.field, got, tt.want)
+		}
+	}
+
+	diag, _ := errSegs[1].Get("7")
+	if !strings.Contains(diag, "table 0001") {
+		t.Errorf("ERR-7 = %q, want diagnostic text", diag)
+	}
+}
+
+func TestBuilder_ValidationErrors(t *testing.T) {
+	b := NewBuilder()
+	result := validate.New().Validate(hl7.NewEmptyMessage())
+
+	if _, err := b.Validation(nil, result); err != ErrNilMessage {
+		t.Errorf("Validation(nil) error = %v, want %v", err, ErrNilMessage)
+	}
+
+	noControlID := mockMessage("A", "B", "C", "D", "ADT^A01", "", "P", "2.5")
+	if _, err := b.Validation(noControlID, result); err != ErrMissingControlID {
+		t.Errorf("Validation() error = %v, want %v", err, ErrMissingControlID)
+	}
+}
+
+func TestErrorLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		want     string
+	}{
+		{"PID", "PID^1"},
+		{"NK1[1]", "NK1^2"},
+		{"PID.3", "PID^1^3^1"},
+		{"OBX[2].5[1].2.1", "OBX^3^5^2^2^1"},
+		{"PID-3-1", "PID^1^3^1^1"},
+		{"", ""},
+		{"not a location", ""},
+	}
+
+	for _, tt := range tests {
+		if got := strings.Join(errorLocation(tt.location), "^"); got != tt.want {
+			t.Errorf("errorLocation(%q) = %q, want %q", tt.location, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package controlid
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Errors returned by generators.
+var (
+	// ErrTooLong indicates that control IDs do not fit the maximum length.
+	ErrTooLong = errors.New("control ID exceeds maximum length")
+
+	// ErrExhausted indicates that a generator has no more IDs, e.g. a ULID
+	// generator that produced 2^80 IDs within one millisecond.
+	ErrExhausted = errors.New("control IDs exhausted")
+
+	// ErrInvalidState indicates that a counter file does not hold a counter.
+	ErrInvalidState = errors.New("invalid counter state")
+)
+
+// Maximum lengths of MSH-10.
+const (
+	// MaxLength is the length of MSH-10 up to HL7 v2.6.
+	MaxLength = 20
+
+	// MaxLengthV27 is the length of MSH-10 from HL7 v2.7.
+	MaxLengthV27 = 199
+)
+
+// MaxLengthFor returns the maximum length of MSH-10 for an HL7 version:
+// MaxLengthV27 for v2.7 and later, MaxLength otherwise.
+func MaxLengthFor(version string) int {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return MaxLength
+	}
+	major, err1 := strconv.Atoi(parts[0])
+	minor, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return MaxLength
+	}
+	if major > 2 || (major == 2 && minor >= 7) {
+		return MaxLengthV27
+	}
+	return MaxLength
+}
+
+// Generator produces message control IDs. Implementations are safe for
+// concurrent use.
+type Generator interface {
+	// Next returns a new control ID.
+	Next() (string, error)
+}
+
+// Func adapts a function to a Generator.
+type Func func() string
+
+// Next returns f().
+func (f Func) Next() (string, error) {
+	return f(), nil
+}
+
+// defaultGenerator is the Timestamp generator returned by Default.
+var defaultGenerator = &Timestamp{clock: time.Now, width: 6}
+
+// Default returns the Timestamp generator with default settings used by the
+// ack builder and marshal, e.g. "20240115103000000042".
+func Default() *Timestamp {
+	return defaultGenerator
+}
+
+// Option configures a generator.
+type Option func(*config)
+
+// config holds generator settings.
+type config struct {
+	prefix    string
+	maxLength int // 0 for the generator's default
+	width     int // 0 for the generator's default
+	start     uint64
+	path      string
+	blockSize uint64
+	clock     func() time.Time
+	entropy   io.Reader
+}
+
+// newConfig returns the configuration for opts.
+func newConfig(opts []Option) *config {
+	cfg := &config{
+		start:     1,
+		blockSize: 100,
+		clock:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// maxLengthOr returns the configured maximum length, or def if none is set.
+func (c *config) maxLengthOr(def int) int {
+	if c.maxLength > 0 {
+		return c.maxLength
+	}
+	return def
+}
+
+// WithPrefix puts prefix before every ID, e.g. a facility code.
+func WithPrefix(prefix string) Option {
+	return func(c *config) {
+		c.prefix = prefix
+	}
+}
+
+// WithMaxLength sets the maximum length of IDs, including the prefix.
+// Counter and Timestamp default to MaxLength, ULID to MaxLengthV27.
+func WithMaxLength(n int) Option {
+	return func(c *config) {
+		c.maxLength = n
+	}
+}
+
+// WithWidth zero-pads Counter values to n digits, and sets the number of
+// sequence digits of Timestamp IDs (default 6).
+func WithWidth(n int) Option {
+	return func(c *config) {
+		c.width = n
+	}
+}
+
+// WithStart sets the first value of a Counter. Default is 1. A counter file
+// with a higher value takes precedence.
+func WithStart(n uint64) Option {
+	return func(c *config) {
+		c.start = n
+	}
+}
+
+// WithFile persists a Counter in the file at path, so that it continues
+// after a restart.
+func WithFile(path string) Option {
+	return func(c *config) {
+		c.path = path
+	}
+}
+
+// WithBlockSize sets how many Counter values are reserved in the counter
+// file at a time. Larger blocks mean fewer writes, and more values skipped
+// after a restart. Default is 100.
+func WithBlockSize(n uint64) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.blockSize = n
+		}
+	}
+}
+
+// WithClock sets the clock of Timestamp and ULID generators. Default is
+// time.Now.
+func WithClock(fn func() time.Time) Option {
+	return func(c *config) {
+		if fn != nil {
+			c.clock = fn
+		}
+	}
+}
+
+// WithEntropy sets the source of the random bits of ULID IDs. Default is
+// crypto/rand.
+func WithEntropy(r io.Reader) Option {
+	return func(c *config) {
+		c.entropy = r
+	}
+}
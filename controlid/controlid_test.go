@@ -0,0 +1,85 @@
+package controlid
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMaxLengthFor(t *testing.T) {
+	tests := []struct {
+		version string
+		want    int
+	}{
+		{"2.3", MaxLength},
+		{"2.5.1", MaxLength},
+		{"2.6", MaxLength},
+		{"2.7", MaxLengthV27},
+		{"2.8.2", MaxLengthV27},
+		{"", MaxLength},
+		{"x.y", MaxLength},
+	}
+
+	for _, tt := range tests {
+		if got := MaxLengthFor(tt.version); got != tt.want {
+			t.Errorf("MaxLengthFor(%q) = %d, want %d", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestFunc(t *testing.T) {
+	var g Generator = Func(func() string { return "MSG001" })
+	if got, err := g.Next(); err != nil || got != "MSG001" {
+		t.Errorf("Next() = %q, %v, want MSG001", got, err)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	g, err := NewTimestamp(WithClock(func() time.Time { return now }))
+	if err != nil {
+		t.Fatalf("NewTimestamp() error = %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		id, err := g.Next()
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		if len(id) != MaxLength || id[:14] != "20240115103000" {
+			t.Fatalf("Next() = %q, want 20 characters starting with the time", id)
+		}
+		if seen[id] {
+			t.Fatalf("Next() repeated %q", id)
+		}
+		seen[id] = true
+	}
+
+	// generators share the sequence, so they do not repeat each other
+	other, _ := NewTimestamp(WithClock(func() time.Time { return now }))
+	if id, _ := other.Next(); seen[id] {
+		t.Errorf("second generator repeated %q", id)
+	}
+	if id := Default().At(now); seen[id] {
+		t.Errorf("Default().At() repeated %q", id)
+	}
+}
+
+func TestTimestamp_MaxLength(t *testing.T) {
+	if _, err := NewTimestamp(WithPrefix("A")); !errors.Is(err, ErrTooLong) {
+		t.Errorf("NewTimestamp(prefix, 21 characters) error = %v, want ErrTooLong", err)
+	}
+
+	g, err := NewTimestamp(WithPrefix("A"), WithWidth(5))
+	if err != nil {
+		t.Fatalf("NewTimestamp() error = %v", err)
+	}
+	if id, _ := g.Next(); len(id) != MaxLength || id[0] != 'A' {
+		t.Errorf("Next() = %q, want 20 characters starting with A", id)
+	}
+
+	if _, err := NewTimestamp(WithPrefix("FACILITY"), WithMaxLength(MaxLengthV27)); err != nil {
+		t.Errorf("NewTimestamp(v2.7 length) error = %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package controlid
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Counter generates IDs from a monotonic sequence, with an optional prefix
+// and zero padding, e.g. "LAB00000042".
+//
+// With WithFile the counter is persisted: before handing out a value it
+// reserves a block of values by writing the end of the block to the file,
+// so a new counter on the same file continues after the last reservation.
+// Values reserved but not used before a restart are skipped, never repeated.
+// A counter file must not be shared by processes running at the same time.
+type Counter struct {
+	mu        sync.Mutex
+	prefix    string
+	width     int
+	maxLength int
+	next      uint64 // value returned by the next call to Next
+	limit     uint64 // first value not reserved in the file
+	path      string
+	blockSize uint64
+}
+
+// NewCounter creates a Counter. It returns ErrTooLong if the prefix and
+// width do not fit the maximum length, 20 characters by default, and
+// ErrInvalidState if the counter file does not hold a counter.
+func NewCounter(opts ...Option) (*Counter, error) {
+	cfg := newConfig(opts)
+
+	c := &Counter{
+		prefix:    cfg.prefix,
+		width:     cfg.width,
+		maxLength: cfg.maxLengthOr(MaxLength),
+		next:      cfg.start,
+		path:      cfg.path,
+		blockSize: cfg.blockSize,
+	}
+	if n := len(c.prefix) + max(c.width, 1); n > c.maxLength {
+		return nil, fmt.Errorf("%w: counter IDs have at least %d characters, maximum is %d", ErrTooLong, n, c.maxLength)
+	}
+
+	if c.path != "" {
+		stored, err := readCounterFile(c.path)
+		if err != nil {
+			return nil, err
+		}
+		c.next = max(c.next, stored)
+		c.limit = c.next
+	}
+
+	return c, nil
+}
+
+// Next returns a new ID. It returns ErrTooLong once the values outgrow the
+// maximum length, and an error if the counter file cannot be written.
+func (c *Counter) Next() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := fmt.Sprintf("%s%0*d", c.prefix, c.width, c.next)
+	if len(id) > c.maxLength {
+		return "", fmt.Errorf("%w: %q has %d characters, maximum is %d", ErrTooLong, id, len(id), c.maxLength)
+	}
+
+	if c.path != "" && c.next >= c.limit {
+		limit := c.next + c.blockSize
+		if err := writeCounterFile(c.path, limit); err != nil {
+			return "", err
+		}
+		c.limit = limit
+	}
+
+	c.next++
+	return id, nil
+}
+
+// readCounterFile returns the value stored in a counter file, or 0 if the
+// file does not exist.
+func readCounterFile(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("reading counter file: %w", err)
+	}
+
+	n, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: %v", ErrInvalidState, path, err)
+	}
+	return n, nil
+}
+
+// writeCounterFile stores n in a counter file. It writes a temporary file
+// and renames it, so the file always holds a complete value.
+func writeCounterFile(path string, n uint64) error {
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+
+	tmp, err := os.CreateTemp(dir, base+".tmp*")
+	if err != nil {
+		return fmt.Errorf("writing counter file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.WriteString(strconv.FormatUint(n, 10) + "\n")
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		return fmt.Errorf("writing counter file: %w", err)
+	}
+	return nil
+}
+
+// Facilities hands out a Counter per sending facility. Each counter has the
+// facility as prefix and, if the Facilities has a directory, is persisted in
+// a file named after the hex-encoded facility in that directory, e.g.
+// "45415354.seq" for "EAST".
+type Facilities struct {
+	mu       sync.Mutex
+	dir      string
+	opts     []Option
+	counters map[string]*Counter
+}
+
+// NewFacilities creates a Facilities whose counters are persisted in dir,
+// or not persisted if dir is empty. The options apply to every counter;
+// WithPrefix and WithFile are overridden per facility.
+func NewFacilities(dir string, opts ...Option) *Facilities {
+	return &Facilities{
+		dir:      dir,
+		opts:     opts,
+		counters: make(map[string]*Counter),
+	}
+}
+
+// For returns the counter of a facility, creating it on first use. The
+// facility must not be empty.
+func (f *Facilities) For(facility string) (*Counter, error) {
+	if facility == "" {
+		return nil, errors.New("empty facility")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if c, ok := f.counters[facility]; ok {
+		return c, nil
+	}
+
+	opts := append(f.opts[:len(f.opts):len(f.opts)], WithPrefix(facility))
+	if f.dir != "" {
+		opts = append(opts, WithFile(filepath.Join(f.dir, facilityFileName(facility))))
+	}
+	c, err := NewCounter(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("facility %q: %w", facility, err)
+	}
+
+	f.counters[facility] = c
+	return c, nil
+}
+
+// facilityFileName returns the counter file name of a facility: the
+// facility in lowercase hex, so that distinct facilities never share a file,
+// even on case-insensitive file systems.
+func facilityFileName(facility string) string {
+	return hex.EncodeToString([]byte(facility)) + ".seq"
+}
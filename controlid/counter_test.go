@@ -0,0 +1,206 @@
+package controlid
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want []string
+	}{
+		{"default", nil, []string{"1", "2", "3"}},
+		{"prefix and width", []Option{WithPrefix("LAB"), WithWidth(5)}, []string{"LAB00001", "LAB00002", "LAB00003"}},
+		{"start", []Option{WithStart(98), WithWidth(2)}, []string{"98", "99", "100"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewCounter(tt.opts...)
+			if err != nil {
+				t.Fatalf("NewCounter() error = %v", err)
+			}
+			for _, want := range tt.want {
+				if got, err := c.Next(); err != nil || got != want {
+					t.Errorf("Next() = %q, %v, want %q", got, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCounter_MaxLength(t *testing.T) {
+	if _, err := NewCounter(WithPrefix("FACILITY"), WithWidth(13)); !errors.Is(err, ErrTooLong) {
+		t.Errorf("NewCounter(21 characters) error = %v, want ErrTooLong", err)
+	}
+
+	c, err := NewCounter(WithPrefix("A"), WithMaxLength(3), WithStart(98))
+	if err != nil {
+		t.Fatalf("NewCounter() error = %v", err)
+	}
+	if got, err := c.Next(); err != nil || got != "A98" {
+		t.Errorf("Next() = %q, %v, want A98", got, err)
+	}
+	if got, err := c.Next(); err != nil || got != "A99" {
+		t.Errorf("Next() = %q, %v, want A99", got, err)
+	}
+	if _, err := c.Next(); !errors.Is(err, ErrTooLong) {
+		t.Errorf("Next() error = %v, want ErrTooLong after A99", err)
+	}
+}
+
+func TestCounter_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "msh10.seq")
+
+	c, err := NewCounter(WithFile(path), WithBlockSize(10))
+	if err != nil {
+		t.Fatalf("NewCounter() error = %v", err)
+	}
+	for i := 0; i < 12; i++ {
+		if _, err := c.Next(); err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got := strings.TrimSpace(string(data)); got != "21" {
+		t.Errorf("counter file = %q, want 21 after reserving two blocks", got)
+	}
+
+	// a restart continues after the reserved block, never repeating a value
+	c, err = NewCounter(WithFile(path), WithBlockSize(10))
+	if err != nil {
+		t.Fatalf("NewCounter() error = %v", err)
+	}
+	if got, err := c.Next(); err != nil || got != "21" {
+		t.Errorf("Next() after restart = %q, %v, want 21", got, err)
+	}
+
+	if err := os.WriteFile(path, []byte("garbage"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCounter(WithFile(path)); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("NewCounter(corrupt file) error = %v, want ErrInvalidState", err)
+	}
+}
+
+func TestCounter_Concurrent(t *testing.T) {
+	c, err := NewCounter(WithFile(filepath.Join(t.TempDir(), "seq")))
+	if err != nil {
+		t.Fatalf("NewCounter() error = %v", err)
+	}
+
+	var (
+		mu   sync.Mutex
+		seen = make(map[string]bool)
+		wg   sync.WaitGroup
+	)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				id, err := c.Next()
+				if err != nil {
+					t.Errorf("Next() error = %v", err)
+					return
+				}
+				mu.Lock()
+				if seen[id] {
+					t.Errorf("Next() repeated %q", id)
+				}
+				seen[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != 2000 {
+		t.Errorf("got %d unique IDs, want 2000", len(seen))
+	}
+}
+
+func TestFacilities(t *testing.T) {
+	dir := t.TempDir()
+	f := NewFacilities(dir, WithWidth(4))
+
+	east, err := f.For("EAST")
+	if err != nil {
+		t.Fatalf("For(EAST) error = %v", err)
+	}
+	west, err := f.For("WEST/2")
+	if err != nil {
+		t.Fatalf("For(WEST/2) error = %v", err)
+	}
+	if again, _ := f.For("EAST"); again != east {
+		t.Error("For(EAST) returned a new counter on second call")
+	}
+
+	for _, want := range []string{"EAST0001", "EAST0002"} {
+		if got, _ := east.Next(); got != want {
+			t.Errorf("EAST Next() = %q, want %q", got, want)
+		}
+	}
+	if got, _ := west.Next(); got != "WEST/20001" {
+		t.Errorf("WEST/2 Next() = %q, want WEST/20001", got)
+	}
+
+	for _, name := range []string{"45415354.seq", "574553542f32.seq"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("counter file %s: %v", name, err)
+		}
+	}
+
+	if _, err := NewFacilities("", WithWidth(8)).For("VERYLONGFACILITYNAME"); !errors.Is(err, ErrTooLong) {
+		t.Errorf("For(long facility) error = %v, want ErrTooLong", err)
+	}
+	if _, err := f.For(""); err == nil {
+		t.Error("For(\"\") expected error")
+	}
+}
+
+func TestFacilities_DistinctFiles(t *testing.T) {
+	dir := t.TempDir()
+	f := NewFacilities(dir, WithWidth(4))
+
+	facilities := []string{"LAB 1", "LAB.1", "LAB_1", "lab_1"}
+	for _, facility := range facilities {
+		c, err := f.For(facility)
+		if err != nil {
+			t.Fatalf("For(%q) error = %v", facility, err)
+		}
+		if got, _ := c.Next(); got != facility+"0001" {
+			t.Errorf("For(%q) Next() = %q, want %s0001", facility, got, facility)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != len(facilities) {
+		t.Errorf("got %d counter files, want %d", len(entries), len(facilities))
+	}
+
+	// a new Facilities on the same directory continues each counter
+	// separately
+	g := NewFacilities(dir, WithWidth(4))
+	for _, facility := range facilities {
+		c, err := g.For(facility)
+		if err != nil {
+			t.Fatalf("For(%q) error = %v", facility, err)
+		}
+		if got, _ := c.Next(); got == facility+"0001" {
+			t.Errorf("For(%q) Next() = %q after reopening, want a later value", facility, got)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Package controlid generates HL7 message control IDs (MSH-10).
+//
+// Each message needs a control ID that is unique for its sender, so that
+// acknowledgments (MSA-2) can be matched to the messages they answer.
+// Generators implement the Generator interface and are safe for concurrent
+// use. The ack builder and marshal use them through their
+// WithControlIDGenerator options.
+//
+// # Generators
+//
+// Timestamp IDs are the UTC time followed by a sequence number shared by
+// all Timestamp generators in the process, e.g. "20240115103000000042".
+// They are the default of the ack builder and marshal:
+//
+//	gen, err := controlid.NewTimestamp()
+//
+// Counter IDs are a monotonic sequence, optionally with a prefix and zero
+// padding. With WithFile the counter survives restarts: it reserves blocks
+// of values in the file, so a restart may skip values but never repeats one:
+//
+//	gen, err := controlid.NewCounter(
+//	    controlid.WithPrefix("LAB"),
+//	    controlid.WithWidth(8),
+//	    controlid.WithFile("/var/lib/myapp/msh10.seq"),
+//	)
+//	id, err := gen.Next() // "LAB00000001"
+//
+// Facilities hands out one Counter per sending facility, with the facility
+// as prefix and, if given a directory, one file per facility:
+//
+//	facilities := controlid.NewFacilities("/var/lib/myapp/seq", controlid.WithWidth(6))
+//	gen, err := facilities.For("EAST")
+//	id, err := gen.Next() // "EAST000001"
+//
+// ULID IDs are 26-character, lexicographically sortable identifiers made of
+// a millisecond timestamp and random bits, monotonic within a millisecond:
+//
+//	gen, err := controlid.NewULID()
+//	id, err := gen.Next() // "01HM6AQH20ZW0FY07Z03ZG1ZR0"
+//
+// # Maximum Length
+//
+// MSH-10 is limited to 20 characters up to HL7 v2.6 and 199 characters
+// from v2.7. Generators check at construction that their IDs fit
+// WithMaxLength, and Counter.Next fails with ErrTooLong once its values
+// outgrow it. Counter and Timestamp default to 20 characters; ULID IDs need
+// 26 and default to 199. MaxLengthFor returns the limit for a version:
+//
+//	gen, err := controlid.NewULID(controlid.WithMaxLength(controlid.MaxLengthFor("2.5.1")))
+//	// err: ErrTooLong
+//
+// Func adapts a plain function, e.g. for tests:
+//
+//	gen := controlid.Func(func() string { return "MSG001" })
+package controlid
@@ -0,0 +1,67 @@
+package controlid
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// timestampFormat is the layout of the time part of Timestamp IDs.
+const timestampFormat = "20060102150405"
+
+// timestampSeq is the sequence shared by all Timestamp generators, so that
+// generators in the same process do not repeat each other's IDs.
+var timestampSeq atomic.Uint64
+
+// Timestamp generates IDs made of an optional prefix, the UTC time to the
+// second and a zero-padded sequence number, e.g. "20240115103000000042".
+// The sequence is shared by all Timestamp generators in the process and
+// wraps around, so IDs are unique as long as fewer than 10^width are
+// generated per second.
+type Timestamp struct {
+	prefix string
+	clock  func() time.Time
+	width  int
+}
+
+// NewTimestamp creates a Timestamp generator. The sequence has 6 digits
+// unless set with WithWidth. It returns ErrTooLong if the IDs do not fit
+// the maximum length, 20 characters by default.
+func NewTimestamp(opts ...Option) (*Timestamp, error) {
+	cfg := newConfig(opts)
+
+	width := cfg.width
+	if width <= 0 {
+		width = 6
+	}
+	if width > 18 {
+		return nil, fmt.Errorf("timestamp sequence width %d: must be at most 18", width)
+	}
+	maxLength := cfg.maxLengthOr(MaxLength)
+	if n := len(cfg.prefix) + len(timestampFormat) + width; n > maxLength {
+		return nil, fmt.Errorf("%w: timestamp IDs have %d characters, maximum is %d", ErrTooLong, n, maxLength)
+	}
+
+	return &Timestamp{prefix: cfg.prefix, clock: cfg.clock, width: width}, nil
+}
+
+// Next returns a new ID for the current time.
+func (g *Timestamp) Next() (string, error) {
+	return g.At(g.clock()), nil
+}
+
+// At returns a new ID for time t, e.g. to match the MSH-7 timestamp of the
+// message it identifies.
+func (g *Timestamp) At(t time.Time) string {
+	seq := timestampSeq.Add(1) % pow10(g.width)
+	return fmt.Sprintf("%s%s%0*d", g.prefix, t.UTC().Format(timestampFormat), g.width, seq)
+}
+
+// pow10 returns 10^n.
+func pow10(n int) uint64 {
+	p := uint64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
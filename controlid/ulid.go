@@ -0,0 +1,94 @@
+package controlid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// crockford is the Crockford base32 alphabet used by ULIDs.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulidLength is the length of a ULID: 10 characters of time and 16 of
+// random bits.
+const ulidLength = 26
+
+// ULID generates ULIDs: 26-character identifiers made of a 48-bit
+// millisecond timestamp and 80 random bits, in Crockford base32, e.g.
+// "01HM6AQH20ZW0FY07Z03ZG1ZR0". IDs sort by creation time. Within the same
+// millisecond, or if the clock goes backwards, the random bits of the
+// previous ID are incremented, so IDs from one generator are strictly
+// increasing.
+type ULID struct {
+	mu      sync.Mutex
+	prefix  string
+	clock   func() time.Time
+	entropy io.Reader
+	lastMS  uint64
+	hi      uint16 // top 16 of the 80 random bits of the last ID
+	lo      uint64 // bottom 64 of the 80 random bits of the last ID
+}
+
+// NewULID creates a ULID generator. It returns ErrTooLong if the IDs do not
+// fit the maximum length, 199 characters by default. IDs are 26 characters
+// plus the prefix, so they need MSH-10 of HL7 v2.7 or later.
+func NewULID(opts ...Option) (*ULID, error) {
+	cfg := newConfig(opts)
+
+	maxLength := cfg.maxLengthOr(MaxLengthV27)
+	if n := len(cfg.prefix) + ulidLength; n > maxLength {
+		return nil, fmt.Errorf("%w: ULIDs have %d characters, maximum is %d", ErrTooLong, n, maxLength)
+	}
+
+	entropy := cfg.entropy
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+	return &ULID{prefix: cfg.prefix, clock: cfg.clock, entropy: entropy}, nil
+}
+
+// Next returns a new ID. It returns ErrExhausted if the random bits overflow
+// within one millisecond, and an error if the entropy source fails.
+func (g *ULID) Next() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := uint64(g.clock().UnixMilli())
+	if ms > g.lastMS {
+		var b [10]byte
+		if _, err := io.ReadFull(g.entropy, b[:]); err != nil {
+			return "", fmt.Errorf("reading entropy: %w", err)
+		}
+		g.lastMS = ms
+		g.hi = binary.BigEndian.Uint16(b[:2])
+		g.lo = binary.BigEndian.Uint64(b[2:])
+	} else {
+		g.lo++
+		if g.lo == 0 {
+			g.hi++
+			if g.hi == 0 {
+				return "", fmt.Errorf("%w: random bits overflowed in millisecond %d", ErrExhausted, g.lastMS)
+			}
+		}
+	}
+
+	return g.prefix + encodeULID(g.lastMS, g.hi, g.lo), nil
+}
+
+// encodeULID returns the base32 text of a ULID.
+func encodeULID(ms uint64, hi uint16, lo uint64) string {
+	var out [ulidLength]byte
+	for i := ulidLength - 1; i >= 10; i-- {
+		out[i] = crockford[lo&31]
+		lo = lo>>5 | uint64(hi&31)<<59
+		hi >>= 5
+	}
+	for i := 9; i >= 0; i-- {
+		out[i] = crockford[ms&31]
+		ms >>= 5
+	}
+	return string(out[:])
+}
@@ -0,0 +1,93 @@
+package controlid
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestULID(t *testing.T) {
+	now := time.UnixMilli(1705314600000)
+	g, err := NewULID(
+		WithClock(func() time.Time { return now }),
+		WithEntropy(bytes.NewReader(bytes.Repeat([]byte{0xff, 0x00}, 10))),
+	)
+	if err != nil {
+		t.Fatalf("NewULID() error = %v", err)
+	}
+
+	first, err := g.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if want := "01HM6AQH20ZW0FY07Z03ZG1ZR0"; first != want {
+		t.Errorf("Next() = %q, want %q", first, want)
+	}
+
+	// within the same millisecond the random bits are incremented
+	second, _ := g.Next()
+	if want := "01HM6AQH20ZW0FY07Z03ZG1ZR1"; second != want {
+		t.Errorf("Next() = %q, want %q", second, want)
+	}
+
+	// a clock going backwards keeps IDs increasing
+	now = now.Add(-time.Second)
+	if third, _ := g.Next(); third <= second {
+		t.Errorf("Next() = %q after clock went back, want greater than %q", third, second)
+	}
+
+	// a later millisecond sorts after earlier IDs
+	now = now.Add(2 * time.Second)
+	if fourth, _ := g.Next(); fourth <= second || len(fourth) != 26 {
+		t.Errorf("Next() = %q, want a 26-character ID greater than %q", fourth, second)
+	}
+}
+
+func TestULID_Sortable(t *testing.T) {
+	g, err := NewULID(WithPrefix("X"))
+	if err != nil {
+		t.Fatalf("NewULID() error = %v", err)
+	}
+
+	prev := ""
+	for i := 0; i < 1000; i++ {
+		id, err := g.Next()
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		if !strings.HasPrefix(id, "X") || len(id) != 27 {
+			t.Fatalf("Next() = %q, want X and 26 characters", id)
+		}
+		if id <= prev {
+			t.Fatalf("Next() = %q, not greater than %q", id, prev)
+		}
+		prev = id
+	}
+}
+
+func TestULID_Errors(t *testing.T) {
+	if _, err := NewULID(WithMaxLength(MaxLength)); !errors.Is(err, ErrTooLong) {
+		t.Errorf("NewULID(max 20) error = %v, want ErrTooLong", err)
+	}
+
+	g, err := NewULID(
+		WithClock(func() time.Time { return time.UnixMilli(1) }),
+		WithEntropy(bytes.NewReader(bytes.Repeat([]byte{0xff}, 10))),
+	)
+	if err != nil {
+		t.Fatalf("NewULID() error = %v", err)
+	}
+	if _, err := g.Next(); err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if _, err := g.Next(); !errors.Is(err, ErrExhausted) {
+		t.Errorf("Next() error = %v, want ErrExhausted when random bits overflow", err)
+	}
+
+	g, _ = NewULID(WithEntropy(bytes.NewReader(nil)))
+	if _, err := g.Next(); err == nil {
+		t.Error("Next() error = nil, want error for failing entropy")
+	}
+}
@@ -35,6 +35,9 @@
 //   - MSH-1: Field separator (typically |)
 //   - MSH-2: Encoding characters (typically ^~\&)
 //
+// The batch and file headers BHS and FHS repeat them in BHS-1/BHS-2 and
+// FHS-1/FHS-2, and are parsed and encoded the same way.
+//
 // The default delimiters are:
 //   - Field: |
 //   - Component: ^
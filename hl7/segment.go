@@ -107,20 +107,27 @@ func ParseSegment(data []rune, delims *Delimiters) (Segment, error) {
 	}
 	copy(seg.value, data)
 
-	// MSH segment has special handling
-	if name == "MSH" {
-		return parseMSHSegment(data, delims)
+	// MSH, BHS and FHS have special handling
+	if isHeaderSegment(name) {
+		return parseHeaderSegment(data, delims, name)
 	}
 
 	// Parse regular segment fields
 	return parseRegularSegment(data, delims, name)
 }
 
-// parseMSHSegment handles the special parsing rules for MSH segments.
-// MSH-1 is the field separator, MSH-2 is the encoding characters.
-func parseMSHSegment(data []rune, delims *Delimiters) (Segment, error) {
+// isHeaderSegment reports whether name is a header segment whose first field
+// is the field separator and whose second is the encoding characters: MSH,
+// and the batch and file headers BHS and FHS.
+func isHeaderSegment(name string) bool {
+	return name == "MSH" || name == "BHS" || name == "FHS"
+}
+
+// parseHeaderSegment handles the special parsing rules for MSH, BHS and FHS
+// segments. MSH-1 is the field separator, MSH-2 is the encoding characters.
+func parseHeaderSegment(data []rune, delims *Delimiters, name string) (Segment, error) {
 	seg := &segment{
-		name:   "MSH",
+		name:   name,
 		fields: make([]Field, 0),
 		value:  make([]rune, len(data)),
 	}
@@ -129,7 +136,7 @@ func parseMSHSegment(data []rune, delims *Delimiters) (Segment, error) {
 	// MSH must have at least "MSH|" (4 characters)
 	if len(data) < 4 {
 		return nil, &ParseError{
-			Message: "MSH segment too short",
+			Message: name + " segment too short",
 		}
 	}
 
@@ -288,7 +295,8 @@ func (s *segment) FieldCount() int {
 }
 
 // Get retrieves a value at the specified location within the segment.
-// Format: ".field" or ".field.component" or ".field.component.subcomponent"
+// Format: ".field" or ".field.component" or ".field.component.subcomponent",
+// with an optional 0-based repetition after the field, e.g. ".3[1].1".
 // Field numbers are 1-based.
 func (s *segment) Get(location string) (string, error) {
 	loc, err := parseSegmentLocation(location)
@@ -303,20 +311,11 @@ func (s *segment) Get(location string) (string, error) {
 	}
 
 	// If only field specified, return full field value
-	if loc.component == 0 && loc.subcomponent == 0 {
+	if loc.repetition < 0 && loc.component == 0 && loc.subcomponent == 0 {
 		return field.Value(), nil
 	}
 
-	// Build field-level location string
-	fieldLoc := ""
-	if loc.component > 0 {
-		fieldLoc = fmt.Sprintf(".%d", loc.component)
-		if loc.subcomponent > 0 {
-			fieldLoc = fmt.Sprintf(".%d.%d", loc.component, loc.subcomponent)
-		}
-	}
-
-	return field.Get(fieldLoc)
+	return field.Get(loc.fieldLocation())
 }
 
 // GetAll retrieves all values at the specified location (for repeating fields).
@@ -331,6 +330,15 @@ func (s *segment) GetAll(location string) ([]string, error) {
 		return nil, nil
 	}
 
+	// A single repetition has a single value
+	if loc.repetition >= 0 {
+		val, err := field.Get(loc.fieldLocation())
+		if err != nil {
+			return nil, err
+		}
+		return []string{val}, nil
+	}
+
 	// If only field specified, return values from all repetitions
 	if loc.component == 0 && loc.subcomponent == 0 {
 		var results []string
@@ -386,18 +394,7 @@ func (s *segment) Set(location string, value string) error {
 		s.fields = append(s.fields, NewField(len(s.fields)+1, ""))
 	}
 
-	// If only setting field value
-	if loc.component == 0 && loc.subcomponent == 0 {
-		return s.fields[loc.field-1].Set("", value)
-	}
-
-	// Build field-level location string
-	fieldLoc := fmt.Sprintf(".%d", loc.component)
-	if loc.subcomponent > 0 {
-		fieldLoc = fmt.Sprintf(".%d.%d", loc.component, loc.subcomponent)
-	}
-
-	return s.fields[loc.field-1].Set(fieldLoc, value)
+	return s.fields[loc.field-1].Set(loc.fieldLocation(), value)
 }
 
 // SetField sets the field at the 1-based sequence number.
@@ -440,9 +437,9 @@ func (s *segment) Bytes(delims *Delimiters) []byte {
 		return buf.Bytes()
 	}
 
-	// MSH special handling
-	if s.name == "MSH" {
-		return s.encodeMSH(delims)
+	// MSH, BHS and FHS special handling
+	if isHeaderSegment(s.name) {
+		return s.encodeHeader(delims)
 	}
 
 	// Regular segment encoding
@@ -456,13 +453,13 @@ func (s *segment) Bytes(delims *Delimiters) []byte {
 	return buf.Bytes()
 }
 
-// encodeMSH handles special encoding for MSH segments.
+// encodeHeader handles special encoding for MSH, BHS and FHS segments.
 // MSH-1 is written as the field separator (not preceded by separator).
 // MSH-2 contains the encoding characters.
-func (s *segment) encodeMSH(delims *Delimiters) []byte {
+func (s *segment) encodeHeader(delims *Delimiters) []byte {
 	var buf bytes.Buffer
 
-	buf.WriteString("MSH")
+	buf.WriteString(s.name)
 
 	// MSH-1: Field separator (no preceding separator)
 	if len(s.fields) > 0 && s.fields[0] != nil {
@@ -495,12 +492,30 @@ func (s *segment) String() string {
 // segmentLocation holds parsed location components for internal use.
 type segmentLocation struct {
 	field        int
+	repetition   int
 	component    int
 	subcomponent int
 }
 
-// parseSegmentLocation parses a location string like ".5.1.2" into components.
-// Returns field, component, subcomponent (all 1-based, 0 means not specified).
+// fieldLocation returns the location of the repetition, component and
+// subcomponent within the field, as understood by Field.Get and Field.Set.
+func (l *segmentLocation) fieldLocation() string {
+	var sb strings.Builder
+	if l.repetition >= 0 {
+		sb.WriteString(fmt.Sprintf("[%d]", l.repetition))
+	}
+	if l.component > 0 {
+		sb.WriteString(fmt.Sprintf(".%d", l.component))
+		if l.subcomponent > 0 {
+			sb.WriteString(fmt.Sprintf(".%d", l.subcomponent))
+		}
+	}
+	return sb.String()
+}
+
+// parseSegmentLocation parses a location string like ".5.1.2" or ".5[1].1"
+// into components. Returns field, component, subcomponent (all 1-based, 0
+// means not specified) and the 0-based repetition (-1 if not specified).
 func parseSegmentLocation(location string) (*segmentLocation, error) {
 	location = strings.TrimSpace(location)
 	if location == "" {
@@ -521,7 +536,20 @@ func parseSegmentLocation(location string) (*segmentLocation, error) {
 	}
 
 	parts := strings.Split(location, ".")
-	loc := &segmentLocation{}
+	loc := &segmentLocation{repetition: -1}
+
+	// Parse repetition index if present, e.g. "5[1]"
+	if open := strings.Index(parts[0], "["); open >= 0 && strings.HasSuffix(parts[0], "]") {
+		rep, err := strconv.Atoi(parts[0][open+1 : len(parts[0])-1])
+		if err != nil || rep < 0 {
+			return nil, &LocationError{
+				Location: parts[0],
+				Reason:   "invalid repetition index",
+			}
+		}
+		loc.repetition = rep
+		parts[0] = parts[0][:open]
+	}
 
 	// Parse field number
 	field, err := strconv.Atoi(parts[0])
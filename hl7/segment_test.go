@@ -1,6 +1,7 @@
 package hl7
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -438,6 +439,26 @@ func TestSegment_Set(t *testing.T) {
 			t.Errorf("Get(.1.2) = %q, want %q", got, "component2")
 		}
 	})
+
+	t.Run("set repetitions", func(t *testing.T) {
+		seg := NewSegment("PID")
+
+		for loc, value := range map[string]string{".3[0]": "ID1", ".3[1]": "ID2", ".3[2].4": "HOSP"} {
+			if err := seg.Set(loc, value); err != nil {
+				t.Fatalf("Set(%s) error = %v", loc, err)
+			}
+		}
+
+		if got := string(seg.Bytes(DefaultDelimiters())); got != "PID|||ID1~ID2~^^^HOSP" {
+			t.Errorf("Bytes() = %q, want %q", got, "PID|||ID1~ID2~^^^HOSP")
+		}
+		if got, _ := seg.Get(".3[1]"); got != "ID2" {
+			t.Errorf("Get(.3[1]) = %q, want %q", got, "ID2")
+		}
+		if got, _ := seg.GetAll(".3[2].4"); len(got) != 1 || got[0] != "HOSP" {
+			t.Errorf("GetAll(.3[2].4) = %q, want [HOSP]", got)
+		}
+	})
 }
 
 func TestSegment_SetField(t *testing.T) {
@@ -597,6 +618,27 @@ func TestSegment_MSH_FieldNumbering(t *testing.T) {
 	})
 }
 
+func TestSegment_BatchHeaderFieldNumbering(t *testing.T) {
+	for _, name := range []string{"BHS", "FHS"} {
+		t.Run(name, func(t *testing.T) {
+			data := name + "|^~\\&|SendApp|SendFac"
+			seg, err := ParseSegment([]rune(data), DefaultDelimiters())
+			if err != nil {
+				t.Fatalf("ParseSegment() error = %v", err)
+			}
+
+			for i, want := range []string{"|", "^~\\&", "SendApp", "SendFac"} {
+				if got, _ := seg.Get(fmt.Sprintf(".%d", i+1)); got != want {
+					t.Errorf("Get(.%d) = %q, want %q", i+1, got, want)
+				}
+			}
+			if got := string(seg.Bytes(DefaultDelimiters())); got != data {
+				t.Errorf("Bytes() = %q, want %q", got, data)
+			}
+		})
+	}
+}
+
 func TestSegment_EmptyFields(t *testing.T) {
 	delims := DefaultDelimiters()
 
@@ -953,33 +995,56 @@ func TestParseSegmentLocation(t *testing.T) {
 		name             string
 		location         string
 		wantField        int
+		wantRepetition   int
 		wantComponent    int
 		wantSubcomponent int
 		wantErr          bool
 	}{
 		{
-			name:      "field only",
-			location:  ".5",
-			wantField: 5,
+			name:           "field only",
+			location:       ".5",
+			wantField:      5,
+			wantRepetition: -1,
 		},
 		{
-			name:      "field without leading dot",
-			location:  "5",
-			wantField: 5,
+			name:           "field without leading dot",
+			location:       "5",
+			wantField:      5,
+			wantRepetition: -1,
 		},
 		{
-			name:          "field and component",
-			location:      ".5.1",
-			wantField:     5,
-			wantComponent: 1,
+			name:           "field and component",
+			location:       ".5.1",
+			wantField:      5,
+			wantRepetition: -1,
+			wantComponent:  1,
 		},
 		{
 			name:             "field, component, subcomponent",
 			location:         ".5.1.2",
 			wantField:        5,
+			wantRepetition:   -1,
 			wantComponent:    1,
 			wantSubcomponent: 2,
 		},
+		{
+			name:           "field repetition",
+			location:       ".5[1]",
+			wantField:      5,
+			wantRepetition: 1,
+		},
+		{
+			name:           "field repetition and component",
+			location:       "5[0].2",
+			wantField:      5,
+			wantRepetition: 0,
+			wantComponent:  2,
+		},
+		{
+			name:     "invalid repetition",
+			location: ".5[x]",
+			wantErr:  true,
+		},
 		{
 			name:     "empty location",
 			location: "",
@@ -1020,6 +1085,9 @@ func TestParseSegmentLocation(t *testing.T) {
 			if loc.field != tt.wantField {
 				t.Errorf("field = %d, want %d", loc.field, tt.wantField)
 			}
+			if loc.repetition != tt.wantRepetition {
+				t.Errorf("repetition = %d, want %d", loc.repetition, tt.wantRepetition)
+			}
 			if loc.component != tt.wantComponent {
 				t.Errorf("component = %d, want %d", loc.component, tt.wantComponent)
 			}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/token"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// segmentDef describes one segment from the definition file.
+type segmentDef struct {
+	Name        string
+	Description string
+	Fields      []fieldDef
+}
+
+// fieldDef describes one field of a segment.
+type fieldDef struct {
+	Seq         int
+	GoName      string
+	DataType    string
+	Optionality string
+	Repeatable  bool
+	Length      int
+	Description string
+}
+
+// Header reports whether the segment is a batch or file header whose first
+// two fields hold the field separator and encoding characters, as in MSH.
+func (d segmentDef) Header() bool {
+	return headerSegments[d.Name]
+}
+
+// Required reports whether the field is mandatory (optionality R).
+func (f fieldDef) Required() bool {
+	return f.Optionality == "R"
+}
+
+var segmentNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{2}$`)
+
+// optionalityNames maps optionality codes to their descriptions.
+var optionalityNames = map[string]string{
+	"R": "required",
+	"O": "optional",
+	"C": "conditional",
+	"B": "backward compatibility",
+}
+
+// handWritten lists segments maintained by hand in the segments package.
+var handWritten = map[string]bool{
+	"MSH": true, "PID": true, "PV1": true, "ORC": true, "OBR": true, "OBX": true,
+}
+
+// headerSegments lists generated segments encoded like MSH.
+var headerSegments = map[string]bool{
+	"BHS": true, "FHS": true,
+}
+
+// parseDefinitions reads segment definitions from r.
+// Errors include the line number of the offending definition.
+func parseDefinitions(r io.Reader) ([]segmentDef, error) {
+	var defs []segmentDef
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			def, err := parseSegmentHeader(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			if seen[def.Name] {
+				return nil, fmt.Errorf("line %d: duplicate segment %s", lineNum, def.Name)
+			}
+			seen[def.Name] = true
+			defs = append(defs, def)
+			continue
+		}
+
+		if len(defs) == 0 {
+			return nil, fmt.Errorf("line %d: field definition before any segment header", lineNum)
+		}
+
+		cur := &defs[len(defs)-1]
+		field, err := parseFieldLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		if err := cur.addField(field); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, def := range defs {
+		if len(def.Fields) == 0 {
+			return nil, fmt.Errorf("segment %s has no fields", def.Name)
+		}
+		if def.Header() && len(def.Fields) < 2 {
+			return nil, fmt.Errorf("header segment %s needs the separator and encoding character fields", def.Name)
+		}
+	}
+
+	return defs, nil
+}
+
+// parseSegmentHeader parses a "[SEG] Description" line.
+func parseSegmentHeader(line string) (segmentDef, error) {
+	end := strings.Index(line, "]")
+	if end < 0 {
+		return segmentDef{}, fmt.Errorf("malformed segment header %q", line)
+	}
+
+	name := strings.TrimSpace(line[1:end])
+	if !segmentNamePattern.MatchString(name) {
+		return segmentDef{}, fmt.Errorf("invalid segment name %q", name)
+	}
+	if handWritten[name] {
+		return segmentDef{}, fmt.Errorf("segment %s is hand-written and cannot be generated", name)
+	}
+
+	desc := strings.TrimSpace(line[end+1:])
+	if desc == "" {
+		return segmentDef{}, fmt.Errorf("segment %s has no description", name)
+	}
+
+	return segmentDef{Name: name, Description: desc}, nil
+}
+
+// parseFieldLine parses a "seq|GoName|DataType|Opt|Rep|Length|Description" line.
+func parseFieldLine(line string) (fieldDef, error) {
+	parts := strings.Split(line, "|")
+	if len(parts) != 7 {
+		return fieldDef{}, fmt.Errorf("expected 7 pipe-separated columns, got %d", len(parts))
+	}
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	seq, err := strconv.Atoi(parts[0])
+	if err != nil || seq < 1 {
+		return fieldDef{}, fmt.Errorf("invalid sequence %q", parts[0])
+	}
+
+	goName := parts[1]
+	if !token.IsIdentifier(goName) || !token.IsExported(goName) {
+		return fieldDef{}, fmt.Errorf("invalid Go field name %q", goName)
+	}
+	if goName == "ToSegment" {
+		return fieldDef{}, fmt.Errorf("field name %q conflicts with the ToSegment method", goName)
+	}
+
+	if parts[2] == "" {
+		return fieldDef{}, fmt.Errorf("field %s has no data type", goName)
+	}
+
+	if _, ok := optionalityNames[parts[3]]; !ok {
+		return fieldDef{}, fmt.Errorf("field %s: invalid optionality %q (want R, O, C or B)", goName, parts[3])
+	}
+
+	var repeatable bool
+	switch parts[4] {
+	case "Y":
+		repeatable = true
+	case "N":
+	default:
+		return fieldDef{}, fmt.Errorf("field %s: invalid repeatable flag %q (want Y or N)", goName, parts[4])
+	}
+
+	length, err := strconv.Atoi(parts[5])
+	if err != nil || length < 1 {
+		return fieldDef{}, fmt.Errorf("field %s: invalid length %q", goName, parts[5])
+	}
+
+	if parts[6] == "" {
+		return fieldDef{}, fmt.Errorf("field %s has no description", goName)
+	}
+
+	return fieldDef{
+		Seq:         seq,
+		GoName:      goName,
+		DataType:    parts[2],
+		Optionality: parts[3],
+		Repeatable:  repeatable,
+		Length:      length,
+		Description: parts[6],
+	}, nil
+}
+
+// addField appends f, requiring sequences to be contiguous from 1 and
+// Go field names to be unique within the segment.
+func (d *segmentDef) addField(f fieldDef) error {
+	if want := len(d.Fields) + 1; f.Seq != want {
+		return fmt.Errorf("segment %s: field sequence %d out of order, want %d", d.Name, f.Seq, want)
+	}
+	for _, existing := range d.Fields {
+		if existing.GoName == f.GoName {
+			return fmt.Errorf("segment %s: duplicate field name %s", d.Name, f.GoName)
+		}
+	}
+	d.Fields = append(d.Fields, f)
+	return nil
+}
@@ -0,0 +1,317 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strings"
+	"text/template"
+)
+
+// generateSegment renders the Go source for a segment type.
+func generateSegment(def segmentDef, source string) ([]byte, error) {
+	return render(segmentTemplate, def, source)
+}
+
+// generateTest renders the Go test source for a segment type.
+func generateTest(def segmentDef, source string) ([]byte, error) {
+	return render(testTemplate, def, source)
+}
+
+// templateData is the data passed to the templates.
+type templateData struct {
+	segmentDef
+	Source string
+}
+
+func render(tmpl *template.Template, def segmentDef, source string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, templateData{segmentDef: def, Source: source}); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+var funcs = template.FuncMap{
+	"article":       article,
+	"receiver":      receiver,
+	"varName":       varName,
+	"fieldDoc":      fieldDoc,
+	"goType":        goType,
+	"tag":           tag,
+	"sample":        sample,
+	"sampleLiteral": sampleLiteral,
+	"sampleSegment": sampleSegment,
+}
+
+// article returns the indefinite article for a segment name read letter by letter.
+func article(name string) string {
+	if strings.ContainsRune("AEFHILMNORSX", rune(name[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// receiver returns the method receiver name for a segment type.
+func receiver(name string) string {
+	return strings.ToLower(name[:1])
+}
+
+// varName returns the local variable name used for a parsed segment.
+// Names that would shadow err or collide with a Go keyword get a Seg suffix.
+func varName(name string) string {
+	v := strings.ToLower(name)
+	if v == "err" || token.IsKeyword(v) {
+		return v + "Seg"
+	}
+	return v
+}
+
+// fieldDoc returns the doc comment text describing a field.
+func fieldDoc(name string, f fieldDef) string {
+	attrs := []string{f.DataType, optionalityNames[f.Optionality]}
+	if f.Repeatable {
+		attrs = append(attrs, "repeating")
+	}
+	attrs = append(attrs, fmt.Sprintf("max length %d", f.Length))
+
+	return fmt.Sprintf("%s is %s-%d: %s (%s).", f.GoName, name, f.Seq, f.Description, strings.Join(attrs, ", "))
+}
+
+// goType returns the Go type of a field: []string for repeating fields,
+// holding one encoded value per repetition, and string otherwise.
+func goType(f fieldDef) string {
+	if f.Repeatable {
+		return "[]string"
+	}
+	return "string"
+}
+
+// tag returns the hl7 struct tag value for a field.
+// Required fields carry the required option and every field carries the
+// maxlen option from the standard, both used by validate.RulesFromStruct.
+func tag(name string, f fieldDef) string {
+	t := fmt.Sprintf("%s.%d", name, f.Seq)
+	if f.Required() {
+		t += ",required"
+	}
+	return t + fmt.Sprintf(",maxlen=%d", f.Length)
+}
+
+// sampleSegment returns an encoded segment with every field set to its sample value.
+// Header segments start with the field separator itself, as MSH does.
+func sampleSegment(name string, header bool, fields []fieldDef) string {
+	values := make([]string, len(fields))
+	for i, f := range fields {
+		values[i] = strings.Join(samples(name, header, f), "~")
+	}
+	if header {
+		return name + values[0] + strings.Join(values[1:], "|")
+	}
+	return name + "|" + strings.Join(values, "|")
+}
+
+// sampleLiteral returns the Go literal for a field's sample value.
+func sampleLiteral(name string, header bool, f fieldDef) string {
+	values := samples(name, header, f)
+	if !f.Repeatable {
+		return fmt.Sprintf("%q", values[0])
+	}
+
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+// samples returns the test values used for a field, one per repetition.
+// Repeating fields get two repetitions; the separator and encoding character
+// fields of header segments get the default delimiters.
+func samples(name string, header bool, f fieldDef) []string {
+	if header {
+		switch f.Seq {
+		case 1:
+			return []string{"|"}
+		case 2:
+			return []string{`^~\&`}
+		}
+	}
+
+	value := sample(name, f)
+	if f.Repeatable {
+		return []string{value + "a", value + "b"}
+	}
+	return []string{value}
+}
+
+// sample returns the test value used for a field.
+func sample(name string, f fieldDef) string {
+	return fmt.Sprintf("%s-%d", name, f.Seq)
+}
+
+var segmentTemplate = template.Must(template.New("segment").Funcs(funcs).Parse(`// Code generated by segmentgen from {{.Source}}. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// {{.Name}} represents the {{.Description}} segment.
+//
+// Field positions follow the HL7 standard where {{.Name}}-1 is the first field
+// after the segment name.
+type {{.Name}} struct {
+{{- range $i, $f := .Fields}}
+{{- if $i}}
+{{end}}
+	// {{fieldDoc $.Name $f}}
+	{{$f.GoName}} {{goType $f}} ` + "`" + `hl7:"{{tag $.Name $f}}"` + "`" + `
+{{- end}}
+}
+
+// ErrNot{{.Name}}Segment indicates the segment is not {{article .Name}} {{.Name}} segment.
+var ErrNot{{.Name}}Segment = fmt.Errorf("segment is not {{.Name}}")
+
+// Parse{{.Name}} extracts {{.Name}} segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not {{article .Name}} {{.Name}} segment.
+func Parse{{.Name}}(seg hl7.Segment) (*{{.Name}}, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "{{.Name}}" {
+		return nil, fmt.Errorf("%w: got %s", ErrNot{{.Name}}Segment, seg.Name())
+	}
+
+	{{varName .Name}} := &{{.Name}}{
+{{- range .Fields}}
+{{- if .Repeatable}}
+		{{.GoName}}: getFieldRepetitions(seg, {{.Seq}}),
+{{- else}}
+		{{.GoName}}: getFieldValue(seg, {{.Seq}}),
+{{- end}}
+{{- end}}
+	}
+
+	return {{varName .Name}}, nil
+}
+
+// ToSegment converts the {{.Name}} struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func ({{receiver .Name}} *{{.Name}}) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+{{- range .Fields}}
+{{- if .Repeatable}}
+		joinRepetitions({{receiver $.Name}}.{{.GoName}}, delims),
+{{- else}}
+		{{receiver $.Name}}.{{.GoName}},
+{{- end}}
+{{- end}}
+	}
+
+{{- if .Header}}
+
+	// {{.Name}}-1 is the field separator itself and {{.Name}}-2 the encoding characters, as in MSH
+	data := buildHeaderSegmentData("{{.Name}}", fields, delims)
+{{- else}}
+
+	data := buildSegmentData("{{.Name}}", fields, delims)
+{{- end}}
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create {{.Name}} segment: %w", err)
+	}
+
+	return seg, nil
+}
+`))
+
+var testTemplate = template.Must(template.New("test").Funcs(funcs).Parse(`// Code generated by segmentgen from {{.Source}}. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParse{{.Name}}(t *testing.T) {
+	input := {{printf "%q" (sampleSegment .Name .Header .Fields)}}
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := Parse{{.Name}}(seg)
+	if err != nil {
+		t.Fatalf("Parse{{.Name}}() unexpected error: %v", err)
+	}
+
+	want := {{.Name}}{
+{{- range .Fields}}
+		{{.GoName}}: {{sampleLiteral $.Name $.Header .}},
+{{- end}}
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("Parse{{.Name}}() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParse{{.Name}}_WrongSegment(t *testing.T) {
+	if _, err := Parse{{.Name}}(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("Parse{{.Name}}(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := Parse{{.Name}}(seg); !errors.Is(err, ErrNot{{.Name}}Segment) {
+		t.Errorf("Parse{{.Name}}() error = %v, want ErrNot{{.Name}}Segment", err)
+	}
+}
+
+func Test{{.Name}}_RoundTrip(t *testing.T) {
+	original := &{{.Name}}{
+{{- range .Fields}}
+		{{.GoName}}: {{sampleLiteral $.Name $.Header .}},
+{{- end}}
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "{{.Name}}" {
+		t.Errorf("segment name = %q, want {{.Name}}", seg.Name())
+	}
+
+	parsed, err := Parse{{.Name}}(seg)
+	if err != nil {
+		t.Fatalf("Parse{{.Name}}() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
+`))
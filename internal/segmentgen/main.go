@@ -0,0 +1,89 @@
+// Command segmentgen generates typed segment helpers for the segments package.
+//
+// It reads a segment definition file (see segments/defs/v2.5.1.txt for the
+// format) and writes one <seg>.go file per segment, containing the struct,
+// its ErrNotXXXSegment sentinel, ParseXXX and ToSegment, plus a matching
+// <seg>_test.go file. It is normally invoked through go generate:
+//
+//	go generate ./segments
+//
+// Usage:
+//
+//	segmentgen -defs defs/v2.5.1.txt -out .
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	defsPath := flag.String("defs", "", "segment definition file")
+	outDir := flag.String("out", ".", "output directory for generated files")
+	flag.Parse()
+
+	if *defsPath == "" {
+		fmt.Fprintln(os.Stderr, "segmentgen: -defs is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*defsPath, *outDir); err != nil {
+		fmt.Fprintf(os.Stderr, "segmentgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the segment and test files for every definition in defsPath.
+func run(defsPath, outDir string) error {
+	f, err := os.Open(defsPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	defs, err := parseDefinitions(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", defsPath, err)
+	}
+
+	files, err := generateFiles(defs, filepath.ToSlash(defsPath))
+	if err != nil {
+		return err
+	}
+
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(outDir, name), src, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateFiles renders every segment definition, keyed by output file name.
+// source is recorded in the generated file header.
+func generateFiles(defs []segmentDef, source string) (map[string][]byte, error) {
+	files := make(map[string][]byte, len(defs)*2)
+
+	for _, def := range defs {
+		base := strings.ToLower(def.Name)
+
+		src, err := generateSegment(def, source)
+		if err != nil {
+			return nil, fmt.Errorf("segment %s: %w", def.Name, err)
+		}
+		files[base+".go"] = src
+
+		test, err := generateTest(def, source)
+		if err != nil {
+			return nil, fmt.Errorf("segment %s test: %w", def.Name, err)
+		}
+		files[base+"_test.go"] = test
+	}
+
+	return files, nil
+}
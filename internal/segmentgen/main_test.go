@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const defsPath = "../../segments/defs/v2.5.1.txt"
+
+func TestParseDefinitions(t *testing.T) {
+	input := `# comment
+[ZZ1] Test Segment
+1|SetID|SI|R|N|4|Set ID - ZZ1
+
+2|Names|XPN|O|Y|250|Names
+`
+	defs, err := parseDefinitions(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseDefinitions() error: %v", err)
+	}
+	if len(defs) != 1 {
+		t.Fatalf("got %d segments, want 1", len(defs))
+	}
+
+	def := defs[0]
+	if def.Name != "ZZ1" || def.Description != "Test Segment" {
+		t.Errorf("segment = %s %q, want ZZ1 \"Test Segment\"", def.Name, def.Description)
+	}
+	if len(def.Fields) != 2 {
+		t.Fatalf("got %d fields, want 2", len(def.Fields))
+	}
+	if !def.Fields[0].Required() || def.Fields[0].Repeatable {
+		t.Errorf("field 1 = %+v, want required and not repeatable", def.Fields[0])
+	}
+	if def.Fields[1].Required() || !def.Fields[1].Repeatable || def.Fields[1].Length != 250 {
+		t.Errorf("field 2 = %+v, want optional, repeatable, length 250", def.Fields[1])
+	}
+}
+
+func TestParseDefinitions_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"field before header", "1|SetID|SI|R|N|4|Set ID", "line 1: field definition before any segment header"},
+		{"bad segment name", "[zz] Test", "invalid segment name"},
+		{"hand-written segment", "[PID] Patient", "hand-written"},
+		{"duplicate segment", "[ZZ1] A\n1|A|ST|O|N|1|A\n[ZZ1] B", "line 3: duplicate segment ZZ1"},
+		{"missing columns", "[ZZ1] A\n1|A|ST", "line 2: expected 7"},
+		{"out of order", "[ZZ1] A\n2|A|ST|O|N|1|A", "sequence 2 out of order, want 1"},
+		{"duplicate field", "[ZZ1] A\n1|A|ST|O|N|1|A\n2|A|ST|O|N|1|B", "duplicate field name A"},
+		{"unexported name", "[ZZ1] A\n1|name|ST|O|N|1|A", "invalid Go field name"},
+		{"bad optionality", "[ZZ1] A\n1|A|ST|X|N|1|A", "invalid optionality"},
+		{"bad repeatable", "[ZZ1] A\n1|A|ST|O|maybe|1|A", "invalid repeatable flag"},
+		{"bad length", "[ZZ1] A\n1|A|ST|O|N|0|A", "invalid length"},
+		{"empty segment", "[ZZ1] A", "segment ZZ1 has no fields"},
+		{"short header segment", "[BHS] A\n1|A|ST|R|N|1|A", "header segment BHS needs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseDefinitions(strings.NewReader(tt.input))
+			if err == nil {
+				t.Fatal("parseDefinitions() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateSegment(t *testing.T) {
+	input := `[ZZ1] Test Segment
+1|SetID|SI|R|N|4|Set ID - ZZ1
+2|Names|XPN|O|Y|250|Names
+`
+	defs, err := parseDefinitions(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseDefinitions() error: %v", err)
+	}
+
+	src, err := generateSegment(defs[0], "test.txt")
+	if err != nil {
+		t.Fatalf("generateSegment() error: %v", err)
+	}
+
+	for _, want := range []string{
+		"SetID string `hl7:\"ZZ1.1,required,maxlen=4\"`",
+		"Names []string `hl7:\"ZZ1.2,maxlen=250\"`",
+		"Names: getFieldRepetitions(seg, 2),",
+		"joinRepetitions(z.Names, delims),",
+		"buildSegmentData(\"ZZ1\", fields, delims)",
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("generated source missing %q", want)
+		}
+	}
+}
+
+func TestGenerateSegment_Header(t *testing.T) {
+	input := `[BHS] Batch Header
+1|BatchFieldSeparator|ST|R|N|1|Batch Field Separator
+2|BatchEncodingCharacters|ST|R|N|4|Batch Encoding Characters
+3|BatchComment|ST|O|N|80|Batch Comment
+`
+	defs, err := parseDefinitions(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseDefinitions() error: %v", err)
+	}
+
+	src, err := generateSegment(defs[0], "test.txt")
+	if err != nil {
+		t.Fatalf("generateSegment() error: %v", err)
+	}
+	if !bytes.Contains(src, []byte(`buildHeaderSegmentData("BHS", fields, delims)`)) {
+		t.Error("header segment not encoded with buildHeaderSegmentData")
+	}
+
+	if got, want := sampleSegment("BHS", true, defs[0].Fields), `BHS|^~\&|BHS-3`; got != want {
+		t.Errorf("sampleSegment() = %q, want %q", got, want)
+	}
+}
+
+// TestGeneratedFilesUpToDate fails when the definitions have changed
+// without re-running go generate.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	f, err := os.Open(defsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	defs, err := parseDefinitions(f)
+	if err != nil {
+		t.Fatalf("parseDefinitions() error: %v", err)
+	}
+
+	files, err := generateFiles(defs, "defs/v2.5.1.txt")
+	if err != nil {
+		t.Fatalf("generateFiles() error: %v", err)
+	}
+
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join("../../segments", name))
+		if err != nil {
+			t.Errorf("%s: %v (run go generate ./segments)", name, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date (run go generate ./segments)", name)
+		}
+	}
+}
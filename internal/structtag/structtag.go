@@ -0,0 +1,41 @@
+// Package structtag resolves the locations of "hl7" struct tags. It is shared
+// by the marshal and validate packages so that a tag names the same location
+// whether a struct is marshaled, unmarshaled or turned into validation rules.
+package structtag
+
+// ResolveLocation resolves a field location against the location of its
+// enclosing tagged struct. Locations starting with a segment name are
+// absolute; others are relative to prefix.
+func ResolveLocation(prefix, location string) string {
+	if prefix == "" || IsAbsolute(location) {
+		return location
+	}
+	return prefix + "." + location
+}
+
+// IsAbsolute reports whether a location starts with a segment name, such as
+// "PID", "PID.5" or "PID[1].5", rather than being a path like "1.2" relative
+// to an enclosing struct.
+func IsAbsolute(location string) bool {
+	if len(location) < 3 {
+		return false
+	}
+	// Segment names are an uppercase letter and two uppercase letters or digits
+	for i := 0; i < 3; i++ {
+		c := location[i]
+		if i == 0 {
+			if c < 'A' || c > 'Z' {
+				return false
+			}
+		} else {
+			if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+				return false
+			}
+		}
+	}
+	// Must be followed by end or '.' or '['
+	if len(location) == 3 {
+		return true
+	}
+	return location[3] == '.' || location[3] == '['
+}
@@ -0,0 +1,51 @@
+package structtag
+
+import "testing"
+
+func TestIsAbsolute(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"PID", true},
+		{"PID.5", true},
+		{"PID.5.1", true},
+		{"PID[0].5", true},
+		{"MSH", true},
+		{"OBX", true},
+		{"ZZ1.2.3.4.5", true},
+		{"1", false},
+		{"1.2", false},
+		{".1.2", false},
+		{"PI", false},
+		{"pid", false},
+		{"PIDX", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := IsAbsolute(tt.input); got != tt.want {
+				t.Errorf("IsAbsolute(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveLocation(t *testing.T) {
+	tests := []struct {
+		prefix, location string
+		want             string
+	}{
+		{"", "PID.5", "PID.5"},
+		{"", "1", "1"},
+		{"PID.5", "1", "PID.5.1"},
+		{"PID.5", "PV1.2", "PV1.2"},
+		{"PID", "5.1", "PID.5.1"},
+	}
+	for _, tt := range tests {
+		if got := ResolveLocation(tt.prefix, tt.location); got != tt.want {
+			t.Errorf("ResolveLocation(%q, %q) = %q, want %q", tt.prefix, tt.location, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package structures
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Element is a segment, segment group or choice in a message structure.
+type Element struct {
+	Segment  string    // segment name; empty for groups and choices
+	Group    string    // group name, after its first segment; empty for segments
+	Choice   bool      // Children are alternatives, exactly one of which occurs
+	Min      int       // minimum occurrences
+	Max      int       // maximum occurrences, -1 for unbounded
+	Children []Element // group contents or choice alternatives
+}
+
+// FirstSegment returns the first segment name in the element.
+func (e Element) FirstSegment() string {
+	if e.Segment != "" {
+		return e.Segment
+	}
+	for _, c := range e.Children {
+		if s := c.FirstSegment(); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// Contains reports whether a segment named seg occurs anywhere in the
+// element.
+func (e Element) Contains(seg string) bool {
+	if e.Segment != "" {
+		return e.Segment == seg
+	}
+	for _, c := range e.Children {
+		if c.Contains(seg) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanStartWith reports whether a segment named seg can be the first segment
+// of an occurrence of the element.
+func (e Element) CanStartWith(seg string) bool {
+	if e.Segment != "" {
+		return e.Segment == seg
+	}
+	for _, c := range e.Children {
+		if c.CanStartWith(seg) {
+			return true
+		}
+		if c.Min > 0 && !e.Choice {
+			return false
+		}
+	}
+	return false
+}
+
+// Parse parses abstract message syntax into structure elements.
+func Parse(syntax string) ([]Element, error) {
+	p := &parser{tokens: tokenize(syntax)}
+	elements, err := p.parseSequence("")
+	if err != nil {
+		return nil, err
+	}
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("structure has no segments")
+	}
+	return elements, nil
+}
+
+// tokenize splits abstract message syntax into segment names and brackets.
+func tokenize(syntax string) []string {
+	var tokens []string
+	var name strings.Builder
+	flush := func() {
+		if name.Len() > 0 {
+			tokens = append(tokens, name.String())
+			name.Reset()
+		}
+	}
+	for _, r := range syntax {
+		switch {
+		case strings.ContainsRune("[]{}<>|", r):
+			flush()
+			tokens = append(tokens, string(r))
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ',':
+			flush()
+		default:
+			name.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+// parser is a recursive descent parser for abstract message syntax.
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+// parseSequence parses elements up to one of the closing tokens, or to the
+// end of the input if closing is empty.
+func (p *parser) parseSequence(closing string) ([]Element, error) {
+	var elements []Element
+	for p.pos < len(p.tokens) {
+		tok := p.tokens[p.pos]
+		p.pos++
+
+		switch {
+		case closing != "" && strings.Contains(closing, tok):
+			p.pos-- // left for the caller
+			return elements, nil
+		case tok == "[" || tok == "{":
+			end := "]"
+			if tok == "{" {
+				end = "}"
+			}
+			children, err := p.parseSequence(end)
+			if err != nil {
+				return nil, err
+			}
+			p.pos++
+			if len(children) == 0 {
+				return nil, fmt.Errorf("empty %s%s", tok, end)
+			}
+			elements = append(elements, bracket(tok, children))
+		case tok == "<":
+			e, err := p.parseChoice()
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, e)
+		case strings.Contains("]}>|", tok):
+			return nil, fmt.Errorf("unexpected %q", tok)
+		default:
+			if !isSegmentName(tok) {
+				return nil, fmt.Errorf("invalid segment name %q", tok)
+			}
+			elements = append(elements, Element{Segment: tok, Min: 1, Max: 1})
+		}
+	}
+	if closing != "" {
+		return nil, fmt.Errorf("missing %q", closing[:1])
+	}
+	return elements, nil
+}
+
+// parseChoice parses the alternatives of a choice after its opening "<".
+func (p *parser) parseChoice() (Element, error) {
+	var alternatives []Element
+	for {
+		children, err := p.parseSequence(">|")
+		if err != nil {
+			return Element{}, err
+		}
+		if len(children) == 0 {
+			return Element{}, fmt.Errorf("empty choice alternative")
+		}
+		alternative := children[0]
+		if len(children) > 1 {
+			alternative = Element{Group: children[0].FirstSegment(), Min: 1, Max: 1, Children: children}
+		}
+		alternatives = append(alternatives, alternative)
+
+		tok := p.tokens[p.pos]
+		p.pos++
+		if tok == ">" {
+			break
+		}
+	}
+	return Element{Group: alternatives[0].FirstSegment(), Choice: true, Min: 1, Max: 1, Children: alternatives}, nil
+}
+
+// bracket applies an optional ([) or repeating ({) bracket to its contents.
+// A single element is modified in place; several form a group named after
+// its first segment.
+func bracket(tok string, children []Element) Element {
+	e := Element{Group: children[0].FirstSegment(), Min: 1, Max: 1, Children: children}
+	if len(children) == 1 {
+		e = children[0]
+	}
+	if tok == "[" {
+		e.Min = 0
+	} else {
+		e.Max = -1
+	}
+	return e
+}
+
+// isSegmentName reports whether s is a three character segment name.
+func isSegmentName(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
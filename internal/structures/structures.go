@@ -0,0 +1,119 @@
+// Package structures holds the HL7 v2.5.1 abstract message structures
+// shared by the validate and marshal packages, and a parser for the abstract
+// message syntax they are written in.
+//
+// Segment names are separated by spaces; [ ] marks optional elements, { }
+// repeating ones and < | > a choice of one alternative. Several segments
+// inside brackets form a group:
+//
+//	MSH [{SFT}] EVN PID [PD1] [{PR1 [{ROL}]}] {ORC [<OBR|RXO>]}
+package structures
+
+import "sort"
+
+// syntax holds the abstract message syntax of the built-in message
+// structures.
+var syntax = map[string]string{
+	"ACK":     "MSH [{SFT}] MSA [{ERR}]",
+	"ADT_A01": "MSH [{SFT}] EVN PID [PD1] [{ROL}] [{NK1}] PV1 [PV2] [{ROL}] [{DB1}] [{OBX}] [{AL1}] [{DG1}] [DRG] [{PR1 [{ROL}]}] [{GT1}] [{IN1 [IN2] [{IN3}] [{ROL}]}] [ACC] [UB1] [UB2] [PDA]",
+	"ADT_A02": "MSH [{SFT}] EVN PID [PD1] [{ROL}] PV1 [PV2] [{ROL}] [{DB1}] [{OBX}] [PDA]",
+	"ADT_A03": "MSH [{SFT}] EVN PID [PD1] [{ROL}] [{NK1}] PV1 [PV2] [{ROL}] [{DB1}] [{AL1}] [{DG1}] [DRG] [{PR1 [{ROL}]}] [{OBX}] [{GT1}] [{IN1 [IN2] [{IN3}] [{ROL}]}] [ACC] [PDA]",
+	"ADT_A05": "MSH [{SFT}] EVN PID [PD1] [{ROL}] [{NK1}] PV1 [PV2] [{ROL}] [{DB1}] [{OBX}] [{AL1}] [{DG1}] [DRG] [{PR1 [{ROL}]}] [{GT1}] [{IN1 [IN2] [{IN3}] [{ROL}]}] [ACC] [UB1] [UB2]",
+	"ADT_A09": "MSH [{SFT}] EVN PID [PD1] PV1 [PV2] [{DB1}] [{OBX}] [{DG1}]",
+	"ADT_A39": "MSH [{SFT}] EVN {PID [PD1] MRG [PV1]}",
+	"BAR_P01": "MSH [{SFT}] EVN PID [PD1] [{ROL}] {[PV1] [PV2] [{ROL}] [{DB1}] [{OBX}] [{AL1}] [{DG1}] [DRG] [{PR1 [{ROL}]}] [{GT1}] [{NK1}] [{IN1 [IN2] [{IN3}] [{ROL}]}] [ACC] [UB1] [UB2]}",
+	"DFT_P03": "MSH [{SFT}] EVN PID [PD1] [{ROL}] [PV1] [PV2] [{ROL}] [{DB1}] [{ORC [{TQ1 [{TQ2}]}] [OBR [{NTE}]] [{OBX [{NTE}]}]}] {FT1 [{PR1 [{ROL}]}] [{ORC [{TQ1 [{TQ2}]}] [OBR [{NTE}]] [{OBX [{NTE}]}]}]} [{DG1}] [DRG] [{GT1}] [{IN1 [IN2] [{IN3}] [{ROL}]}] [ACC]",
+	"MDM_T01": "MSH [{SFT}] EVN PID PV1 [{ORC [{TQ1 [{TQ2}]}] OBR [{NTE}]}] TXA [{CON}]",
+	"MDM_T02": "MSH [{SFT}] EVN PID PV1 [{ORC [{TQ1 [{TQ2}]}] OBR [{NTE}]}] TXA [{CON}] {OBX [{NTE}]}",
+	"OML_O21": "MSH [{SFT}] [{NTE}] [PID [PD1] [{NTE}] [{NK1}] [PV1 [PV2]] [{IN1 [IN2] [IN3]}] [GT1] [{AL1}]] {ORC [{TQ1 [{TQ2}]}] [OBR [{TCD}] [{NTE}] [{CTD}] [{DG1}] [{OBX [TCD] [{NTE}]}] [{SPM [{OBX}] [{SAC [{OBX}]}]}] [{[PID [PD1]] [PV1 [PV2]] [{AL1}] {[ORC] OBR [{NTE}] [{TQ1 [{TQ2}]}] [{OBX [{NTE}]}]}}]] [{FT1}] [{CTI}] [BLG]}",
+	"ORL_O22": "MSH MSA [{ERR}] [{SFT}] [{NTE}] [PID [{ORC [{TQ1 [{TQ2}]}] [OBR [{SPM [{SAC}]}]]}]]",
+	"ORM_O01": "MSH [{SFT}] [{NTE}] [PID [PD1] [{NTE}] [PV1 [PV2]] [{IN1 [IN2] [IN3]}] [GT1] [{AL1}]] {ORC [<OBR|RQD|RQ1|RXO|ODS|ODT> [{NTE}] [CTD] [{DG1}] [{OBX [{NTE}]}]] [{FT1}] [{CTI}] [BLG]}",
+	"ORR_O02": "MSH MSA [{ERR}] [{NTE}] [[PID [{NTE}]] {ORC [<OBR|RQD|RQ1|RXO|ODS|ODT>] [{NTE}] [{CTI}]}]",
+	"ORU_R01": "MSH [{SFT}] {[PID [PD1] [{NTE}] [{NK1}] [PV1 [PV2]]] {[ORC] OBR [{NTE}] [{TQ1 [{TQ2}]}] [CTD] [{OBX [{NTE}]}] [{FT1}] [{CTI}] [{SPM [{OBX}]}]}} [DSC]",
+	"QBP_Q11": "MSH [{SFT}] QPD RCP [DSC]",
+	"RDE_O11": "MSH [{SFT}] [{NTE}] [PID [PD1] [{NTE}] [PV1 [PV2]] [{IN1 [IN2] [IN3]}] [GT1] [{AL1}]] {ORC [{TQ1 [{TQ2}]}] [RXO [{NTE}] {RXR} [{RXC [{NTE}]}]] RXE [{NTE}] {TQ1 [{TQ2}]} {RXR} [{RXC}] [{OBX [{NTE}]}] [{FT1}] [BLG] [{CTI}]}",
+	"RSP_K11": "MSH [{SFT}] MSA [ERR] QAK QPD [DSC]",
+	"SIU_S12": "MSH SCH [{TQ1}] [{NTE}] [{PID [PD1] [PV1] [PV2] [{OBX}] [{DG1}]}] {RGS [{AIS [{NTE}]}] [{AIG [{NTE}]}] [{AIL [{NTE}]}] [{AIP [{NTE}]}]}",
+	"VXU_V04": "MSH [{SFT}] PID [PD1] [{NK1}] [PV1 [PV2]] [{GT1}] [{IN1 [IN2] [IN3]}] [{ORC [{TQ1 [TQ2]}] RXA [RXR] [{OBX [{NTE}]}]}]",
+}
+
+// partial lists structures whose syntax leaves out segments, such as the
+// query-specific segments of RSP_K11. They give the segment order but cannot
+// be used to validate a message.
+var partial = map[string]bool{
+	"RSP_K11": true,
+}
+
+// events maps message type and trigger event pairs to the message structure
+// they share, for events whose structure is not named after them.
+var events = map[string]string{
+	"ADT^A04": "ADT_A01",
+	"ADT^A08": "ADT_A01",
+	"ADT^A13": "ADT_A01",
+	"ADT^A10": "ADT_A09",
+	"ADT^A11": "ADT_A09",
+	"ADT^A14": "ADT_A05",
+	"ADT^A28": "ADT_A05",
+	"ADT^A31": "ADT_A05",
+	"ADT^A40": "ADT_A39",
+	"ADT^A41": "ADT_A39",
+	"ADT^A42": "ADT_A39",
+	"MDM^T03": "MDM_T01",
+	"MDM^T04": "MDM_T02",
+	"MDM^T05": "MDM_T01",
+	"MDM^T06": "MDM_T02",
+	"MDM^T07": "MDM_T01",
+	"MDM^T08": "MDM_T02",
+	"MDM^T09": "MDM_T01",
+	"MDM^T10": "MDM_T02",
+	"MDM^T11": "MDM_T01",
+	"SIU^S13": "SIU_S12",
+	"SIU^S14": "SIU_S12",
+	"SIU^S15": "SIU_S12",
+	"SIU^S16": "SIU_S12",
+	"SIU^S17": "SIU_S12",
+	"SIU^S18": "SIU_S12",
+	"SIU^S19": "SIU_S12",
+	"SIU^S20": "SIU_S12",
+	"SIU^S21": "SIU_S12",
+	"SIU^S22": "SIU_S12",
+	"SIU^S23": "SIU_S12",
+	"SIU^S24": "SIU_S12",
+	"SIU^S26": "SIU_S12",
+}
+
+// Syntax returns the abstract message syntax of a built-in message
+// structure such as "ADT_A01", and false if it is not known.
+func Syntax(name string) (string, bool) {
+	s, ok := syntax[name]
+	return s, ok
+}
+
+// Partial reports whether the syntax of a structure leaves out segments, so
+// that it gives the segment order but cannot validate a message.
+func Partial(name string) bool {
+	return partial[name]
+}
+
+// Names returns the names of the built-in message structures in sorted
+// order.
+func Names() []string {
+	names := make([]string, 0, len(syntax))
+	for name := range syntax {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the message structure for a message type and trigger
+// event, or "" if it is not known.
+func Lookup(msgType, trigger string) string {
+	if msgType == "ACK" {
+		return "ACK"
+	}
+	if s := msgType + "_" + trigger; syntax[s] != "" {
+		return s
+	}
+	return events[msgType+"^"+trigger]
+}
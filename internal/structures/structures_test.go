@@ -0,0 +1,77 @@
+package structures
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSyntax(t *testing.T) {
+	for _, name := range Names() {
+		s, ok := Syntax(name)
+		if !ok {
+			t.Errorf("Syntax(%s) not found", name)
+			continue
+		}
+		if _, err := Parse(s); err != nil {
+			t.Errorf("structure %s: %v", name, err)
+		}
+	}
+
+	if _, ok := Syntax("XYZ_Z99"); ok {
+		t.Error("Syntax(XYZ_Z99) found")
+	}
+	if !Partial("RSP_K11") || Partial("ADT_A01") {
+		t.Error("Partial() should be true for RSP_K11 only")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		msgType, trigger string
+		want             string
+	}{
+		{"ADT", "A01", "ADT_A01"},
+		{"ADT", "A08", "ADT_A01"},
+		{"ACK", "A01", "ACK"},
+		{"ORM", "O01", "ORM_O01"},
+		{"ZZZ", "Z01", ""},
+	}
+	for _, tt := range tests {
+		if got := Lookup(tt.msgType, tt.trigger); got != tt.want {
+			t.Errorf("Lookup(%s, %s) = %q, want %q", tt.msgType, tt.trigger, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	elements, err := Parse("MSH [PD1] {ORC [<OBR|RXO [{NTE}]>]}")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []Element{
+		{Segment: "MSH", Min: 1, Max: 1},
+		{Segment: "PD1", Min: 0, Max: 1},
+		{Group: "ORC", Min: 1, Max: -1, Children: []Element{
+			{Segment: "ORC", Min: 1, Max: 1},
+			{Group: "OBR", Choice: true, Min: 0, Max: 1, Children: []Element{
+				{Segment: "OBR", Min: 1, Max: 1},
+				{Group: "RXO", Min: 1, Max: 1, Children: []Element{
+					{Segment: "RXO", Min: 1, Max: 1},
+					{Segment: "NTE", Min: 0, Max: -1},
+				}},
+			}},
+		}},
+	}
+	if !reflect.DeepEqual(elements, want) {
+		t.Errorf("Parse() =\n%+v\nwant\n%+v", elements, want)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, syntax := range []string{"", "MSH [PD1", "MSH PD1]", "MSH []", "MSH [PD1}", "MSH pid", "MSH PIDX", "MSH <OBR", "MSH <>", "MSH <OBR|>", "MSH OBR|RXO"} {
+		if _, err := Parse(syntax); err == nil {
+			t.Errorf("Parse(%q) expected error", syntax)
+		}
+	}
+}
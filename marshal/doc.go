@@ -67,7 +67,8 @@
 //   - bool: Boolean values (true/false, yes/no, Y/N)
 //   - time.Time: Date and time values (configurable format)
 //   - *T: Pointers to any supported type (nil = empty field)
-//   - []T: Slices for repeating fields
+//   - []T and *[]T: Slices for repeating fields
+//   - map[string]string: All values of a segment or field, keyed by position
 //
 // # Marshaler Options
 //
@@ -86,6 +87,9 @@
 //	loc, _ := time.LoadLocation("America/New_York")
 //	m := marshal.NewMarshaler(marshal.WithTimeLocation(loc))
 //
+//	// Copy MSH defaults from a template message
+//	m := marshal.NewMarshaler(marshal.WithTemplate(tmpl))
+//
 // # Time Formats
 //
 // Common HL7 time formats:
@@ -132,6 +136,65 @@
 //	    Name Name `hl7:"PID.5"`  // Maps to PID-5 (patient name)
 //	}
 //
+// # Embedded and Inline Structs
+//
+// Anonymous embedded structs are promoted, as in Go: their fields behave as if
+// declared in the enclosing struct. The inline option does the same for a
+// named field, which is useful for sharing a header across message structs:
+//
+//	type Header struct {
+//	    SendingApp string `hl7:"MSH.3"`
+//	    ControlID  string `hl7:"MSH.10"`
+//	}
+//
+//	type ADTMessage struct {
+//	    Header `hl7:",inline"`
+//	    PatientID string `hl7:"PID.3"`
+//	}
+//
+// # Map Fields
+//
+// A map[string]string captures every non-empty value under a segment, keyed by
+// field number, or under a field, keyed by component number. This is useful
+// for site-specific Z-segments:
+//
+//	type Custom struct {
+//	    ZPI  map[string]string `hl7:"ZPI"`   // {"1": "A", "3": "B^C"}
+//	    Name map[string]string `hl7:"PID.5"` // {"1": "Smith", "2": "John"}
+//	}
+//
+// When marshaling, keys must be positive integers; entries are written in
+// ascending order.
+//
+// # Message Templates
+//
+// By default Marshal builds a message from scratch, so every MSH field must be
+// tagged. A struct can instead declare its message type with an hl7msg tag on
+// any field, usually a blank marker field. The format is
+// "TYPE^TRIGGER[^STRUCTURE][,VERSION]"; a missing structure is looked up from
+// the trigger event where known (ADT^A04 uses ADT_A01):
+//
+//	type Admit struct {
+//	    _         struct{} `hl7msg:"ADT^A01^ADT_A01,2.5.1"`
+//	    PatientID string   `hl7:"PID.3"`
+//	}
+//
+// WithTemplate supplies a message, typically just an MSH segment with the
+// sending and receiving applications, that is copied for every Marshal call.
+// The declaration takes precedence over the template's MSH-9 and MSH-12.
+//
+// With either, Marshal seeds MSH with the delimiters, a fresh timestamp
+// (MSH-7), the message type (MSH-9), a generated control ID (MSH-10), the
+// processing ID "P" if the template has none (MSH-11) and the version
+// (MSH-12). Struct fields tagged with MSH locations override seeded values.
+// Segments are then ordered by the message structure rather than tag order.
+// Within a repeating group, such as an ORU order, segments keep the order
+// they were added in, so an NTE stays with the OBX it follows; segments the
+// structure does not define, such as Z-segments, come last.
+// Use WithTimeFunc and WithControlIDFunc or WithControlIDGenerator to
+// control the generated values.
+// MarshalInto does not apply templates or declarations.
+//
 // # Example: ADT Message Processing
 //
 //	// Define structs for ADT message
@@ -170,4 +233,26 @@
 //	            fieldErr.Field, fieldErr.Location, fieldErr.Cause)
 //	    }
 //	}
+//
+// # Strict Mode
+//
+// By default unmarshaling stops at the first conversion failure. For intake QA,
+// strict mode attempts every field and returns all failures as FieldErrors.
+// Unknown fields can also be reported, similar to json.Decoder.DisallowUnknownFields:
+//
+//	u := marshal.NewUnmarshaler(
+//	    marshal.WithStrict(true),
+//	    marshal.WithDisallowUnknownFields(true),
+//	)
+//
+//	var errs marshal.FieldErrors
+//	if err := u.Unmarshal(msg, &patient); errors.As(err, &errs) {
+//	    for _, fe := range errs {
+//	        if errors.Is(fe, marshal.ErrUnknownField) {
+//	            fmt.Printf("unmapped value at %s\n", fe.Location)
+//	            continue
+//	        }
+//	        fmt.Printf("%s (%s): %v\n", fe.Field, fe.Location, fe.Cause)
+//	    }
+//	}
 package marshal
@@ -0,0 +1,81 @@
+package marshal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownField indicates a message value that is not mapped to any struct field.
+// It is only reported when unknown fields are disallowed.
+var ErrUnknownField = errors.New("unknown field")
+
+// ErrInvalidMapKey indicates a map field key that is not a positive
+// field or component number.
+var ErrInvalidMapKey = errors.New("map key must be a positive field or component number")
+
+// FieldError describes a failure to convert a single message value into a struct field.
+type FieldError struct {
+	// Field is the Go field path (e.g., "Name.Last" or "IDs[1]").
+	// Empty for unknown fields, which have no corresponding struct field.
+	Field string
+	// Location is the HL7 location of the value (e.g., "PID.5.1").
+	Location string
+	// Cause is the underlying conversion error.
+	Cause error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	var msg string
+	switch {
+	case e.Field != "" && e.Location != "":
+		msg = fmt.Sprintf("field %s (%s)", e.Field, e.Location)
+	case e.Field != "":
+		msg = fmt.Sprintf("field %s", e.Field)
+	default:
+		msg = fmt.Sprintf("location %s", e.Location)
+	}
+
+	if e.Cause != nil {
+		msg = fmt.Sprintf("%s: %v", msg, e.Cause)
+	}
+
+	return msg
+}
+
+// Unwrap returns the underlying cause of the field error.
+func (e *FieldError) Unwrap() error {
+	return e.Cause
+}
+
+// FieldErrors is the aggregated error returned by a strict Unmarshaler.
+// It contains one FieldError per failed field, in struct field order,
+// followed by any unknown fields in message order.
+type FieldErrors []*FieldError
+
+// Error implements the error interface.
+func (e FieldErrors) Error() string {
+	switch len(e) {
+	case 0:
+		return "no field errors"
+	case 1:
+		return e[0].Error()
+	}
+
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return fmt.Sprintf("%d field errors: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual field errors so errors.Is and errors.As
+// can match any of them.
+func (e FieldErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+	return errs
+}
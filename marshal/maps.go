@@ -0,0 +1,159 @@
+package marshal
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// checkMapType reports an error unless t is a map with string keys and values.
+func checkMapType(t reflect.Type) error {
+	if t.Key().Kind() != reflect.String || t.Elem().Kind() != reflect.String {
+		return fmt.Errorf("%w: %s (maps must have string keys and values)", ErrUnsupportedType, t.String())
+	}
+	return nil
+}
+
+// parseMapLocation parses the location of a map field, which must name
+// a segment or a field.
+func parseMapLocation(location string) (*hl7.Location, error) {
+	loc, err := hl7.ParseLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	if loc.HasComponent() {
+		return nil, fmt.Errorf("%w: map location %s must name a segment or field", ErrInvalidTagFormat, location)
+	}
+	return loc, nil
+}
+
+// marshalMap sets every non-empty map entry below the map's location.
+// Entries are written in ascending key order.
+func (m *marshaler) marshalMap(msg hl7.Message, field reflect.Value, tagInfo *tagInfo) error {
+	if err := checkMapType(field.Type()); err != nil {
+		return err
+	}
+	if _, err := parseMapLocation(tagInfo.location); err != nil {
+		return err
+	}
+
+	type entry struct {
+		pos   int
+		value string
+	}
+
+	entries := make([]entry, 0, field.Len())
+	iter := field.MapRange()
+	for iter.Next() {
+		key := iter.Key().String()
+		pos, err := strconv.Atoi(key)
+		if err != nil || pos < 1 {
+			return fmt.Errorf("%w: %q", ErrInvalidMapKey, key)
+		}
+		if value := iter.Value().String(); value != "" {
+			entries = append(entries, entry{pos: pos, value: value})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].pos < entries[j].pos })
+
+	for _, e := range entries {
+		location := tagInfo.location + "." + strconv.Itoa(e.pos)
+		if err := m.setMessageValue(msg, location, e.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unmarshalMap fills a map field with the non-empty values below its location.
+// An existing map is added to rather than replaced.
+func (u *unmarshaler) unmarshalMap(msg hl7.Message, field reflect.Value, tagInfo *tagInfo, path string, st *decodeState) error {
+	if err := checkMapType(field.Type()); err != nil {
+		return st.fail(path, tagInfo.location, err)
+	}
+
+	st.markMapped(tagInfo.location, false)
+
+	values, err := mapValues(msg, tagInfo.location)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return st.fail(path, tagInfo.location, err)
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	mapType := field.Type()
+	if field.IsNil() {
+		field.Set(reflect.MakeMapWithSize(mapType, len(values)))
+	}
+	for key, value := range values {
+		field.SetMapIndex(
+			reflect.ValueOf(key).Convert(mapType.Key()),
+			reflect.ValueOf(value).Convert(mapType.Elem()),
+		)
+	}
+
+	return nil
+}
+
+// mapValues returns the non-empty values below location keyed by position.
+func mapValues(msg hl7.Message, location string) (map[string]string, error) {
+	loc, err := parseMapLocation(location)
+	if err != nil {
+		return nil, err
+	}
+
+	segs := msg.Segments(loc.Segment)
+	segIndex := 0
+	if loc.HasSegmentIndex() {
+		segIndex = loc.SegmentIndex
+	}
+	if segIndex >= len(segs) {
+		return nil, fmt.Errorf("%w: %s", hl7.ErrSegmentNotFound, location)
+	}
+	seg := segs[segIndex]
+
+	values := make(map[string]string)
+
+	if !loc.HasField() {
+		for i, f := range seg.AllFields() {
+			if f == nil {
+				continue
+			}
+			if v := f.String(); v != "" {
+				values[strconv.Itoa(i+1)] = v
+			}
+		}
+		return values, nil
+	}
+
+	f, ok := seg.Field(loc.Field)
+	if !ok || f == nil {
+		return nil, fmt.Errorf("%w: %s", hl7.ErrFieldNotFound, location)
+	}
+
+	comps := f.Components()
+	if loc.HasRepetition() {
+		rep, ok := f.Repetition(loc.Repetition)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", hl7.ErrFieldNotFound, location)
+		}
+		comps = rep.Components()
+	}
+
+	for i, c := range comps {
+		if v := c.String(); v != "" {
+			values[strconv.Itoa(i+1)] = v
+		}
+	}
+	return values, nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/structtag"
 )
 
 // Marshal errors.
@@ -20,6 +21,8 @@ var (
 type Marshaler interface {
 	// Marshal creates a new HL7 message from the struct.
 	// The struct fields should be tagged with hl7 tags specifying the location path.
+	// If a template is configured or the struct declares its message type with an
+	// hl7msg tag, MSH is seeded and segments are ordered by the message structure.
 	//
 	// Example:
 	//   type Patient struct {
@@ -35,6 +38,7 @@ type Marshaler interface {
 
 	// MarshalInto populates an existing HL7 message with data from the struct.
 	// This allows updating specific fields while preserving other message content.
+	// The template and hl7msg declaration are not applied.
 	//
 	// Example:
 	//   msg, _ := parser.Parse(rawMessage)
@@ -62,14 +66,21 @@ func (m *marshaler) Marshal(v interface{}) (hl7.Message, error) {
 		return nil, err
 	}
 
-	// Create a new message
-	msg := hl7.NewEmptyMessage()
+	// Create a new message, seeded from the template and message declaration
+	msg, decl, err := m.newMessage(rv.Type())
+	if err != nil {
+		return nil, err
+	}
 
-	// Marshal struct into message
+	// Marshal struct into message; struct values override seeded MSH values
 	if err := m.marshalStruct(msg, rv); err != nil {
 		return nil, err
 	}
 
+	if decl != nil && decl.structure != "" {
+		msg = orderSegments(msg, decl.structure)
+	}
+
 	return msg, nil
 }
 
@@ -108,23 +119,37 @@ func (m *marshaler) getStructValue(v interface{}) (reflect.Value, error) {
 
 // marshalStruct marshals a struct value into an HL7 message.
 func (m *marshaler) marshalStruct(msg hl7.Message, rv reflect.Value) error {
+	return m.marshalFields(msg, rv, "")
+}
+
+// marshalFields marshals the fields of a struct value. prefix is the location
+// of the enclosing tagged struct, against which relative locations are resolved.
+// Untagged struct fields are walked only at the top level (empty prefix);
+// embedded and inline structs are promoted at any level.
+func (m *marshaler) marshalFields(msg hl7.Message, rv reflect.Value, prefix string) error {
 	rt := rv.Type()
 
 	for i := 0; i < rv.NumField(); i++ {
 		field := rv.Field(i)
 		fieldType := rt.Field(i)
 
-		// Skip unexported fields
-		if !fieldType.IsExported() {
+		// Skip unexported fields, except embedded structs whose exported
+		// fields are promoted
+		if !fieldType.IsExported() && !isEmbeddedStruct(fieldType) {
 			continue
 		}
 
 		// Get and parse tag
 		tag := fieldType.Tag.Get(m.config.tagName)
 		if tag == "" {
-			// Check if it's a nested struct without a tag
-			if field.Kind() == reflect.Struct && fieldType.Type != reflect.TypeOf(time.Time{}) {
-				if err := m.marshalStruct(msg, field); err != nil {
+			switch {
+			case isEmbeddedStruct(fieldType):
+				if err := m.marshalPromoted(msg, field, prefix); err != nil {
+					return err
+				}
+			case prefix == "" && field.Kind() == reflect.Struct && fieldType.Type != timeType:
+				// Untagged nested struct at the top level
+				if err := m.marshalFields(msg, field, ""); err != nil {
 					return err
 				}
 			}
@@ -136,17 +161,26 @@ func (m *marshaler) marshalStruct(msg hl7.Message, rv reflect.Value) error {
 			return fmt.Errorf("field %s: %w", fieldType.Name, err)
 		}
 
-		if tagInfo.ignore || !tagInfo.hasLocation() {
+		if tagInfo.ignore {
 			continue
 		}
 
+		if tagInfo.inline {
+			if err := m.marshalPromoted(msg, field, prefix); err != nil {
+				return fmt.Errorf("field %s: %w", fieldType.Name, err)
+			}
+			continue
+		}
+
+		tagInfo.location = structtag.ResolveLocation(prefix, tagInfo.location)
+
 		// Check if we should skip zero values
 		if tagInfo.shouldOmit(m.config.omitEmpty) && isZeroValue(field) {
 			continue
 		}
 
 		// Marshal field into message
-		if err := m.marshalField(msg, field, fieldType, tagInfo); err != nil {
+		if err := m.marshalField(msg, field, tagInfo); err != nil {
 			return fmt.Errorf("field %s: %w", fieldType.Name, err)
 		}
 	}
@@ -154,14 +188,26 @@ func (m *marshaler) marshalStruct(msg hl7.Message, rv reflect.Value) error {
 	return nil
 }
 
-// marshalField marshals a single field into the message.
-func (m *marshaler) marshalField(msg hl7.Message, field reflect.Value, fieldType reflect.StructField, tagInfo *tagInfo) error {
-	// Handle slice types for repetitions
-	if field.Kind() == reflect.Slice {
-		return m.marshalSlice(msg, field, tagInfo)
+// marshalPromoted marshals the fields of an embedded or inline struct as if
+// they were declared in the enclosing struct. Nil pointers are skipped.
+func (m *marshaler) marshalPromoted(msg hl7.Message, field reflect.Value, prefix string) error {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return nil
+		}
+		field = field.Elem()
+	}
+
+	if field.Kind() != reflect.Struct || field.Type() == timeType {
+		return errInlineNotStruct
 	}
 
-	// Handle pointer types
+	return m.marshalFields(msg, field, prefix)
+}
+
+// marshalField marshals a single field into the message.
+func (m *marshaler) marshalField(msg hl7.Message, field reflect.Value, tagInfo *tagInfo) error {
+	// Handle pointer types, including pointers to slices and maps
 	if field.Kind() == reflect.Ptr {
 		if field.IsNil() {
 			return nil // Skip nil pointers
@@ -169,9 +215,15 @@ func (m *marshaler) marshalField(msg hl7.Message, field reflect.Value, fieldType
 		field = field.Elem()
 	}
 
-	// Handle nested structs (but not time.Time)
-	if field.Kind() == reflect.Struct && fieldType.Type != reflect.TypeOf(time.Time{}) {
-		return m.marshalNestedStruct(msg, field, tagInfo)
+	switch {
+	case field.Kind() == reflect.Slice:
+		// Repetitions
+		return m.marshalSlice(msg, field, tagInfo)
+	case field.Kind() == reflect.Map:
+		return m.marshalMap(msg, field, tagInfo)
+	case field.Kind() == reflect.Struct && field.Type() != timeType:
+		// Nested struct: its location is a prefix for the nested fields
+		return m.marshalFields(msg, field, tagInfo.location)
 	}
 
 	// Convert field value to string
@@ -188,15 +240,21 @@ func (m *marshaler) marshalField(msg hl7.Message, field reflect.Value, fieldType
 	return m.setMessageValue(msg, tagInfo.location, value)
 }
 
-// marshalSlice marshals a slice field into the message (for repetitions).
+// marshalSlice marshals a slice field into the message, one element per
+// repetition of the field. Empty elements leave their repetition empty.
 func (m *marshaler) marshalSlice(msg hl7.Message, field reflect.Value, tagInfo *tagInfo) error {
 	if field.Len() == 0 {
 		return nil
 	}
 
-	// For now, we handle the first element
-	// Full repetition support requires message interface changes
-	// to support setting multiple repetitions
+	loc, err := hl7.ParseLocation(tagInfo.location)
+	if err != nil {
+		return err
+	}
+	first := 0
+	if loc.HasRepetition() {
+		first = loc.Repetition
+	}
 
 	for i := 0; i < field.Len(); i++ {
 		elem := field.Index(i)
@@ -218,58 +276,8 @@ func (m *marshaler) marshalSlice(msg hl7.Message, field reflect.Value, tagInfo *
 			continue
 		}
 
-		// For the first element, set normally
-		// For subsequent elements, we would need SetRepetition or similar
-		if i == 0 {
-			if err := m.setMessageValue(msg, tagInfo.location, value); err != nil {
-				return err
-			}
-		}
-		// Note: Additional repetitions require message interface support
-		// which may not be fully implemented yet
-	}
-
-	return nil
-}
-
-// marshalNestedStruct handles nested struct fields.
-func (m *marshaler) marshalNestedStruct(msg hl7.Message, field reflect.Value, tagInfo *tagInfo) error {
-	rt := field.Type()
-
-	for i := 0; i < field.NumField(); i++ {
-		nestedField := field.Field(i)
-		nestedFieldType := rt.Field(i)
-
-		if !nestedFieldType.IsExported() {
-			continue
-		}
-
-		tag := nestedFieldType.Tag.Get(m.config.tagName)
-		if tag == "" {
-			continue
-		}
-
-		nestedTagInfo, err := parseTag(tag)
-		if err != nil {
-			return fmt.Errorf("nested field %s: %w", nestedFieldType.Name, err)
-		}
-
-		if nestedTagInfo.ignore || !nestedTagInfo.hasLocation() {
-			continue
-		}
-
-		// Combine parent location with nested location
-		location := nestedTagInfo.location
-		if tagInfo.location != "" && !startsWithSegment(location) {
-			location = tagInfo.location + "." + location
-			nestedTagInfo.location = location
-		}
-
-		if nestedTagInfo.shouldOmit(m.config.omitEmpty) && isZeroValue(nestedField) {
-			continue
-		}
-
-		if err := m.marshalField(msg, nestedField, nestedFieldType, nestedTagInfo); err != nil {
+		loc.Repetition = first + i
+		if err := m.setMessageValue(msg, loc.String(), value); err != nil {
 			return err
 		}
 	}
@@ -333,7 +341,7 @@ func (m *marshaler) fieldToString(field reflect.Value, tagInfo *tagInfo) (string
 
 	case reflect.Struct:
 		// Check for time.Time
-		if field.Type() == reflect.TypeOf(time.Time{}) {
+		if field.Type() == timeType {
 			return m.timeToString(field.Interface().(time.Time), tagInfo), nil
 		}
 		return "", fmt.Errorf("%w: %s", ErrUnsupportedType, field.Type().String())
@@ -370,7 +378,7 @@ func isZeroValue(v reflect.Value) bool {
 		return v.IsNil()
 	case reflect.Struct:
 		// Special case for time.Time
-		if v.Type() == reflect.TypeOf(time.Time{}) {
+		if v.Type() == timeType {
 			return v.Interface().(time.Time).IsZero()
 		}
 		// Check all fields
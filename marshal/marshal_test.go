@@ -2,10 +2,14 @@ package marshal
 
 import (
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/dshills/golevel7/controlid"
 	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/parse"
 )
 
 func TestNewMarshaler(t *testing.T) {
@@ -498,9 +502,469 @@ func TestMarshaler_SliceField(t *testing.T) {
 		t.Fatalf("Marshal() error = %v", err)
 	}
 
-	// At minimum, the first ID should be set
-	gotID, _ := msg.Get("PID.3")
-	if gotID != "ID1" {
-		t.Errorf("PID.3 = %q, want %q", gotID, "ID1")
+	got, err := msg.GetAll("PID.3")
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, ids.IDs) {
+		t.Errorf("PID.3 repetitions = %q, want %q", got, ids.IDs)
+	}
+
+	var out Identifiers
+	if err := NewUnmarshaler().Unmarshal(msg, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(out, ids) {
+		t.Errorf("round trip = %+v, want %+v", out, ids)
+	}
+}
+
+func TestMarshaler_SliceComponent(t *testing.T) {
+	type Names struct {
+		Family []string `hl7:"PID.5.1"`
+	}
+
+	msg, err := NewMarshaler().Marshal(Names{Family: []string{"SMITH", "", "JONES"}})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	seg, _ := msg.Segment("PID")
+	if got := string(seg.Bytes(msg.Delimiters())); got != "PID|||||SMITH~~JONES" {
+		t.Errorf("PID = %q, want %q", got, "PID|||||SMITH~~JONES")
+	}
+}
+
+func TestMarshaler_MapField(t *testing.T) {
+	type Record struct {
+		Custom map[string]string `hl7:"ZPI"`
+		Parts  map[string]string `hl7:"ZPX.2"`
+	}
+
+	r := Record{
+		Custom: map[string]string{"1": "a", "3": "b^c", "2": ""},
+		Parts:  map[string]string{"2": "y", "1": "x"},
+	}
+
+	msg, err := NewMarshaler().Marshal(r)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	for loc, want := range map[string]string{"ZPI.1": "a", "ZPI.2": "", "ZPI.3": "b^c", "ZPX.2.1": "x", "ZPX.2.2": "y"} {
+		got, _ := msg.Get(loc)
+		if got != want {
+			t.Errorf("%s = %q, want %q", loc, got, want)
+		}
+	}
+}
+
+func TestMarshaler_MapFieldInvalidKey(t *testing.T) {
+	r := struct {
+		Custom map[string]string `hl7:"ZPI"`
+	}{Custom: map[string]string{"name": "a"}}
+
+	_, err := NewMarshaler().Marshal(r)
+	if !errors.Is(err, ErrInvalidMapKey) {
+		t.Errorf("Marshal() error = %v, want ErrInvalidMapKey", err)
+	}
+}
+
+func TestMarshaler_EmbeddedStruct(t *testing.T) {
+	type Message struct {
+		*Header
+		ID string `hl7:"PID.3"`
+	}
+
+	msg, err := NewMarshaler().Marshal(Message{
+		Header: &Header{SendingApp: "APP", ControlID: "MSG1"},
+		ID:     "12345",
+	})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	for loc, want := range map[string]string{"MSH.3": "APP", "MSH.10": "MSG1", "PID.3": "12345"} {
+		got, _ := msg.Get(loc)
+		if got != want {
+			t.Errorf("%s = %q, want %q", loc, got, want)
+		}
+	}
+
+	// A nil embedded pointer is skipped
+	msg, err = NewMarshaler().Marshal(Message{ID: "12345"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if _, found := msg.Segment("MSH"); found {
+		t.Error("MSH segment created for nil embedded header")
+	}
+}
+
+func TestMarshaler_Inline(t *testing.T) {
+	type ADT struct {
+		Hdr Header `hl7:",inline"`
+		ID  string `hl7:"PID.3"`
+	}
+
+	msg, err := NewMarshaler().Marshal(ADT{Hdr: Header{SendingApp: "APP"}, ID: "12345"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if got, _ := msg.Get("MSH.3"); got != "APP" {
+		t.Errorf("MSH.3 = %q, want %q", got, "APP")
+	}
+
+	bad := struct {
+		Hdr string `hl7:",inline"`
+	}{Hdr: "x"}
+	if _, err := NewMarshaler().Marshal(bad); !errors.Is(err, ErrInvalidTagFormat) {
+		t.Errorf("Marshal() error = %v, want ErrInvalidTagFormat", err)
+	}
+}
+
+func TestMarshaler_PointerToSlice(t *testing.T) {
+	type Identifiers struct {
+		IDs   *[]string `hl7:"PID.3"`
+		Other *[]string `hl7:"PID.4"`
+	}
+
+	ids := []string{"ID1", "ID2"}
+	msg, err := NewMarshaler().Marshal(Identifiers{IDs: &ids})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if got, _ := msg.GetAll("PID.3"); !reflect.DeepEqual(got, ids) {
+		t.Errorf("PID.3 repetitions = %q, want %q", got, ids)
+	}
+	if got, _ := msg.Get("PID.4"); got != "" {
+		t.Errorf("PID.4 = %q, want empty for nil pointer", got)
+	}
+}
+
+func TestMarshaler_MapRoundTrip(t *testing.T) {
+	type Record struct {
+		Header `hl7:",inline"`
+		Custom map[string]string `hl7:"ZPI"`
+	}
+
+	in := Record{
+		Header: Header{SendingApp: "APP"},
+		Custom: map[string]string{"1": "a", "4": "d"},
+	}
+	msg, err := NewMarshaler().Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var out Record
+	if err := NewUnmarshaler().Unmarshal(msg, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+}
+
+// fixedClock returns options that make seeded MSH values deterministic.
+func fixedClock() []Option {
+	return []Option{
+		WithTimeFunc(func() time.Time { return time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC) }),
+		WithControlIDFunc(func() string { return "CTRL1" }),
+	}
+}
+
+func TestMarshaler_MessageDeclaration(t *testing.T) {
+	type Admit struct {
+		_         struct{} `hl7msg:"ADT^A01^ADT_A01,2.5.1"`
+		PatientID string   `hl7:"PID.3"`
+		Event     string   `hl7:"EVN.1"`
+	}
+
+	msg, err := NewMarshaler(fixedClock()...).Marshal(Admit{PatientID: "12345", Event: "A01"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	want := "MSH|^~\\&|||||20240115103000||ADT^A01^ADT_A01|CTRL1|P|2.5.1\rEVN|A01\rPID|||12345\r"
+	if got := msg.String(); got != want {
+		t.Errorf("Marshal() =\n%q\nwant\n%q", got, want)
+	}
+
+	parsed, err := parse.New().Parse(msg.Bytes())
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if parsed.ControlID() != "CTRL1" || parsed.Version() != "2.5.1" {
+		t.Errorf("parsed control ID/version = %q/%q, want CTRL1/2.5.1", parsed.ControlID(), parsed.Version())
+	}
+}
+
+func TestMarshaler_MessageDeclarationTruncationCharacter(t *testing.T) {
+	type AdmitV27 struct {
+		_         struct{} `hl7msg:"ADT^A01^ADT_A01,2.7"`
+		PatientID string   `hl7:"PID.3"`
+	}
+	type AdmitNoVersion struct {
+		_         struct{} `hl7msg:"ADT^A01"`
+		PatientID string   `hl7:"PID.3"`
+	}
+
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"v2.7", AdmitV27{PatientID: "12345"}, "^~\\&#"},
+		{"no version", AdmitNoVersion{PatientID: "12345"}, "^~\\&"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := NewMarshaler(fixedClock()...).Marshal(tt.v)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if got, _ := msg.Get("MSH.2"); got != tt.want {
+				t.Errorf("MSH-2 = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshaler_MessageDeclarationInfersStructure(t *testing.T) {
+	type Register struct {
+		_         struct{} `hl7msg:"ADT^A04"`
+		PatientID string   `hl7:"PID.3"`
+	}
+
+	msg, err := NewMarshaler(fixedClock()...).Marshal(Register{PatientID: "1"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if got, _ := msg.Get("MSH.9"); got != "ADT^A04^ADT_A01" {
+		t.Errorf("MSH.9 = %q, want %q", got, "ADT^A04^ADT_A01")
+	}
+	if got, _ := msg.Get("MSH.12"); got != "" {
+		t.Errorf("MSH.12 = %q, want empty without a declared version", got)
+	}
+}
+
+func TestMarshaler_SegmentOrder(t *testing.T) {
+	type Result struct {
+		_          struct{} `hl7msg:"ORU^R01,2.5.1"`
+		Custom     string   `hl7:"ZRS.1"`
+		Value      string   `hl7:"OBX.5"`
+		Test       string   `hl7:"OBR.4"`
+		Visit      string   `hl7:"PV1.2"`
+		PatientID  string   `hl7:"PID.3"`
+		ControlID  string   `hl7:"MSH.10"`
+		Processing string   `hl7:"MSH.11"`
+	}
+
+	msg, err := NewMarshaler(fixedClock()...).Marshal(Result{
+		Custom: "Z", Value: "7.2", Test: "GLU", Visit: "O", PatientID: "1",
+		ControlID: "MINE", Processing: "T",
+	})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var names []string
+	for _, seg := range msg.AllSegments() {
+		names = append(names, seg.Name())
+	}
+	want := []string{"MSH", "PID", "PV1", "OBR", "OBX", "ZRS"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("segments = %v, want %v", names, want)
+	}
+
+	// Struct values override seeded MSH values
+	if got := msg.ControlID(); got != "MINE" {
+		t.Errorf("ControlID() = %q, want %q", got, "MINE")
+	}
+	if got, _ := msg.Get("MSH.11"); got != "T" {
+		t.Errorf("MSH.11 = %q, want %q", got, "T")
+	}
+}
+
+func TestOrderSegments_RepeatingGroups(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			"note after observation",
+			"MSH PID OBR OBX NTE|obx",
+			"MSH PID OBR OBX NTE|obx",
+		},
+		{
+			"notes in several groups",
+			"MSH PID NTE|pid OBR|1 NTE|obr1 OBX|1 NTE|obx1 OBR|2 OBX|2 NTE|obx2 OBX|3",
+			"MSH PID NTE|pid OBR|1 NTE|obr1 OBX|1 NTE|obx1 OBR|2 OBX|2 NTE|obx2 OBX|3",
+		},
+		{
+			"groups out of order",
+			"OBX|1 NTE|obx1 MSH OBR|1 ZRS PID",
+			"MSH PID OBR|1 OBX|1 NTE|obx1 ZRS",
+		},
+		{
+			"second patient",
+			"MSH PID|1 OBR|1 OBX|1 PID|2 PV1|2 OBR|2",
+			"MSH PID|1 OBR|1 OBX|1 PID|2 PV1|2 OBR|2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var segs []hl7.Segment
+			for _, s := range strings.Fields(tt.in) {
+				name, value, _ := strings.Cut(s, "|")
+				seg := hl7.NewSegment(name)
+				if value != "" {
+					_ = seg.Set("1", value)
+				}
+				segs = append(segs, seg)
+			}
+			msg := orderSegments(hl7.NewMessage(segs, hl7.DefaultDelimiters()), "ORU_R01")
+
+			var got []string
+			for _, seg := range msg.AllSegments() {
+				s := seg.Name()
+				if v, _ := seg.Get("1"); v != "" {
+					s += "|" + v
+				}
+				got = append(got, s)
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Errorf("orderSegments() = %s, want %s", strings.Join(got, " "), tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshaler_Template(t *testing.T) {
+	tmpl, err := parse.New().Parse([]byte("MSH|^~\\&|MYAPP|MYFAC|THEIRAPP|THEIRFAC|20000101||ADT^A01^ADT_A01|OLD|D|2.3\rZTP|keep\r"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	before := tmpl.String()
+
+	type Admit struct {
+		PatientID string `hl7:"PID.3"`
+	}
+
+	m := NewMarshaler(append(fixedClock(), WithTemplate(tmpl))...)
+	msg, err := m.Marshal(Admit{PatientID: "12345"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	want := "MSH|^~\\&|MYAPP|MYFAC|THEIRAPP|THEIRFAC|20240115103000||ADT^A01^ADT_A01|CTRL1|D|2.3\rPID|||12345\rZTP|keep\r"
+	if got := msg.String(); got != want {
+		t.Errorf("Marshal() =\n%q\nwant\n%q", got, want)
+	}
+	if tmpl.String() != before {
+		t.Errorf("template modified:\n%q\nwant\n%q", tmpl.String(), before)
+	}
+
+	// The declaration overrides the template's message type and version
+	type Discharge struct {
+		_         struct{} `hl7msg:"ADT^A03,2.5.1"`
+		PatientID string   `hl7:"PID.3"`
+	}
+	msg, err = m.Marshal(Discharge{PatientID: "12345"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if got := msg.Type(); got != "ADT^A03^ADT_A03" {
+		t.Errorf("Type() = %q, want %q", got, "ADT^A03^ADT_A03")
+	}
+	if got := msg.Version(); got != "2.5.1" {
+		t.Errorf("Version() = %q, want %q", got, "2.5.1")
+	}
+	if got, _ := msg.Get("MSH.3"); got != "MYAPP" {
+		t.Errorf("MSH.3 = %q, want %q", got, "MYAPP")
+	}
+}
+
+func TestMarshaler_DefaultControlID(t *testing.T) {
+	type Admit struct {
+		_ struct{} `hl7msg:"ADT^A01"`
+	}
+
+	m := NewMarshaler()
+	first, err := m.Marshal(Admit{})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	second, err := m.Marshal(Admit{})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if len(first.ControlID()) != 20 {
+		t.Errorf("ControlID() = %q, want 20 characters", first.ControlID())
+	}
+	if first.ControlID() == second.ControlID() {
+		t.Errorf("ControlID() = %q for both messages, want unique IDs", first.ControlID())
+	}
+}
+
+func TestMarshaler_ControlIDGenerator(t *testing.T) {
+	type Admit struct {
+		_ struct{} `hl7msg:"ADT^A01"`
+	}
+
+	counter, err := controlid.NewCounter(controlid.WithPrefix("MSG"), controlid.WithWidth(3))
+	if err != nil {
+		t.Fatalf("NewCounter() error = %v", err)
+	}
+	m := NewMarshaler(WithControlIDGenerator(counter))
+	for _, want := range []string{"MSG001", "MSG002"} {
+		msg, err := m.Marshal(Admit{})
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if got := msg.ControlID(); got != want {
+			t.Errorf("ControlID() = %q, want %q", got, want)
+		}
+	}
+
+	full, _ := controlid.NewCounter(controlid.WithMaxLength(1), controlid.WithStart(10))
+	if _, err := NewMarshaler(WithControlIDGenerator(full)).Marshal(Admit{}); !errors.Is(err, controlid.ErrTooLong) {
+		t.Errorf("Marshal() error = %v, want controlid.ErrTooLong", err)
+	}
+}
+
+func TestMarshaler_InvalidMessageTag(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+	}{
+		{"missing trigger", struct {
+			_ struct{} `hl7msg:"ADT"`
+		}{}},
+		{"empty component", struct {
+			_ struct{} `hl7msg:"ADT^^ADT_A01"`
+		}{}},
+		{"empty version", struct {
+			_ struct{} `hl7msg:"ADT^A01,"`
+		}{}},
+		{"declared twice", struct {
+			_ struct{} `hl7msg:"ADT^A01"`
+			X struct{} `hl7msg:"ADT^A02"`
+		}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMarshaler().Marshal(tt.v)
+			if !errors.Is(err, ErrInvalidMessageTag) {
+				t.Errorf("Marshal() error = %v, want %v", err, ErrInvalidMessageTag)
+			}
+		})
 	}
 }
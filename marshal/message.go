@@ -0,0 +1,344 @@
+package marshal
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dshills/golevel7/controlid"
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/structures"
+)
+
+// messageTagName is the struct tag that declares the message a struct marshals to.
+const messageTagName = "hl7msg"
+
+// mshTimeFormat is the layout used for the generated MSH-7 timestamp.
+const mshTimeFormat = "20060102150405"
+
+// messageDecl describes the message type declared by an hl7msg tag or taken
+// from a template's MSH segment.
+type messageDecl struct {
+	msgType   string // MSH-9.1, e.g. "ADT"
+	trigger   string // MSH-9.2, e.g. "A01"
+	structure string // MSH-9.3, e.g. "ADT_A01"
+	version   string // MSH-12, e.g. "2.5.1"
+}
+
+// parseMessageTag parses an hl7msg tag.
+// Tag format: "TYPE^TRIGGER[^STRUCTURE][,VERSION]"
+func parseMessageTag(tag string) (*messageDecl, error) {
+	typePart, version, hasVersion := strings.Cut(tag, ",")
+	parts := strings.Split(typePart, "^")
+	if len(parts) < 2 || len(parts) > 3 || (hasVersion && version == "") {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidMessageTag, tag)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidMessageTag, tag)
+		}
+	}
+
+	decl := &messageDecl{msgType: parts[0], trigger: parts[1], version: version}
+	if len(parts) == 3 {
+		decl.structure = parts[2]
+	}
+	return decl, nil
+}
+
+// findMessageDecl returns the message declared by an hl7msg tag on any field
+// of the struct type, or nil if there is none. The tag is usually placed on a
+// blank marker field:
+//
+//	_ struct{} `hl7msg:"ADT^A01^ADT_A01,2.5.1"`
+func findMessageDecl(rt reflect.Type) (*messageDecl, error) {
+	var decl *messageDecl
+	for i := 0; i < rt.NumField(); i++ {
+		tag, ok := rt.Field(i).Tag.Lookup(messageTagName)
+		if !ok {
+			continue
+		}
+		if decl != nil {
+			return nil, fmt.Errorf("%w: declared more than once", ErrInvalidMessageTag)
+		}
+		d, err := parseMessageTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", rt.Field(i).Name, err)
+		}
+		decl = d
+	}
+	return decl, nil
+}
+
+// headerDecl reads the message type and version from a message's MSH segment.
+func headerDecl(msg hl7.Message) *messageDecl {
+	get := func(location string) string {
+		v, _ := msg.Get(location)
+		return v
+	}
+	return &messageDecl{
+		msgType:   get("MSH.9.1"),
+		trigger:   get("MSH.9.2"),
+		structure: get("MSH.9.3"),
+		version:   get("MSH.12.1"),
+	}
+}
+
+// messageType returns the MSH-9 value for the declaration.
+func (d *messageDecl) messageType(delims *hl7.Delimiters) string {
+	parts := []string{d.msgType, d.trigger}
+	if d.structure != "" {
+		parts = append(parts, d.structure)
+	}
+	return strings.Join(parts, string(delims.Component))
+}
+
+// newMessage creates the message that Marshal populates for a struct type.
+// Without a template or hl7msg declaration this is an empty message; otherwise
+// the template is copied and MSH is seeded from the declaration. The returned
+// declaration is nil when the message was not seeded.
+func (m *marshaler) newMessage(rt reflect.Type) (hl7.Message, *messageDecl, error) {
+	decl, err := findMessageDecl(rt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if m.config.template == nil && decl == nil {
+		return hl7.NewEmptyMessage(), nil, nil
+	}
+
+	msg := hl7.NewEmptyMessage()
+	if m.config.template != nil {
+		msg, err = cloneMessage(m.config.template)
+		if err != nil {
+			return nil, nil, fmt.Errorf("copying template: %w", err)
+		}
+
+		// The struct declaration takes precedence over the template's MSH-9;
+		// the template's version is kept unless the declaration names one.
+		tmpl := headerDecl(msg)
+		if decl == nil {
+			decl = tmpl
+		} else if decl.version == "" {
+			decl.version = tmpl.version
+		}
+	}
+
+	if decl.structure == "" {
+		decl.structure = structures.Lookup(decl.msgType, decl.trigger)
+	}
+
+	if err := m.seedHeader(msg, decl); err != nil {
+		return nil, nil, err
+	}
+
+	return msg, decl, nil
+}
+
+// cloneMessage returns a deep copy of msg by re-encoding each segment.
+func cloneMessage(msg hl7.Message) (hl7.Message, error) {
+	delims := *msg.Delimiters()
+
+	all := msg.AllSegments()
+	segs := make([]hl7.Segment, 0, len(all))
+	for _, seg := range all {
+		clone, err := hl7.ParseSegment([]rune(string(seg.Bytes(&delims))), &delims)
+		if err != nil {
+			return nil, fmt.Errorf("segment %s: %w", seg.Name(), err)
+		}
+		segs = append(segs, clone)
+	}
+
+	return hl7.NewMessage(segs, &delims), nil
+}
+
+// seedHeader fills in MSH: the delimiters of a new MSH segment, a fresh timestamp
+// (MSH-7) and control ID (MSH-10), the declared message type (MSH-9) and
+// version (MSH-12), and processing ID "P" (MSH-11) if missing.
+// The MSH segment is created at the start of the message if necessary.
+func (m *marshaler) seedHeader(msg hl7.Message, decl *messageDecl) error {
+	delims := msg.Delimiters()
+	now := m.config.timeFunc()
+
+	set := func(location, value string, onlyIfEmpty bool) error {
+		if onlyIfEmpty {
+			if v, _ := msg.Get(location); v != "" {
+				return nil
+			}
+		}
+		if err := msg.Set(location, value); err != nil {
+			return fmt.Errorf("setting %s: %w", location, err)
+		}
+		return nil
+	}
+
+	if _, ok := msg.Segment("MSH"); !ok {
+		if err := msg.InsertSegment(0, hl7.NewSegment("MSH")); err != nil {
+			return err
+		}
+		if err := set("MSH.1", delims.MSH1(), false); err != nil {
+			return err
+		}
+		if err := set("MSH.2", encodingCharacters(delims, decl.version), false); err != nil {
+			return err
+		}
+	}
+	if err := set("MSH.7", now.In(m.config.timeLocation).Format(mshTimeFormat), false); err != nil {
+		return err
+	}
+	if decl.msgType != "" {
+		if err := set("MSH.9", decl.messageType(delims), false); err != nil {
+			return err
+		}
+	}
+	controlID, err := m.controlID(now)
+	if err != nil {
+		return fmt.Errorf("generating control ID: %w", err)
+	}
+	if err := set("MSH.10", controlID, false); err != nil {
+		return err
+	}
+	if err := set("MSH.11", "P", true); err != nil {
+		return err
+	}
+	if decl.version != "" {
+		if err := set("MSH.12", decl.version, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodingCharacters returns the MSH-2 value for a message of the given
+// version. The truncation character is only part of MSH-2 from HL7 v2.7, so
+// it is left out for earlier or unknown versions.
+func encodingCharacters(delims *hl7.Delimiters, version string) string {
+	chars := delims.MSH2()
+	if supportsTruncation(version) || len([]rune(chars)) <= 4 {
+		return chars
+	}
+	return string([]rune(chars)[:4])
+}
+
+// supportsTruncation reports whether version is HL7 v2.7 or later.
+func supportsTruncation(version string) bool {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	major, err1 := strconv.Atoi(parts[0])
+	minor, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return major > 2 || (major == 2 && minor >= 7)
+}
+
+// controlID returns the MSH-10 value for a new message.
+// The default is the UTC timestamp followed by a six-digit sequence number.
+func (m *marshaler) controlID(now time.Time) (string, error) {
+	if m.config.controlIDs != nil {
+		return m.config.controlIDs.Next()
+	}
+	return controlid.Default().At(now), nil
+}
+
+// orderSegments returns msg with its segments ordered according to the
+// message structure. The structure's group tree is walked level by level:
+// segments are placed in the order of the elements they belong to, and the
+// segments of a repeating group stay together, in the order they were
+// added, in each occurrence of the group. So an NTE added after an OBX stays
+// with that OBX, and a second OBR starts a second order group. Segments the
+// structure does not define, such as Z-segments, follow in the order they
+// were added.
+func orderSegments(msg hl7.Message, structure string) hl7.Message {
+	syntax, ok := structures.Syntax(structure)
+	if !ok {
+		return msg
+	}
+	elements, err := structures.Parse(syntax)
+	if err != nil {
+		return msg
+	}
+
+	var known, unknown []hl7.Segment
+	for _, seg := range msg.AllSegments() {
+		if elementFor(elements, seg.Name(), -1) < 0 {
+			unknown = append(unknown, seg)
+		} else {
+			known = append(known, seg)
+		}
+	}
+
+	segs := append(orderElements(elements, known), unknown...)
+	return hl7.NewMessage(segs, msg.Delimiters())
+}
+
+// orderElements orders segments, all of which occur in elements, by the
+// element they belong to. The segments of each occurrence of a group are
+// ordered by the group's children in turn.
+func orderElements(elements []structures.Element, segs []hl7.Segment) []hl7.Segment {
+	occurrences := make([][][]hl7.Segment, len(elements))
+	prev := -1
+	for _, seg := range segs {
+		i := elementFor(elements, seg.Name(), prev)
+		prev = i
+
+		n := len(occurrences[i])
+		if n == 0 || startsOccurrence(elements[i], seg.Name(), occurrences[i][n-1]) {
+			occurrences[i] = append(occurrences[i], nil)
+			n++
+		}
+		occurrences[i][n-1] = append(occurrences[i][n-1], seg)
+	}
+
+	ordered := make([]hl7.Segment, 0, len(segs))
+	for i, e := range elements {
+		for _, occurrence := range occurrences[i] {
+			if e.Segment != "" {
+				ordered = append(ordered, occurrence...)
+			} else {
+				ordered = append(ordered, orderElements(e.Children, occurrence)...)
+			}
+		}
+	}
+	return ordered
+}
+
+// elementFor returns the index of the element a segment named seg belongs
+// to, or -1 if none contains it. A segment such as NTE that several elements
+// contain belongs to the first of them at or after prev, the element of the
+// segment added before it, so that it stays with that segment; failing
+// that, to the last of them.
+func elementFor(elements []structures.Element, seg string, prev int) int {
+	found := -1
+	for i, e := range elements {
+		if !e.Contains(seg) {
+			continue
+		}
+		if i >= prev {
+			return i
+		}
+		found = i
+	}
+	return found
+}
+
+// startsOccurrence reports whether a segment named seg starts a new
+// occurrence of e rather than joining the current one: e must be a
+// repeating group that seg can start, and the current occurrence must
+// already hold a segment named seg.
+func startsOccurrence(e structures.Element, seg string, current []hl7.Segment) bool {
+	if e.Segment != "" || e.Max == 1 || !e.CanStartWith(seg) {
+		return false
+	}
+	for _, s := range current {
+		if s.Name() == seg {
+			return true
+		}
+	}
+	return false
+}
@@ -3,26 +3,39 @@
 // struct tags to specify field locations.
 package marshal
 
-import "time"
+import (
+	"time"
+
+	"github.com/dshills/golevel7/controlid"
+	"github.com/dshills/golevel7/hl7"
+)
 
 // Option configures the marshaler/unmarshaler behavior.
 type Option func(*marshalConfig)
 
 // marshalConfig holds configuration for marshaling/unmarshaling operations.
 type marshalConfig struct {
-	tagName      string         // struct tag name, default "hl7"
-	omitEmpty    bool           // skip zero-value fields when marshaling
-	timeFormat   string         // for time.Time fields, default "20060102150405"
-	timeLocation *time.Location // timezone for time parsing, default UTC
+	tagName               string              // struct tag name, default "hl7"
+	omitEmpty             bool                // skip zero-value fields when marshaling
+	timeFormat            string              // for time.Time fields, default "20060102150405"
+	timeLocation          *time.Location      // timezone for time parsing, default UTC
+	strict                bool                // collect all unmarshal errors instead of stopping at the first
+	disallowUnknownFields bool                // report message values not mapped to any struct field
+	template              hl7.Message         // message copied as the starting point for Marshal
+	timeFunc              func() time.Time    // clock for the MSH-7 timestamp, default time.Now
+	controlIDs            controlid.Generator // MSH-10 generator, default controlid.Default at the MSH-7 time
 }
 
 // defaultConfig returns the default marshal configuration.
 func defaultConfig() *marshalConfig {
 	return &marshalConfig{
-		tagName:      "hl7",
-		omitEmpty:    false,
-		timeFormat:   "20060102150405",
-		timeLocation: time.UTC,
+		tagName:               "hl7",
+		omitEmpty:             false,
+		timeFormat:            "20060102150405",
+		timeLocation:          time.UTC,
+		strict:                false,
+		disallowUnknownFields: false,
+		timeFunc:              time.Now,
 	}
 }
 
@@ -97,3 +110,93 @@ func WithTimeLocation(loc *time.Location) Option {
 		}
 	}
 }
+
+// WithStrict controls whether unmarshaling collects every conversion failure.
+// When true, Unmarshal attempts every tagged field and returns a FieldErrors
+// value listing each failure with its Go field path and HL7 location.
+// When false, Unmarshal stops at the first failure and returns a *FieldError.
+// Default is false.
+//
+// Example:
+//
+//	u := NewUnmarshaler(WithStrict(true))
+//	if err := u.Unmarshal(msg, &patient); err != nil {
+//	    var errs FieldErrors
+//	    if errors.As(err, &errs) {
+//	        for _, fe := range errs {
+//	            fmt.Printf("%s at %s: %v\n", fe.Field, fe.Location, fe.Cause)
+//	        }
+//	    }
+//	}
+func WithStrict(strict bool) Option {
+	return func(c *marshalConfig) {
+		c.strict = strict
+	}
+}
+
+// WithDisallowUnknownFields controls whether unmarshaling reports message values
+// that are not mapped to any struct field, similar to
+// json.Decoder.DisallowUnknownFields. Unknown values are reported as FieldErrors
+// wrapping ErrUnknownField. MSH-1 and MSH-2 (delimiters) are never reported.
+// Default is false.
+//
+// Example:
+//
+//	u := NewUnmarshaler(WithStrict(true), WithDisallowUnknownFields(true))
+func WithDisallowUnknownFields(disallow bool) Option {
+	return func(c *marshalConfig) {
+		c.disallowUnknownFields = disallow
+	}
+}
+
+// WithTemplate sets a message that Marshal copies as the starting point for
+// every message it creates, typically carrying MSH defaults such as the sending
+// and receiving applications and facilities. The template is not modified.
+//
+// When a template is set, Marshal generates a fresh timestamp (MSH-7) and
+// control ID (MSH-10) for each message and orders segments according to the
+// message structure named in MSH-9 or declared with an hl7msg tag.
+// Struct fields tagged with MSH locations override template values.
+//
+// Example:
+//
+//	tmpl, _ := parse.New().Parse([]byte("MSH|^~\\&|MYAPP|MYFAC|THEIRAPP|THEIRFAC|||||P|2.5.1"))
+//	m := NewMarshaler(WithTemplate(tmpl))
+func WithTemplate(msg hl7.Message) Option {
+	return func(c *marshalConfig) {
+		c.template = msg
+	}
+}
+
+// WithTimeFunc sets the clock used for the MSH-7 timestamp of seeded messages.
+// Default is time.Now.
+func WithTimeFunc(fn func() time.Time) Option {
+	return func(c *marshalConfig) {
+		if fn != nil {
+			c.timeFunc = fn
+		}
+	}
+}
+
+// WithControlIDFunc sets the generator for the MSH-10 control ID of seeded
+// messages. By default control IDs are the UTC timestamp followed by a
+// six-digit sequence number, e.g. "20240115103000000042". A nil fn keeps the
+// default.
+func WithControlIDFunc(fn func() string) Option {
+	return func(c *marshalConfig) {
+		if fn != nil {
+			c.controlIDs = controlid.Func(fn)
+		}
+	}
+}
+
+// WithControlIDGenerator sets the generator for the MSH-10 control ID of
+// seeded messages, e.g. a persistent controlid.Counter. A nil gen keeps the
+// default.
+func WithControlIDGenerator(gen controlid.Generator) Option {
+	return func(c *marshalConfig) {
+		if gen != nil {
+			c.controlIDs = gen
+		}
+	}
+}
@@ -162,3 +162,71 @@ func TestApplyOptions(t *testing.T) {
 		t.Errorf("timeLocation = %v, want %v", cfg.timeLocation, loc)
 	}
 }
+
+func TestWithStrict(t *testing.T) {
+	cfg := defaultConfig()
+	if cfg.strict {
+		t.Error("strict = true, want false by default")
+	}
+	WithStrict(true)(cfg)
+	if !cfg.strict {
+		t.Error("strict = false, want true")
+	}
+}
+
+func TestWithDisallowUnknownFields(t *testing.T) {
+	cfg := defaultConfig()
+	if cfg.disallowUnknownFields {
+		t.Error("disallowUnknownFields = true, want false by default")
+	}
+	WithDisallowUnknownFields(true)(cfg)
+	if !cfg.disallowUnknownFields {
+		t.Error("disallowUnknownFields = false, want true")
+	}
+}
+
+func TestWithTimeFunc(t *testing.T) {
+	fixed := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	cfg := defaultConfig()
+	WithTimeFunc(func() time.Time { return fixed })(cfg)
+	if got := cfg.timeFunc(); !got.Equal(fixed) {
+		t.Errorf("timeFunc() = %v, want %v", got, fixed)
+	}
+
+	// nil keeps the current clock
+	WithTimeFunc(nil)(cfg)
+	if cfg.timeFunc == nil {
+		t.Error("timeFunc = nil after WithTimeFunc(nil)")
+	}
+}
+
+func TestWithControlIDFunc(t *testing.T) {
+	cfg := defaultConfig()
+	if cfg.controlIDs != nil {
+		t.Error("controlIDs set by default, want nil")
+	}
+	WithControlIDFunc(func() string { return "ID1" })(cfg)
+	if got, _ := cfg.controlIDs.Next(); got != "ID1" {
+		t.Errorf("controlIDs.Next() = %q, want %q", got, "ID1")
+	}
+
+	// nil keeps the current generator
+	WithControlIDFunc(nil)(cfg)
+	WithControlIDGenerator(nil)(cfg)
+	if got, _ := cfg.controlIDs.Next(); got != "ID1" {
+		t.Errorf("controlIDs.Next() after nil options = %q, want %q", got, "ID1")
+	}
+
+	type Admit struct {
+		_         struct{} `hl7msg:"ADT^A01"`
+		PatientID string   `hl7:"PID.3"`
+	}
+	msg, err := NewMarshaler(WithControlIDFunc(nil)).Marshal(Admit{PatientID: "1"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if msg.ControlID() == "" {
+		t.Error("MSH-10 is empty, want the default control ID")
+	}
+}
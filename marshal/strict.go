@@ -0,0 +1,172 @@
+package marshal
+
+import (
+	"github.com/dshills/golevel7/hl7"
+)
+
+// decodeState tracks per-call unmarshal state: collected errors in strict
+// mode and the message locations mapped by struct fields.
+type decodeState struct {
+	strict bool
+	errs   FieldErrors
+	mapped []mappedLocation
+}
+
+// mappedLocation records a message location consumed by a struct field.
+// A value of -1 matches any index at that level.
+type mappedLocation struct {
+	segment      string
+	segmentIndex int
+	field        int
+	repetition   int
+	component    int
+}
+
+// fail reports a failure for the field at path. In strict mode the failure
+// is recorded and nil is returned so unmarshaling continues; otherwise the
+// failure is returned as a *FieldError.
+func (st *decodeState) fail(path, location string, cause error) error {
+	fe := &FieldError{Field: path, Location: location, Cause: cause}
+	if !st.strict {
+		return fe
+	}
+	st.errs = append(st.errs, fe)
+	return nil
+}
+
+// err returns the collected errors, or nil if there were none.
+func (st *decodeState) err() error {
+	if len(st.errs) == 0 {
+		return nil
+	}
+	return st.errs
+}
+
+// markMapped records that location is consumed by a struct field.
+// Slice fields consume every segment instance and repetition unless the
+// location pins a specific index; other fields only see the first.
+func (st *decodeState) markMapped(location string, all bool) {
+	loc, err := hl7.ParseLocation(location)
+	if err != nil {
+		// Invalid locations are reported when the value is read
+		return
+	}
+
+	first := 0
+	if all {
+		first = -1
+	}
+
+	m := mappedLocation{
+		segment:      loc.Segment,
+		segmentIndex: first,
+		field:        loc.Field,
+		repetition:   first,
+		component:    loc.Component,
+	}
+	if loc.HasSegmentIndex() {
+		m.segmentIndex = loc.SegmentIndex
+	}
+	if loc.HasRepetition() {
+		m.repetition = loc.Repetition
+	}
+
+	st.mapped = append(st.mapped, m)
+}
+
+// covers reports whether a value at the given position is consumed by a struct field.
+// Pass -1 for component to ask whether the whole field is consumed.
+func (st *decodeState) covers(segment string, segIndex, field, rep, component int) bool {
+	for _, m := range st.mapped {
+		if m.segment != segment {
+			continue
+		}
+		if m.segmentIndex >= 0 && m.segmentIndex != segIndex {
+			continue
+		}
+		if m.field < 0 {
+			return true // whole segment
+		}
+		if m.field != field {
+			continue
+		}
+		if rep >= 0 && m.repetition >= 0 && m.repetition != rep {
+			continue
+		}
+		if m.component < 0 || m.component == component {
+			return true
+		}
+	}
+	return false
+}
+
+// checkUnknownFields reports every non-empty message value that is not
+// consumed by a struct field. MSH-1 and MSH-2 hold the delimiters and are skipped.
+func (st *decodeState) checkUnknownFields(msg hl7.Message) error {
+	counts := make(map[string]int)
+
+	for _, seg := range msg.AllSegments() {
+		name := seg.Name()
+		segIndex := counts[name]
+		counts[name]++
+
+		for i, f := range seg.AllFields() {
+			seq := i + 1
+			if f == nil || (name == "MSH" && seq <= 2) {
+				continue
+			}
+
+			reps := f.Repetitions()
+			if len(reps) == 0 {
+				if f.Value() != "" && !st.covers(name, segIndex, seq, 0, 1) {
+					loc := unknownLocation(name, segIndex, seq, 0, -1)
+					if err := st.fail("", loc, ErrUnknownField); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			for r, rep := range reps {
+				if st.covers(name, segIndex, seq, r, -1) {
+					continue
+				}
+
+				comps := rep.Components()
+				if len(comps) <= 1 {
+					if rep.Value() != "" && !st.covers(name, segIndex, seq, r, 1) {
+						loc := unknownLocation(name, segIndex, seq, r, -1)
+						if err := st.fail("", loc, ErrUnknownField); err != nil {
+							return err
+						}
+					}
+					continue
+				}
+
+				for c, comp := range comps {
+					if comp.Value() == "" || st.covers(name, segIndex, seq, r, c+1) {
+						continue
+					}
+					loc := unknownLocation(name, segIndex, seq, r, c+1)
+					if err := st.fail("", loc, ErrUnknownField); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// unknownLocation formats the location of an unknown value, omitting
+// segment and repetition indexes when they refer to the first instance.
+func unknownLocation(segment string, segIndex, field, rep, component int) string {
+	if segIndex == 0 {
+		segIndex = -1
+	}
+	if rep == 0 {
+		rep = -1
+	}
+	return hl7.NewLocationFull(segment, segIndex, field, rep, component, -1).String()
+}
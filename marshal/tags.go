@@ -2,7 +2,10 @@ package marshal
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
 	"strings"
+	"time"
 )
 
 // Tag parsing errors.
@@ -11,14 +14,21 @@ var (
 	ErrEmptyTag = errors.New("empty tag")
 	// ErrInvalidTagFormat indicates the tag format is invalid.
 	ErrInvalidTagFormat = errors.New("invalid tag format")
+	// ErrInvalidMessageTag indicates an hl7msg message declaration is invalid.
+	ErrInvalidMessageTag = errors.New("invalid hl7msg tag")
 )
 
+// timeType is the reflect.Type of time.Time, which is marshaled as a value
+// rather than walked as a nested struct.
+var timeType = reflect.TypeOf(time.Time{})
+
 // tagInfo holds parsed struct tag information.
 type tagInfo struct {
 	location   string // HL7 location path (e.g., "PID.5.1")
 	omitEmpty  bool   // skip if field is zero value
 	timeFormat string // custom time format for this field
 	ignore     bool   // ignore this field (tag is "-")
+	inline     bool   // promote the fields of this struct into the parent
 }
 
 // parseTag parses an HL7 struct tag into tagInfo.
@@ -27,14 +37,20 @@ type tagInfo struct {
 // Supported options:
 //   - omitempty: skip field if zero value when marshaling
 //   - format=<layout>: custom time format for time.Time fields
+//   - inline: promote the fields of a struct field into the enclosing struct;
+//     the location must be empty
 //   - -: ignore this field
 //
+// Validation options such as required, oneof= and maxlen= are ignored here;
+// they are interpreted by validate.RulesFromStruct.
+//
 // Examples:
 //
 //	`hl7:"PID.5.1"`                    - simple location
 //	`hl7:"PID.5.1,omitempty"`          - with omitempty
 //	`hl7:"PID.7,format=20060102"`      - with custom time format
 //	`hl7:"PID.5.1,omitempty,format=20060102"` - multiple options
+//	`hl7:",inline"`                    - flatten a shared struct
 //	`hl7:"-"`                          - ignore field
 func parseTag(tag string) (*tagInfo, error) {
 	tag = strings.TrimSpace(tag)
@@ -53,11 +69,7 @@ func parseTag(tag string) (*tagInfo, error) {
 	parts := strings.Split(tag, ",")
 
 	// First part is always the location
-	location := strings.TrimSpace(parts[0])
-	if location == "" {
-		return nil, ErrInvalidTagFormat
-	}
-	info.location = location
+	info.location = strings.TrimSpace(parts[0])
 
 	// Parse remaining options
 	for i := 1; i < len(parts); i++ {
@@ -71,11 +83,19 @@ func parseTag(tag string) (*tagInfo, error) {
 			info.omitEmpty = true
 		case strings.HasPrefix(opt, "format="):
 			info.timeFormat = strings.TrimPrefix(opt, "format=")
+		case opt == "inline":
+			info.inline = true
 		default:
 			// Unknown options are ignored for forward compatibility
 		}
 	}
 
+	// Inline fields take their locations from the promoted fields;
+	// every other field needs one of its own.
+	if info.inline != (info.location == "") {
+		return nil, ErrInvalidTagFormat
+	}
+
 	return info, nil
 }
 
@@ -99,3 +119,24 @@ func (t *tagInfo) getTimeFormat(defaultFormat string) string {
 	}
 	return defaultFormat
 }
+
+// isEmbeddedStruct reports whether sf is an anonymous struct (or pointer to
+// struct) field whose fields are promoted into the enclosing struct, as Go
+// promotes them. Embedded pointers to unexported types are not promoted
+// because they cannot be allocated.
+func isEmbeddedStruct(sf reflect.StructField) bool {
+	if !sf.Anonymous {
+		return false
+	}
+	t := sf.Type
+	if t.Kind() == reflect.Ptr {
+		if !sf.IsExported() {
+			return false
+		}
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+// errInlineNotStruct is returned for an inline tag on a non-struct field.
+var errInlineNotStruct = fmt.Errorf("%w: inline requires a struct field", ErrInvalidTagFormat)
@@ -290,3 +290,17 @@ func TestTagInfo_GetTimeFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTag_Inline(t *testing.T) {
+	got, err := parseTag(",inline")
+	if err != nil {
+		t.Fatalf("parseTag(\",inline\") unexpected error: %v", err)
+	}
+	if !got.inline || got.location != "" {
+		t.Errorf("parseTag(\",inline\") = %+v, want inline with no location", got)
+	}
+
+	if _, err := parseTag("MSH,inline"); !errors.Is(err, ErrInvalidTagFormat) {
+		t.Errorf("parseTag(\"MSH,inline\") error = %v, want ErrInvalidTagFormat", err)
+	}
+}
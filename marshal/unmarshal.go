@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/structtag"
 )
 
 // Unmarshal errors.
@@ -74,28 +75,58 @@ func (u *unmarshaler) Unmarshal(msg hl7.Message, v interface{}) error {
 		return ErrNotStruct
 	}
 
-	return u.unmarshalStruct(msg, rv)
+	st := &decodeState{strict: u.config.strict}
+	if err := u.unmarshalStruct(msg, rv, "", st); err != nil {
+		return err
+	}
+
+	if u.config.disallowUnknownFields {
+		if err := st.checkUnknownFields(msg); err != nil {
+			return err
+		}
+	}
+
+	return st.err()
 }
 
 // unmarshalStruct unmarshals message data into a struct value.
-func (u *unmarshaler) unmarshalStruct(msg hl7.Message, rv reflect.Value) error {
+// path is the Go field path of rv, used to annotate errors.
+func (u *unmarshaler) unmarshalStruct(msg hl7.Message, rv reflect.Value, path string, st *decodeState) error {
+	return u.unmarshalFields(msg, rv, "", path, st)
+}
+
+// unmarshalFields unmarshals message data into the fields of a struct value.
+// prefix is the location of the enclosing tagged struct, against which
+// relative locations are resolved. Untagged struct fields are walked only at
+// the top level (empty prefix); embedded and inline structs are promoted at
+// any level.
+func (u *unmarshaler) unmarshalFields(msg hl7.Message, rv reflect.Value, prefix, path string, st *decodeState) error {
 	rt := rv.Type()
 
 	for i := 0; i < rv.NumField(); i++ {
 		field := rv.Field(i)
 		fieldType := rt.Field(i)
 
-		// Skip unexported fields
-		if !field.CanSet() {
+		// Skip unexported fields, except embedded structs whose exported
+		// fields are promoted
+		if !field.CanSet() && !isEmbeddedStruct(fieldType) {
 			continue
 		}
 
+		fieldPath := joinFieldPath(path, fieldType.Name)
+
 		// Get and parse tag
 		tag := fieldType.Tag.Get(u.config.tagName)
 		if tag == "" {
-			// Check if it's a nested struct without a tag
-			if field.Kind() == reflect.Struct && fieldType.Type != reflect.TypeOf(time.Time{}) {
-				if err := u.unmarshalStruct(msg, field); err != nil {
+			switch {
+			case isEmbeddedStruct(fieldType):
+				// Promoted fields keep the parent's path, as in Go
+				if err := u.unmarshalPromoted(msg, field, prefix, path, st); err != nil {
+					return err
+				}
+			case prefix == "" && field.Kind() == reflect.Struct && fieldType.Type != timeType:
+				// Untagged nested struct at the top level
+				if err := u.unmarshalFields(msg, field, "", fieldPath, st); err != nil {
 					return err
 				}
 			}
@@ -104,61 +135,104 @@ func (u *unmarshaler) unmarshalStruct(msg hl7.Message, rv reflect.Value) error {
 
 		tagInfo, err := parseTag(tag)
 		if err != nil {
-			return fmt.Errorf("field %s: %w", fieldType.Name, err)
+			return &FieldError{Field: fieldPath, Cause: err}
+		}
+
+		if tagInfo.ignore {
+			continue
 		}
 
-		if tagInfo.ignore || !tagInfo.hasLocation() {
+		if tagInfo.inline {
+			if err := u.unmarshalPromoted(msg, field, prefix, fieldPath, st); err != nil {
+				return err
+			}
 			continue
 		}
 
+		tagInfo.location = structtag.ResolveLocation(prefix, tagInfo.location)
+
 		// Get value from message
-		if err := u.unmarshalField(msg, field, fieldType, tagInfo); err != nil {
-			return fmt.Errorf("field %s: %w", fieldType.Name, err)
+		if err := u.unmarshalField(msg, field, tagInfo, fieldPath, st); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// unmarshalField unmarshals a single field from the message.
-func (u *unmarshaler) unmarshalField(msg hl7.Message, field reflect.Value, fieldType reflect.StructField, tagInfo *tagInfo) error {
-	// Handle slice types for repetitions
-	if field.Kind() == reflect.Slice {
-		return u.unmarshalSlice(msg, field, fieldType, tagInfo)
+// unmarshalPromoted unmarshals into the fields of an embedded or inline struct
+// as if they were declared in the enclosing struct. A nil pointer is only
+// allocated if at least one promoted field receives a value.
+func (u *unmarshaler) unmarshalPromoted(msg hl7.Message, field reflect.Value, prefix, path string, st *decodeState) error {
+	t := field.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType {
+		return &FieldError{Field: path, Cause: errInlineNotStruct}
+	}
+
+	if field.Kind() != reflect.Ptr {
+		return u.unmarshalFields(msg, field, prefix, path, st)
+	}
+
+	if !field.IsNil() {
+		return u.unmarshalFields(msg, field.Elem(), prefix, path, st)
 	}
 
-	// Handle pointer types
-	if field.Kind() == reflect.Ptr {
-		return u.unmarshalPointer(msg, field, fieldType, tagInfo)
+	ptr := reflect.New(t)
+	if err := u.unmarshalFields(msg, ptr.Elem(), prefix, path, st); err != nil {
+		return err
+	}
+	if !isZeroValue(ptr.Elem()) {
+		field.Set(ptr)
 	}
+	return nil
+}
 
-	// Handle nested structs (but not time.Time)
-	if field.Kind() == reflect.Struct && fieldType.Type != reflect.TypeOf(time.Time{}) {
-		return u.unmarshalNestedStruct(msg, field, tagInfo)
+// unmarshalField unmarshals a single field from the message.
+// Conversion failures are passed to st, which either returns them (stopping
+// the unmarshal) or records them and lets unmarshaling continue.
+func (u *unmarshaler) unmarshalField(msg hl7.Message, field reflect.Value, tagInfo *tagInfo, path string, st *decodeState) error {
+	switch {
+	case field.Kind() == reflect.Slice:
+		// Repetitions
+		return u.unmarshalSlice(msg, field, tagInfo, path, st)
+	case field.Kind() == reflect.Map:
+		return u.unmarshalMap(msg, field, tagInfo, path, st)
+	case field.Kind() == reflect.Ptr:
+		return u.unmarshalPointer(msg, field, tagInfo, path, st)
+	case field.Kind() == reflect.Struct && field.Type() != timeType:
+		// Nested struct: its location is a prefix for the nested fields
+		return u.unmarshalFields(msg, field, tagInfo.location, path, st)
 	}
 
+	st.markMapped(tagInfo.location, false)
+
 	// Get single value from message
 	value, err := msg.Get(tagInfo.location)
 	if err != nil {
 		// Field not found is not an error for unmarshaling
-		if errors.Is(err, hl7.ErrSegmentNotFound) ||
-			errors.Is(err, hl7.ErrFieldNotFound) ||
-			errors.Is(err, hl7.ErrComponentNotFound) ||
-			errors.Is(err, hl7.ErrSubComponentNotFound) {
+		if isNotFound(err) {
 			return nil
 		}
-		return err
+		return st.fail(path, tagInfo.location, err)
 	}
 
 	if value == "" {
 		return nil
 	}
 
-	return u.setFieldValue(field, value, tagInfo)
+	if err := u.setFieldValue(field, value, tagInfo); err != nil {
+		return st.fail(path, tagInfo.location, err)
+	}
+	return nil
 }
 
 // unmarshalSlice unmarshals a slice field (for repetitions).
-func (u *unmarshaler) unmarshalSlice(msg hl7.Message, field reflect.Value, fieldType reflect.StructField, tagInfo *tagInfo) error {
+func (u *unmarshaler) unmarshalSlice(msg hl7.Message, field reflect.Value, tagInfo *tagInfo, path string, st *decodeState) error {
+	st.markMapped(tagInfo.location, true)
+
 	// Get all values for this location
 	values, err := msg.GetAll(tagInfo.location)
 	if err != nil {
@@ -167,7 +241,7 @@ func (u *unmarshaler) unmarshalSlice(msg hl7.Message, field reflect.Value, field
 			errors.Is(err, hl7.ErrFieldNotFound) {
 			return nil
 		}
-		return err
+		return st.fail(path, tagInfo.location, err)
 	}
 
 	if len(values) == 0 {
@@ -175,8 +249,8 @@ func (u *unmarshaler) unmarshalSlice(msg hl7.Message, field reflect.Value, field
 	}
 
 	// Create slice of appropriate type
-	elemType := fieldType.Type.Elem()
-	slice := reflect.MakeSlice(fieldType.Type, len(values), len(values))
+	elemType := field.Type().Elem()
+	slice := reflect.MakeSlice(field.Type(), len(values), len(values))
 
 	for i, value := range values {
 		if value == "" {
@@ -184,17 +258,23 @@ func (u *unmarshaler) unmarshalSlice(msg hl7.Message, field reflect.Value, field
 		}
 
 		elem := slice.Index(i)
+		elemPath := fmt.Sprintf("%s[%d]", path, i)
 
 		// Handle pointer elements
 		if elemType.Kind() == reflect.Ptr {
 			ptr := reflect.New(elemType.Elem())
 			if err := u.setFieldValue(ptr.Elem(), value, tagInfo); err != nil {
-				return err
+				if err := st.fail(elemPath, tagInfo.location, err); err != nil {
+					return err
+				}
+				continue
 			}
 			elem.Set(ptr)
 		} else {
 			if err := u.setFieldValue(elem, value, tagInfo); err != nil {
-				return err
+				if err := st.fail(elemPath, tagInfo.location, err); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -203,18 +283,34 @@ func (u *unmarshaler) unmarshalSlice(msg hl7.Message, field reflect.Value, field
 	return nil
 }
 
-// unmarshalPointer unmarshals a pointer field.
-func (u *unmarshaler) unmarshalPointer(msg hl7.Message, field reflect.Value, fieldType reflect.StructField, tagInfo *tagInfo) error {
+// unmarshalPointer unmarshals a pointer field. Pointers to slices, maps and
+// structs are only allocated if the pointed-to value receives data.
+func (u *unmarshaler) unmarshalPointer(msg hl7.Message, field reflect.Value, tagInfo *tagInfo, path string, st *decodeState) error {
+	elemType := field.Type().Elem()
+
+	switch {
+	case elemType.Kind() == reflect.Slice,
+		elemType.Kind() == reflect.Map,
+		elemType.Kind() == reflect.Struct && elemType != timeType:
+		ptr := reflect.New(elemType)
+		if err := u.unmarshalField(msg, ptr.Elem(), tagInfo, path, st); err != nil {
+			return err
+		}
+		if !isZeroValue(ptr.Elem()) {
+			field.Set(ptr)
+		}
+		return nil
+	}
+
+	st.markMapped(tagInfo.location, false)
+
 	value, err := msg.Get(tagInfo.location)
 	if err != nil {
 		// Field not found is not an error
-		if errors.Is(err, hl7.ErrSegmentNotFound) ||
-			errors.Is(err, hl7.ErrFieldNotFound) ||
-			errors.Is(err, hl7.ErrComponentNotFound) ||
-			errors.Is(err, hl7.ErrSubComponentNotFound) {
+		if isNotFound(err) {
 			return nil
 		}
-		return err
+		return st.fail(path, tagInfo.location, err)
 	}
 
 	if value == "" {
@@ -222,81 +318,29 @@ func (u *unmarshaler) unmarshalPointer(msg hl7.Message, field reflect.Value, fie
 	}
 
 	// Create new value and set
-	ptr := reflect.New(fieldType.Type.Elem())
+	ptr := reflect.New(elemType)
 	if err := u.setFieldValue(ptr.Elem(), value, tagInfo); err != nil {
-		return err
+		return st.fail(path, tagInfo.location, err)
 	}
 	field.Set(ptr)
 	return nil
 }
 
-// unmarshalNestedStruct handles nested struct fields.
-func (u *unmarshaler) unmarshalNestedStruct(msg hl7.Message, field reflect.Value, tagInfo *tagInfo) error {
-	// For nested structs with a location tag, we treat the location as a prefix
-	// and the nested fields extend from that prefix
-	rt := field.Type()
-
-	for i := 0; i < field.NumField(); i++ {
-		nestedField := field.Field(i)
-		nestedFieldType := rt.Field(i)
-
-		if !nestedField.CanSet() {
-			continue
-		}
-
-		tag := nestedFieldType.Tag.Get(u.config.tagName)
-		if tag == "" {
-			continue
-		}
-
-		nestedTagInfo, err := parseTag(tag)
-		if err != nil {
-			return fmt.Errorf("nested field %s: %w", nestedFieldType.Name, err)
-		}
-
-		if nestedTagInfo.ignore || !nestedTagInfo.hasLocation() {
-			continue
-		}
-
-		// Combine parent location with nested location if the nested location
-		// doesn't start with a segment name
-		location := nestedTagInfo.location
-		if tagInfo.location != "" && !startsWithSegment(location) {
-			location = tagInfo.location + "." + location
-			nestedTagInfo.location = location
-		}
-
-		if err := u.unmarshalField(msg, nestedField, nestedFieldType, nestedTagInfo); err != nil {
-			return err
-		}
-	}
-
-	return nil
+// isNotFound reports whether err indicates a missing message element,
+// which is not an error for unmarshaling.
+func isNotFound(err error) bool {
+	return errors.Is(err, hl7.ErrSegmentNotFound) ||
+		errors.Is(err, hl7.ErrFieldNotFound) ||
+		errors.Is(err, hl7.ErrComponentNotFound) ||
+		errors.Is(err, hl7.ErrSubComponentNotFound)
 }
 
-// startsWithSegment checks if a location string starts with a segment name.
-func startsWithSegment(loc string) bool {
-	if len(loc) < 3 {
-		return false
-	}
-	// Segment names are 3 uppercase letters
-	for i := 0; i < 3; i++ {
-		c := loc[i]
-		if i == 0 {
-			if c < 'A' || c > 'Z' {
-				return false
-			}
-		} else {
-			if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
-				return false
-			}
-		}
-	}
-	// Must be followed by end or '.' or '['
-	if len(loc) == 3 {
-		return true
+// joinFieldPath appends a Go field name to a parent field path.
+func joinFieldPath(parent, name string) string {
+	if parent == "" {
+		return name
 	}
-	return loc[3] == '.' || loc[3] == '['
+	return parent + "." + name
 }
 
 // setFieldValue sets the field value from a string, performing type conversion.
@@ -320,7 +364,7 @@ func (u *unmarshaler) setFieldValue(field reflect.Value, value string, tagInfo *
 
 	case reflect.Struct:
 		// Check for time.Time
-		if field.Type() == reflect.TypeOf(time.Time{}) {
+		if field.Type() == timeType {
 			return u.setTimeValue(field, value, tagInfo)
 		}
 		return fmt.Errorf("%w: %s", ErrUnsupportedType, field.Type().String())
@@ -2,6 +2,7 @@ package marshal
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -569,30 +570,450 @@ func TestUnmarshaler_NestedStruct(t *testing.T) {
 	}
 }
 
-func TestStartsWithSegment(t *testing.T) {
-	tests := []struct {
-		input string
-		want  bool
-	}{
-		{"PID", true},
-		{"PID.5", true},
-		{"PID.5.1", true},
-		{"PID[0].5", true},
-		{"MSH", true},
-		{"OBX", true},
-		{"1", false},
-		{"1.2", false},
-		{".1.2", false},
-		{"PI", false},
-		{"pid", false},
-		{"", false},
+func TestUnmarshaler_FieldError(t *testing.T) {
+	type Numbers struct {
+		Count int `hl7:"OBX.1"`
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.input, func(t *testing.T) {
-			if got := startsWithSegment(tt.input); got != tt.want {
-				t.Errorf("startsWithSegment(%q) = %v, want %v", tt.input, got, tt.want)
-			}
-		})
+	msg := newMockMessage()
+	_ = msg.Set("OBX.1", "abc")
+
+	u := NewUnmarshaler()
+	var n Numbers
+	err := u.Unmarshal(msg, &n)
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Unmarshal() error = %v, want *FieldError", err)
+	}
+	if fieldErr.Field != "Count" {
+		t.Errorf("Field = %q, want %q", fieldErr.Field, "Count")
+	}
+	if fieldErr.Location != "OBX.1" {
+		t.Errorf("Location = %q, want %q", fieldErr.Location, "OBX.1")
+	}
+}
+
+func TestUnmarshaler_Strict(t *testing.T) {
+	type Name struct {
+		Last string `hl7:"1"`
+		Rank int    `hl7:"2"`
+	}
+	type Record struct {
+		Count  int     `hl7:"OBX.1"`
+		Value  string  `hl7:"OBX.5"`
+		Amount float64 `hl7:"OBX.6"`
+		Name   Name    `hl7:"PID.5"`
+		Flags  []bool  `hl7:"OBX.8"`
+	}
+
+	msg := newMockMessage()
+	_ = msg.Set("OBX.1", "abc")
+	_ = msg.Set("OBX.5", "ok")
+	_ = msg.Set("OBX.6", "1.x")
+	_ = msg.Set("PID.5.1", "Smith")
+	_ = msg.Set("PID.5.2", "first")
+	msg.SetAll("OBX.8", []string{"Y", "maybe", "N"})
+
+	u := NewUnmarshaler(WithStrict(true))
+	var r Record
+	err := u.Unmarshal(msg, &r)
+
+	var errs FieldErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Unmarshal() error = %v, want FieldErrors", err)
+	}
+
+	want := []struct{ field, location string }{
+		{"Count", "OBX.1"},
+		{"Amount", "OBX.6"},
+		{"Name.Rank", "PID.5.2"},
+		{"Flags[1]", "OBX.8"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(want), err)
+	}
+	for i, w := range want {
+		if errs[i].Field != w.field || errs[i].Location != w.location {
+			t.Errorf("errs[%d] = %s at %s, want %s at %s",
+				i, errs[i].Field, errs[i].Location, w.field, w.location)
+		}
+	}
+
+	// Fields that converted successfully are still populated
+	if r.Value != "ok" {
+		t.Errorf("Value = %q, want %q", r.Value, "ok")
+	}
+	if r.Name.Last != "Smith" {
+		t.Errorf("Name.Last = %q, want %q", r.Name.Last, "Smith")
+	}
+	if len(r.Flags) != 3 || !r.Flags[0] || r.Flags[2] {
+		t.Errorf("Flags = %v, want [true false false]", r.Flags)
+	}
+}
+
+func TestUnmarshaler_StrictNoErrors(t *testing.T) {
+	msg := newMockMessage()
+	_ = msg.Set("PID.3", "12345")
+
+	u := NewUnmarshaler(WithStrict(true))
+	var p Patient
+	if err := u.Unmarshal(msg, &p); err != nil {
+		t.Fatalf("Unmarshal() error = %v, want nil", err)
+	}
+}
+
+func TestUnmarshaler_DisallowUnknownFields(t *testing.T) {
+	segs := []string{
+		"MSH|^~\\&|APP|FAC|||20240101120000||ADT^A01|MSG1|P|2.5",
+		"PID|1||12345~67890||Smith^John^Q||19800101|M",
+		"OBX|1|NM|GLU",
+		"OBX|2|NM|HGB",
+	}
+	msg := hl7.NewEmptyMessage()
+	for _, s := range segs {
+		seg, err := hl7.ParseSegment([]rune(s), hl7.DefaultDelimiters())
+		if err != nil {
+			t.Fatalf("ParseSegment(%q) error = %v", s, err)
+		}
+		_ = msg.AddSegment(seg)
+	}
+
+	type Record struct {
+		Header string   `hl7:"MSH.3,omitempty"`
+		ID     string   `hl7:"PID.3"`
+		Last   string   `hl7:"PID.5.1"`
+		First  string   `hl7:"PID.5.2"`
+		Codes  []string `hl7:"OBX.3"`
+	}
+
+	u := NewUnmarshaler(WithStrict(true), WithDisallowUnknownFields(true))
+	var r Record
+	err := u.Unmarshal(msg, &r)
+
+	var errs FieldErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Unmarshal() error = %v, want FieldErrors", err)
+	}
+
+	var got []string
+	for _, fe := range errs {
+		if !errors.Is(fe, ErrUnknownField) {
+			t.Errorf("error %v does not wrap ErrUnknownField", fe)
+		}
+		got = append(got, fe.Location)
+	}
+
+	want := []string{
+		"MSH.4", "MSH.7", "MSH.9.1", "MSH.9.2", "MSH.10", "MSH.11", "MSH.12",
+		"PID.1", "PID.3[1]", "PID.5.3", "PID.7", "PID.8",
+		"OBX.1", "OBX.2", "OBX[1].1", "OBX[1].2",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unknown locations = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("unknown[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	// Known fields are still populated
+	if r.ID != "12345" || r.First != "John" || len(r.Codes) != 2 {
+		t.Errorf("Record = %+v, want populated fields", r)
+	}
+}
+
+func TestUnmarshaler_DisallowUnknownFieldsNonStrict(t *testing.T) {
+	seg, err := hl7.ParseSegment([]rune("PID|1||12345"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("ParseSegment() error = %v", err)
+	}
+	msg := hl7.NewMessage([]hl7.Segment{seg}, nil)
+
+	type Record struct {
+		ID string `hl7:"PID.3"`
+	}
+
+	u := NewUnmarshaler(WithDisallowUnknownFields(true))
+	var r Record
+	err = u.Unmarshal(msg, &r)
+
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Unmarshal() error = %v, want *FieldError", err)
+	}
+	if fieldErr.Location != "PID.1" || !errors.Is(err, ErrUnknownField) {
+		t.Errorf("error = %v, want unknown field at PID.1", err)
+	}
+}
+
+func TestFieldErrors_Error(t *testing.T) {
+	errs := FieldErrors{
+		{Field: "Count", Location: "OBX.1", Cause: errors.New("bad int")},
+		{Location: "PID.8", Cause: ErrUnknownField},
+	}
+
+	want := "2 field errors: field Count (OBX.1): bad int; location PID.8: unknown field"
+	if got := errs.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(errs, ErrUnknownField) {
+		t.Error("errors.Is(errs, ErrUnknownField) = false, want true")
+	}
+}
+
+// newTestMessage builds a message from encoded segments.
+func newTestMessage(t *testing.T, segs ...string) hl7.Message {
+	t.Helper()
+	msg := hl7.NewEmptyMessage()
+	for _, s := range segs {
+		seg, err := hl7.ParseSegment([]rune(s), hl7.DefaultDelimiters())
+		if err != nil {
+			t.Fatalf("ParseSegment(%q) error = %v", s, err)
+		}
+		if err := msg.AddSegment(seg); err != nil {
+			t.Fatalf("AddSegment(%q) error = %v", s, err)
+		}
+	}
+	return msg
+}
+
+func TestUnmarshaler_MapField(t *testing.T) {
+	msg := newTestMessage(t,
+		"PID|1||12345",
+		"ZPI|a|b^c||d~e",
+		"ZPI|second",
+	)
+
+	type Record struct {
+		Custom    map[string]string `hl7:"ZPI"`
+		Second    map[string]string `hl7:"ZPI[1]"`
+		Parts     map[string]string `hl7:"ZPI.2"`
+		Missing   map[string]string `hl7:"ZZZ"`
+		PatientID string            `hl7:"PID.3"`
+	}
+
+	var r Record
+	if err := NewUnmarshaler().Unmarshal(msg, &r); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	wantCustom := map[string]string{"1": "a", "2": "b^c", "4": "d~e"}
+	if !reflect.DeepEqual(r.Custom, wantCustom) {
+		t.Errorf("Custom = %v, want %v", r.Custom, wantCustom)
+	}
+	if want := map[string]string{"1": "second"}; !reflect.DeepEqual(r.Second, want) {
+		t.Errorf("Second = %v, want %v", r.Second, want)
+	}
+	if want := map[string]string{"1": "b", "2": "c"}; !reflect.DeepEqual(r.Parts, want) {
+		t.Errorf("Parts = %v, want %v", r.Parts, want)
+	}
+	if r.Missing != nil {
+		t.Errorf("Missing = %v, want nil", r.Missing)
+	}
+	if r.PatientID != "12345" {
+		t.Errorf("PatientID = %q, want %q", r.PatientID, "12345")
+	}
+}
+
+func TestUnmarshaler_MapFieldErrors(t *testing.T) {
+	msg := newTestMessage(t, "ZPI|a")
+
+	t.Run("unsupported map type", func(t *testing.T) {
+		var r struct {
+			Custom map[string]int `hl7:"ZPI"`
+		}
+		err := NewUnmarshaler().Unmarshal(msg, &r)
+		if !errors.Is(err, ErrUnsupportedType) {
+			t.Errorf("Unmarshal() error = %v, want ErrUnsupportedType", err)
+		}
+	})
+
+	t.Run("component location", func(t *testing.T) {
+		var r struct {
+			Custom map[string]string `hl7:"ZPI.1.1"`
+		}
+		err := NewUnmarshaler().Unmarshal(msg, &r)
+		if !errors.Is(err, ErrInvalidTagFormat) {
+			t.Errorf("Unmarshal() error = %v, want ErrInvalidTagFormat", err)
+		}
+	})
+}
+
+func TestUnmarshaler_MapFieldCoversSegment(t *testing.T) {
+	msg := newTestMessage(t, "PID|1||12345", "ZPI|a|b")
+
+	var r struct {
+		ID     string            `hl7:"PID.3"`
+		SetID  string            `hl7:"PID.1"`
+		Custom map[string]string `hl7:"ZPI"`
+	}
+	u := NewUnmarshaler(WithDisallowUnknownFields(true))
+	if err := u.Unmarshal(msg, &r); err != nil {
+		t.Errorf("Unmarshal() error = %v, want nil", err)
+	}
+}
+
+// Header is a shared MSH header used by the embedding tests.
+type Header struct {
+	SendingApp string `hl7:"MSH.3"`
+	ControlID  string `hl7:"MSH.10"`
+}
+
+func TestUnmarshaler_EmbeddedStruct(t *testing.T) {
+	msg := newTestMessage(t,
+		"MSH|^~\\&|APP|FAC|||20240101||ADT^A01|MSG1|P|2.5",
+		"PID|1||12345||Smith^John",
+	)
+
+	t.Run("embedded value", func(t *testing.T) {
+		var r struct {
+			Header
+			ID string `hl7:"PID.3"`
+		}
+		if err := NewUnmarshaler().Unmarshal(msg, &r); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if r.SendingApp != "APP" || r.ControlID != "MSG1" || r.ID != "12345" {
+			t.Errorf("got %+v", r)
+		}
+	})
+
+	t.Run("embedded pointer", func(t *testing.T) {
+		var r struct {
+			*Header
+			ID string `hl7:"PID.3"`
+		}
+		if err := NewUnmarshaler().Unmarshal(msg, &r); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if r.Header == nil || r.SendingApp != "APP" {
+			t.Errorf("Header = %+v, want SendingApp APP", r.Header)
+		}
+	})
+
+	t.Run("embedded pointer without data stays nil", func(t *testing.T) {
+		var r struct {
+			*Header
+		}
+		if err := NewUnmarshaler().Unmarshal(newTestMessage(t, "PID|1"), &r); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if r.Header != nil {
+			t.Errorf("Header = %+v, want nil", r.Header)
+		}
+	})
+
+	t.Run("embedded unexported type", func(t *testing.T) {
+		type header struct {
+			ControlID string `hl7:"MSH.10"`
+		}
+		var r struct {
+			header
+		}
+		if err := NewUnmarshaler().Unmarshal(msg, &r); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if r.ControlID != "MSG1" {
+			t.Errorf("ControlID = %q, want %q", r.ControlID, "MSG1")
+		}
+	})
+
+	t.Run("promoted into nested struct", func(t *testing.T) {
+		type Name struct {
+			Last string `hl7:"1"`
+		}
+		type FullName struct {
+			Name
+			First string `hl7:"2"`
+		}
+		var r struct {
+			Name FullName `hl7:"PID.5"`
+		}
+		if err := NewUnmarshaler().Unmarshal(msg, &r); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if r.Name.Last != "Smith" || r.Name.First != "John" {
+			t.Errorf("Name = %+v, want Smith John", r.Name)
+		}
+	})
+}
+
+func TestUnmarshaler_Inline(t *testing.T) {
+	msg := newTestMessage(t,
+		"MSH|^~\\&|APP|FAC|||20240101||ADT^A01|MSG1|P|2.5",
+		"PID|1||12345",
+	)
+
+	type ADT struct {
+		Hdr Header `hl7:",inline"`
+		ID  string `hl7:"PID.3"`
+	}
+	type ORU struct {
+		Hdr *Header `hl7:",inline"`
+	}
+
+	var adt ADT
+	if err := NewUnmarshaler().Unmarshal(msg, &adt); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if adt.Hdr.SendingApp != "APP" || adt.Hdr.ControlID != "MSG1" || adt.ID != "12345" {
+		t.Errorf("got %+v", adt)
+	}
+
+	var oru ORU
+	if err := NewUnmarshaler().Unmarshal(msg, &oru); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if oru.Hdr == nil || oru.Hdr.ControlID != "MSG1" {
+		t.Errorf("Hdr = %+v, want ControlID MSG1", oru.Hdr)
+	}
+
+	t.Run("inline on non-struct", func(t *testing.T) {
+		var r struct {
+			Bad string `hl7:",inline"`
+		}
+		err := NewUnmarshaler().Unmarshal(msg, &r)
+		var fe *FieldError
+		if !errors.As(err, &fe) || fe.Field != "Bad" || !errors.Is(err, ErrInvalidTagFormat) {
+			t.Errorf("Unmarshal() error = %v, want FieldError for Bad wrapping ErrInvalidTagFormat", err)
+		}
+	})
+
+	t.Run("strict error path", func(t *testing.T) {
+		type Counted struct {
+			Count int `hl7:"MSH.10"`
+		}
+		var r struct {
+			Hdr Counted `hl7:",inline"`
+		}
+		err := NewUnmarshaler().Unmarshal(msg, &r)
+		var fe *FieldError
+		if !errors.As(err, &fe) || fe.Field != "Hdr.Count" {
+			t.Errorf("Unmarshal() error = %v, want FieldError for Hdr.Count", err)
+		}
+	})
+}
+
+func TestUnmarshaler_PointerToSlice(t *testing.T) {
+	msg := newTestMessage(t, "PID|1||A~B~C")
+
+	var r struct {
+		IDs     *[]string `hl7:"PID.3"`
+		Missing *[]string `hl7:"PID.4"`
+	}
+	if err := NewUnmarshaler().Unmarshal(msg, &r); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if r.IDs == nil {
+		t.Fatal("IDs = nil, want values")
+	}
+	if want := []string{"A", "B", "C"}; !reflect.DeepEqual(*r.IDs, want) {
+		t.Errorf("IDs = %v, want %v", *r.IDs, want)
+	}
+	if r.Missing != nil {
+		t.Errorf("Missing = %v, want nil", *r.Missing)
 	}
 }
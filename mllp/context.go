@@ -0,0 +1,63 @@
+package mllp
+
+import (
+	"context"
+	"net"
+)
+
+// Context keys of connection metadata.
+type (
+	connInfoKey struct{}
+	sequenceKey struct{}
+)
+
+// connInfo is the metadata of a client connection.
+type connInfo struct {
+	id         uint64
+	remoteAddr net.Addr
+	tlsSubject string
+}
+
+// connInfoFrom returns the connection metadata of ctx, or nil if ctx is not
+// a handler context.
+func connInfoFrom(ctx context.Context) *connInfo {
+	info, _ := ctx.Value(connInfoKey{}).(*connInfo)
+	return info
+}
+
+// ConnectionID returns the ID of the connection a message arrived on, given
+// the context passed to a Handler. IDs are unique per server and start at
+// 1. It returns 0 for other contexts.
+func ConnectionID(ctx context.Context) uint64 {
+	if info := connInfoFrom(ctx); info != nil {
+		return info.id
+	}
+	return 0
+}
+
+// RemoteAddr returns the address of the client a message came from, given
+// the context passed to a Handler. It returns nil for other contexts.
+func RemoteAddr(ctx context.Context) net.Addr {
+	if info := connInfoFrom(ctx); info != nil {
+		return info.remoteAddr
+	}
+	return nil
+}
+
+// TLSPeerSubject returns the subject of the client's TLS certificate, e.g.
+// "CN=lab.example.org,O=Example", given the context passed to a Handler. It
+// returns "" for connections without TLS or without a client certificate.
+func TLSPeerSubject(ctx context.Context) string {
+	if info := connInfoFrom(ctx); info != nil {
+		return info.tlsSubject
+	}
+	return ""
+}
+
+// MessageSequence returns the position of a message on its connection,
+// given the context passed to a Handler: 1 for the first message, 2 for the
+// second, and so on. It returns 0 for other contexts.
+func MessageSequence(ctx context.Context) uint64 {
+	seq, _ := ctx.Value(sequenceKey{}).(uint64)
+	return seq
+}
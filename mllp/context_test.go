@@ -0,0 +1,213 @@
+package mllp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dshills/golevel7/ack"
+	"github.com/dshills/golevel7/hl7"
+)
+
+// connMeta is the metadata seen by a handler.
+type connMeta struct {
+	id         uint64
+	remoteAddr net.Addr
+	tlsSubject string
+	seq        uint64
+}
+
+// metaHandler records the metadata of each message and accepts it.
+func metaHandler(seen chan<- connMeta) Handler {
+	b := ack.NewBuilder()
+	return HandlerFunc(func(ctx context.Context, msg hl7.Message) (hl7.Message, error) {
+		seen <- connMeta{ConnectionID(ctx), RemoteAddr(ctx), TLSPeerSubject(ctx), MessageSequence(ctx)}
+		return b.Accept(msg)
+	})
+}
+
+func TestHandlerContextMetadata(t *testing.T) {
+	seen := make(chan connMeta, 10)
+	addr := startServer(t, metaHandler(seen))
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+
+	reader := NewReader(conn, MaxMessageSize)
+	for i := 0; i < 2; i++ {
+		if err := NewWriter(conn).WriteMessage([]byte(testADT)); err != nil {
+			t.Fatalf("WriteMessage() error = %v", err)
+		}
+		if _, err := reader.ReadMessage(); err != nil {
+			t.Fatalf("ReadMessage() error = %v", err)
+		}
+	}
+	exchange(t, addr, testADT, 5*time.Second)
+
+	first, second, other := <-seen, <-seen, <-seen
+	if first.id == 0 || second.id != first.id || other.id == first.id {
+		t.Errorf("ConnectionID() = %d, %d, %d, want the same nonzero ID on one connection and another on the next",
+			first.id, second.id, other.id)
+	}
+	if first.seq != 1 || second.seq != 2 || other.seq != 1 {
+		t.Errorf("MessageSequence() = %d, %d, %d, want 1, 2, 1", first.seq, second.seq, other.seq)
+	}
+	if first.remoteAddr == nil || first.remoteAddr.String() != conn.LocalAddr().String() {
+		t.Errorf("RemoteAddr() = %v, want %v", first.remoteAddr, conn.LocalAddr())
+	}
+	if first.tlsSubject != "" {
+		t.Errorf("TLSPeerSubject() = %q, want empty without TLS", first.tlsSubject)
+	}
+}
+
+func TestHandlerContextOther(t *testing.T) {
+	ctx := context.Background()
+	if ConnectionID(ctx) != 0 || RemoteAddr(ctx) != nil || TLSPeerSubject(ctx) != "" || MessageSequence(ctx) != 0 {
+		t.Error("metadata helpers returned values for a context without metadata")
+	}
+}
+
+func TestHandlerContextCanceledOnDisconnect(t *testing.T) {
+	canceled := make(chan struct{})
+	addr := startServer(t, HandlerFunc(func(ctx context.Context, _ hl7.Message) (hl7.Message, error) {
+		select {
+		case <-ctx.Done():
+			close(canceled)
+		case <-time.After(5 * time.Second):
+		}
+		return nil, nil
+	}))
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	if err := NewWriter(conn).WriteMessage([]byte(testADT)); err != nil {
+		t.Fatalf("WriteMessage() error = %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	_ = conn.Close()
+
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler context not canceled after client disconnected")
+	}
+}
+
+func TestHandlerContextCanceledOnShutdown(t *testing.T) {
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+	handler := HandlerFunc(func(ctx context.Context, _ hl7.Message) (hl7.Message, error) {
+		close(started)
+		select {
+		case <-ctx.Done():
+			close(canceled)
+		case <-time.After(5 * time.Second):
+		}
+		return nil, nil
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	srv := NewServer(WithHandler(handler))
+	go func() { _ = srv.Serve(listener) }()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+	if err := NewWriter(conn).WriteMessage([]byte(testADT)); err != nil {
+		t.Fatalf("WriteMessage() error = %v", err)
+	}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown() error = %v, want connections to finish", err)
+	}
+	select {
+	case <-canceled:
+	default:
+		t.Error("handler context not canceled by Shutdown")
+	}
+}
+
+func TestHandlerContextTLSSubject(t *testing.T) {
+	serverCert := newTestCert(t, "server.example.org")
+	clientCert := newTestCert(t, "lab.example.org")
+
+	seen := make(chan connMeta, 1)
+	addr := startServer(t, metaHandler(seen), WithTLSConfig(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAnyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(serverCert.Leaf)
+	conn, err := tls.Dial("tcp", addr, &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      roots,
+		ServerName:   "server.example.org",
+		MinVersion:   tls.VersionTLS12,
+	})
+	if err != nil {
+		t.Fatalf("tls.Dial() error = %v", err)
+	}
+	defer conn.Close()
+
+	if err := NewWriter(conn).WriteMessage([]byte(testADT)); err != nil {
+		t.Fatalf("WriteMessage() error = %v", err)
+	}
+	if _, err := NewReader(conn, MaxMessageSize).ReadMessage(); err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+
+	if got := (<-seen).tlsSubject; got != "CN=lab.example.org,O=Test" {
+		t.Errorf("TLSPeerSubject() = %q, want CN=lab.example.org,O=Test", got)
+	}
+}
+
+// newTestCert returns a self-signed certificate for commonName.
+func newTestCert(t *testing.T, commonName string) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"Test"}},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
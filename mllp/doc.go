@@ -45,6 +45,58 @@
 //	    mllp.WithTLS(tlsConfig),
 //	)
 //
+// # Handler Context
+//
+// Each connection has a context that is canceled when the client disconnects
+// or Shutdown is called, and handlers receive a context derived from it.
+// Helper functions return the connection's metadata:
+//
+//	handler := mllp.HandlerFunc(func(ctx context.Context, msg hl7.Message) (hl7.Message, error) {
+//	    log.Printf("conn %d msg %d from %s (%s)",
+//	        mllp.ConnectionID(ctx), mllp.MessageSequence(ctx),
+//	        mllp.RemoteAddr(ctx), mllp.TLSPeerSubject(ctx))
+//	    return store.Save(ctx, msg) // aborted if the sender goes away
+//	})
+//
+// # Negative Acknowledgments
+//
+// The server answers every message it cannot process, so senders do not
+// wait for a timeout and resend. Data that cannot be parsed is rejected
+// (AR), with the MSH segment recovered from the data so the sender can match
+// the NAK to its message. A handler error is answered with an application
+// error (AE) with error code 207 and the error text. NAKs are always general
+// ACKs, even for message types the ack.Builder maps to their own responses.
+//
+// WithNAKFunc maps errors to other acknowledgments; errors for unparseable
+// data wrap ErrUnparseable. WithACKBuilder sets the ack.Builder the NAKs are
+// built with, and WithAutoNAK(false) turns them off:
+//
+//	server := mllp.NewServer(
+//	    mllp.WithHandler(handler),
+//	    mllp.WithNAKFunc(func(msg hl7.Message, err error) ack.ACK {
+//	        if errors.Is(err, ErrRecordLocked) {
+//	            return ack.NewErrorACK(msg.ControlID(), "206", "record locked")
+//	        }
+//	        return mllp.DefaultNAK(msg, err)
+//	    }),
+//	)
+//
+// # Logging and Error Callbacks
+//
+// Errors the server handles itself, such as unparseable data, handler
+// errors and failed writes, are logged to the logger set with WithLogger and
+// passed to the function set with WithErrorHandler, together with the
+// client's address and the received data. Logs carry the size of the data,
+// not its content:
+//
+//	server := mllp.NewServer(
+//	    mllp.WithHandler(handler),
+//	    mllp.WithLogger(slog.Default()),
+//	    mllp.WithErrorHandler(func(addr net.Addr, data []byte, err error) {
+//	        metrics.Inc("mllp_errors", addr.String())
+//	    }),
+//	)
+//
 // # Client Usage
 //
 // Create an MLLP client to send HL7 messages:
@@ -2,6 +2,7 @@ package mllp
 
 import (
 	"context"
+	"net"
 
 	"github.com/dshills/golevel7/hl7"
 )
@@ -33,11 +34,13 @@ type Handler interface {
 	// HandleMessage processes an incoming HL7 message and returns a response.
 	// The response is typically an ACK (acknowledgment) message.
 	//
-	// If the handler returns an error, the server will send a NAK (negative
-	// acknowledgment) to the client if possible.
+	// If the handler returns an error, the server sends a NAK (negative
+	// acknowledgment) built from the error by the server's NAKFunc; see
+	// WithNAKFunc and WithAutoNAK.
 	//
-	// The context will be canceled if the client disconnects or the server
-	// is shutting down.
+	// The context is canceled if the client disconnects or the server is
+	// shutting down. It carries metadata of the connection, available with
+	// ConnectionID, RemoteAddr, TLSPeerSubject and MessageSequence.
 	HandleMessage(ctx context.Context, msg hl7.Message) (hl7.Message, error)
 }
 
@@ -66,6 +69,15 @@ func (f HandlerFunc) HandleMessage(ctx context.Context, msg hl7.Message) (hl7.Me
 // Ensure HandlerFunc implements Handler at compile time.
 var _ Handler = HandlerFunc(nil)
 
+// ErrorHandler is called for errors the server handles without returning
+// them, such as messages that cannot be parsed. remoteAddr is the client's
+// address, or nil for errors accepting connections. data is the received
+// message, or nil if the error occurred before a message was read.
+//
+// The error handler is called from connection goroutines and must be safe
+// for concurrent use. data must not be retained after it returns.
+type ErrorHandler func(remoteAddr net.Addr, data []byte, err error)
+
 // MiddlewareFunc defines a function that wraps a Handler to add behavior.
 // Middleware can be used for logging, authentication, metrics, etc.
 //
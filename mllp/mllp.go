@@ -46,6 +46,10 @@ var (
 
 	// ErrMaxConnectionsReached is returned when the server has reached its connection limit.
 	ErrMaxConnectionsReached = errors.New("mllp: maximum connections reached")
+
+	// ErrUnparseable is wrapped by the errors passed to a NAKFunc for data
+	// that could not be parsed as an HL7 message.
+	ErrUnparseable = errors.New("mllp: unable to parse message")
 )
 
 // MaxMessageSize is the default maximum message size (16 MB).
@@ -0,0 +1,68 @@
+package mllp
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/dshills/golevel7/ack"
+	"github.com/dshills/golevel7/hl7"
+)
+
+// NAKFunc maps an error to the negative acknowledgment the server sends in
+// place of a response.
+//
+// msg is the message that failed. If the data received could not be parsed,
+// err wraps ErrUnparseable and msg holds only the MSH segment recovered from
+// the data, or an empty MSH segment if none could be recovered.
+//
+// Returning an ACK with an empty Code sends no response.
+type NAKFunc func(msg hl7.Message, err error) ack.ACK
+
+// DefaultNAK is the default NAKFunc. It rejects (AR) messages that could not
+// be parsed and returns an application error (AE) with error code 207
+// (application internal error) for handler errors.
+func DefaultNAK(msg hl7.Message, err error) ack.ACK {
+	if errors.Is(err, ErrUnparseable) {
+		return ack.NewRejectACK(msg.ControlID(), err.Error())
+	}
+	return ack.NewErrorACK(msg.ControlID(), "207", err.Error())
+}
+
+// nak returns the negative acknowledgment of msg for err, or nil if none is
+// sent.
+func (s *server) nak(msg hl7.Message, err error) (hl7.Message, error) {
+	if !s.config.autoNAK {
+		return nil, nil
+	}
+
+	a := s.config.nakFunc(msg, err)
+	if a.Code == "" {
+		return nil, nil
+	}
+
+	// NAKs are sent in place of the application's response, so they are
+	// general ACKs whatever the message type
+	return s.config.ackBuilder.General(msg, a)
+}
+
+// fallbackMessage returns a message holding the MSH segment of data that
+// could not be parsed, so that it can still be acknowledged. If data has no
+// usable MSH segment the message has an empty one.
+func fallbackMessage(data []byte) hl7.Message {
+	line := data
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		line = data[:i]
+	}
+
+	if delims, err := hl7.ParseDelimiters(line); err == nil {
+		if msh, err := hl7.ParseSegment([]rune(string(line)), delims); err == nil {
+			return hl7.NewMessage([]hl7.Segment{msh}, delims)
+		}
+	}
+
+	delims := hl7.DefaultDelimiters()
+	msh := hl7.NewSegment("MSH")
+	_ = msh.Set("1", delims.MSH1())
+	_ = msh.Set("2", delims.MSH2())
+	return hl7.NewMessage([]hl7.Segment{msh}, delims)
+}
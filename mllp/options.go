@@ -33,7 +33,10 @@ package mllp
 
 import (
 	"crypto/tls"
+	"log/slog"
 	"time"
+
+	"github.com/dshills/golevel7/ack"
 )
 
 // Default configuration values for MLLP clients and servers.
@@ -129,6 +132,11 @@ type serverConfig struct {
 	readTimeout    time.Duration
 	writeTimeout   time.Duration
 	tlsConfig      *tls.Config
+	autoNAK        bool
+	nakFunc        NAKFunc
+	ackBuilder     ack.Builder
+	logger         *slog.Logger
+	errorHandler   ErrorHandler
 }
 
 // defaultServerConfig returns a serverConfig with default values.
@@ -139,6 +147,9 @@ func defaultServerConfig() serverConfig {
 		readTimeout:    DefaultReadTimeout,
 		writeTimeout:   DefaultWriteTimeout,
 		tlsConfig:      nil,
+		autoNAK:        true,
+		nakFunc:        DefaultNAK,
+		ackBuilder:     ack.NewBuilder(),
 	}
 }
 
@@ -194,3 +205,68 @@ func WithTLSConfig(config *tls.Config) ServerOption {
 		c.tlsConfig = config
 	}
 }
+
+// WithAutoNAK enables or disables negative acknowledgments for messages that
+// cannot be parsed and messages whose handler returns an error. When
+// enabled (default), the server responds with the ACK returned by the
+// NAKFunc; when disabled, it sends no response and the client times out.
+func WithAutoNAK(enable bool) ServerOption {
+	return func(c *serverConfig) {
+		c.autoNAK = enable
+	}
+}
+
+// WithNAKFunc sets the function that maps errors to negative
+// acknowledgments. Default is DefaultNAK.
+//
+// Example:
+//
+//	mllp.WithNAKFunc(func(msg hl7.Message, err error) ack.ACK {
+//	    if errors.Is(err, ErrRecordLocked) {
+//	        return ack.NewErrorACK(msg.ControlID(), "206", "record locked")
+//	    }
+//	    return mllp.DefaultNAK(msg, err)
+//	})
+func WithNAKFunc(fn NAKFunc) ServerOption {
+	return func(c *serverConfig) {
+		if fn != nil {
+			c.nakFunc = fn
+		}
+	}
+}
+
+// WithACKBuilder sets the builder of negative acknowledgments, e.g. one with
+// custom control IDs or response types. Default is ack.NewBuilder().
+func WithACKBuilder(b ack.Builder) ServerOption {
+	return func(c *serverConfig) {
+		if b != nil {
+			c.ackBuilder = b
+		}
+	}
+}
+
+// WithLogger sets the logger for connection events and errors. Errors are
+// logged with the remote address and the size of the message, never its
+// content. Default is no logging.
+func WithLogger(logger *slog.Logger) ServerOption {
+	return func(c *serverConfig) {
+		c.logger = logger
+	}
+}
+
+// WithErrorHandler sets a function called for every error the server handles
+// itself: accept, read, parse, handler, encode and write errors, and refused
+// connections.
+//
+// Example:
+//
+//	mllp.WithErrorHandler(func(addr net.Addr, data []byte, err error) {
+//	    if errors.Is(err, mllp.ErrUnparseable) {
+//	        quarantine.Store(addr, bytes.Clone(data))
+//	    }
+//	})
+func WithErrorHandler(h ErrorHandler) ServerOption {
+	return func(c *serverConfig) {
+		c.errorHandler = h
+	}
+}
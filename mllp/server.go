@@ -1,17 +1,20 @@
 package mllp
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/dshills/golevel7/encode"
+	"github.com/dshills/golevel7/hl7"
 	"github.com/dshills/golevel7/parse"
 )
 
@@ -29,8 +32,9 @@ type Server interface {
 	Serve(listener net.Listener) error
 
 	// Shutdown gracefully shuts down the server.
-	// It stops accepting new connections and waits for existing connections
-	// to complete or for the context to be canceled.
+	// It stops accepting new connections, cancels the contexts of running
+	// handlers and waits for existing connections to send their responses
+	// and complete, or for the context to be canceled.
 	Shutdown(ctx context.Context) error
 }
 
@@ -41,12 +45,15 @@ type server struct {
 	parser       parse.Parser
 	listener     net.Listener
 	connections  map[net.Conn]struct{}
-	connMu       sync.Mutex
+	connMu       sync.Mutex // guards listener and connections
 	activeConns  atomic.Int32
 	shutdown     atomic.Bool
 	shutdownChan chan struct{}
 	shutdownOnce sync.Once
 	wg           sync.WaitGroup
+	baseCtx      context.Context // parent of connection contexts, canceled by Shutdown
+	cancelBase   context.CancelFunc
+	nextConnID   atomic.Uint64
 }
 
 // NewServer creates a new MLLP server with the provided options.
@@ -74,12 +81,15 @@ func NewServer(opts ...ServerOption) Server {
 		opt(&config)
 	}
 
+	baseCtx, cancelBase := context.WithCancel(context.Background())
 	return &server{
 		config:       config,
 		encoder:      encode.New(),
 		parser:       parse.New(),
 		connections:  make(map[net.Conn]struct{}),
 		shutdownChan: make(chan struct{}),
+		baseCtx:      baseCtx,
+		cancelBase:   cancelBase,
 	}
 }
 
@@ -94,7 +104,9 @@ func (s *server) Serve(listener net.Listener) error {
 		listener = tls.NewListener(listener, s.config.tlsConfig)
 	}
 
+	s.connMu.Lock()
 	s.listener = listener
+	s.connMu.Unlock()
 
 	for {
 		// Check if we're shutting down
@@ -111,6 +123,7 @@ func (s *server) Serve(listener net.Listener) error {
 
 			// Check for temporary errors
 			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				s.reportError(slog.LevelError, "accept failed", nil, nil, fmt.Errorf("mllp: accept error: %w", err))
 				continue
 			}
 
@@ -119,6 +132,7 @@ func (s *server) Serve(listener net.Listener) error {
 
 		// Check connection limit
 		if s.activeConns.Load() >= int32(s.config.maxConnections) {
+			s.reportError(slog.LevelWarn, "connection refused", conn.RemoteAddr(), nil, ErrMaxConnectionsReached)
 			_ = conn.Close()
 			continue
 		}
@@ -135,83 +149,232 @@ func (s *server) Serve(listener net.Listener) error {
 }
 
 // handleConnection processes messages from a single client connection.
+//
+// A goroutine reads messages while the previous one is handled, so that a
+// disconnect cancels the connection context. The read timeout only applies
+// while the connection is idle.
 func (s *server) handleConnection(conn net.Conn) {
+	remoteAddr := conn.RemoteAddr()
+	s.log(slog.LevelDebug, "connection opened", remoteAddr)
+
+	ctx, cancel := context.WithCancel(s.baseCtx)
+	var readerDone chan struct{} // closed when the reader goroutine returns
+
 	defer func() {
+		cancel()
+		_ = conn.Close()
+		if readerDone != nil {
+			<-readerDone
+		}
+
 		s.connMu.Lock()
 		delete(s.connections, conn)
 		s.connMu.Unlock()
 
 		s.activeConns.Add(-1)
-		_ = conn.Close()
 		s.wg.Done()
-	}()
 
-	reader := NewReader(conn, MaxMessageSize)
-	writer := NewWriter(conn)
+		s.log(slog.LevelDebug, "connection closed", remoteAddr)
+	}()
 
-	for {
-		// Check for shutdown
-		if s.shutdown.Load() {
+	info := &connInfo{id: s.nextConnID.Add(1), remoteAddr: remoteAddr}
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		subject, err := s.handshake(ctx, tlsConn)
+		if err != nil {
+			s.reportError(slog.LevelWarn, "TLS handshake failed", remoteAddr, nil, err)
 			return
 		}
+		info.tlsSubject = subject
+	}
+	ctx = context.WithValue(ctx, connInfoKey{}, info)
+
+	// Read deadlines are set here and by Shutdown, which ends pending reads
+	var deadlineMu sync.Mutex
+	setReadDeadline := func(t time.Time) {
+		deadlineMu.Lock()
+		defer deadlineMu.Unlock()
+		if ctx.Err() == nil {
+			_ = conn.SetReadDeadline(t)
+		}
+	}
+	stop := context.AfterFunc(ctx, func() {
+		deadlineMu.Lock()
+		defer deadlineMu.Unlock()
+		_ = conn.SetReadDeadline(time.Now())
+	})
+	defer stop()
 
-		// Set read deadline
+	idle := func() {
 		if s.config.readTimeout > 0 {
-			_ = conn.SetReadDeadline(time.Now().Add(s.config.readTimeout))
+			setReadDeadline(time.Now().Add(s.config.readTimeout))
+		}
+	}
+	idle()
+
+	messages := make(chan []byte)
+	readerDone = make(chan struct{})
+	go func() {
+		defer close(readerDone)
+		s.readMessages(ctx, cancel, conn, messages)
+	}()
+
+	writer := NewWriter(conn)
+	var seq uint64
+	for data := range messages {
+		setReadDeadline(time.Time{}) // no read timeout while handling
+
+		seq++
+		msgCtx := context.WithValue(ctx, sequenceKey{}, seq)
+		if !s.handleMessage(msgCtx, conn, writer, data) {
+			return
 		}
 
-		// Read next message
+		idle()
+	}
+}
+
+// handshake completes the TLS handshake of conn and returns the subject of
+// the client's certificate, or "" if it sent none.
+func (s *server) handshake(ctx context.Context, conn *tls.Conn) (string, error) {
+	if s.config.readTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.readTimeout)
+		defer cancel()
+	}
+	if err := conn.HandshakeContext(ctx); err != nil {
+		return "", fmt.Errorf("mllp: TLS handshake: %w", err)
+	}
+
+	if certs := conn.ConnectionState().PeerCertificates; len(certs) > 0 {
+		return certs[0].Subject.String(), nil
+	}
+	return "", nil
+}
+
+// readMessages reads messages from conn and sends them to messages until the
+// connection ends. It cancels the connection context when the client
+// disconnects, and closes messages when it returns.
+func (s *server) readMessages(ctx context.Context, cancel context.CancelFunc, conn net.Conn, messages chan<- []byte) {
+	defer close(messages)
+
+	remoteAddr := conn.RemoteAddr()
+	reader := NewReader(conn, MaxMessageSize)
+
+	for {
 		data, err := reader.ReadMessage()
 		if err != nil {
-			if errors.Is(err, io.EOF) || errors.Is(err, ErrConnectionClosed) {
-				return // Client disconnected
-			}
-			if ne, ok := err.(net.Error); ok && ne.Timeout() {
-				return // Read timeout, close connection
+			var ne net.Error
+			switch {
+			case ctx.Err() != nil:
+				// Server shutting down or connection closed by the server
+			case errors.Is(err, io.EOF) || errors.Is(err, ErrConnectionClosed):
+				// Client disconnected
+				cancel()
+			case errors.As(err, &ne) && ne.Timeout():
+				// Read timeout, close connection
+				s.log(slog.LevelDebug, "read timeout", remoteAddr)
+			default:
+				s.reportError(slog.LevelError, "read failed", remoteAddr, nil, fmt.Errorf("mllp: reading message: %w", err))
+				cancel()
 			}
-			// Log error and continue or close depending on severity
 			return
 		}
 
-		// Parse the message
-		msg, err := s.parser.Parse(data)
-		if err != nil {
-			// Send NAK if possible, then continue
-			// For now, just log and continue
-			continue
+		// The reader reuses its buffer for the next message
+		select {
+		case messages <- bytes.Clone(data):
+		case <-ctx.Done():
+			return
 		}
+	}
+}
 
-		// Create context for handler
-		ctx, cancel := context.WithCancel(context.Background())
+// handleMessage parses data, passes it to the handler and sends the response
+// or a NAK. It returns false if the connection must be closed.
+func (s *server) handleMessage(ctx context.Context, conn net.Conn, writer *Writer, data []byte) bool {
+	remoteAddr := conn.RemoteAddr()
+
+	// Parse the message; data that cannot be parsed is rejected
+	msg, err := s.parser.Parse(data)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", ErrUnparseable, err)
+		s.reportError(slog.LevelWarn, "message rejected", remoteAddr, data, err)
+		return s.sendNAK(conn, writer, data, fallbackMessage(data), err)
+	}
 
-		// Handle message
-		resp, err := s.config.handler.HandleMessage(ctx, msg)
-		cancel()
+	// Handle message
+	resp, err := s.config.handler.HandleMessage(ctx, msg)
+	if err != nil {
+		s.reportError(slog.LevelWarn, "handler failed", remoteAddr, data, fmt.Errorf("mllp: handling message: %w", err))
+		return s.sendNAK(conn, writer, data, msg, err)
+	}
 
-		if err != nil {
-			// Handler returned error - could send NAK here
-			continue
-		}
+	return s.respond(conn, writer, data, resp)
+}
 
-		if resp == nil {
-			continue // No response to send
-		}
+// sendNAK sends the negative acknowledgment of msg for err, if any. It
+// returns false if the connection must be closed.
+func (s *server) sendNAK(conn net.Conn, writer *Writer, data []byte, msg hl7.Message, err error) bool {
+	nak, err := s.nak(msg, err)
+	if err != nil {
+		s.reportError(slog.LevelError, "NAK failed", conn.RemoteAddr(), data, fmt.Errorf("mllp: building NAK: %w", err))
+		return true
+	}
+	return s.respond(conn, writer, data, nak)
+}
 
-		// Set write deadline
-		if s.config.writeTimeout > 0 {
-			_ = conn.SetWriteDeadline(time.Now().Add(s.config.writeTimeout))
-		}
+// respond encodes resp and sends it with MLLP framing. A nil resp sends
+// nothing. data is the received message the response answers. It returns
+// false if writing failed and the connection must be closed.
+func (s *server) respond(conn net.Conn, writer *Writer, data []byte, resp hl7.Message) bool {
+	if resp == nil {
+		return true // No response to send
+	}
 
-		// Encode response
-		respData, err := s.encoder.Encode(resp)
-		if err != nil {
-			continue
-		}
+	// Set write deadline
+	if s.config.writeTimeout > 0 {
+		_ = conn.SetWriteDeadline(time.Now().Add(s.config.writeTimeout))
+	}
+
+	// Encode response
+	respData, err := s.encoder.Encode(resp)
+	if err != nil {
+		s.reportError(slog.LevelError, "encode failed", conn.RemoteAddr(), data, fmt.Errorf("mllp: encoding response: %w", err))
+		return true
+	}
+
+	// Send response with MLLP framing
+	if err := writer.WriteMessage(respData); err != nil {
+		s.reportError(slog.LevelError, "write failed", conn.RemoteAddr(), data, fmt.Errorf("mllp: writing response: %w", err))
+		return false
+	}
+	return true
+}
 
-		// Send response with MLLP framing
-		if err := writer.WriteMessage(respData); err != nil {
-			return // Write error, close connection
+// reportError logs err and passes it to the error handler. data is the
+// received message, if any; only its length is logged, as messages usually
+// carry patient data.
+func (s *server) reportError(level slog.Level, msg string, remoteAddr net.Addr, data []byte, err error) {
+	if s.config.logger != nil {
+		attrs := []slog.Attr{slog.Any("error", err)}
+		if remoteAddr != nil {
+			attrs = append(attrs, slog.String("remote_addr", remoteAddr.String()))
 		}
+		if data != nil {
+			attrs = append(attrs, slog.Int("bytes", len(data)))
+		}
+		s.config.logger.LogAttrs(context.Background(), level, "mllp: "+msg, attrs...)
+	}
+
+	if s.config.errorHandler != nil {
+		s.config.errorHandler(remoteAddr, data, err)
+	}
+}
+
+// log logs a connection event.
+func (s *server) log(level slog.Level, msg string, remoteAddr net.Addr) {
+	if s.config.logger != nil {
+		s.config.logger.LogAttrs(context.Background(), level, "mllp: "+msg, slog.String("remote_addr", remoteAddr.String()))
 	}
 }
 
@@ -221,10 +384,15 @@ func (s *server) Shutdown(ctx context.Context) error {
 		s.shutdown.Store(true)
 		close(s.shutdownChan)
 
+		// Cancel handler contexts and end pending reads
+		s.cancelBase()
+
 		// Close the listener to stop accepting new connections
+		s.connMu.Lock()
 		if s.listener != nil {
 			_ = s.listener.Close()
 		}
+		s.connMu.Unlock()
 	})
 
 	// Wait for all connections to complete or context to be canceled
@@ -0,0 +1,292 @@
+package mllp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dshills/golevel7/ack"
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/parse"
+)
+
+const testADT = "MSH|^~\\&|SND|SFAC|RCV|RFAC|20240115103000||ADT^A01|MSG001|P|2.5.1\r" +
+	"PID|1||12345\r"
+
+// startServer serves handler on a local port and returns the address.
+func startServer(t *testing.T, handler Handler, opts ...ServerOption) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	srv := NewServer(append([]ServerOption{WithHandler(handler)}, opts...)...)
+	go func() { _ = srv.Serve(listener) }()
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = srv.Shutdown(ctx)
+	})
+	return listener.Addr().String()
+}
+
+// exchange sends data to the server at addr and returns the parsed
+// response, or nil if none arrives within wait.
+func exchange(t *testing.T, addr, data string, wait time.Duration) hl7.Message {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+
+	if err := NewWriter(conn).WriteMessage([]byte(data)); err != nil {
+		t.Fatalf("WriteMessage() error = %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(wait))
+	respData, err := NewReader(conn, MaxMessageSize).ReadMessage()
+	if err != nil {
+		var ne net.Error
+		if errors.As(err, &ne) && ne.Timeout() {
+			return nil
+		}
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+
+	resp, err := parse.New().Parse(respData)
+	if err != nil {
+		t.Fatalf("Parse(response) error = %v", err)
+	}
+	return resp
+}
+
+func TestServerHandlerErrorNAK(t *testing.T) {
+	addr := startServer(t, HandlerFunc(func(_ context.Context, _ hl7.Message) (hl7.Message, error) {
+		return nil, errors.New("database unavailable")
+	}))
+
+	resp := exchange(t, addr, testADT, 5*time.Second)
+	if resp == nil {
+		t.Fatal("no response to handler error, want AE")
+	}
+
+	a, details, err := ack.Parse(resp, ack.ExpectControlID("MSG001"))
+	if err != nil {
+		t.Fatalf("ack.Parse() error = %v", err)
+	}
+	if a.Code != ack.ApplicationError {
+		t.Errorf("MSA-1 = %s, want AE", a.Code)
+	}
+	if len(details) != 1 || details[0].Code != "207" {
+		t.Errorf("ERR details = %+v, want code 207", details)
+	}
+	if app, _ := resp.Get("MSH.3"); app != "RCV" {
+		t.Errorf("MSH-3 = %q, want RCV", app)
+	}
+}
+
+func TestServerNAKIsGeneralACK(t *testing.T) {
+	addr := startServer(t, HandlerFunc(func(_ context.Context, _ hl7.Message) (hl7.Message, error) {
+		return nil, errors.New("database unavailable")
+	}), WithACKBuilder(ack.NewBuilder(ack.WithResponses(ack.DefaultResponses()))))
+
+	orm := "MSH|^~\\&|SND|SFAC|RCV|RFAC|20240115103000||ORM^O01^ORM_O01|ORD001|P|2.5.1\r" +
+		"PID|1||12345\r" +
+		"ORC|NW|PLC1\r"
+	resp := exchange(t, addr, orm, 5*time.Second)
+	if resp == nil {
+		t.Fatal("no response to handler error, want AE")
+	}
+	if got, _ := resp.Get("MSH.9"); got != "ACK^O01" {
+		t.Errorf("MSH-9 = %q, want ACK^O01", got)
+	}
+	if _, ok := resp.Segment("ORC"); ok {
+		t.Error("NAK echoes ORC, want a general ACK")
+	}
+}
+
+func TestServerParseErrorNAK(t *testing.T) {
+	var called atomic.Bool
+	addr := startServer(t, HandlerFunc(func(_ context.Context, _ hl7.Message) (hl7.Message, error) {
+		called.Store(true)
+		return nil, nil
+	}))
+
+	tests := []struct {
+		name          string
+		data          string
+		wantControlID string
+	}{
+		{"bad segment", testADT + "1X|bad\r", "MSG001"},
+		{"no MSH", "garbage", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := exchange(t, addr, tt.data, 5*time.Second)
+			if resp == nil {
+				t.Fatal("no response to unparseable message, want AR")
+			}
+			if code, _ := resp.Get("MSA.1"); code != "AR" {
+				t.Errorf("MSA-1 = %q, want AR", code)
+			}
+			if id, _ := resp.Get("MSA.2"); id != tt.wantControlID {
+				t.Errorf("MSA-2 = %q, want %q", id, tt.wantControlID)
+			}
+		})
+	}
+
+	if called.Load() {
+		t.Error("handler called for unparseable message")
+	}
+}
+
+func TestServerNAKFunc(t *testing.T) {
+	errLocked := errors.New("record locked")
+	handler := HandlerFunc(func(_ context.Context, msg hl7.Message) (hl7.Message, error) {
+		if id := msg.ControlID(); id == "MSG001" {
+			return nil, errLocked
+		}
+		return nil, errors.New("ignored")
+	})
+	nakFunc := func(msg hl7.Message, err error) ack.ACK {
+		if errors.Is(err, errLocked) {
+			return ack.NewErrorACK(msg.ControlID(), "206", err.Error())
+		}
+		return ack.ACK{} // no response
+	}
+	addr := startServer(t, handler, WithNAKFunc(nakFunc))
+
+	resp := exchange(t, addr, testADT, 5*time.Second)
+	if resp == nil {
+		t.Fatal("no response, want AE with code 206")
+	}
+	if a, _, _ := ack.Parse(resp); a.Outcome() != ack.Retryable {
+		t.Errorf("Outcome() = %v, want Retryable for code 206", a.Outcome())
+	}
+
+	other := "MSH|^~\\&|SND|SFAC|RCV|RFAC|20240115103000||ADT^A01|MSG002|P|2.5.1\r"
+	if resp := exchange(t, addr, other, 200*time.Millisecond); resp != nil {
+		t.Errorf("response = %q, want none for empty ACK code", resp.String())
+	}
+}
+
+func TestServerAutoNAKDisabled(t *testing.T) {
+	addr := startServer(t, HandlerFunc(func(_ context.Context, _ hl7.Message) (hl7.Message, error) {
+		return nil, errors.New("failed")
+	}), WithAutoNAK(false))
+
+	if resp := exchange(t, addr, testADT, 200*time.Millisecond); resp != nil {
+		t.Errorf("response = %q, want none with auto NAK disabled", resp.String())
+	}
+}
+
+func TestFallbackMessage(t *testing.T) {
+	msg := fallbackMessage([]byte("MSH|^~\\&|SND|SFAC|RCV|RFAC|20240115||ADT^A01|MSG001|P|2.5\r1X|bad"))
+	if id := msg.ControlID(); id != "MSG001" {
+		t.Errorf("ControlID() = %q, want MSG001", id)
+	}
+	if _, ok := msg.Segment("PID"); ok {
+		t.Error("fallback message has segments after MSH")
+	}
+
+	msg = fallbackMessage([]byte("not HL7"))
+	if _, ok := msg.Segment("MSH"); !ok {
+		t.Error("fallback message for non-HL7 data has no MSH segment")
+	}
+}
+
+// errorRecord is an error passed to an ErrorHandler.
+type errorRecord struct {
+	addr net.Addr
+	data string
+	err  error
+}
+
+func TestServerErrorHandler(t *testing.T) {
+	records := make(chan errorRecord, 10)
+	errHandler := func(addr net.Addr, data []byte, err error) {
+		records <- errorRecord{addr, string(data), err}
+	}
+	errFailed := errors.New("failed")
+	addr := startServer(t, HandlerFunc(func(_ context.Context, _ hl7.Message) (hl7.Message, error) {
+		return nil, errFailed
+	}), WithErrorHandler(errHandler))
+
+	bad := testADT + "1X|bad\r"
+	exchange(t, addr, bad, 5*time.Second)
+	exchange(t, addr, testADT, 5*time.Second)
+
+	tests := []struct {
+		data string
+		want error
+	}{
+		{bad, ErrUnparseable},
+		{testADT, errFailed},
+	}
+	for _, tt := range tests {
+		select {
+		case r := <-records:
+			if !errors.Is(r.err, tt.want) {
+				t.Errorf("error = %v, want %v", r.err, tt.want)
+			}
+			if r.data != tt.data {
+				t.Errorf("data = %q, want %q", r.data, tt.data)
+			}
+			if r.addr == nil {
+				t.Error("remote address = nil")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("error handler not called for %v", tt.want)
+		}
+	}
+}
+
+func TestServerLogger(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		buf bytes.Buffer
+	)
+	logger := slog.New(slog.NewJSONHandler(&lockedWriter{mu: &mu, w: &buf}, nil))
+	addr := startServer(t, HandlerFunc(func(_ context.Context, _ hl7.Message) (hl7.Message, error) {
+		return nil, nil
+	}), WithLogger(logger))
+
+	exchange(t, addr, "MSH|^~\\&|SND|SFAC|RCV|RFAC|20240115||ADT^A01|MSG001|P|2.5\rP\r", 5*time.Second)
+
+	mu.Lock()
+	out := buf.String()
+	mu.Unlock()
+
+	for _, want := range []string{`"level":"WARN"`, `"msg":"mllp: message rejected"`, `"remote_addr":"127.0.0.1:`, `"bytes":`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log = %s, want %s", out, want)
+		}
+	}
+	if strings.Contains(out, "SFAC") {
+		t.Errorf("log = %s, want no message content", out)
+	}
+}
+
+// lockedWriter serializes writes to w.
+type lockedWriter struct {
+	mu *sync.Mutex
+	w  io.Writer
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
@@ -0,0 +1,94 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseABS(t *testing.T) {
+	input := "ABS|ABS-1|ABS-2|ABS-3|ABS-4|ABS-5|ABS-6|ABS-7|ABS-8|ABS-9|ABS-10|ABS-11|ABS-12|ABS-13|ABS-14"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseABS(seg)
+	if err != nil {
+		t.Fatalf("ParseABS() unexpected error: %v", err)
+	}
+
+	want := ABS{
+		DischargeCareProvider:      "ABS-1",
+		TransferMedicalServiceCode: "ABS-2",
+		SeverityOfIllnessCode:      "ABS-3",
+		DateTimeOfAttestation:      "ABS-4",
+		AttestedBy:                 "ABS-5",
+		TriageCode:                 "ABS-6",
+		AbstractCompletionDateTime: "ABS-7",
+		AbstractedBy:               "ABS-8",
+		CaseCategoryCode:           "ABS-9",
+		CaesarianSectionIndicator:  "ABS-10",
+		GestationCategoryCode:      "ABS-11",
+		GestationPeriodWeeks:       "ABS-12",
+		NewbornCode:                "ABS-13",
+		StillbornIndicator:         "ABS-14",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseABS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseABS_WrongSegment(t *testing.T) {
+	if _, err := ParseABS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseABS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseABS(seg); !errors.Is(err, ErrNotABSSegment) {
+		t.Errorf("ParseABS() error = %v, want ErrNotABSSegment", err)
+	}
+}
+
+func TestABS_RoundTrip(t *testing.T) {
+	original := &ABS{
+		DischargeCareProvider:      "ABS-1",
+		TransferMedicalServiceCode: "ABS-2",
+		SeverityOfIllnessCode:      "ABS-3",
+		DateTimeOfAttestation:      "ABS-4",
+		AttestedBy:                 "ABS-5",
+		TriageCode:                 "ABS-6",
+		AbstractCompletionDateTime: "ABS-7",
+		AbstractedBy:               "ABS-8",
+		CaseCategoryCode:           "ABS-9",
+		CaesarianSectionIndicator:  "ABS-10",
+		GestationCategoryCode:      "ABS-11",
+		GestationPeriodWeeks:       "ABS-12",
+		NewbornCode:                "ABS-13",
+		StillbornIndicator:         "ABS-14",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ABS" {
+		t.Errorf("segment name = %q, want ABS", seg.Name())
+	}
+
+	parsed, err := ParseABS(seg)
+	if err != nil {
+		t.Fatalf("ParseABS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
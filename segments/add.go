@@ -0,0 +1,61 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ADD represents the Addendum segment.
+//
+// Field positions follow the HL7 standard where ADD-1 is the first field
+// after the segment name.
+type ADD struct {
+	// AddendumContinuationPointer is ADD-1: Addendum Continuation Pointer (ST, optional, max length 65536).
+	AddendumContinuationPointer string `hl7:"ADD.1,maxlen=65536"`
+}
+
+// ErrNotADDSegment indicates the segment is not an ADD segment.
+var ErrNotADDSegment = fmt.Errorf("segment is not ADD")
+
+// ParseADD extracts ADD segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an ADD segment.
+func ParseADD(seg hl7.Segment) (*ADD, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "ADD" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotADDSegment, seg.Name())
+	}
+
+	add := &ADD{
+		AddendumContinuationPointer: getFieldValue(seg, 1),
+	}
+
+	return add, nil
+}
+
+// ToSegment converts the ADD struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (a *ADD) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		a.AddendumContinuationPointer,
+	}
+
+	data := buildSegmentData("ADD", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ADD segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,68 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseADD(t *testing.T) {
+	input := "ADD|ADD-1"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseADD(seg)
+	if err != nil {
+		t.Fatalf("ParseADD() unexpected error: %v", err)
+	}
+
+	want := ADD{
+		AddendumContinuationPointer: "ADD-1",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseADD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseADD_WrongSegment(t *testing.T) {
+	if _, err := ParseADD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseADD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseADD(seg); !errors.Is(err, ErrNotADDSegment) {
+		t.Errorf("ParseADD() error = %v, want ErrNotADDSegment", err)
+	}
+}
+
+func TestADD_RoundTrip(t *testing.T) {
+	original := &ADD{
+		AddendumContinuationPointer: "ADD-1",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ADD" {
+		t.Errorf("segment name = %q, want ADD", seg.Name())
+	}
+
+	parsed, err := ParseADD(seg)
+	if err != nil {
+		t.Fatalf("ParseADD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
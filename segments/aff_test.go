@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseAFF(t *testing.T) {
+	input := "AFF|AFF-1|AFF-2|AFF-3|AFF-4a~AFF-4b|AFF-5"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseAFF(seg)
+	if err != nil {
+		t.Fatalf("ParseAFF() unexpected error: %v", err)
+	}
+
+	want := AFF{
+		SetID:                           "AFF-1",
+		ProfessionalOrganization:        "AFF-2",
+		ProfessionalOrganizationAddress: "AFF-3",
+		ProfessionalOrganizationAffiliationDateRange: []string{"AFF-4a", "AFF-4b"},
+		ProfessionalAffiliationAdditionalInformation: "AFF-5",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseAFF() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseAFF_WrongSegment(t *testing.T) {
+	if _, err := ParseAFF(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseAFF(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseAFF(seg); !errors.Is(err, ErrNotAFFSegment) {
+		t.Errorf("ParseAFF() error = %v, want ErrNotAFFSegment", err)
+	}
+}
+
+func TestAFF_RoundTrip(t *testing.T) {
+	original := &AFF{
+		SetID:                           "AFF-1",
+		ProfessionalOrganization:        "AFF-2",
+		ProfessionalOrganizationAddress: "AFF-3",
+		ProfessionalOrganizationAffiliationDateRange: []string{"AFF-4a", "AFF-4b"},
+		ProfessionalAffiliationAdditionalInformation: "AFF-5",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "AFF" {
+		t.Errorf("segment name = %q, want AFF", seg.Name())
+	}
+
+	parsed, err := ParseAFF(seg)
+	if err != nil {
+		t.Fatalf("ParseAFF() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
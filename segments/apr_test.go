@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseAPR(t *testing.T) {
+	input := "APR|APR-1a~APR-1b|APR-2a~APR-2b|APR-3a~APR-3b|APR-4|APR-5a~APR-5b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseAPR(seg)
+	if err != nil {
+		t.Fatalf("ParseAPR() unexpected error: %v", err)
+	}
+
+	want := APR{
+		TimeSelectionCriteria:     []string{"APR-1a", "APR-1b"},
+		ResourceSelectionCriteria: []string{"APR-2a", "APR-2b"},
+		LocationSelectionCriteria: []string{"APR-3a", "APR-3b"},
+		SlotSpacingCriteria:       "APR-4",
+		FillerOverrideCriteria:    []string{"APR-5a", "APR-5b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseAPR() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseAPR_WrongSegment(t *testing.T) {
+	if _, err := ParseAPR(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseAPR(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseAPR(seg); !errors.Is(err, ErrNotAPRSegment) {
+		t.Errorf("ParseAPR() error = %v, want ErrNotAPRSegment", err)
+	}
+}
+
+func TestAPR_RoundTrip(t *testing.T) {
+	original := &APR{
+		TimeSelectionCriteria:     []string{"APR-1a", "APR-1b"},
+		ResourceSelectionCriteria: []string{"APR-2a", "APR-2b"},
+		LocationSelectionCriteria: []string{"APR-3a", "APR-3b"},
+		SlotSpacingCriteria:       "APR-4",
+		FillerOverrideCriteria:    []string{"APR-5a", "APR-5b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "APR" {
+		t.Errorf("segment name = %q, want APR", seg.Name())
+	}
+
+	parsed, err := ParseAPR(seg)
+	if err != nil {
+		t.Fatalf("ParseAPR() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,181 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ARQ represents the Appointment Request segment.
+//
+// Field positions follow the HL7 standard where ARQ-1 is the first field
+// after the segment name.
+type ARQ struct {
+	// PlacerAppointmentID is ARQ-1: Placer Appointment ID (EI, required, max length 75).
+	PlacerAppointmentID string `hl7:"ARQ.1,required,maxlen=75"`
+
+	// FillerAppointmentID is ARQ-2: Filler Appointment ID (EI, conditional, max length 75).
+	FillerAppointmentID string `hl7:"ARQ.2,maxlen=75"`
+
+	// OccurrenceNumber is ARQ-3: Occurrence Number (NM, conditional, max length 5).
+	OccurrenceNumber string `hl7:"ARQ.3,maxlen=5"`
+
+	// PlacerGroupNumber is ARQ-4: Placer Group Number (EI, optional, max length 22).
+	PlacerGroupNumber string `hl7:"ARQ.4,maxlen=22"`
+
+	// ScheduleID is ARQ-5: Schedule ID (CE, optional, max length 250).
+	ScheduleID string `hl7:"ARQ.5,maxlen=250"`
+
+	// RequestEventReason is ARQ-6: Request Event Reason (CE, optional, max length 250).
+	RequestEventReason string `hl7:"ARQ.6,maxlen=250"`
+
+	// AppointmentReason is ARQ-7: Appointment Reason (CE, optional, max length 250).
+	AppointmentReason string `hl7:"ARQ.7,maxlen=250"`
+
+	// AppointmentType is ARQ-8: Appointment Type (CE, optional, max length 250).
+	AppointmentType string `hl7:"ARQ.8,maxlen=250"`
+
+	// AppointmentDuration is ARQ-9: Appointment Duration (NM, optional, max length 20).
+	AppointmentDuration string `hl7:"ARQ.9,maxlen=20"`
+
+	// AppointmentDurationUnits is ARQ-10: Appointment Duration Units (CE, optional, max length 250).
+	AppointmentDurationUnits string `hl7:"ARQ.10,maxlen=250"`
+
+	// RequestedStartDateTimeRange is ARQ-11: Requested Start Date/Time Range (DR, optional, repeating, max length 53).
+	RequestedStartDateTimeRange []string `hl7:"ARQ.11,maxlen=53"`
+
+	// Priority is ARQ-12: Priority-ARQ (ST, optional, max length 5).
+	Priority string `hl7:"ARQ.12,maxlen=5"`
+
+	// RepeatingInterval is ARQ-13: Repeating Interval (RI, optional, max length 100).
+	RepeatingInterval string `hl7:"ARQ.13,maxlen=100"`
+
+	// RepeatingIntervalDuration is ARQ-14: Repeating Interval Duration (ST, optional, max length 5).
+	RepeatingIntervalDuration string `hl7:"ARQ.14,maxlen=5"`
+
+	// PlacerContactPerson is ARQ-15: Placer Contact Person (XCN, required, repeating, max length 250).
+	PlacerContactPerson []string `hl7:"ARQ.15,required,maxlen=250"`
+
+	// PlacerContactPhoneNumber is ARQ-16: Placer Contact Phone Number (XTN, optional, repeating, max length 250).
+	PlacerContactPhoneNumber []string `hl7:"ARQ.16,maxlen=250"`
+
+	// PlacerContactAddress is ARQ-17: Placer Contact Address (XAD, optional, repeating, max length 250).
+	PlacerContactAddress []string `hl7:"ARQ.17,maxlen=250"`
+
+	// PlacerContactLocation is ARQ-18: Placer Contact Location (PL, optional, max length 80).
+	PlacerContactLocation string `hl7:"ARQ.18,maxlen=80"`
+
+	// EnteredByPerson is ARQ-19: Entered By Person (XCN, required, repeating, max length 250).
+	EnteredByPerson []string `hl7:"ARQ.19,required,maxlen=250"`
+
+	// EnteredByPhoneNumber is ARQ-20: Entered By Phone Number (XTN, optional, repeating, max length 250).
+	EnteredByPhoneNumber []string `hl7:"ARQ.20,maxlen=250"`
+
+	// EnteredByLocation is ARQ-21: Entered By Location (PL, optional, max length 80).
+	EnteredByLocation string `hl7:"ARQ.21,maxlen=80"`
+
+	// ParentPlacerAppointmentID is ARQ-22: Parent Placer Appointment ID (EI, optional, max length 75).
+	ParentPlacerAppointmentID string `hl7:"ARQ.22,maxlen=75"`
+
+	// ParentFillerAppointmentID is ARQ-23: Parent Filler Appointment ID (EI, optional, max length 75).
+	ParentFillerAppointmentID string `hl7:"ARQ.23,maxlen=75"`
+
+	// PlacerOrderNumber is ARQ-24: Placer Order Number (EI, conditional, repeating, max length 22).
+	PlacerOrderNumber []string `hl7:"ARQ.24,maxlen=22"`
+
+	// FillerOrderNumber is ARQ-25: Filler Order Number (EI, conditional, repeating, max length 22).
+	FillerOrderNumber []string `hl7:"ARQ.25,maxlen=22"`
+}
+
+// ErrNotARQSegment indicates the segment is not an ARQ segment.
+var ErrNotARQSegment = fmt.Errorf("segment is not ARQ")
+
+// ParseARQ extracts ARQ segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an ARQ segment.
+func ParseARQ(seg hl7.Segment) (*ARQ, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "ARQ" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotARQSegment, seg.Name())
+	}
+
+	arq := &ARQ{
+		PlacerAppointmentID:         getFieldValue(seg, 1),
+		FillerAppointmentID:         getFieldValue(seg, 2),
+		OccurrenceNumber:            getFieldValue(seg, 3),
+		PlacerGroupNumber:           getFieldValue(seg, 4),
+		ScheduleID:                  getFieldValue(seg, 5),
+		RequestEventReason:          getFieldValue(seg, 6),
+		AppointmentReason:           getFieldValue(seg, 7),
+		AppointmentType:             getFieldValue(seg, 8),
+		AppointmentDuration:         getFieldValue(seg, 9),
+		AppointmentDurationUnits:    getFieldValue(seg, 10),
+		RequestedStartDateTimeRange: getFieldRepetitions(seg, 11),
+		Priority:                    getFieldValue(seg, 12),
+		RepeatingInterval:           getFieldValue(seg, 13),
+		RepeatingIntervalDuration:   getFieldValue(seg, 14),
+		PlacerContactPerson:         getFieldRepetitions(seg, 15),
+		PlacerContactPhoneNumber:    getFieldRepetitions(seg, 16),
+		PlacerContactAddress:        getFieldRepetitions(seg, 17),
+		PlacerContactLocation:       getFieldValue(seg, 18),
+		EnteredByPerson:             getFieldRepetitions(seg, 19),
+		EnteredByPhoneNumber:        getFieldRepetitions(seg, 20),
+		EnteredByLocation:           getFieldValue(seg, 21),
+		ParentPlacerAppointmentID:   getFieldValue(seg, 22),
+		ParentFillerAppointmentID:   getFieldValue(seg, 23),
+		PlacerOrderNumber:           getFieldRepetitions(seg, 24),
+		FillerOrderNumber:           getFieldRepetitions(seg, 25),
+	}
+
+	return arq, nil
+}
+
+// ToSegment converts the ARQ struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (a *ARQ) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		a.PlacerAppointmentID,
+		a.FillerAppointmentID,
+		a.OccurrenceNumber,
+		a.PlacerGroupNumber,
+		a.ScheduleID,
+		a.RequestEventReason,
+		a.AppointmentReason,
+		a.AppointmentType,
+		a.AppointmentDuration,
+		a.AppointmentDurationUnits,
+		joinRepetitions(a.RequestedStartDateTimeRange, delims),
+		a.Priority,
+		a.RepeatingInterval,
+		a.RepeatingIntervalDuration,
+		joinRepetitions(a.PlacerContactPerson, delims),
+		joinRepetitions(a.PlacerContactPhoneNumber, delims),
+		joinRepetitions(a.PlacerContactAddress, delims),
+		a.PlacerContactLocation,
+		joinRepetitions(a.EnteredByPerson, delims),
+		joinRepetitions(a.EnteredByPhoneNumber, delims),
+		a.EnteredByLocation,
+		a.ParentPlacerAppointmentID,
+		a.ParentFillerAppointmentID,
+		joinRepetitions(a.PlacerOrderNumber, delims),
+		joinRepetitions(a.FillerOrderNumber, delims),
+	}
+
+	data := buildSegmentData("ARQ", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ARQ segment: %w", err)
+	}
+
+	return seg, nil
+}
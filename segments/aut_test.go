@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseAUT(t *testing.T) {
+	input := "AUT|AUT-1|AUT-2|AUT-3|AUT-4|AUT-5|AUT-6|AUT-7|AUT-8|AUT-9|AUT-10"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseAUT(seg)
+	if err != nil {
+		t.Fatalf("ParseAUT() unexpected error: %v", err)
+	}
+
+	want := AUT{
+		AuthorizingPayorPlanID:       "AUT-1",
+		AuthorizingPayorCompanyID:    "AUT-2",
+		AuthorizingPayorCompanyName:  "AUT-3",
+		AuthorizationEffectiveDate:   "AUT-4",
+		AuthorizationExpirationDate:  "AUT-5",
+		AuthorizationIdentifier:      "AUT-6",
+		ReimbursementLimit:           "AUT-7",
+		RequestedNumberOfTreatments:  "AUT-8",
+		AuthorizedNumberOfTreatments: "AUT-9",
+		ProcessDate:                  "AUT-10",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseAUT() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseAUT_WrongSegment(t *testing.T) {
+	if _, err := ParseAUT(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseAUT(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseAUT(seg); !errors.Is(err, ErrNotAUTSegment) {
+		t.Errorf("ParseAUT() error = %v, want ErrNotAUTSegment", err)
+	}
+}
+
+func TestAUT_RoundTrip(t *testing.T) {
+	original := &AUT{
+		AuthorizingPayorPlanID:       "AUT-1",
+		AuthorizingPayorCompanyID:    "AUT-2",
+		AuthorizingPayorCompanyName:  "AUT-3",
+		AuthorizationEffectiveDate:   "AUT-4",
+		AuthorizationExpirationDate:  "AUT-5",
+		AuthorizationIdentifier:      "AUT-6",
+		ReimbursementLimit:           "AUT-7",
+		RequestedNumberOfTreatments:  "AUT-8",
+		AuthorizedNumberOfTreatments: "AUT-9",
+		ProcessDate:                  "AUT-10",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "AUT" {
+		t.Errorf("segment name = %q, want AUT", seg.Name())
+	}
+
+	parsed, err := ParseAUT(seg)
+	if err != nil {
+		t.Fatalf("ParseAUT() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseBHS(t *testing.T) {
+	input := "BHS|^~\\&|BHS-3|BHS-4|BHS-5|BHS-6|BHS-7|BHS-8|BHS-9|BHS-10|BHS-11|BHS-12"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseBHS(seg)
+	if err != nil {
+		t.Fatalf("ParseBHS() unexpected error: %v", err)
+	}
+
+	want := BHS{
+		BatchFieldSeparator:       "|",
+		BatchEncodingCharacters:   "^~\\&",
+		BatchSendingApplication:   "BHS-3",
+		BatchSendingFacility:      "BHS-4",
+		BatchReceivingApplication: "BHS-5",
+		BatchReceivingFacility:    "BHS-6",
+		BatchCreationDateTime:     "BHS-7",
+		BatchSecurity:             "BHS-8",
+		BatchNameIDType:           "BHS-9",
+		BatchComment:              "BHS-10",
+		BatchControlID:            "BHS-11",
+		ReferenceBatchControlID:   "BHS-12",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseBHS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseBHS_WrongSegment(t *testing.T) {
+	if _, err := ParseBHS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseBHS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseBHS(seg); !errors.Is(err, ErrNotBHSSegment) {
+		t.Errorf("ParseBHS() error = %v, want ErrNotBHSSegment", err)
+	}
+}
+
+func TestBHS_RoundTrip(t *testing.T) {
+	original := &BHS{
+		BatchFieldSeparator:       "|",
+		BatchEncodingCharacters:   "^~\\&",
+		BatchSendingApplication:   "BHS-3",
+		BatchSendingFacility:      "BHS-4",
+		BatchReceivingApplication: "BHS-5",
+		BatchReceivingFacility:    "BHS-6",
+		BatchCreationDateTime:     "BHS-7",
+		BatchSecurity:             "BHS-8",
+		BatchNameIDType:           "BHS-9",
+		BatchComment:              "BHS-10",
+		BatchControlID:            "BHS-11",
+		ReferenceBatchControlID:   "BHS-12",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "BHS" {
+		t.Errorf("segment name = %q, want BHS", seg.Name())
+	}
+
+	parsed, err := ParseBHS(seg)
+	if err != nil {
+		t.Fatalf("ParseBHS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
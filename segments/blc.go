@@ -0,0 +1,66 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// BLC represents the Blood Code segment.
+//
+// Field positions follow the HL7 standard where BLC-1 is the first field
+// after the segment name.
+type BLC struct {
+	// BloodProductCode is BLC-1: Blood Product Code (CE, optional, max length 250).
+	BloodProductCode string `hl7:"BLC.1,maxlen=250"`
+
+	// BloodAmount is BLC-2: Blood Amount (CQ, optional, max length 267).
+	BloodAmount string `hl7:"BLC.2,maxlen=267"`
+}
+
+// ErrNotBLCSegment indicates the segment is not a BLC segment.
+var ErrNotBLCSegment = fmt.Errorf("segment is not BLC")
+
+// ParseBLC extracts BLC segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a BLC segment.
+func ParseBLC(seg hl7.Segment) (*BLC, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "BLC" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotBLCSegment, seg.Name())
+	}
+
+	blc := &BLC{
+		BloodProductCode: getFieldValue(seg, 1),
+		BloodAmount:      getFieldValue(seg, 2),
+	}
+
+	return blc, nil
+}
+
+// ToSegment converts the BLC struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (b *BLC) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		b.BloodProductCode,
+		b.BloodAmount,
+	}
+
+	data := buildSegmentData("BLC", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BLC segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,70 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseBLC(t *testing.T) {
+	input := "BLC|BLC-1|BLC-2"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseBLC(seg)
+	if err != nil {
+		t.Fatalf("ParseBLC() unexpected error: %v", err)
+	}
+
+	want := BLC{
+		BloodProductCode: "BLC-1",
+		BloodAmount:      "BLC-2",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseBLC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseBLC_WrongSegment(t *testing.T) {
+	if _, err := ParseBLC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseBLC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseBLC(seg); !errors.Is(err, ErrNotBLCSegment) {
+		t.Errorf("ParseBLC() error = %v, want ErrNotBLCSegment", err)
+	}
+}
+
+func TestBLC_RoundTrip(t *testing.T) {
+	original := &BLC{
+		BloodProductCode: "BLC-1",
+		BloodAmount:      "BLC-2",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "BLC" {
+		t.Errorf("segment name = %q, want BLC", seg.Name())
+	}
+
+	parsed, err := ParseBLC(seg)
+	if err != nil {
+		t.Fatalf("ParseBLC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// BLG represents the Billing segment.
+//
+// Field positions follow the HL7 standard where BLG-1 is the first field
+// after the segment name.
+type BLG struct {
+	// WhenToCharge is BLG-1: When to Charge (CCD, optional, max length 40).
+	WhenToCharge string `hl7:"BLG.1,maxlen=40"`
+
+	// ChargeType is BLG-2: Charge Type (ID, optional, max length 50).
+	ChargeType string `hl7:"BLG.2,maxlen=50"`
+
+	// AccountID is BLG-3: Account ID (CX, optional, max length 100).
+	AccountID string `hl7:"BLG.3,maxlen=100"`
+
+	// ChargeTypeReason is BLG-4: Charge Type Reason (CWE, optional, max length 60).
+	ChargeTypeReason string `hl7:"BLG.4,maxlen=60"`
+}
+
+// ErrNotBLGSegment indicates the segment is not a BLG segment.
+var ErrNotBLGSegment = fmt.Errorf("segment is not BLG")
+
+// ParseBLG extracts BLG segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a BLG segment.
+func ParseBLG(seg hl7.Segment) (*BLG, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "BLG" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotBLGSegment, seg.Name())
+	}
+
+	blg := &BLG{
+		WhenToCharge:     getFieldValue(seg, 1),
+		ChargeType:       getFieldValue(seg, 2),
+		AccountID:        getFieldValue(seg, 3),
+		ChargeTypeReason: getFieldValue(seg, 4),
+	}
+
+	return blg, nil
+}
+
+// ToSegment converts the BLG struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (b *BLG) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		b.WhenToCharge,
+		b.ChargeType,
+		b.AccountID,
+		b.ChargeTypeReason,
+	}
+
+	data := buildSegmentData("BLG", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BLG segment: %w", err)
+	}
+
+	return seg, nil
+}
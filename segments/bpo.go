@@ -0,0 +1,126 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// BPO represents the Blood product order segment.
+//
+// Field positions follow the HL7 standard where BPO-1 is the first field
+// after the segment name.
+type BPO struct {
+	// SetID is BPO-1: Set ID - BPO (SI, required, max length 4).
+	SetID string `hl7:"BPO.1,required,maxlen=4"`
+
+	// BPUniversalServiceID is BPO-2: BP Universal Service ID (CWE, required, max length 250).
+	BPUniversalServiceID string `hl7:"BPO.2,required,maxlen=250"`
+
+	// BPProcessingRequirements is BPO-3: BP Processing Requirements (CWE, optional, repeating, max length 250).
+	BPProcessingRequirements []string `hl7:"BPO.3,maxlen=250"`
+
+	// BPQuantity is BPO-4: BP Quantity (NM, required, max length 5).
+	BPQuantity string `hl7:"BPO.4,required,maxlen=5"`
+
+	// BPAmount is BPO-5: BP Amount (NM, optional, max length 5).
+	BPAmount string `hl7:"BPO.5,maxlen=5"`
+
+	// BPUnits is BPO-6: BP Units (CE, optional, max length 250).
+	BPUnits string `hl7:"BPO.6,maxlen=250"`
+
+	// BPIntendedUseDateTime is BPO-7: BP Intended Use Date/Time (TS, optional, max length 26).
+	BPIntendedUseDateTime string `hl7:"BPO.7,maxlen=26"`
+
+	// BPIntendedDispenseFromLocation is BPO-8: BP Intended Dispense From Location (PL, optional, max length 80).
+	BPIntendedDispenseFromLocation string `hl7:"BPO.8,maxlen=80"`
+
+	// BPIntendedDispenseFromAddress is BPO-9: BP Intended Dispense From Address (XAD, optional, max length 250).
+	BPIntendedDispenseFromAddress string `hl7:"BPO.9,maxlen=250"`
+
+	// BPRequestedDispenseDateTime is BPO-10: BP Requested Dispense Date/Time (TS, optional, max length 26).
+	BPRequestedDispenseDateTime string `hl7:"BPO.10,maxlen=26"`
+
+	// BPRequestedDispenseToLocation is BPO-11: BP Requested Dispense To Location (PL, optional, max length 80).
+	BPRequestedDispenseToLocation string `hl7:"BPO.11,maxlen=80"`
+
+	// BPRequestedDispenseToAddress is BPO-12: BP Requested Dispense To Address (XAD, optional, max length 250).
+	BPRequestedDispenseToAddress string `hl7:"BPO.12,maxlen=250"`
+
+	// BPIndicationForUse is BPO-13: BP Indication for Use (CWE, optional, repeating, max length 250).
+	BPIndicationForUse []string `hl7:"BPO.13,maxlen=250"`
+
+	// BPInformedConsentIndicator is BPO-14: BP Informed Consent Indicator (ID, optional, max length 1).
+	BPInformedConsentIndicator string `hl7:"BPO.14,maxlen=1"`
+}
+
+// ErrNotBPOSegment indicates the segment is not a BPO segment.
+var ErrNotBPOSegment = fmt.Errorf("segment is not BPO")
+
+// ParseBPO extracts BPO segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a BPO segment.
+func ParseBPO(seg hl7.Segment) (*BPO, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "BPO" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotBPOSegment, seg.Name())
+	}
+
+	bpo := &BPO{
+		SetID:                          getFieldValue(seg, 1),
+		BPUniversalServiceID:           getFieldValue(seg, 2),
+		BPProcessingRequirements:       getFieldRepetitions(seg, 3),
+		BPQuantity:                     getFieldValue(seg, 4),
+		BPAmount:                       getFieldValue(seg, 5),
+		BPUnits:                        getFieldValue(seg, 6),
+		BPIntendedUseDateTime:          getFieldValue(seg, 7),
+		BPIntendedDispenseFromLocation: getFieldValue(seg, 8),
+		BPIntendedDispenseFromAddress:  getFieldValue(seg, 9),
+		BPRequestedDispenseDateTime:    getFieldValue(seg, 10),
+		BPRequestedDispenseToLocation:  getFieldValue(seg, 11),
+		BPRequestedDispenseToAddress:   getFieldValue(seg, 12),
+		BPIndicationForUse:             getFieldRepetitions(seg, 13),
+		BPInformedConsentIndicator:     getFieldValue(seg, 14),
+	}
+
+	return bpo, nil
+}
+
+// ToSegment converts the BPO struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (b *BPO) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		b.SetID,
+		b.BPUniversalServiceID,
+		joinRepetitions(b.BPProcessingRequirements, delims),
+		b.BPQuantity,
+		b.BPAmount,
+		b.BPUnits,
+		b.BPIntendedUseDateTime,
+		b.BPIntendedDispenseFromLocation,
+		b.BPIntendedDispenseFromAddress,
+		b.BPRequestedDispenseDateTime,
+		b.BPRequestedDispenseToLocation,
+		b.BPRequestedDispenseToAddress,
+		joinRepetitions(b.BPIndicationForUse, delims),
+		b.BPInformedConsentIndicator,
+	}
+
+	data := buildSegmentData("BPO", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BPO segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,94 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseBPO(t *testing.T) {
+	input := "BPO|BPO-1|BPO-2|BPO-3a~BPO-3b|BPO-4|BPO-5|BPO-6|BPO-7|BPO-8|BPO-9|BPO-10|BPO-11|BPO-12|BPO-13a~BPO-13b|BPO-14"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseBPO(seg)
+	if err != nil {
+		t.Fatalf("ParseBPO() unexpected error: %v", err)
+	}
+
+	want := BPO{
+		SetID:                          "BPO-1",
+		BPUniversalServiceID:           "BPO-2",
+		BPProcessingRequirements:       []string{"BPO-3a", "BPO-3b"},
+		BPQuantity:                     "BPO-4",
+		BPAmount:                       "BPO-5",
+		BPUnits:                        "BPO-6",
+		BPIntendedUseDateTime:          "BPO-7",
+		BPIntendedDispenseFromLocation: "BPO-8",
+		BPIntendedDispenseFromAddress:  "BPO-9",
+		BPRequestedDispenseDateTime:    "BPO-10",
+		BPRequestedDispenseToLocation:  "BPO-11",
+		BPRequestedDispenseToAddress:   "BPO-12",
+		BPIndicationForUse:             []string{"BPO-13a", "BPO-13b"},
+		BPInformedConsentIndicator:     "BPO-14",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseBPO() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseBPO_WrongSegment(t *testing.T) {
+	if _, err := ParseBPO(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseBPO(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseBPO(seg); !errors.Is(err, ErrNotBPOSegment) {
+		t.Errorf("ParseBPO() error = %v, want ErrNotBPOSegment", err)
+	}
+}
+
+func TestBPO_RoundTrip(t *testing.T) {
+	original := &BPO{
+		SetID:                          "BPO-1",
+		BPUniversalServiceID:           "BPO-2",
+		BPProcessingRequirements:       []string{"BPO-3a", "BPO-3b"},
+		BPQuantity:                     "BPO-4",
+		BPAmount:                       "BPO-5",
+		BPUnits:                        "BPO-6",
+		BPIntendedUseDateTime:          "BPO-7",
+		BPIntendedDispenseFromLocation: "BPO-8",
+		BPIntendedDispenseFromAddress:  "BPO-9",
+		BPRequestedDispenseDateTime:    "BPO-10",
+		BPRequestedDispenseToLocation:  "BPO-11",
+		BPRequestedDispenseToAddress:   "BPO-12",
+		BPIndicationForUse:             []string{"BPO-13a", "BPO-13b"},
+		BPInformedConsentIndicator:     "BPO-14",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "BPO" {
+		t.Errorf("segment name = %q, want BPO", seg.Name())
+	}
+
+	parsed, err := ParseBPO(seg)
+	if err != nil {
+		t.Fatalf("ParseBPO() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
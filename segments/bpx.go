@@ -0,0 +1,161 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// BPX represents the Blood product dispense status segment.
+//
+// Field positions follow the HL7 standard where BPX-1 is the first field
+// after the segment name.
+type BPX struct {
+	// SetID is BPX-1: Set ID - BPX (SI, required, max length 4).
+	SetID string `hl7:"BPX.1,required,maxlen=4"`
+
+	// BPDispenseStatus is BPX-2: BP Dispense Status (CWE, required, max length 250).
+	BPDispenseStatus string `hl7:"BPX.2,required,maxlen=250"`
+
+	// BPStatus is BPX-3: BP Status (ID, required, max length 1).
+	BPStatus string `hl7:"BPX.3,required,maxlen=1"`
+
+	// BPDateTimeOfStatus is BPX-4: BP Date/Time of Status (TS, required, max length 26).
+	BPDateTimeOfStatus string `hl7:"BPX.4,required,maxlen=26"`
+
+	// BCDonationID is BPX-5: BC Donation ID (EI, conditional, max length 22).
+	BCDonationID string `hl7:"BPX.5,maxlen=22"`
+
+	// BCComponent is BPX-6: BC Component (CNE, conditional, max length 250).
+	BCComponent string `hl7:"BPX.6,maxlen=250"`
+
+	// BCDonationTypeIntendedUse is BPX-7: BC Donation Type / Intended Use (CNE, optional, max length 250).
+	BCDonationTypeIntendedUse string `hl7:"BPX.7,maxlen=250"`
+
+	// CPCommercialProduct is BPX-8: CP Commercial Product (CWE, conditional, max length 250).
+	CPCommercialProduct string `hl7:"BPX.8,maxlen=250"`
+
+	// CPManufacturer is BPX-9: CP Manufacturer (XON, conditional, max length 250).
+	CPManufacturer string `hl7:"BPX.9,maxlen=250"`
+
+	// CPLotNumber is BPX-10: CP Lot Number (EI, conditional, max length 22).
+	CPLotNumber string `hl7:"BPX.10,maxlen=22"`
+
+	// BPBloodGroup is BPX-11: BP Blood Group (CNE, optional, max length 250).
+	BPBloodGroup string `hl7:"BPX.11,maxlen=250"`
+
+	// BCSpecialTesting is BPX-12: BC Special Testing (CNE, optional, repeating, max length 250).
+	BCSpecialTesting []string `hl7:"BPX.12,maxlen=250"`
+
+	// BPExpirationDateTime is BPX-13: BP Expiration Date/Time (TS, optional, max length 26).
+	BPExpirationDateTime string `hl7:"BPX.13,maxlen=26"`
+
+	// BPQuantity is BPX-14: BP Quantity (NM, required, max length 5).
+	BPQuantity string `hl7:"BPX.14,required,maxlen=5"`
+
+	// BPAmount is BPX-15: BP Amount (NM, optional, max length 5).
+	BPAmount string `hl7:"BPX.15,maxlen=5"`
+
+	// BPUnits is BPX-16: BP Units (CE, optional, max length 250).
+	BPUnits string `hl7:"BPX.16,maxlen=250"`
+
+	// BPUniqueID is BPX-17: BP Unique ID (EI, optional, max length 22).
+	BPUniqueID string `hl7:"BPX.17,maxlen=22"`
+
+	// BPActualDispensedToLocation is BPX-18: BP Actual Dispensed To Location (PL, optional, max length 80).
+	BPActualDispensedToLocation string `hl7:"BPX.18,maxlen=80"`
+
+	// BPActualDispensedToAddress is BPX-19: BP Actual Dispensed To Address (XAD, optional, max length 250).
+	BPActualDispensedToAddress string `hl7:"BPX.19,maxlen=250"`
+
+	// BPDispensedToReceiver is BPX-20: BP Dispensed to Receiver (XCN, optional, max length 250).
+	BPDispensedToReceiver string `hl7:"BPX.20,maxlen=250"`
+
+	// BPDispensingIndividual is BPX-21: BP Dispensing Individual (XCN, optional, max length 250).
+	BPDispensingIndividual string `hl7:"BPX.21,maxlen=250"`
+}
+
+// ErrNotBPXSegment indicates the segment is not a BPX segment.
+var ErrNotBPXSegment = fmt.Errorf("segment is not BPX")
+
+// ParseBPX extracts BPX segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a BPX segment.
+func ParseBPX(seg hl7.Segment) (*BPX, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "BPX" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotBPXSegment, seg.Name())
+	}
+
+	bpx := &BPX{
+		SetID:                       getFieldValue(seg, 1),
+		BPDispenseStatus:            getFieldValue(seg, 2),
+		BPStatus:                    getFieldValue(seg, 3),
+		BPDateTimeOfStatus:          getFieldValue(seg, 4),
+		BCDonationID:                getFieldValue(seg, 5),
+		BCComponent:                 getFieldValue(seg, 6),
+		BCDonationTypeIntendedUse:   getFieldValue(seg, 7),
+		CPCommercialProduct:         getFieldValue(seg, 8),
+		CPManufacturer:              getFieldValue(seg, 9),
+		CPLotNumber:                 getFieldValue(seg, 10),
+		BPBloodGroup:                getFieldValue(seg, 11),
+		BCSpecialTesting:            getFieldRepetitions(seg, 12),
+		BPExpirationDateTime:        getFieldValue(seg, 13),
+		BPQuantity:                  getFieldValue(seg, 14),
+		BPAmount:                    getFieldValue(seg, 15),
+		BPUnits:                     getFieldValue(seg, 16),
+		BPUniqueID:                  getFieldValue(seg, 17),
+		BPActualDispensedToLocation: getFieldValue(seg, 18),
+		BPActualDispensedToAddress:  getFieldValue(seg, 19),
+		BPDispensedToReceiver:       getFieldValue(seg, 20),
+		BPDispensingIndividual:      getFieldValue(seg, 21),
+	}
+
+	return bpx, nil
+}
+
+// ToSegment converts the BPX struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (b *BPX) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		b.SetID,
+		b.BPDispenseStatus,
+		b.BPStatus,
+		b.BPDateTimeOfStatus,
+		b.BCDonationID,
+		b.BCComponent,
+		b.BCDonationTypeIntendedUse,
+		b.CPCommercialProduct,
+		b.CPManufacturer,
+		b.CPLotNumber,
+		b.BPBloodGroup,
+		joinRepetitions(b.BCSpecialTesting, delims),
+		b.BPExpirationDateTime,
+		b.BPQuantity,
+		b.BPAmount,
+		b.BPUnits,
+		b.BPUniqueID,
+		b.BPActualDispensedToLocation,
+		b.BPActualDispensedToAddress,
+		b.BPDispensedToReceiver,
+		b.BPDispensingIndividual,
+	}
+
+	data := buildSegmentData("BPX", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BPX segment: %w", err)
+	}
+
+	return seg, nil
+}
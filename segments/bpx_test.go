@@ -0,0 +1,108 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseBPX(t *testing.T) {
+	input := "BPX|BPX-1|BPX-2|BPX-3|BPX-4|BPX-5|BPX-6|BPX-7|BPX-8|BPX-9|BPX-10|BPX-11|BPX-12a~BPX-12b|BPX-13|BPX-14|BPX-15|BPX-16|BPX-17|BPX-18|BPX-19|BPX-20|BPX-21"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseBPX(seg)
+	if err != nil {
+		t.Fatalf("ParseBPX() unexpected error: %v", err)
+	}
+
+	want := BPX{
+		SetID:                       "BPX-1",
+		BPDispenseStatus:            "BPX-2",
+		BPStatus:                    "BPX-3",
+		BPDateTimeOfStatus:          "BPX-4",
+		BCDonationID:                "BPX-5",
+		BCComponent:                 "BPX-6",
+		BCDonationTypeIntendedUse:   "BPX-7",
+		CPCommercialProduct:         "BPX-8",
+		CPManufacturer:              "BPX-9",
+		CPLotNumber:                 "BPX-10",
+		BPBloodGroup:                "BPX-11",
+		BCSpecialTesting:            []string{"BPX-12a", "BPX-12b"},
+		BPExpirationDateTime:        "BPX-13",
+		BPQuantity:                  "BPX-14",
+		BPAmount:                    "BPX-15",
+		BPUnits:                     "BPX-16",
+		BPUniqueID:                  "BPX-17",
+		BPActualDispensedToLocation: "BPX-18",
+		BPActualDispensedToAddress:  "BPX-19",
+		BPDispensedToReceiver:       "BPX-20",
+		BPDispensingIndividual:      "BPX-21",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseBPX() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseBPX_WrongSegment(t *testing.T) {
+	if _, err := ParseBPX(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseBPX(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseBPX(seg); !errors.Is(err, ErrNotBPXSegment) {
+		t.Errorf("ParseBPX() error = %v, want ErrNotBPXSegment", err)
+	}
+}
+
+func TestBPX_RoundTrip(t *testing.T) {
+	original := &BPX{
+		SetID:                       "BPX-1",
+		BPDispenseStatus:            "BPX-2",
+		BPStatus:                    "BPX-3",
+		BPDateTimeOfStatus:          "BPX-4",
+		BCDonationID:                "BPX-5",
+		BCComponent:                 "BPX-6",
+		BCDonationTypeIntendedUse:   "BPX-7",
+		CPCommercialProduct:         "BPX-8",
+		CPManufacturer:              "BPX-9",
+		CPLotNumber:                 "BPX-10",
+		BPBloodGroup:                "BPX-11",
+		BCSpecialTesting:            []string{"BPX-12a", "BPX-12b"},
+		BPExpirationDateTime:        "BPX-13",
+		BPQuantity:                  "BPX-14",
+		BPAmount:                    "BPX-15",
+		BPUnits:                     "BPX-16",
+		BPUniqueID:                  "BPX-17",
+		BPActualDispensedToLocation: "BPX-18",
+		BPActualDispensedToAddress:  "BPX-19",
+		BPDispensedToReceiver:       "BPX-20",
+		BPDispensingIndividual:      "BPX-21",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "BPX" {
+		t.Errorf("segment name = %q, want BPX", seg.Name())
+	}
+
+	parsed, err := ParseBPX(seg)
+	if err != nil {
+		t.Fatalf("ParseBPX() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// BTS represents the Batch Trailer segment.
+//
+// Field positions follow the HL7 standard where BTS-1 is the first field
+// after the segment name.
+type BTS struct {
+	// BatchMessageCount is BTS-1: Batch Message Count (ST, optional, max length 10).
+	BatchMessageCount string `hl7:"BTS.1,maxlen=10"`
+
+	// BatchComment is BTS-2: Batch Comment (ST, optional, max length 80).
+	BatchComment string `hl7:"BTS.2,maxlen=80"`
+
+	// BatchTotals is BTS-3: Batch Totals (NM, optional, repeating, max length 100).
+	BatchTotals []string `hl7:"BTS.3,maxlen=100"`
+}
+
+// ErrNotBTSSegment indicates the segment is not a BTS segment.
+var ErrNotBTSSegment = fmt.Errorf("segment is not BTS")
+
+// ParseBTS extracts BTS segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a BTS segment.
+func ParseBTS(seg hl7.Segment) (*BTS, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "BTS" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotBTSSegment, seg.Name())
+	}
+
+	bts := &BTS{
+		BatchMessageCount: getFieldValue(seg, 1),
+		BatchComment:      getFieldValue(seg, 2),
+		BatchTotals:       getFieldRepetitions(seg, 3),
+	}
+
+	return bts, nil
+}
+
+// ToSegment converts the BTS struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (b *BTS) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		b.BatchMessageCount,
+		b.BatchComment,
+		joinRepetitions(b.BatchTotals, delims),
+	}
+
+	data := buildSegmentData("BTS", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BTS segment: %w", err)
+	}
+
+	return seg, nil
+}
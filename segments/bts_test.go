@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseBTS(t *testing.T) {
+	input := "BTS|BTS-1|BTS-2|BTS-3a~BTS-3b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseBTS(seg)
+	if err != nil {
+		t.Fatalf("ParseBTS() unexpected error: %v", err)
+	}
+
+	want := BTS{
+		BatchMessageCount: "BTS-1",
+		BatchComment:      "BTS-2",
+		BatchTotals:       []string{"BTS-3a", "BTS-3b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseBTS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseBTS_WrongSegment(t *testing.T) {
+	if _, err := ParseBTS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseBTS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseBTS(seg); !errors.Is(err, ErrNotBTSSegment) {
+		t.Errorf("ParseBTS() error = %v, want ErrNotBTSSegment", err)
+	}
+}
+
+func TestBTS_RoundTrip(t *testing.T) {
+	original := &BTS{
+		BatchMessageCount: "BTS-1",
+		BatchComment:      "BTS-2",
+		BatchTotals:       []string{"BTS-3a", "BTS-3b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "BTS" {
+		t.Errorf("segment name = %q, want BTS", seg.Name())
+	}
+
+	parsed, err := ParseBTS(seg)
+	if err != nil {
+		t.Fatalf("ParseBTS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,151 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// BTX represents the Blood Product Transfusion/Disposition segment.
+//
+// Field positions follow the HL7 standard where BTX-1 is the first field
+// after the segment name.
+type BTX struct {
+	// SetID is BTX-1: Set ID - BTX (SI, required, max length 4).
+	SetID string `hl7:"BTX.1,required,maxlen=4"`
+
+	// BCDonationID is BTX-2: BC Donation ID (EI, conditional, max length 22).
+	BCDonationID string `hl7:"BTX.2,maxlen=22"`
+
+	// BCComponent is BTX-3: BC Component (CNE, conditional, max length 250).
+	BCComponent string `hl7:"BTX.3,maxlen=250"`
+
+	// BCBloodGroup is BTX-4: BC Blood Group (CNE, conditional, max length 250).
+	BCBloodGroup string `hl7:"BTX.4,maxlen=250"`
+
+	// CPCommercialProduct is BTX-5: CP Commercial Product (CWE, conditional, max length 250).
+	CPCommercialProduct string `hl7:"BTX.5,maxlen=250"`
+
+	// CPManufacturer is BTX-6: CP Manufacturer (XON, conditional, max length 250).
+	CPManufacturer string `hl7:"BTX.6,maxlen=250"`
+
+	// CPLotNumber is BTX-7: CP Lot Number (EI, conditional, max length 22).
+	CPLotNumber string `hl7:"BTX.7,maxlen=22"`
+
+	// BPQuantity is BTX-8: BP Quantity (NM, required, max length 5).
+	BPQuantity string `hl7:"BTX.8,required,maxlen=5"`
+
+	// BPAmount is BTX-9: BP Amount (NM, optional, max length 5).
+	BPAmount string `hl7:"BTX.9,maxlen=5"`
+
+	// BPUnits is BTX-10: BP Units (CE, optional, max length 250).
+	BPUnits string `hl7:"BTX.10,maxlen=250"`
+
+	// BPTransfusionDispositionStatus is BTX-11: BP Transfusion/Disposition Status (CWE, required, max length 250).
+	BPTransfusionDispositionStatus string `hl7:"BTX.11,required,maxlen=250"`
+
+	// BPMessageStatus is BTX-12: BP Message Status (ID, required, max length 1).
+	BPMessageStatus string `hl7:"BTX.12,required,maxlen=1"`
+
+	// BPDateTimeOfStatus is BTX-13: BP Date/Time of Status (TS, required, max length 26).
+	BPDateTimeOfStatus string `hl7:"BTX.13,required,maxlen=26"`
+
+	// BPAdministrator is BTX-14: BP Administrator (XCN, optional, max length 250).
+	BPAdministrator string `hl7:"BTX.14,maxlen=250"`
+
+	// BPVerifier is BTX-15: BP Verifier (XCN, optional, max length 250).
+	BPVerifier string `hl7:"BTX.15,maxlen=250"`
+
+	// BPTransfusionStartDateTimeOfStatus is BTX-16: BP Transfusion Start Date/Time of Status (TS, optional, max length 26).
+	BPTransfusionStartDateTimeOfStatus string `hl7:"BTX.16,maxlen=26"`
+
+	// BPTransfusionEndDateTimeOfStatus is BTX-17: BP Transfusion End Date/Time of Status (TS, optional, max length 26).
+	BPTransfusionEndDateTimeOfStatus string `hl7:"BTX.17,maxlen=26"`
+
+	// BPAdverseReactionType is BTX-18: BP Adverse Reaction Type (CWE, optional, repeating, max length 250).
+	BPAdverseReactionType []string `hl7:"BTX.18,maxlen=250"`
+
+	// BPTransfusionInterruptedReason is BTX-19: BP Transfusion Interrupted Reason (CWE, optional, max length 250).
+	BPTransfusionInterruptedReason string `hl7:"BTX.19,maxlen=250"`
+}
+
+// ErrNotBTXSegment indicates the segment is not a BTX segment.
+var ErrNotBTXSegment = fmt.Errorf("segment is not BTX")
+
+// ParseBTX extracts BTX segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a BTX segment.
+func ParseBTX(seg hl7.Segment) (*BTX, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "BTX" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotBTXSegment, seg.Name())
+	}
+
+	btx := &BTX{
+		SetID:                              getFieldValue(seg, 1),
+		BCDonationID:                       getFieldValue(seg, 2),
+		BCComponent:                        getFieldValue(seg, 3),
+		BCBloodGroup:                       getFieldValue(seg, 4),
+		CPCommercialProduct:                getFieldValue(seg, 5),
+		CPManufacturer:                     getFieldValue(seg, 6),
+		CPLotNumber:                        getFieldValue(seg, 7),
+		BPQuantity:                         getFieldValue(seg, 8),
+		BPAmount:                           getFieldValue(seg, 9),
+		BPUnits:                            getFieldValue(seg, 10),
+		BPTransfusionDispositionStatus:     getFieldValue(seg, 11),
+		BPMessageStatus:                    getFieldValue(seg, 12),
+		BPDateTimeOfStatus:                 getFieldValue(seg, 13),
+		BPAdministrator:                    getFieldValue(seg, 14),
+		BPVerifier:                         getFieldValue(seg, 15),
+		BPTransfusionStartDateTimeOfStatus: getFieldValue(seg, 16),
+		BPTransfusionEndDateTimeOfStatus:   getFieldValue(seg, 17),
+		BPAdverseReactionType:              getFieldRepetitions(seg, 18),
+		BPTransfusionInterruptedReason:     getFieldValue(seg, 19),
+	}
+
+	return btx, nil
+}
+
+// ToSegment converts the BTX struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (b *BTX) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		b.SetID,
+		b.BCDonationID,
+		b.BCComponent,
+		b.BCBloodGroup,
+		b.CPCommercialProduct,
+		b.CPManufacturer,
+		b.CPLotNumber,
+		b.BPQuantity,
+		b.BPAmount,
+		b.BPUnits,
+		b.BPTransfusionDispositionStatus,
+		b.BPMessageStatus,
+		b.BPDateTimeOfStatus,
+		b.BPAdministrator,
+		b.BPVerifier,
+		b.BPTransfusionStartDateTimeOfStatus,
+		b.BPTransfusionEndDateTimeOfStatus,
+		joinRepetitions(b.BPAdverseReactionType, delims),
+		b.BPTransfusionInterruptedReason,
+	}
+
+	data := buildSegmentData("BTX", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BTX segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,104 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseBTX(t *testing.T) {
+	input := "BTX|BTX-1|BTX-2|BTX-3|BTX-4|BTX-5|BTX-6|BTX-7|BTX-8|BTX-9|BTX-10|BTX-11|BTX-12|BTX-13|BTX-14|BTX-15|BTX-16|BTX-17|BTX-18a~BTX-18b|BTX-19"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseBTX(seg)
+	if err != nil {
+		t.Fatalf("ParseBTX() unexpected error: %v", err)
+	}
+
+	want := BTX{
+		SetID:                              "BTX-1",
+		BCDonationID:                       "BTX-2",
+		BCComponent:                        "BTX-3",
+		BCBloodGroup:                       "BTX-4",
+		CPCommercialProduct:                "BTX-5",
+		CPManufacturer:                     "BTX-6",
+		CPLotNumber:                        "BTX-7",
+		BPQuantity:                         "BTX-8",
+		BPAmount:                           "BTX-9",
+		BPUnits:                            "BTX-10",
+		BPTransfusionDispositionStatus:     "BTX-11",
+		BPMessageStatus:                    "BTX-12",
+		BPDateTimeOfStatus:                 "BTX-13",
+		BPAdministrator:                    "BTX-14",
+		BPVerifier:                         "BTX-15",
+		BPTransfusionStartDateTimeOfStatus: "BTX-16",
+		BPTransfusionEndDateTimeOfStatus:   "BTX-17",
+		BPAdverseReactionType:              []string{"BTX-18a", "BTX-18b"},
+		BPTransfusionInterruptedReason:     "BTX-19",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseBTX() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseBTX_WrongSegment(t *testing.T) {
+	if _, err := ParseBTX(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseBTX(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseBTX(seg); !errors.Is(err, ErrNotBTXSegment) {
+		t.Errorf("ParseBTX() error = %v, want ErrNotBTXSegment", err)
+	}
+}
+
+func TestBTX_RoundTrip(t *testing.T) {
+	original := &BTX{
+		SetID:                              "BTX-1",
+		BCDonationID:                       "BTX-2",
+		BCComponent:                        "BTX-3",
+		BCBloodGroup:                       "BTX-4",
+		CPCommercialProduct:                "BTX-5",
+		CPManufacturer:                     "BTX-6",
+		CPLotNumber:                        "BTX-7",
+		BPQuantity:                         "BTX-8",
+		BPAmount:                           "BTX-9",
+		BPUnits:                            "BTX-10",
+		BPTransfusionDispositionStatus:     "BTX-11",
+		BPMessageStatus:                    "BTX-12",
+		BPDateTimeOfStatus:                 "BTX-13",
+		BPAdministrator:                    "BTX-14",
+		BPVerifier:                         "BTX-15",
+		BPTransfusionStartDateTimeOfStatus: "BTX-16",
+		BPTransfusionEndDateTimeOfStatus:   "BTX-17",
+		BPAdverseReactionType:              []string{"BTX-18a", "BTX-18b"},
+		BPTransfusionInterruptedReason:     "BTX-19",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "BTX" {
+		t.Errorf("segment name = %q, want BTX", seg.Name())
+	}
+
+	parsed, err := ParseBTX(seg)
+	if err != nil {
+		t.Fatalf("ParseBTX() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCDM(t *testing.T) {
+	input := "CDM|CDM-1|CDM-2a~CDM-2b|CDM-3|CDM-4|CDM-5|CDM-6a~CDM-6b|CDM-7a~CDM-7b|CDM-8|CDM-9a~CDM-9b|CDM-10|CDM-11a~CDM-11b|CDM-12a~CDM-12b|CDM-13"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCDM(seg)
+	if err != nil {
+		t.Fatalf("ParseCDM() unexpected error: %v", err)
+	}
+
+	want := CDM{
+		PrimaryKeyValue:              "CDM-1",
+		ChargeCodeAlias:              []string{"CDM-2a", "CDM-2b"},
+		ChargeDescriptionShort:       "CDM-3",
+		ChargeDescriptionLong:        "CDM-4",
+		DescriptionOverrideIndicator: "CDM-5",
+		ExplodingCharges:             []string{"CDM-6a", "CDM-6b"},
+		ProcedureCode:                []string{"CDM-7a", "CDM-7b"},
+		ActiveInactiveFlag:           "CDM-8",
+		InventoryNumber:              []string{"CDM-9a", "CDM-9b"},
+		ResourceLoad:                 "CDM-10",
+		ContractNumber:               []string{"CDM-11a", "CDM-11b"},
+		ContractOrganization:         []string{"CDM-12a", "CDM-12b"},
+		RoomFeeIndicator:             "CDM-13",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCDM() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCDM_WrongSegment(t *testing.T) {
+	if _, err := ParseCDM(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCDM(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCDM(seg); !errors.Is(err, ErrNotCDMSegment) {
+		t.Errorf("ParseCDM() error = %v, want ErrNotCDMSegment", err)
+	}
+}
+
+func TestCDM_RoundTrip(t *testing.T) {
+	original := &CDM{
+		PrimaryKeyValue:              "CDM-1",
+		ChargeCodeAlias:              []string{"CDM-2a", "CDM-2b"},
+		ChargeDescriptionShort:       "CDM-3",
+		ChargeDescriptionLong:        "CDM-4",
+		DescriptionOverrideIndicator: "CDM-5",
+		ExplodingCharges:             []string{"CDM-6a", "CDM-6b"},
+		ProcedureCode:                []string{"CDM-7a", "CDM-7b"},
+		ActiveInactiveFlag:           "CDM-8",
+		InventoryNumber:              []string{"CDM-9a", "CDM-9b"},
+		ResourceLoad:                 "CDM-10",
+		ContractNumber:               []string{"CDM-11a", "CDM-11b"},
+		ContractOrganization:         []string{"CDM-12a", "CDM-12b"},
+		RoomFeeIndicator:             "CDM-13",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CDM" {
+		t.Errorf("segment name = %q, want CDM", seg.Name())
+	}
+
+	parsed, err := ParseCDM(seg)
+	if err != nil {
+		t.Fatalf("ParseCDM() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,211 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// CER represents the Certificate Detail segment.
+//
+// Field positions follow the HL7 standard where CER-1 is the first field
+// after the segment name.
+type CER struct {
+	// SetID is CER-1: Set ID - CER (SI, required, max length 4).
+	SetID string `hl7:"CER.1,required,maxlen=4"`
+
+	// SerialNumber is CER-2: Serial Number (ST, optional, max length 80).
+	SerialNumber string `hl7:"CER.2,maxlen=80"`
+
+	// Version is CER-3: Version (ST, optional, max length 80).
+	Version string `hl7:"CER.3,maxlen=80"`
+
+	// GrantingAuthority is CER-4: Granting Authority (XON, optional, max length 250).
+	GrantingAuthority string `hl7:"CER.4,maxlen=250"`
+
+	// IssuingAuthority is CER-5: Issuing Authority (XCN, optional, max length 250).
+	IssuingAuthority string `hl7:"CER.5,maxlen=250"`
+
+	// SignatureOfIssuingAuthority is CER-6: Signature of Issuing Authority (ED, optional, max length 65536).
+	SignatureOfIssuingAuthority string `hl7:"CER.6,maxlen=65536"`
+
+	// GrantingCountry is CER-7: Granting Country (ID, optional, max length 3).
+	GrantingCountry string `hl7:"CER.7,maxlen=3"`
+
+	// GrantingStateProvince is CER-8: Granting State/Province (CWE, optional, max length 250).
+	GrantingStateProvince string `hl7:"CER.8,maxlen=250"`
+
+	// GrantingCountyParish is CER-9: Granting County/Parish (CWE, optional, max length 250).
+	GrantingCountyParish string `hl7:"CER.9,maxlen=250"`
+
+	// CertificateType is CER-10: Certificate Type (CWE, optional, max length 250).
+	CertificateType string `hl7:"CER.10,maxlen=250"`
+
+	// CertificateDomain is CER-11: Certificate Domain (CWE, optional, max length 250).
+	CertificateDomain string `hl7:"CER.11,maxlen=250"`
+
+	// SubjectID is CER-12: Subject ID (ID, conditional, max length 250).
+	SubjectID string `hl7:"CER.12,maxlen=250"`
+
+	// SubjectName is CER-13: Subject Name (ST, required, max length 250).
+	SubjectName string `hl7:"CER.13,required,maxlen=250"`
+
+	// SubjectDirectoryAttributeExtension is CER-14: Subject Directory Attribute Extension (Health Professional Data) (CWE, optional, repeating, max length 250).
+	SubjectDirectoryAttributeExtension []string `hl7:"CER.14,maxlen=250"`
+
+	// SubjectPublicKeyInfo is CER-15: Subject Public Key Info (CWE, optional, max length 250).
+	SubjectPublicKeyInfo string `hl7:"CER.15,maxlen=250"`
+
+	// AuthorityKeyIdentifier is CER-16: Authority Key Identifier (CWE, optional, max length 250).
+	AuthorityKeyIdentifier string `hl7:"CER.16,maxlen=250"`
+
+	// BasicConstraint is CER-17: Basic Constraint (ID, optional, max length 250).
+	BasicConstraint string `hl7:"CER.17,maxlen=250"`
+
+	// CRLDistributionPoint is CER-18: CRL Distribution Point (CWE, optional, repeating, max length 250).
+	CRLDistributionPoint []string `hl7:"CER.18,maxlen=250"`
+
+	// JurisdictionCountry is CER-19: Jurisdiction Country (ID, optional, max length 3).
+	JurisdictionCountry string `hl7:"CER.19,maxlen=3"`
+
+	// JurisdictionStateProvince is CER-20: Jurisdiction State/Province (CWE, optional, max length 250).
+	JurisdictionStateProvince string `hl7:"CER.20,maxlen=250"`
+
+	// JurisdictionCountyParish is CER-21: Jurisdiction County/Parish (CWE, optional, max length 250).
+	JurisdictionCountyParish string `hl7:"CER.21,maxlen=250"`
+
+	// JurisdictionBreadth is CER-22: Jurisdiction Breadth (CWE, optional, repeating, max length 250).
+	JurisdictionBreadth []string `hl7:"CER.22,maxlen=250"`
+
+	// GrantingDate is CER-23: Granting Date (TS, optional, max length 26).
+	GrantingDate string `hl7:"CER.23,maxlen=26"`
+
+	// IssuingDate is CER-24: Issuing Date (TS, optional, max length 26).
+	IssuingDate string `hl7:"CER.24,maxlen=26"`
+
+	// ActivationDate is CER-25: Activation Date (TS, optional, max length 26).
+	ActivationDate string `hl7:"CER.25,maxlen=26"`
+
+	// InactivationDate is CER-26: Inactivation Date (TS, optional, max length 26).
+	InactivationDate string `hl7:"CER.26,maxlen=26"`
+
+	// ExpirationDate is CER-27: Expiration Date (TS, optional, max length 26).
+	ExpirationDate string `hl7:"CER.27,maxlen=26"`
+
+	// RenewalDate is CER-28: Renewal Date (TS, optional, max length 26).
+	RenewalDate string `hl7:"CER.28,maxlen=26"`
+
+	// RevocationDate is CER-29: Revocation Date (TS, optional, max length 26).
+	RevocationDate string `hl7:"CER.29,maxlen=26"`
+
+	// RevocationReasonCode is CER-30: Revocation Reason Code (CE, optional, max length 250).
+	RevocationReasonCode string `hl7:"CER.30,maxlen=250"`
+
+	// CertificateStatus is CER-31: Certificate Status (CWE, optional, max length 250).
+	CertificateStatus string `hl7:"CER.31,maxlen=250"`
+}
+
+// ErrNotCERSegment indicates the segment is not a CER segment.
+var ErrNotCERSegment = fmt.Errorf("segment is not CER")
+
+// ParseCER extracts CER segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a CER segment.
+func ParseCER(seg hl7.Segment) (*CER, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "CER" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotCERSegment, seg.Name())
+	}
+
+	cer := &CER{
+		SetID:                              getFieldValue(seg, 1),
+		SerialNumber:                       getFieldValue(seg, 2),
+		Version:                            getFieldValue(seg, 3),
+		GrantingAuthority:                  getFieldValue(seg, 4),
+		IssuingAuthority:                   getFieldValue(seg, 5),
+		SignatureOfIssuingAuthority:        getFieldValue(seg, 6),
+		GrantingCountry:                    getFieldValue(seg, 7),
+		GrantingStateProvince:              getFieldValue(seg, 8),
+		GrantingCountyParish:               getFieldValue(seg, 9),
+		CertificateType:                    getFieldValue(seg, 10),
+		CertificateDomain:                  getFieldValue(seg, 11),
+		SubjectID:                          getFieldValue(seg, 12),
+		SubjectName:                        getFieldValue(seg, 13),
+		SubjectDirectoryAttributeExtension: getFieldRepetitions(seg, 14),
+		SubjectPublicKeyInfo:               getFieldValue(seg, 15),
+		AuthorityKeyIdentifier:             getFieldValue(seg, 16),
+		BasicConstraint:                    getFieldValue(seg, 17),
+		CRLDistributionPoint:               getFieldRepetitions(seg, 18),
+		JurisdictionCountry:                getFieldValue(seg, 19),
+		JurisdictionStateProvince:          getFieldValue(seg, 20),
+		JurisdictionCountyParish:           getFieldValue(seg, 21),
+		JurisdictionBreadth:                getFieldRepetitions(seg, 22),
+		GrantingDate:                       getFieldValue(seg, 23),
+		IssuingDate:                        getFieldValue(seg, 24),
+		ActivationDate:                     getFieldValue(seg, 25),
+		InactivationDate:                   getFieldValue(seg, 26),
+		ExpirationDate:                     getFieldValue(seg, 27),
+		RenewalDate:                        getFieldValue(seg, 28),
+		RevocationDate:                     getFieldValue(seg, 29),
+		RevocationReasonCode:               getFieldValue(seg, 30),
+		CertificateStatus:                  getFieldValue(seg, 31),
+	}
+
+	return cer, nil
+}
+
+// ToSegment converts the CER struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (c *CER) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		c.SetID,
+		c.SerialNumber,
+		c.Version,
+		c.GrantingAuthority,
+		c.IssuingAuthority,
+		c.SignatureOfIssuingAuthority,
+		c.GrantingCountry,
+		c.GrantingStateProvince,
+		c.GrantingCountyParish,
+		c.CertificateType,
+		c.CertificateDomain,
+		c.SubjectID,
+		c.SubjectName,
+		joinRepetitions(c.SubjectDirectoryAttributeExtension, delims),
+		c.SubjectPublicKeyInfo,
+		c.AuthorityKeyIdentifier,
+		c.BasicConstraint,
+		joinRepetitions(c.CRLDistributionPoint, delims),
+		c.JurisdictionCountry,
+		c.JurisdictionStateProvince,
+		c.JurisdictionCountyParish,
+		joinRepetitions(c.JurisdictionBreadth, delims),
+		c.GrantingDate,
+		c.IssuingDate,
+		c.ActivationDate,
+		c.InactivationDate,
+		c.ExpirationDate,
+		c.RenewalDate,
+		c.RevocationDate,
+		c.RevocationReasonCode,
+		c.CertificateStatus,
+	}
+
+	data := buildSegmentData("CER", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CER segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,88 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCM0(t *testing.T) {
+	input := "CM0|CM0-1|CM0-2|CM0-3a~CM0-3b|CM0-4|CM0-5a~CM0-5b|CM0-6|CM0-7|CM0-8|CM0-9a~CM0-9b|CM0-10|CM0-11a~CM0-11b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCM0(seg)
+	if err != nil {
+		t.Fatalf("ParseCM0() unexpected error: %v", err)
+	}
+
+	want := CM0{
+		SetID:                   "CM0-1",
+		SponsorStudyID:          "CM0-2",
+		AlternateStudyID:        []string{"CM0-3a", "CM0-3b"},
+		TitleOfStudy:            "CM0-4",
+		ChairmanOfStudy:         []string{"CM0-5a", "CM0-5b"},
+		LastIRBApprovalDate:     "CM0-6",
+		TotalAccrualToDate:      "CM0-7",
+		LastAccrualDate:         "CM0-8",
+		ContactForStudy:         []string{"CM0-9a", "CM0-9b"},
+		ContactsTelephoneNumber: "CM0-10",
+		ContactsAddress:         []string{"CM0-11a", "CM0-11b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCM0() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCM0_WrongSegment(t *testing.T) {
+	if _, err := ParseCM0(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCM0(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCM0(seg); !errors.Is(err, ErrNotCM0Segment) {
+		t.Errorf("ParseCM0() error = %v, want ErrNotCM0Segment", err)
+	}
+}
+
+func TestCM0_RoundTrip(t *testing.T) {
+	original := &CM0{
+		SetID:                   "CM0-1",
+		SponsorStudyID:          "CM0-2",
+		AlternateStudyID:        []string{"CM0-3a", "CM0-3b"},
+		TitleOfStudy:            "CM0-4",
+		ChairmanOfStudy:         []string{"CM0-5a", "CM0-5b"},
+		LastIRBApprovalDate:     "CM0-6",
+		TotalAccrualToDate:      "CM0-7",
+		LastAccrualDate:         "CM0-8",
+		ContactForStudy:         []string{"CM0-9a", "CM0-9b"},
+		ContactsTelephoneNumber: "CM0-10",
+		ContactsAddress:         []string{"CM0-11a", "CM0-11b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CM0" {
+		t.Errorf("segment name = %q, want CM0", seg.Name())
+	}
+
+	parsed, err := ParseCM0(seg)
+	if err != nil {
+		t.Fatalf("ParseCM0() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
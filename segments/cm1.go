@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// CM1 represents the Clinical Study Phase Master segment.
+//
+// Field positions follow the HL7 standard where CM1-1 is the first field
+// after the segment name.
+type CM1 struct {
+	// SetID is CM1-1: Set ID - CM1 (SI, required, max length 4).
+	SetID string `hl7:"CM1.1,required,maxlen=4"`
+
+	// StudyPhaseIdentifier is CM1-2: Study Phase Identifier (CE, required, max length 250).
+	StudyPhaseIdentifier string `hl7:"CM1.2,required,maxlen=250"`
+
+	// DescriptionOfStudyPhase is CM1-3: Description of Study Phase (ST, required, max length 300).
+	DescriptionOfStudyPhase string `hl7:"CM1.3,required,maxlen=300"`
+}
+
+// ErrNotCM1Segment indicates the segment is not a CM1 segment.
+var ErrNotCM1Segment = fmt.Errorf("segment is not CM1")
+
+// ParseCM1 extracts CM1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a CM1 segment.
+func ParseCM1(seg hl7.Segment) (*CM1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "CM1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotCM1Segment, seg.Name())
+	}
+
+	cm1 := &CM1{
+		SetID:                   getFieldValue(seg, 1),
+		StudyPhaseIdentifier:    getFieldValue(seg, 2),
+		DescriptionOfStudyPhase: getFieldValue(seg, 3),
+	}
+
+	return cm1, nil
+}
+
+// ToSegment converts the CM1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (c *CM1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		c.SetID,
+		c.StudyPhaseIdentifier,
+		c.DescriptionOfStudyPhase,
+	}
+
+	data := buildSegmentData("CM1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CM1 segment: %w", err)
+	}
+
+	return seg, nil
+}
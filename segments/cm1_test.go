@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCM1(t *testing.T) {
+	input := "CM1|CM1-1|CM1-2|CM1-3"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCM1(seg)
+	if err != nil {
+		t.Fatalf("ParseCM1() unexpected error: %v", err)
+	}
+
+	want := CM1{
+		SetID:                   "CM1-1",
+		StudyPhaseIdentifier:    "CM1-2",
+		DescriptionOfStudyPhase: "CM1-3",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCM1() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCM1_WrongSegment(t *testing.T) {
+	if _, err := ParseCM1(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCM1(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCM1(seg); !errors.Is(err, ErrNotCM1Segment) {
+		t.Errorf("ParseCM1() error = %v, want ErrNotCM1Segment", err)
+	}
+}
+
+func TestCM1_RoundTrip(t *testing.T) {
+	original := &CM1{
+		SetID:                   "CM1-1",
+		StudyPhaseIdentifier:    "CM1-2",
+		DescriptionOfStudyPhase: "CM1-3",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CM1" {
+		t.Errorf("segment name = %q, want CM1", seg.Name())
+	}
+
+	parsed, err := ParseCM1(seg)
+	if err != nil {
+		t.Fatalf("ParseCM1() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// CM2 represents the Clinical Study Schedule Master segment.
+//
+// Field positions follow the HL7 standard where CM2-1 is the first field
+// after the segment name.
+type CM2 struct {
+	// SetID is CM2-1: Set ID - CM2 (SI, optional, max length 4).
+	SetID string `hl7:"CM2.1,maxlen=4"`
+
+	// ScheduledTimePoint is CM2-2: Scheduled Time Point (CE, required, max length 250).
+	ScheduledTimePoint string `hl7:"CM2.2,required,maxlen=250"`
+
+	// DescriptionOfTimePoint is CM2-3: Description of Time Point (ST, optional, max length 300).
+	DescriptionOfTimePoint string `hl7:"CM2.3,maxlen=300"`
+
+	// EventsScheduledThisTimePoint is CM2-4: Events Scheduled This Time Point (CE, required, repeating, max length 250).
+	EventsScheduledThisTimePoint []string `hl7:"CM2.4,required,maxlen=250"`
+}
+
+// ErrNotCM2Segment indicates the segment is not a CM2 segment.
+var ErrNotCM2Segment = fmt.Errorf("segment is not CM2")
+
+// ParseCM2 extracts CM2 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a CM2 segment.
+func ParseCM2(seg hl7.Segment) (*CM2, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "CM2" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotCM2Segment, seg.Name())
+	}
+
+	cm2 := &CM2{
+		SetID:                        getFieldValue(seg, 1),
+		ScheduledTimePoint:           getFieldValue(seg, 2),
+		DescriptionOfTimePoint:       getFieldValue(seg, 3),
+		EventsScheduledThisTimePoint: getFieldRepetitions(seg, 4),
+	}
+
+	return cm2, nil
+}
+
+// ToSegment converts the CM2 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (c *CM2) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		c.SetID,
+		c.ScheduledTimePoint,
+		c.DescriptionOfTimePoint,
+		joinRepetitions(c.EventsScheduledThisTimePoint, delims),
+	}
+
+	data := buildSegmentData("CM2", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CM2 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCM2(t *testing.T) {
+	input := "CM2|CM2-1|CM2-2|CM2-3|CM2-4a~CM2-4b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCM2(seg)
+	if err != nil {
+		t.Fatalf("ParseCM2() unexpected error: %v", err)
+	}
+
+	want := CM2{
+		SetID:                        "CM2-1",
+		ScheduledTimePoint:           "CM2-2",
+		DescriptionOfTimePoint:       "CM2-3",
+		EventsScheduledThisTimePoint: []string{"CM2-4a", "CM2-4b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCM2() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCM2_WrongSegment(t *testing.T) {
+	if _, err := ParseCM2(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCM2(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCM2(seg); !errors.Is(err, ErrNotCM2Segment) {
+		t.Errorf("ParseCM2() error = %v, want ErrNotCM2Segment", err)
+	}
+}
+
+func TestCM2_RoundTrip(t *testing.T) {
+	original := &CM2{
+		SetID:                        "CM2-1",
+		ScheduledTimePoint:           "CM2-2",
+		DescriptionOfTimePoint:       "CM2-3",
+		EventsScheduledThisTimePoint: []string{"CM2-4a", "CM2-4b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CM2" {
+		t.Errorf("segment name = %q, want CM2", seg.Name())
+	}
+
+	parsed, err := ParseCM2(seg)
+	if err != nil {
+		t.Fatalf("ParseCM2() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
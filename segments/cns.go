@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// CNS represents the Clear Notification segment.
+//
+// Field positions follow the HL7 standard where CNS-1 is the first field
+// after the segment name.
+type CNS struct {
+	// StartingNotificationReferenceNumber is CNS-1: Starting Notification Reference Number (NM, optional, max length 20).
+	StartingNotificationReferenceNumber string `hl7:"CNS.1,maxlen=20"`
+
+	// EndingNotificationReferenceNumber is CNS-2: Ending Notification Reference Number (NM, optional, max length 20).
+	EndingNotificationReferenceNumber string `hl7:"CNS.2,maxlen=20"`
+
+	// StartingNotificationDateTime is CNS-3: Starting Notification Date/Time (TS, optional, max length 26).
+	StartingNotificationDateTime string `hl7:"CNS.3,maxlen=26"`
+
+	// EndingNotificationDateTime is CNS-4: Ending Notification Date/Time (TS, optional, max length 26).
+	EndingNotificationDateTime string `hl7:"CNS.4,maxlen=26"`
+
+	// StartingNotificationCode is CNS-5: Starting Notification Code (CE, optional, max length 250).
+	StartingNotificationCode string `hl7:"CNS.5,maxlen=250"`
+
+	// EndingNotificationCode is CNS-6: Ending Notification Code (CE, optional, max length 250).
+	EndingNotificationCode string `hl7:"CNS.6,maxlen=250"`
+}
+
+// ErrNotCNSSegment indicates the segment is not a CNS segment.
+var ErrNotCNSSegment = fmt.Errorf("segment is not CNS")
+
+// ParseCNS extracts CNS segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a CNS segment.
+func ParseCNS(seg hl7.Segment) (*CNS, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "CNS" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotCNSSegment, seg.Name())
+	}
+
+	cns := &CNS{
+		StartingNotificationReferenceNumber: getFieldValue(seg, 1),
+		EndingNotificationReferenceNumber:   getFieldValue(seg, 2),
+		StartingNotificationDateTime:        getFieldValue(seg, 3),
+		EndingNotificationDateTime:          getFieldValue(seg, 4),
+		StartingNotificationCode:            getFieldValue(seg, 5),
+		EndingNotificationCode:              getFieldValue(seg, 6),
+	}
+
+	return cns, nil
+}
+
+// ToSegment converts the CNS struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (c *CNS) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		c.StartingNotificationReferenceNumber,
+		c.EndingNotificationReferenceNumber,
+		c.StartingNotificationDateTime,
+		c.EndingNotificationDateTime,
+		c.StartingNotificationCode,
+		c.EndingNotificationCode,
+	}
+
+	data := buildSegmentData("CNS", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CNS segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,78 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCNS(t *testing.T) {
+	input := "CNS|CNS-1|CNS-2|CNS-3|CNS-4|CNS-5|CNS-6"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCNS(seg)
+	if err != nil {
+		t.Fatalf("ParseCNS() unexpected error: %v", err)
+	}
+
+	want := CNS{
+		StartingNotificationReferenceNumber: "CNS-1",
+		EndingNotificationReferenceNumber:   "CNS-2",
+		StartingNotificationDateTime:        "CNS-3",
+		EndingNotificationDateTime:          "CNS-4",
+		StartingNotificationCode:            "CNS-5",
+		EndingNotificationCode:              "CNS-6",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCNS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCNS_WrongSegment(t *testing.T) {
+	if _, err := ParseCNS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCNS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCNS(seg); !errors.Is(err, ErrNotCNSSegment) {
+		t.Errorf("ParseCNS() error = %v, want ErrNotCNSSegment", err)
+	}
+}
+
+func TestCNS_RoundTrip(t *testing.T) {
+	original := &CNS{
+		StartingNotificationReferenceNumber: "CNS-1",
+		EndingNotificationReferenceNumber:   "CNS-2",
+		StartingNotificationDateTime:        "CNS-3",
+		EndingNotificationDateTime:          "CNS-4",
+		StartingNotificationCode:            "CNS-5",
+		EndingNotificationCode:              "CNS-6",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CNS" {
+		t.Errorf("segment name = %q, want CNS", seg.Name())
+	}
+
+	parsed, err := ParseCNS(seg)
+	if err != nil {
+		t.Fatalf("ParseCNS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
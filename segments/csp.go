@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// CSP represents the Clinical Study Phase segment.
+//
+// Field positions follow the HL7 standard where CSP-1 is the first field
+// after the segment name.
+type CSP struct {
+	// StudyPhaseIdentifier is CSP-1: Study Phase Identifier (CE, required, max length 250).
+	StudyPhaseIdentifier string `hl7:"CSP.1,required,maxlen=250"`
+
+	// DateTimeStudyPhaseBegan is CSP-2: Date/time Study Phase Began (TS, required, max length 26).
+	DateTimeStudyPhaseBegan string `hl7:"CSP.2,required,maxlen=26"`
+
+	// DateTimeStudyPhaseEnded is CSP-3: Date/time Study Phase Ended (TS, optional, max length 26).
+	DateTimeStudyPhaseEnded string `hl7:"CSP.3,maxlen=26"`
+
+	// StudyPhaseEvaluability is CSP-4: Study Phase Evaluability (CE, conditional, max length 250).
+	StudyPhaseEvaluability string `hl7:"CSP.4,maxlen=250"`
+}
+
+// ErrNotCSPSegment indicates the segment is not a CSP segment.
+var ErrNotCSPSegment = fmt.Errorf("segment is not CSP")
+
+// ParseCSP extracts CSP segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a CSP segment.
+func ParseCSP(seg hl7.Segment) (*CSP, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "CSP" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotCSPSegment, seg.Name())
+	}
+
+	csp := &CSP{
+		StudyPhaseIdentifier:    getFieldValue(seg, 1),
+		DateTimeStudyPhaseBegan: getFieldValue(seg, 2),
+		DateTimeStudyPhaseEnded: getFieldValue(seg, 3),
+		StudyPhaseEvaluability:  getFieldValue(seg, 4),
+	}
+
+	return csp, nil
+}
+
+// ToSegment converts the CSP struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (c *CSP) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		c.StudyPhaseIdentifier,
+		c.DateTimeStudyPhaseBegan,
+		c.DateTimeStudyPhaseEnded,
+		c.StudyPhaseEvaluability,
+	}
+
+	data := buildSegmentData("CSP", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CSP segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCSP(t *testing.T) {
+	input := "CSP|CSP-1|CSP-2|CSP-3|CSP-4"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCSP(seg)
+	if err != nil {
+		t.Fatalf("ParseCSP() unexpected error: %v", err)
+	}
+
+	want := CSP{
+		StudyPhaseIdentifier:    "CSP-1",
+		DateTimeStudyPhaseBegan: "CSP-2",
+		DateTimeStudyPhaseEnded: "CSP-3",
+		StudyPhaseEvaluability:  "CSP-4",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCSP() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCSP_WrongSegment(t *testing.T) {
+	if _, err := ParseCSP(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCSP(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCSP(seg); !errors.Is(err, ErrNotCSPSegment) {
+		t.Errorf("ParseCSP() error = %v, want ErrNotCSPSegment", err)
+	}
+}
+
+func TestCSP_RoundTrip(t *testing.T) {
+	original := &CSP{
+		StudyPhaseIdentifier:    "CSP-1",
+		DateTimeStudyPhaseBegan: "CSP-2",
+		DateTimeStudyPhaseEnded: "CSP-3",
+		StudyPhaseEvaluability:  "CSP-4",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CSP" {
+		t.Errorf("segment name = %q, want CSP", seg.Name())
+	}
+
+	parsed, err := ParseCSP(seg)
+	if err != nil {
+		t.Fatalf("ParseCSP() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
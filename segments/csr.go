@@ -0,0 +1,136 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// CSR represents the Clinical Study Registration segment.
+//
+// Field positions follow the HL7 standard where CSR-1 is the first field
+// after the segment name.
+type CSR struct {
+	// SponsorStudyID is CSR-1: Sponsor Study ID (EI, required, max length 60).
+	SponsorStudyID string `hl7:"CSR.1,required,maxlen=60"`
+
+	// AlternateStudyID is CSR-2: Alternate Study ID (EI, optional, max length 60).
+	AlternateStudyID string `hl7:"CSR.2,maxlen=60"`
+
+	// InstitutionRegisteringThePatient is CSR-3: Institution Registering the Patient (CE, optional, max length 250).
+	InstitutionRegisteringThePatient string `hl7:"CSR.3,maxlen=250"`
+
+	// SponsorPatientID is CSR-4: Sponsor Patient ID (CX, required, max length 30).
+	SponsorPatientID string `hl7:"CSR.4,required,maxlen=30"`
+
+	// AlternatePatientID is CSR-5: Alternate Patient ID - CSR (CX, optional, max length 30).
+	AlternatePatientID string `hl7:"CSR.5,maxlen=30"`
+
+	// DateTimeOfPatientStudyRegistration is CSR-6: Date/Time Of Patient Study Registration (TS, required, max length 26).
+	DateTimeOfPatientStudyRegistration string `hl7:"CSR.6,required,maxlen=26"`
+
+	// PersonPerformingStudyRegistration is CSR-7: Person Performing Study Registration (XCN, optional, repeating, max length 250).
+	PersonPerformingStudyRegistration []string `hl7:"CSR.7,maxlen=250"`
+
+	// StudyAuthorizingProvider is CSR-8: Study Authorizing Provider (XCN, required, repeating, max length 250).
+	StudyAuthorizingProvider []string `hl7:"CSR.8,required,maxlen=250"`
+
+	// DateTimePatientStudyConsentSigned is CSR-9: Date/time Patient Study Consent Signed (TS, conditional, max length 26).
+	DateTimePatientStudyConsentSigned string `hl7:"CSR.9,maxlen=26"`
+
+	// PatientStudyEligibilityStatus is CSR-10: Patient Study Eligibility Status (CE, conditional, max length 250).
+	PatientStudyEligibilityStatus string `hl7:"CSR.10,maxlen=250"`
+
+	// StudyRandomizationDateTime is CSR-11: Study Randomization Date/time (TS, optional, repeating, max length 26).
+	StudyRandomizationDateTime []string `hl7:"CSR.11,maxlen=26"`
+
+	// RandomizedStudyArm is CSR-12: Randomized Study Arm (CE, optional, repeating, max length 250).
+	RandomizedStudyArm []string `hl7:"CSR.12,maxlen=250"`
+
+	// StratumForStudyRandomization is CSR-13: Stratum for Study Randomization (CE, optional, repeating, max length 250).
+	StratumForStudyRandomization []string `hl7:"CSR.13,maxlen=250"`
+
+	// PatientEvaluabilityStatus is CSR-14: Patient Evaluability Status (CE, conditional, max length 250).
+	PatientEvaluabilityStatus string `hl7:"CSR.14,maxlen=250"`
+
+	// DateTimeEndedStudy is CSR-15: Date/time Ended Study (TS, conditional, max length 26).
+	DateTimeEndedStudy string `hl7:"CSR.15,maxlen=26"`
+
+	// ReasonEndedStudy is CSR-16: Reason Ended Study (CE, conditional, max length 250).
+	ReasonEndedStudy string `hl7:"CSR.16,maxlen=250"`
+}
+
+// ErrNotCSRSegment indicates the segment is not a CSR segment.
+var ErrNotCSRSegment = fmt.Errorf("segment is not CSR")
+
+// ParseCSR extracts CSR segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a CSR segment.
+func ParseCSR(seg hl7.Segment) (*CSR, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "CSR" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotCSRSegment, seg.Name())
+	}
+
+	csr := &CSR{
+		SponsorStudyID:                     getFieldValue(seg, 1),
+		AlternateStudyID:                   getFieldValue(seg, 2),
+		InstitutionRegisteringThePatient:   getFieldValue(seg, 3),
+		SponsorPatientID:                   getFieldValue(seg, 4),
+		AlternatePatientID:                 getFieldValue(seg, 5),
+		DateTimeOfPatientStudyRegistration: getFieldValue(seg, 6),
+		PersonPerformingStudyRegistration:  getFieldRepetitions(seg, 7),
+		StudyAuthorizingProvider:           getFieldRepetitions(seg, 8),
+		DateTimePatientStudyConsentSigned:  getFieldValue(seg, 9),
+		PatientStudyEligibilityStatus:      getFieldValue(seg, 10),
+		StudyRandomizationDateTime:         getFieldRepetitions(seg, 11),
+		RandomizedStudyArm:                 getFieldRepetitions(seg, 12),
+		StratumForStudyRandomization:       getFieldRepetitions(seg, 13),
+		PatientEvaluabilityStatus:          getFieldValue(seg, 14),
+		DateTimeEndedStudy:                 getFieldValue(seg, 15),
+		ReasonEndedStudy:                   getFieldValue(seg, 16),
+	}
+
+	return csr, nil
+}
+
+// ToSegment converts the CSR struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (c *CSR) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		c.SponsorStudyID,
+		c.AlternateStudyID,
+		c.InstitutionRegisteringThePatient,
+		c.SponsorPatientID,
+		c.AlternatePatientID,
+		c.DateTimeOfPatientStudyRegistration,
+		joinRepetitions(c.PersonPerformingStudyRegistration, delims),
+		joinRepetitions(c.StudyAuthorizingProvider, delims),
+		c.DateTimePatientStudyConsentSigned,
+		c.PatientStudyEligibilityStatus,
+		joinRepetitions(c.StudyRandomizationDateTime, delims),
+		joinRepetitions(c.RandomizedStudyArm, delims),
+		joinRepetitions(c.StratumForStudyRandomization, delims),
+		c.PatientEvaluabilityStatus,
+		c.DateTimeEndedStudy,
+		c.ReasonEndedStudy,
+	}
+
+	data := buildSegmentData("CSR", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CSR segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,98 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCSR(t *testing.T) {
+	input := "CSR|CSR-1|CSR-2|CSR-3|CSR-4|CSR-5|CSR-6|CSR-7a~CSR-7b|CSR-8a~CSR-8b|CSR-9|CSR-10|CSR-11a~CSR-11b|CSR-12a~CSR-12b|CSR-13a~CSR-13b|CSR-14|CSR-15|CSR-16"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCSR(seg)
+	if err != nil {
+		t.Fatalf("ParseCSR() unexpected error: %v", err)
+	}
+
+	want := CSR{
+		SponsorStudyID:                     "CSR-1",
+		AlternateStudyID:                   "CSR-2",
+		InstitutionRegisteringThePatient:   "CSR-3",
+		SponsorPatientID:                   "CSR-4",
+		AlternatePatientID:                 "CSR-5",
+		DateTimeOfPatientStudyRegistration: "CSR-6",
+		PersonPerformingStudyRegistration:  []string{"CSR-7a", "CSR-7b"},
+		StudyAuthorizingProvider:           []string{"CSR-8a", "CSR-8b"},
+		DateTimePatientStudyConsentSigned:  "CSR-9",
+		PatientStudyEligibilityStatus:      "CSR-10",
+		StudyRandomizationDateTime:         []string{"CSR-11a", "CSR-11b"},
+		RandomizedStudyArm:                 []string{"CSR-12a", "CSR-12b"},
+		StratumForStudyRandomization:       []string{"CSR-13a", "CSR-13b"},
+		PatientEvaluabilityStatus:          "CSR-14",
+		DateTimeEndedStudy:                 "CSR-15",
+		ReasonEndedStudy:                   "CSR-16",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCSR() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCSR_WrongSegment(t *testing.T) {
+	if _, err := ParseCSR(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCSR(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCSR(seg); !errors.Is(err, ErrNotCSRSegment) {
+		t.Errorf("ParseCSR() error = %v, want ErrNotCSRSegment", err)
+	}
+}
+
+func TestCSR_RoundTrip(t *testing.T) {
+	original := &CSR{
+		SponsorStudyID:                     "CSR-1",
+		AlternateStudyID:                   "CSR-2",
+		InstitutionRegisteringThePatient:   "CSR-3",
+		SponsorPatientID:                   "CSR-4",
+		AlternatePatientID:                 "CSR-5",
+		DateTimeOfPatientStudyRegistration: "CSR-6",
+		PersonPerformingStudyRegistration:  []string{"CSR-7a", "CSR-7b"},
+		StudyAuthorizingProvider:           []string{"CSR-8a", "CSR-8b"},
+		DateTimePatientStudyConsentSigned:  "CSR-9",
+		PatientStudyEligibilityStatus:      "CSR-10",
+		StudyRandomizationDateTime:         []string{"CSR-11a", "CSR-11b"},
+		RandomizedStudyArm:                 []string{"CSR-12a", "CSR-12b"},
+		StratumForStudyRandomization:       []string{"CSR-13a", "CSR-13b"},
+		PatientEvaluabilityStatus:          "CSR-14",
+		DateTimeEndedStudy:                 "CSR-15",
+		ReasonEndedStudy:                   "CSR-16",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CSR" {
+		t.Errorf("segment name = %q, want CSR", seg.Name())
+	}
+
+	parsed, err := ParseCSR(seg)
+	if err != nil {
+		t.Fatalf("ParseCSR() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// CSS represents the Clinical Study Data Schedule segment.
+//
+// Field positions follow the HL7 standard where CSS-1 is the first field
+// after the segment name.
+type CSS struct {
+	// StudyScheduledTimePoint is CSS-1: Study Scheduled Time Point (CE, required, max length 250).
+	StudyScheduledTimePoint string `hl7:"CSS.1,required,maxlen=250"`
+
+	// StudyScheduledPatientTimePoint is CSS-2: Study Scheduled Patient Time Point (TS, optional, max length 26).
+	StudyScheduledPatientTimePoint string `hl7:"CSS.2,maxlen=26"`
+
+	// StudyQualityControlCodes is CSS-3: Study Quality Control Codes (CE, optional, repeating, max length 250).
+	StudyQualityControlCodes []string `hl7:"CSS.3,maxlen=250"`
+}
+
+// ErrNotCSSSegment indicates the segment is not a CSS segment.
+var ErrNotCSSSegment = fmt.Errorf("segment is not CSS")
+
+// ParseCSS extracts CSS segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a CSS segment.
+func ParseCSS(seg hl7.Segment) (*CSS, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "CSS" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotCSSSegment, seg.Name())
+	}
+
+	css := &CSS{
+		StudyScheduledTimePoint:        getFieldValue(seg, 1),
+		StudyScheduledPatientTimePoint: getFieldValue(seg, 2),
+		StudyQualityControlCodes:       getFieldRepetitions(seg, 3),
+	}
+
+	return css, nil
+}
+
+// ToSegment converts the CSS struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (c *CSS) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		c.StudyScheduledTimePoint,
+		c.StudyScheduledPatientTimePoint,
+		joinRepetitions(c.StudyQualityControlCodes, delims),
+	}
+
+	data := buildSegmentData("CSS", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CSS segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCSS(t *testing.T) {
+	input := "CSS|CSS-1|CSS-2|CSS-3a~CSS-3b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCSS(seg)
+	if err != nil {
+		t.Fatalf("ParseCSS() unexpected error: %v", err)
+	}
+
+	want := CSS{
+		StudyScheduledTimePoint:        "CSS-1",
+		StudyScheduledPatientTimePoint: "CSS-2",
+		StudyQualityControlCodes:       []string{"CSS-3a", "CSS-3b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCSS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCSS_WrongSegment(t *testing.T) {
+	if _, err := ParseCSS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCSS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCSS(seg); !errors.Is(err, ErrNotCSSSegment) {
+		t.Errorf("ParseCSS() error = %v, want ErrNotCSSSegment", err)
+	}
+}
+
+func TestCSS_RoundTrip(t *testing.T) {
+	original := &CSS{
+		StudyScheduledTimePoint:        "CSS-1",
+		StudyScheduledPatientTimePoint: "CSS-2",
+		StudyQualityControlCodes:       []string{"CSS-3a", "CSS-3b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CSS" {
+		t.Errorf("segment name = %q, want CSS", seg.Name())
+	}
+
+	parsed, err := ParseCSS(seg)
+	if err != nil {
+		t.Fatalf("ParseCSS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseCTD(t *testing.T) {
+	input := "CTD|CTD-1a~CTD-1b|CTD-2a~CTD-2b|CTD-3a~CTD-3b|CTD-4|CTD-5a~CTD-5b|CTD-6|CTD-7a~CTD-7b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseCTD(seg)
+	if err != nil {
+		t.Fatalf("ParseCTD() unexpected error: %v", err)
+	}
+
+	want := CTD{
+		ContactRole:                     []string{"CTD-1a", "CTD-1b"},
+		ContactName:                     []string{"CTD-2a", "CTD-2b"},
+		ContactAddress:                  []string{"CTD-3a", "CTD-3b"},
+		ContactLocation:                 "CTD-4",
+		ContactCommunicationInformation: []string{"CTD-5a", "CTD-5b"},
+		PreferredMethodOfContact:        "CTD-6",
+		ContactIdentifiers:              []string{"CTD-7a", "CTD-7b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseCTD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseCTD_WrongSegment(t *testing.T) {
+	if _, err := ParseCTD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseCTD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseCTD(seg); !errors.Is(err, ErrNotCTDSegment) {
+		t.Errorf("ParseCTD() error = %v, want ErrNotCTDSegment", err)
+	}
+}
+
+func TestCTD_RoundTrip(t *testing.T) {
+	original := &CTD{
+		ContactRole:                     []string{"CTD-1a", "CTD-1b"},
+		ContactName:                     []string{"CTD-2a", "CTD-2b"},
+		ContactAddress:                  []string{"CTD-3a", "CTD-3b"},
+		ContactLocation:                 "CTD-4",
+		ContactCommunicationInformation: []string{"CTD-5a", "CTD-5b"},
+		PreferredMethodOfContact:        "CTD-6",
+		ContactIdentifiers:              []string{"CTD-7a", "CTD-7b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "CTD" {
+		t.Errorf("segment name = %q, want CTD", seg.Name())
+	}
+
+	parsed, err := ParseCTD(seg)
+	if err != nil {
+		t.Fatalf("ParseCTD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseDB1(t *testing.T) {
+	input := "DB1|DB1-1|DB1-2|DB1-3a~DB1-3b|DB1-4|DB1-5|DB1-6|DB1-7|DB1-8"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseDB1(seg)
+	if err != nil {
+		t.Fatalf("ParseDB1() unexpected error: %v", err)
+	}
+
+	want := DB1{
+		SetID:                      "DB1-1",
+		DisabledPersonCode:         "DB1-2",
+		DisabledPersonIdentifier:   []string{"DB1-3a", "DB1-3b"},
+		DisabledIndicator:          "DB1-4",
+		DisabilityStartDate:        "DB1-5",
+		DisabilityEndDate:          "DB1-6",
+		DisabilityReturnToWorkDate: "DB1-7",
+		DisabilityUnableToWorkDate: "DB1-8",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseDB1() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseDB1_WrongSegment(t *testing.T) {
+	if _, err := ParseDB1(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseDB1(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseDB1(seg); !errors.Is(err, ErrNotDB1Segment) {
+		t.Errorf("ParseDB1() error = %v, want ErrNotDB1Segment", err)
+	}
+}
+
+func TestDB1_RoundTrip(t *testing.T) {
+	original := &DB1{
+		SetID:                      "DB1-1",
+		DisabledPersonCode:         "DB1-2",
+		DisabledPersonIdentifier:   []string{"DB1-3a", "DB1-3b"},
+		DisabledIndicator:          "DB1-4",
+		DisabilityStartDate:        "DB1-5",
+		DisabilityEndDate:          "DB1-6",
+		DisabilityReturnToWorkDate: "DB1-7",
+		DisabilityUnableToWorkDate: "DB1-8",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "DB1" {
+		t.Errorf("segment name = %q, want DB1", seg.Name())
+	}
+
+	parsed, err := ParseDB1(seg)
+	if err != nil {
+		t.Fatalf("ParseDB1() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,161 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// DG1 represents the Diagnosis segment.
+//
+// Field positions follow the HL7 standard where DG1-1 is the first field
+// after the segment name.
+type DG1 struct {
+	// SetID is DG1-1: Set ID - DG1 (SI, required, max length 4).
+	SetID string `hl7:"DG1.1,required,maxlen=4"`
+
+	// DiagnosisCodingMethod is DG1-2: Diagnosis Coding Method (ID, backward compatibility, max length 2).
+	DiagnosisCodingMethod string `hl7:"DG1.2,maxlen=2"`
+
+	// DiagnosisCode is DG1-3: Diagnosis Code - DG1 (CE, optional, max length 250).
+	DiagnosisCode string `hl7:"DG1.3,maxlen=250"`
+
+	// DiagnosisDescription is DG1-4: Diagnosis Description (ST, backward compatibility, max length 40).
+	DiagnosisDescription string `hl7:"DG1.4,maxlen=40"`
+
+	// DiagnosisDateTime is DG1-5: Diagnosis Date/Time (TS, optional, max length 26).
+	DiagnosisDateTime string `hl7:"DG1.5,maxlen=26"`
+
+	// DiagnosisType is DG1-6: Diagnosis Type (IS, required, max length 2).
+	DiagnosisType string `hl7:"DG1.6,required,maxlen=2"`
+
+	// MajorDiagnosticCategory is DG1-7: Major Diagnostic Category (CE, backward compatibility, max length 250).
+	MajorDiagnosticCategory string `hl7:"DG1.7,maxlen=250"`
+
+	// DiagnosticRelatedGroup is DG1-8: Diagnostic Related Group (CE, backward compatibility, max length 250).
+	DiagnosticRelatedGroup string `hl7:"DG1.8,maxlen=250"`
+
+	// DRGApprovalIndicator is DG1-9: DRG Approval Indicator (ID, backward compatibility, max length 1).
+	DRGApprovalIndicator string `hl7:"DG1.9,maxlen=1"`
+
+	// DRGGrouperReviewCode is DG1-10: DRG Grouper Review Code (IS, backward compatibility, max length 2).
+	DRGGrouperReviewCode string `hl7:"DG1.10,maxlen=2"`
+
+	// OutlierType is DG1-11: Outlier Type (CE, backward compatibility, max length 250).
+	OutlierType string `hl7:"DG1.11,maxlen=250"`
+
+	// OutlierDays is DG1-12: Outlier Days (NM, backward compatibility, max length 3).
+	OutlierDays string `hl7:"DG1.12,maxlen=3"`
+
+	// OutlierCost is DG1-13: Outlier Cost (CP, backward compatibility, max length 12).
+	OutlierCost string `hl7:"DG1.13,maxlen=12"`
+
+	// GrouperVersionAndType is DG1-14: Grouper Version And Type (ST, backward compatibility, max length 4).
+	GrouperVersionAndType string `hl7:"DG1.14,maxlen=4"`
+
+	// DiagnosisPriority is DG1-15: Diagnosis Priority (ID, optional, max length 2).
+	DiagnosisPriority string `hl7:"DG1.15,maxlen=2"`
+
+	// DiagnosingClinician is DG1-16: Diagnosing Clinician (XCN, optional, repeating, max length 250).
+	DiagnosingClinician []string `hl7:"DG1.16,maxlen=250"`
+
+	// DiagnosisClassification is DG1-17: Diagnosis Classification (IS, optional, max length 3).
+	DiagnosisClassification string `hl7:"DG1.17,maxlen=3"`
+
+	// ConfidentialIndicator is DG1-18: Confidential Indicator (ID, optional, max length 1).
+	ConfidentialIndicator string `hl7:"DG1.18,maxlen=1"`
+
+	// AttestationDateTime is DG1-19: Attestation Date/Time (TS, optional, max length 26).
+	AttestationDateTime string `hl7:"DG1.19,maxlen=26"`
+
+	// DiagnosisIdentifier is DG1-20: Diagnosis Identifier (EI, conditional, max length 427).
+	DiagnosisIdentifier string `hl7:"DG1.20,maxlen=427"`
+
+	// DiagnosisActionCode is DG1-21: Diagnosis Action Code (ID, conditional, max length 1).
+	DiagnosisActionCode string `hl7:"DG1.21,maxlen=1"`
+}
+
+// ErrNotDG1Segment indicates the segment is not a DG1 segment.
+var ErrNotDG1Segment = fmt.Errorf("segment is not DG1")
+
+// ParseDG1 extracts DG1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a DG1 segment.
+func ParseDG1(seg hl7.Segment) (*DG1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "DG1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotDG1Segment, seg.Name())
+	}
+
+	dg1 := &DG1{
+		SetID:                   getFieldValue(seg, 1),
+		DiagnosisCodingMethod:   getFieldValue(seg, 2),
+		DiagnosisCode:           getFieldValue(seg, 3),
+		DiagnosisDescription:    getFieldValue(seg, 4),
+		DiagnosisDateTime:       getFieldValue(seg, 5),
+		DiagnosisType:           getFieldValue(seg, 6),
+		MajorDiagnosticCategory: getFieldValue(seg, 7),
+		DiagnosticRelatedGroup:  getFieldValue(seg, 8),
+		DRGApprovalIndicator:    getFieldValue(seg, 9),
+		DRGGrouperReviewCode:    getFieldValue(seg, 10),
+		OutlierType:             getFieldValue(seg, 11),
+		OutlierDays:             getFieldValue(seg, 12),
+		OutlierCost:             getFieldValue(seg, 13),
+		GrouperVersionAndType:   getFieldValue(seg, 14),
+		DiagnosisPriority:       getFieldValue(seg, 15),
+		DiagnosingClinician:     getFieldRepetitions(seg, 16),
+		DiagnosisClassification: getFieldValue(seg, 17),
+		ConfidentialIndicator:   getFieldValue(seg, 18),
+		AttestationDateTime:     getFieldValue(seg, 19),
+		DiagnosisIdentifier:     getFieldValue(seg, 20),
+		DiagnosisActionCode:     getFieldValue(seg, 21),
+	}
+
+	return dg1, nil
+}
+
+// ToSegment converts the DG1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (d *DG1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		d.SetID,
+		d.DiagnosisCodingMethod,
+		d.DiagnosisCode,
+		d.DiagnosisDescription,
+		d.DiagnosisDateTime,
+		d.DiagnosisType,
+		d.MajorDiagnosticCategory,
+		d.DiagnosticRelatedGroup,
+		d.DRGApprovalIndicator,
+		d.DRGGrouperReviewCode,
+		d.OutlierType,
+		d.OutlierDays,
+		d.OutlierCost,
+		d.GrouperVersionAndType,
+		d.DiagnosisPriority,
+		joinRepetitions(d.DiagnosingClinician, delims),
+		d.DiagnosisClassification,
+		d.ConfidentialIndicator,
+		d.AttestationDateTime,
+		d.DiagnosisIdentifier,
+		d.DiagnosisActionCode,
+	}
+
+	data := buildSegmentData("DG1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DG1 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -18,6 +18,27 @@
 //   - OBX (Observation Result) - obx.go
 //   - ORC (Common Order) - orc.go
 //
+// Every other v2.5.1 segment, from ABS to VTQ, is generated from
+// defs/v2.5.1.txt by internal/segmentgen and follows the same pattern. Each
+// generated file has a matching generated test.
+//
+// Generated fields carry a "required" tag option when the standard marks them
+// R and a "maxlen" option with the standard's maximum length, so
+// validate.RulesFromStruct can derive presence and length rules from them.
+//
+// BHS and FHS are encoded like MSH: BHS-1 and FHS-1 hold the field separator
+// and BHS-2 and FHS-2 the encoding characters.
+//
+// # Adding Segments
+//
+// Add a segment to defs/v2.5.1.txt (the format is described at the top of the
+// file) and run:
+//
+//	go generate ./segments
+//
+// Generated files must not be edited by hand. A test in internal/segmentgen
+// fails if they are out of date with the definitions.
+//
 // # Usage Example
 //
 // Parsing a segment:
@@ -77,6 +98,9 @@
 //
 // # Repetitions
 //
-// Fields that can repeat store only the first repetition in the typed struct.
-// For full repetition access, use the underlying hl7.Segment interface.
+// Generated segments store repeating fields as []string, one encoded value per
+// repetition, and ToSegment joins them with the repetition delimiter. The
+// hand-written segments store the whole encoded field, repetitions included,
+// in a string; use the underlying hl7.Segment interface to access them
+// individually.
 package segments
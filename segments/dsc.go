@@ -0,0 +1,66 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// DSC represents the Continuation Pointer segment.
+//
+// Field positions follow the HL7 standard where DSC-1 is the first field
+// after the segment name.
+type DSC struct {
+	// ContinuationPointer is DSC-1: Continuation Pointer (ST, optional, max length 180).
+	ContinuationPointer string `hl7:"DSC.1,maxlen=180"`
+
+	// ContinuationStyle is DSC-2: Continuation Style (ID, optional, max length 1).
+	ContinuationStyle string `hl7:"DSC.2,maxlen=1"`
+}
+
+// ErrNotDSCSegment indicates the segment is not a DSC segment.
+var ErrNotDSCSegment = fmt.Errorf("segment is not DSC")
+
+// ParseDSC extracts DSC segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a DSC segment.
+func ParseDSC(seg hl7.Segment) (*DSC, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "DSC" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotDSCSegment, seg.Name())
+	}
+
+	dsc := &DSC{
+		ContinuationPointer: getFieldValue(seg, 1),
+		ContinuationStyle:   getFieldValue(seg, 2),
+	}
+
+	return dsc, nil
+}
+
+// ToSegment converts the DSC struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (d *DSC) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		d.ContinuationPointer,
+		d.ContinuationStyle,
+	}
+
+	data := buildSegmentData("DSC", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DSC segment: %w", err)
+	}
+
+	return seg, nil
+}
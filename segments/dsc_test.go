@@ -0,0 +1,70 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseDSC(t *testing.T) {
+	input := "DSC|DSC-1|DSC-2"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseDSC(seg)
+	if err != nil {
+		t.Fatalf("ParseDSC() unexpected error: %v", err)
+	}
+
+	want := DSC{
+		ContinuationPointer: "DSC-1",
+		ContinuationStyle:   "DSC-2",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseDSC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseDSC_WrongSegment(t *testing.T) {
+	if _, err := ParseDSC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseDSC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseDSC(seg); !errors.Is(err, ErrNotDSCSegment) {
+		t.Errorf("ParseDSC() error = %v, want ErrNotDSCSegment", err)
+	}
+}
+
+func TestDSC_RoundTrip(t *testing.T) {
+	original := &DSC{
+		ContinuationPointer: "DSC-1",
+		ContinuationStyle:   "DSC-2",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "DSC" {
+		t.Errorf("segment name = %q, want DSC", seg.Name())
+	}
+
+	parsed, err := ParseDSC(seg)
+	if err != nil {
+		t.Fatalf("ParseDSC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
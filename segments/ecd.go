@@ -0,0 +1,81 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ECD represents the Equipment Command segment.
+//
+// Field positions follow the HL7 standard where ECD-1 is the first field
+// after the segment name.
+type ECD struct {
+	// ReferenceCommandNumber is ECD-1: Reference Command Number (NM, required, max length 20).
+	ReferenceCommandNumber string `hl7:"ECD.1,required,maxlen=20"`
+
+	// RemoteControlCommand is ECD-2: Remote Control Command (CE, required, max length 250).
+	RemoteControlCommand string `hl7:"ECD.2,required,maxlen=250"`
+
+	// ResponseRequired is ECD-3: Response Required (ID, optional, max length 80).
+	ResponseRequired string `hl7:"ECD.3,maxlen=80"`
+
+	// RequestedCompletionTime is ECD-4: Requested Completion Time (TQ, backward compatibility, max length 200).
+	RequestedCompletionTime string `hl7:"ECD.4,maxlen=200"`
+
+	// Parameters is ECD-5: Parameters (TX, optional, repeating, max length 65536).
+	Parameters []string `hl7:"ECD.5,maxlen=65536"`
+}
+
+// ErrNotECDSegment indicates the segment is not an ECD segment.
+var ErrNotECDSegment = fmt.Errorf("segment is not ECD")
+
+// ParseECD extracts ECD segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an ECD segment.
+func ParseECD(seg hl7.Segment) (*ECD, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "ECD" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotECDSegment, seg.Name())
+	}
+
+	ecd := &ECD{
+		ReferenceCommandNumber:  getFieldValue(seg, 1),
+		RemoteControlCommand:    getFieldValue(seg, 2),
+		ResponseRequired:        getFieldValue(seg, 3),
+		RequestedCompletionTime: getFieldValue(seg, 4),
+		Parameters:              getFieldRepetitions(seg, 5),
+	}
+
+	return ecd, nil
+}
+
+// ToSegment converts the ECD struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (e *ECD) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		e.ReferenceCommandNumber,
+		e.RemoteControlCommand,
+		e.ResponseRequired,
+		e.RequestedCompletionTime,
+		joinRepetitions(e.Parameters, delims),
+	}
+
+	data := buildSegmentData("ECD", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ECD segment: %w", err)
+	}
+
+	return seg, nil
+}
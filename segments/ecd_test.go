@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseECD(t *testing.T) {
+	input := "ECD|ECD-1|ECD-2|ECD-3|ECD-4|ECD-5a~ECD-5b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseECD(seg)
+	if err != nil {
+		t.Fatalf("ParseECD() unexpected error: %v", err)
+	}
+
+	want := ECD{
+		ReferenceCommandNumber:  "ECD-1",
+		RemoteControlCommand:    "ECD-2",
+		ResponseRequired:        "ECD-3",
+		RequestedCompletionTime: "ECD-4",
+		Parameters:              []string{"ECD-5a", "ECD-5b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseECD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseECD_WrongSegment(t *testing.T) {
+	if _, err := ParseECD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseECD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseECD(seg); !errors.Is(err, ErrNotECDSegment) {
+		t.Errorf("ParseECD() error = %v, want ErrNotECDSegment", err)
+	}
+}
+
+func TestECD_RoundTrip(t *testing.T) {
+	original := &ECD{
+		ReferenceCommandNumber:  "ECD-1",
+		RemoteControlCommand:    "ECD-2",
+		ResponseRequired:        "ECD-3",
+		RequestedCompletionTime: "ECD-4",
+		Parameters:              []string{"ECD-5a", "ECD-5b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ECD" {
+		t.Errorf("segment name = %q, want ECD", seg.Name())
+	}
+
+	parsed, err := ParseECD(seg)
+	if err != nil {
+		t.Fatalf("ParseECD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
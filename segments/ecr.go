@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ECR represents the Equipment Command Response segment.
+//
+// Field positions follow the HL7 standard where ECR-1 is the first field
+// after the segment name.
+type ECR struct {
+	// CommandResponse is ECR-1: Command Response (CE, required, max length 250).
+	CommandResponse string `hl7:"ECR.1,required,maxlen=250"`
+
+	// DateTimeCompleted is ECR-2: Date/Time Completed (TS, required, max length 26).
+	DateTimeCompleted string `hl7:"ECR.2,required,maxlen=26"`
+
+	// CommandResponseParameters is ECR-3: Command Response Parameters (TX, optional, repeating, max length 65536).
+	CommandResponseParameters []string `hl7:"ECR.3,maxlen=65536"`
+}
+
+// ErrNotECRSegment indicates the segment is not an ECR segment.
+var ErrNotECRSegment = fmt.Errorf("segment is not ECR")
+
+// ParseECR extracts ECR segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an ECR segment.
+func ParseECR(seg hl7.Segment) (*ECR, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "ECR" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotECRSegment, seg.Name())
+	}
+
+	ecr := &ECR{
+		CommandResponse:           getFieldValue(seg, 1),
+		DateTimeCompleted:         getFieldValue(seg, 2),
+		CommandResponseParameters: getFieldRepetitions(seg, 3),
+	}
+
+	return ecr, nil
+}
+
+// ToSegment converts the ECR struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (e *ECR) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		e.CommandResponse,
+		e.DateTimeCompleted,
+		joinRepetitions(e.CommandResponseParameters, delims),
+	}
+
+	data := buildSegmentData("ECR", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ECR segment: %w", err)
+	}
+
+	return seg, nil
+}
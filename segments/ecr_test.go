@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseECR(t *testing.T) {
+	input := "ECR|ECR-1|ECR-2|ECR-3a~ECR-3b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseECR(seg)
+	if err != nil {
+		t.Fatalf("ParseECR() unexpected error: %v", err)
+	}
+
+	want := ECR{
+		CommandResponse:           "ECR-1",
+		DateTimeCompleted:         "ECR-2",
+		CommandResponseParameters: []string{"ECR-3a", "ECR-3b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseECR() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseECR_WrongSegment(t *testing.T) {
+	if _, err := ParseECR(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseECR(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseECR(seg); !errors.Is(err, ErrNotECRSegment) {
+		t.Errorf("ParseECR() error = %v, want ErrNotECRSegment", err)
+	}
+}
+
+func TestECR_RoundTrip(t *testing.T) {
+	original := &ECR{
+		CommandResponse:           "ECR-1",
+		DateTimeCompleted:         "ECR-2",
+		CommandResponseParameters: []string{"ECR-3a", "ECR-3b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ECR" {
+		t.Errorf("segment name = %q, want ECR", seg.Name())
+	}
+
+	parsed, err := ParseECR(seg)
+	if err != nil {
+		t.Fatalf("ParseECR() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseEDU(t *testing.T) {
+	input := "EDU|EDU-1|EDU-2|EDU-3|EDU-4|EDU-5|EDU-6|EDU-7|EDU-8|EDU-9a~EDU-9b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseEDU(seg)
+	if err != nil {
+		t.Fatalf("ParseEDU() unexpected error: %v", err)
+	}
+
+	want := EDU{
+		SetID:                          "EDU-1",
+		AcademicDegree:                 "EDU-2",
+		AcademicDegreeProgramDateRange: "EDU-3",
+		AcademicDegreeProgramParticipationDateRange: "EDU-4",
+		AcademicDegreeGrantedDate:                   "EDU-5",
+		School:                                      "EDU-6",
+		SchoolTypeCode:                              "EDU-7",
+		SchoolAddress:                               "EDU-8",
+		MajorFieldOfStudy:                           []string{"EDU-9a", "EDU-9b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseEDU() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseEDU_WrongSegment(t *testing.T) {
+	if _, err := ParseEDU(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseEDU(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseEDU(seg); !errors.Is(err, ErrNotEDUSegment) {
+		t.Errorf("ParseEDU() error = %v, want ErrNotEDUSegment", err)
+	}
+}
+
+func TestEDU_RoundTrip(t *testing.T) {
+	original := &EDU{
+		SetID:                          "EDU-1",
+		AcademicDegree:                 "EDU-2",
+		AcademicDegreeProgramDateRange: "EDU-3",
+		AcademicDegreeProgramParticipationDateRange: "EDU-4",
+		AcademicDegreeGrantedDate:                   "EDU-5",
+		School:                                      "EDU-6",
+		SchoolTypeCode:                              "EDU-7",
+		SchoolAddress:                               "EDU-8",
+		MajorFieldOfStudy:                           []string{"EDU-9a", "EDU-9b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "EDU" {
+		t.Errorf("segment name = %q, want EDU", seg.Name())
+	}
+
+	parsed, err := ParseEDU(seg)
+	if err != nil {
+		t.Fatalf("ParseEDU() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
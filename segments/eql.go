@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// EQL represents the Embedded Query Language segment.
+//
+// Field positions follow the HL7 standard where EQL-1 is the first field
+// after the segment name.
+type EQL struct {
+	// QueryTag is EQL-1: Query Tag (ST, optional, max length 32).
+	QueryTag string `hl7:"EQL.1,maxlen=32"`
+
+	// QueryResponseFormatCode is EQL-2: Query/Response Format Code (ID, required, max length 1).
+	QueryResponseFormatCode string `hl7:"EQL.2,required,maxlen=1"`
+
+	// EQLQueryName is EQL-3: EQL Query Name (CE, required, max length 250).
+	EQLQueryName string `hl7:"EQL.3,required,maxlen=250"`
+
+	// EQLQueryStatement is EQL-4: EQL Query Statement (ST, required, max length 4096).
+	EQLQueryStatement string `hl7:"EQL.4,required,maxlen=4096"`
+}
+
+// ErrNotEQLSegment indicates the segment is not an EQL segment.
+var ErrNotEQLSegment = fmt.Errorf("segment is not EQL")
+
+// ParseEQL extracts EQL segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an EQL segment.
+func ParseEQL(seg hl7.Segment) (*EQL, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "EQL" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotEQLSegment, seg.Name())
+	}
+
+	eql := &EQL{
+		QueryTag:                getFieldValue(seg, 1),
+		QueryResponseFormatCode: getFieldValue(seg, 2),
+		EQLQueryName:            getFieldValue(seg, 3),
+		EQLQueryStatement:       getFieldValue(seg, 4),
+	}
+
+	return eql, nil
+}
+
+// ToSegment converts the EQL struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (e *EQL) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		e.QueryTag,
+		e.QueryResponseFormatCode,
+		e.EQLQueryName,
+		e.EQLQueryStatement,
+	}
+
+	data := buildSegmentData("EQL", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EQL segment: %w", err)
+	}
+
+	return seg, nil
+}
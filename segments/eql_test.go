@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseEQL(t *testing.T) {
+	input := "EQL|EQL-1|EQL-2|EQL-3|EQL-4"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseEQL(seg)
+	if err != nil {
+		t.Fatalf("ParseEQL() unexpected error: %v", err)
+	}
+
+	want := EQL{
+		QueryTag:                "EQL-1",
+		QueryResponseFormatCode: "EQL-2",
+		EQLQueryName:            "EQL-3",
+		EQLQueryStatement:       "EQL-4",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseEQL() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseEQL_WrongSegment(t *testing.T) {
+	if _, err := ParseEQL(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseEQL(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseEQL(seg); !errors.Is(err, ErrNotEQLSegment) {
+		t.Errorf("ParseEQL() error = %v, want ErrNotEQLSegment", err)
+	}
+}
+
+func TestEQL_RoundTrip(t *testing.T) {
+	original := &EQL{
+		QueryTag:                "EQL-1",
+		QueryResponseFormatCode: "EQL-2",
+		EQLQueryName:            "EQL-3",
+		EQLQueryStatement:       "EQL-4",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "EQL" {
+		t.Errorf("segment name = %q, want EQL", seg.Name())
+	}
+
+	parsed, err := ParseEQL(seg)
+	if err != nil {
+		t.Fatalf("ParseEQL() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
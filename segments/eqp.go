@@ -0,0 +1,81 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// EQP represents the Equipment/log Service segment.
+//
+// Field positions follow the HL7 standard where EQP-1 is the first field
+// after the segment name.
+type EQP struct {
+	// EventType is EQP-1: Event type (CE, required, max length 250).
+	EventType string `hl7:"EQP.1,required,maxlen=250"`
+
+	// FileName is EQP-2: File Name (ST, optional, max length 20).
+	FileName string `hl7:"EQP.2,maxlen=20"`
+
+	// StartDateTime is EQP-3: Start Date/Time (TS, required, max length 26).
+	StartDateTime string `hl7:"EQP.3,required,maxlen=26"`
+
+	// EndDateTime is EQP-4: End Date/Time (TS, optional, max length 26).
+	EndDateTime string `hl7:"EQP.4,maxlen=26"`
+
+	// TransactionData is EQP-5: Transaction Data (FT, required, max length 65536).
+	TransactionData string `hl7:"EQP.5,required,maxlen=65536"`
+}
+
+// ErrNotEQPSegment indicates the segment is not an EQP segment.
+var ErrNotEQPSegment = fmt.Errorf("segment is not EQP")
+
+// ParseEQP extracts EQP segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an EQP segment.
+func ParseEQP(seg hl7.Segment) (*EQP, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "EQP" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotEQPSegment, seg.Name())
+	}
+
+	eqp := &EQP{
+		EventType:       getFieldValue(seg, 1),
+		FileName:        getFieldValue(seg, 2),
+		StartDateTime:   getFieldValue(seg, 3),
+		EndDateTime:     getFieldValue(seg, 4),
+		TransactionData: getFieldValue(seg, 5),
+	}
+
+	return eqp, nil
+}
+
+// ToSegment converts the EQP struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (e *EQP) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		e.EventType,
+		e.FileName,
+		e.StartDateTime,
+		e.EndDateTime,
+		e.TransactionData,
+	}
+
+	data := buildSegmentData("EQP", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EQP segment: %w", err)
+	}
+
+	return seg, nil
+}
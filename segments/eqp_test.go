@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseEQP(t *testing.T) {
+	input := "EQP|EQP-1|EQP-2|EQP-3|EQP-4|EQP-5"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseEQP(seg)
+	if err != nil {
+		t.Fatalf("ParseEQP() unexpected error: %v", err)
+	}
+
+	want := EQP{
+		EventType:       "EQP-1",
+		FileName:        "EQP-2",
+		StartDateTime:   "EQP-3",
+		EndDateTime:     "EQP-4",
+		TransactionData: "EQP-5",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseEQP() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseEQP_WrongSegment(t *testing.T) {
+	if _, err := ParseEQP(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseEQP(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseEQP(seg); !errors.Is(err, ErrNotEQPSegment) {
+		t.Errorf("ParseEQP() error = %v, want ErrNotEQPSegment", err)
+	}
+}
+
+func TestEQP_RoundTrip(t *testing.T) {
+	original := &EQP{
+		EventType:       "EQP-1",
+		FileName:        "EQP-2",
+		StartDateTime:   "EQP-3",
+		EndDateTime:     "EQP-4",
+		TransactionData: "EQP-5",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "EQP" {
+		t.Errorf("segment name = %q, want EQP", seg.Name())
+	}
+
+	parsed, err := ParseEQP(seg)
+	if err != nil {
+		t.Fatalf("ParseEQP() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// EQU represents the Equipment Detail segment.
+//
+// Field positions follow the HL7 standard where EQU-1 is the first field
+// after the segment name.
+type EQU struct {
+	// EquipmentInstanceIdentifier is EQU-1: Equipment Instance Identifier (EI, required, max length 22).
+	EquipmentInstanceIdentifier string `hl7:"EQU.1,required,maxlen=22"`
+
+	// EventDateTime is EQU-2: Event Date/Time (TS, required, max length 26).
+	EventDateTime string `hl7:"EQU.2,required,maxlen=26"`
+
+	// EquipmentState is EQU-3: Equipment State (CE, conditional, max length 250).
+	EquipmentState string `hl7:"EQU.3,maxlen=250"`
+
+	// LocalRemoteControlState is EQU-4: Local/Remote Control State (CE, optional, max length 250).
+	LocalRemoteControlState string `hl7:"EQU.4,maxlen=250"`
+
+	// AlertLevel is EQU-5: Alert Level (CE, optional, max length 250).
+	AlertLevel string `hl7:"EQU.5,maxlen=250"`
+}
+
+// ErrNotEQUSegment indicates the segment is not an EQU segment.
+var ErrNotEQUSegment = fmt.Errorf("segment is not EQU")
+
+// ParseEQU extracts EQU segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an EQU segment.
+func ParseEQU(seg hl7.Segment) (*EQU, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "EQU" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotEQUSegment, seg.Name())
+	}
+
+	equ := &EQU{
+		EquipmentInstanceIdentifier: getFieldValue(seg, 1),
+		EventDateTime:               getFieldValue(seg, 2),
+		EquipmentState:              getFieldValue(seg, 3),
+		LocalRemoteControlState:     getFieldValue(seg, 4),
+		AlertLevel:                  getFieldValue(seg, 5),
+	}
+
+	return equ, nil
+}
+
+// ToSegment converts the EQU struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (e *EQU) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		e.EquipmentInstanceIdentifier,
+		e.EventDateTime,
+		e.EquipmentState,
+		e.LocalRemoteControlState,
+		e.AlertLevel,
+	}
+
+	data := buildSegmentData("EQU", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create EQU segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseEQU(t *testing.T) {
+	input := "EQU|EQU-1|EQU-2|EQU-3|EQU-4|EQU-5"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseEQU(seg)
+	if err != nil {
+		t.Fatalf("ParseEQU() unexpected error: %v", err)
+	}
+
+	want := EQU{
+		EquipmentInstanceIdentifier: "EQU-1",
+		EventDateTime:               "EQU-2",
+		EquipmentState:              "EQU-3",
+		LocalRemoteControlState:     "EQU-4",
+		AlertLevel:                  "EQU-5",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseEQU() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseEQU_WrongSegment(t *testing.T) {
+	if _, err := ParseEQU(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseEQU(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseEQU(seg); !errors.Is(err, ErrNotEQUSegment) {
+		t.Errorf("ParseEQU() error = %v, want ErrNotEQUSegment", err)
+	}
+}
+
+func TestEQU_RoundTrip(t *testing.T) {
+	original := &EQU{
+		EquipmentInstanceIdentifier: "EQU-1",
+		EventDateTime:               "EQU-2",
+		EquipmentState:              "EQU-3",
+		LocalRemoteControlState:     "EQU-4",
+		AlertLevel:                  "EQU-5",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "EQU" {
+		t.Errorf("segment name = %q, want EQU", seg.Name())
+	}
+
+	parsed, err := ParseEQU(seg)
+	if err != nil {
+		t.Fatalf("ParseEQU() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
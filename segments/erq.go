@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ERQ represents the Event replay query segment.
+//
+// Field positions follow the HL7 standard where ERQ-1 is the first field
+// after the segment name.
+type ERQ struct {
+	// QueryTag is ERQ-1: Query Tag (ST, optional, max length 32).
+	QueryTag string `hl7:"ERQ.1,maxlen=32"`
+
+	// EventIdentifier is ERQ-2: Event Identifier (CE, required, max length 250).
+	EventIdentifier string `hl7:"ERQ.2,required,maxlen=250"`
+
+	// InputParameterList is ERQ-3: Input Parameter List (QIP, optional, repeating, max length 256).
+	InputParameterList []string `hl7:"ERQ.3,maxlen=256"`
+}
+
+// ErrNotERQSegment indicates the segment is not an ERQ segment.
+var ErrNotERQSegment = fmt.Errorf("segment is not ERQ")
+
+// ParseERQ extracts ERQ segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an ERQ segment.
+func ParseERQ(seg hl7.Segment) (*ERQ, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "ERQ" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotERQSegment, seg.Name())
+	}
+
+	erq := &ERQ{
+		QueryTag:           getFieldValue(seg, 1),
+		EventIdentifier:    getFieldValue(seg, 2),
+		InputParameterList: getFieldRepetitions(seg, 3),
+	}
+
+	return erq, nil
+}
+
+// ToSegment converts the ERQ struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (e *ERQ) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		e.QueryTag,
+		e.EventIdentifier,
+		joinRepetitions(e.InputParameterList, delims),
+	}
+
+	data := buildSegmentData("ERQ", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ERQ segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseERQ(t *testing.T) {
+	input := "ERQ|ERQ-1|ERQ-2|ERQ-3a~ERQ-3b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseERQ(seg)
+	if err != nil {
+		t.Fatalf("ParseERQ() unexpected error: %v", err)
+	}
+
+	want := ERQ{
+		QueryTag:           "ERQ-1",
+		EventIdentifier:    "ERQ-2",
+		InputParameterList: []string{"ERQ-3a", "ERQ-3b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseERQ() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseERQ_WrongSegment(t *testing.T) {
+	if _, err := ParseERQ(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseERQ(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseERQ(seg); !errors.Is(err, ErrNotERQSegment) {
+		t.Errorf("ParseERQ() error = %v, want ErrNotERQSegment", err)
+	}
+}
+
+func TestERQ_RoundTrip(t *testing.T) {
+	original := &ERQ{
+		QueryTag:           "ERQ-1",
+		EventIdentifier:    "ERQ-2",
+		InputParameterList: []string{"ERQ-3a", "ERQ-3b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ERQ" {
+		t.Errorf("segment name = %q, want ERQ", seg.Name())
+	}
+
+	parsed, err := ParseERQ(seg)
+	if err != nil {
+		t.Fatalf("ParseERQ() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseFAC(t *testing.T) {
+	input := "FAC|FAC-1|FAC-2|FAC-3a~FAC-3b|FAC-4|FAC-5a~FAC-5b|FAC-6a~FAC-6b|FAC-7a~FAC-7b|FAC-8a~FAC-8b|FAC-9a~FAC-9b|FAC-10|FAC-11a~FAC-11b|FAC-12"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseFAC(seg)
+	if err != nil {
+		t.Fatalf("ParseFAC() unexpected error: %v", err)
+	}
+
+	want := FAC{
+		FacilityID:                          "FAC-1",
+		FacilityType:                        "FAC-2",
+		FacilityAddress:                     []string{"FAC-3a", "FAC-3b"},
+		FacilityTelecommunication:           "FAC-4",
+		ContactPerson:                       []string{"FAC-5a", "FAC-5b"},
+		ContactTitle:                        []string{"FAC-6a", "FAC-6b"},
+		ContactAddress:                      []string{"FAC-7a", "FAC-7b"},
+		ContactTelecommunication:            []string{"FAC-8a", "FAC-8b"},
+		SignatureAuthority:                  []string{"FAC-9a", "FAC-9b"},
+		SignatureAuthorityTitle:             "FAC-10",
+		SignatureAuthorityAddress:           []string{"FAC-11a", "FAC-11b"},
+		SignatureAuthorityTelecommunication: "FAC-12",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseFAC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseFAC_WrongSegment(t *testing.T) {
+	if _, err := ParseFAC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseFAC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseFAC(seg); !errors.Is(err, ErrNotFACSegment) {
+		t.Errorf("ParseFAC() error = %v, want ErrNotFACSegment", err)
+	}
+}
+
+func TestFAC_RoundTrip(t *testing.T) {
+	original := &FAC{
+		FacilityID:                          "FAC-1",
+		FacilityType:                        "FAC-2",
+		FacilityAddress:                     []string{"FAC-3a", "FAC-3b"},
+		FacilityTelecommunication:           "FAC-4",
+		ContactPerson:                       []string{"FAC-5a", "FAC-5b"},
+		ContactTitle:                        []string{"FAC-6a", "FAC-6b"},
+		ContactAddress:                      []string{"FAC-7a", "FAC-7b"},
+		ContactTelecommunication:            []string{"FAC-8a", "FAC-8b"},
+		SignatureAuthority:                  []string{"FAC-9a", "FAC-9b"},
+		SignatureAuthorityTitle:             "FAC-10",
+		SignatureAuthorityAddress:           []string{"FAC-11a", "FAC-11b"},
+		SignatureAuthorityTelecommunication: "FAC-12",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "FAC" {
+		t.Errorf("segment name = %q, want FAC", seg.Name())
+	}
+
+	parsed, err := ParseFAC(seg)
+	if err != nil {
+		t.Fatalf("ParseFAC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseFHS(t *testing.T) {
+	input := "FHS|^~\\&|FHS-3|FHS-4|FHS-5|FHS-6|FHS-7|FHS-8|FHS-9|FHS-10|FHS-11|FHS-12"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseFHS(seg)
+	if err != nil {
+		t.Fatalf("ParseFHS() unexpected error: %v", err)
+	}
+
+	want := FHS{
+		FileFieldSeparator:       "|",
+		FileEncodingCharacters:   "^~\\&",
+		FileSendingApplication:   "FHS-3",
+		FileSendingFacility:      "FHS-4",
+		FileReceivingApplication: "FHS-5",
+		FileReceivingFacility:    "FHS-6",
+		FileCreationDateTime:     "FHS-7",
+		FileSecurity:             "FHS-8",
+		FileNameID:               "FHS-9",
+		FileHeaderComment:        "FHS-10",
+		FileControlID:            "FHS-11",
+		ReferenceFileControlID:   "FHS-12",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseFHS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseFHS_WrongSegment(t *testing.T) {
+	if _, err := ParseFHS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseFHS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseFHS(seg); !errors.Is(err, ErrNotFHSSegment) {
+		t.Errorf("ParseFHS() error = %v, want ErrNotFHSSegment", err)
+	}
+}
+
+func TestFHS_RoundTrip(t *testing.T) {
+	original := &FHS{
+		FileFieldSeparator:       "|",
+		FileEncodingCharacters:   "^~\\&",
+		FileSendingApplication:   "FHS-3",
+		FileSendingFacility:      "FHS-4",
+		FileReceivingApplication: "FHS-5",
+		FileReceivingFacility:    "FHS-6",
+		FileCreationDateTime:     "FHS-7",
+		FileSecurity:             "FHS-8",
+		FileNameID:               "FHS-9",
+		FileHeaderComment:        "FHS-10",
+		FileControlID:            "FHS-11",
+		ReferenceFileControlID:   "FHS-12",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "FHS" {
+		t.Errorf("segment name = %q, want FHS", seg.Name())
+	}
+
+	parsed, err := ParseFHS(seg)
+	if err != nil {
+		t.Fatalf("ParseFHS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
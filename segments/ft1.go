@@ -0,0 +1,211 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// FT1 represents the Financial Transaction segment.
+//
+// Field positions follow the HL7 standard where FT1-1 is the first field
+// after the segment name.
+type FT1 struct {
+	// SetID is FT1-1: Set ID - FT1 (SI, optional, max length 4).
+	SetID string `hl7:"FT1.1,maxlen=4"`
+
+	// TransactionID is FT1-2: Transaction ID (ST, optional, max length 12).
+	TransactionID string `hl7:"FT1.2,maxlen=12"`
+
+	// TransactionBatchID is FT1-3: Transaction Batch ID (ST, optional, max length 10).
+	TransactionBatchID string `hl7:"FT1.3,maxlen=10"`
+
+	// TransactionDate is FT1-4: Transaction Date (DR, required, max length 53).
+	TransactionDate string `hl7:"FT1.4,required,maxlen=53"`
+
+	// TransactionPostingDate is FT1-5: Transaction Posting Date (TS, optional, max length 26).
+	TransactionPostingDate string `hl7:"FT1.5,maxlen=26"`
+
+	// TransactionType is FT1-6: Transaction Type (IS, required, max length 8).
+	TransactionType string `hl7:"FT1.6,required,maxlen=8"`
+
+	// TransactionCode is FT1-7: Transaction Code (CE, required, max length 250).
+	TransactionCode string `hl7:"FT1.7,required,maxlen=250"`
+
+	// TransactionDescription is FT1-8: Transaction Description (ST, backward compatibility, max length 40).
+	TransactionDescription string `hl7:"FT1.8,maxlen=40"`
+
+	// TransactionDescriptionAlt is FT1-9: Transaction Description - Alt (ST, backward compatibility, max length 40).
+	TransactionDescriptionAlt string `hl7:"FT1.9,maxlen=40"`
+
+	// TransactionQuantity is FT1-10: Transaction Quantity (NM, optional, max length 6).
+	TransactionQuantity string `hl7:"FT1.10,maxlen=6"`
+
+	// TransactionAmountExtended is FT1-11: Transaction Amount - Extended (CP, optional, max length 12).
+	TransactionAmountExtended string `hl7:"FT1.11,maxlen=12"`
+
+	// TransactionAmountUnit is FT1-12: Transaction Amount - Unit (CP, optional, max length 12).
+	TransactionAmountUnit string `hl7:"FT1.12,maxlen=12"`
+
+	// DepartmentCode is FT1-13: Department Code (CE, optional, max length 250).
+	DepartmentCode string `hl7:"FT1.13,maxlen=250"`
+
+	// InsurancePlanID is FT1-14: Insurance Plan ID (CE, optional, max length 250).
+	InsurancePlanID string `hl7:"FT1.14,maxlen=250"`
+
+	// InsuranceAmount is FT1-15: Insurance Amount (CP, optional, max length 12).
+	InsuranceAmount string `hl7:"FT1.15,maxlen=12"`
+
+	// AssignedPatientLocation is FT1-16: Assigned Patient Location (PL, optional, max length 80).
+	AssignedPatientLocation string `hl7:"FT1.16,maxlen=80"`
+
+	// FeeSchedule is FT1-17: Fee Schedule (IS, optional, max length 1).
+	FeeSchedule string `hl7:"FT1.17,maxlen=1"`
+
+	// PatientType is FT1-18: Patient Type (IS, optional, max length 2).
+	PatientType string `hl7:"FT1.18,maxlen=2"`
+
+	// DiagnosisCode is FT1-19: Diagnosis Code - FT1 (CE, optional, repeating, max length 250).
+	DiagnosisCode []string `hl7:"FT1.19,maxlen=250"`
+
+	// PerformedByCode is FT1-20: Performed By Code (XCN, optional, repeating, max length 250).
+	PerformedByCode []string `hl7:"FT1.20,maxlen=250"`
+
+	// OrderedByCode is FT1-21: Ordered By Code (XCN, optional, repeating, max length 250).
+	OrderedByCode []string `hl7:"FT1.21,maxlen=250"`
+
+	// UnitCost is FT1-22: Unit Cost (CP, optional, max length 12).
+	UnitCost string `hl7:"FT1.22,maxlen=12"`
+
+	// FillerOrderNumber is FT1-23: Filler Order Number (EI, optional, max length 427).
+	FillerOrderNumber string `hl7:"FT1.23,maxlen=427"`
+
+	// EnteredByCode is FT1-24: Entered By Code (XCN, optional, repeating, max length 250).
+	EnteredByCode []string `hl7:"FT1.24,maxlen=250"`
+
+	// ProcedureCode is FT1-25: Procedure Code (CE, optional, max length 250).
+	ProcedureCode string `hl7:"FT1.25,maxlen=250"`
+
+	// ProcedureCodeModifier is FT1-26: Procedure Code Modifier (CE, optional, repeating, max length 250).
+	ProcedureCodeModifier []string `hl7:"FT1.26,maxlen=250"`
+
+	// AdvancedBeneficiaryNoticeCode is FT1-27: Advanced Beneficiary Notice Code (CE, optional, max length 250).
+	AdvancedBeneficiaryNoticeCode string `hl7:"FT1.27,maxlen=250"`
+
+	// MedicallyNecessaryDuplicateProcedureReason is FT1-28: Medically Necessary Duplicate Procedure Reason (CWE, optional, max length 250).
+	MedicallyNecessaryDuplicateProcedureReason string `hl7:"FT1.28,maxlen=250"`
+
+	// NDCCode is FT1-29: NDC Code (CNE, optional, max length 250).
+	NDCCode string `hl7:"FT1.29,maxlen=250"`
+
+	// PaymentReferenceID is FT1-30: Payment Reference ID (CX, optional, max length 250).
+	PaymentReferenceID string `hl7:"FT1.30,maxlen=250"`
+
+	// TransactionReferenceKey is FT1-31: Transaction Reference Key (SI, optional, repeating, max length 4).
+	TransactionReferenceKey []string `hl7:"FT1.31,maxlen=4"`
+}
+
+// ErrNotFT1Segment indicates the segment is not an FT1 segment.
+var ErrNotFT1Segment = fmt.Errorf("segment is not FT1")
+
+// ParseFT1 extracts FT1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an FT1 segment.
+func ParseFT1(seg hl7.Segment) (*FT1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "FT1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotFT1Segment, seg.Name())
+	}
+
+	ft1 := &FT1{
+		SetID:                         getFieldValue(seg, 1),
+		TransactionID:                 getFieldValue(seg, 2),
+		TransactionBatchID:            getFieldValue(seg, 3),
+		TransactionDate:               getFieldValue(seg, 4),
+		TransactionPostingDate:        getFieldValue(seg, 5),
+		TransactionType:               getFieldValue(seg, 6),
+		TransactionCode:               getFieldValue(seg, 7),
+		TransactionDescription:        getFieldValue(seg, 8),
+		TransactionDescriptionAlt:     getFieldValue(seg, 9),
+		TransactionQuantity:           getFieldValue(seg, 10),
+		TransactionAmountExtended:     getFieldValue(seg, 11),
+		TransactionAmountUnit:         getFieldValue(seg, 12),
+		DepartmentCode:                getFieldValue(seg, 13),
+		InsurancePlanID:               getFieldValue(seg, 14),
+		InsuranceAmount:               getFieldValue(seg, 15),
+		AssignedPatientLocation:       getFieldValue(seg, 16),
+		FeeSchedule:                   getFieldValue(seg, 17),
+		PatientType:                   getFieldValue(seg, 18),
+		DiagnosisCode:                 getFieldRepetitions(seg, 19),
+		PerformedByCode:               getFieldRepetitions(seg, 20),
+		OrderedByCode:                 getFieldRepetitions(seg, 21),
+		UnitCost:                      getFieldValue(seg, 22),
+		FillerOrderNumber:             getFieldValue(seg, 23),
+		EnteredByCode:                 getFieldRepetitions(seg, 24),
+		ProcedureCode:                 getFieldValue(seg, 25),
+		ProcedureCodeModifier:         getFieldRepetitions(seg, 26),
+		AdvancedBeneficiaryNoticeCode: getFieldValue(seg, 27),
+		MedicallyNecessaryDuplicateProcedureReason: getFieldValue(seg, 28),
+		NDCCode:                 getFieldValue(seg, 29),
+		PaymentReferenceID:      getFieldValue(seg, 30),
+		TransactionReferenceKey: getFieldRepetitions(seg, 31),
+	}
+
+	return ft1, nil
+}
+
+// ToSegment converts the FT1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (f *FT1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		f.SetID,
+		f.TransactionID,
+		f.TransactionBatchID,
+		f.TransactionDate,
+		f.TransactionPostingDate,
+		f.TransactionType,
+		f.TransactionCode,
+		f.TransactionDescription,
+		f.TransactionDescriptionAlt,
+		f.TransactionQuantity,
+		f.TransactionAmountExtended,
+		f.TransactionAmountUnit,
+		f.DepartmentCode,
+		f.InsurancePlanID,
+		f.InsuranceAmount,
+		f.AssignedPatientLocation,
+		f.FeeSchedule,
+		f.PatientType,
+		joinRepetitions(f.DiagnosisCode, delims),
+		joinRepetitions(f.PerformedByCode, delims),
+		joinRepetitions(f.OrderedByCode, delims),
+		f.UnitCost,
+		f.FillerOrderNumber,
+		joinRepetitions(f.EnteredByCode, delims),
+		f.ProcedureCode,
+		joinRepetitions(f.ProcedureCodeModifier, delims),
+		f.AdvancedBeneficiaryNoticeCode,
+		f.MedicallyNecessaryDuplicateProcedureReason,
+		f.NDCCode,
+		f.PaymentReferenceID,
+		joinRepetitions(f.TransactionReferenceKey, delims),
+	}
+
+	data := buildSegmentData("FT1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create FT1 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,66 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// FTS represents the File Trailer segment.
+//
+// Field positions follow the HL7 standard where FTS-1 is the first field
+// after the segment name.
+type FTS struct {
+	// FileBatchCount is FTS-1: File Batch Count (NM, optional, max length 10).
+	FileBatchCount string `hl7:"FTS.1,maxlen=10"`
+
+	// FileTrailerComment is FTS-2: File Trailer Comment (ST, optional, max length 80).
+	FileTrailerComment string `hl7:"FTS.2,maxlen=80"`
+}
+
+// ErrNotFTSSegment indicates the segment is not an FTS segment.
+var ErrNotFTSSegment = fmt.Errorf("segment is not FTS")
+
+// ParseFTS extracts FTS segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an FTS segment.
+func ParseFTS(seg hl7.Segment) (*FTS, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "FTS" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotFTSSegment, seg.Name())
+	}
+
+	fts := &FTS{
+		FileBatchCount:     getFieldValue(seg, 1),
+		FileTrailerComment: getFieldValue(seg, 2),
+	}
+
+	return fts, nil
+}
+
+// ToSegment converts the FTS struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (f *FTS) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		f.FileBatchCount,
+		f.FileTrailerComment,
+	}
+
+	data := buildSegmentData("FTS", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create FTS segment: %w", err)
+	}
+
+	return seg, nil
+}
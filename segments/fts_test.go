@@ -0,0 +1,70 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseFTS(t *testing.T) {
+	input := "FTS|FTS-1|FTS-2"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseFTS(seg)
+	if err != nil {
+		t.Fatalf("ParseFTS() unexpected error: %v", err)
+	}
+
+	want := FTS{
+		FileBatchCount:     "FTS-1",
+		FileTrailerComment: "FTS-2",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseFTS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseFTS_WrongSegment(t *testing.T) {
+	if _, err := ParseFTS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseFTS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseFTS(seg); !errors.Is(err, ErrNotFTSSegment) {
+		t.Errorf("ParseFTS() error = %v, want ErrNotFTSSegment", err)
+	}
+}
+
+func TestFTS_RoundTrip(t *testing.T) {
+	original := &FTS{
+		FileBatchCount:     "FTS-1",
+		FileTrailerComment: "FTS-2",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "FTS" {
+		t.Errorf("segment name = %q, want FTS", seg.Name())
+	}
+
+	parsed, err := ParseFTS(seg)
+	if err != nil {
+		t.Fatalf("ParseFTS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
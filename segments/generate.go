@@ -0,0 +1,3 @@
+package segments
+
+//go:generate go run ../internal/segmentgen -defs defs/v2.5.1.txt -out .
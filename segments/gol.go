@@ -0,0 +1,161 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// GOL represents the Goal Detail segment.
+//
+// Field positions follow the HL7 standard where GOL-1 is the first field
+// after the segment name.
+type GOL struct {
+	// ActionCode is GOL-1: Action Code (ID, required, max length 2).
+	ActionCode string `hl7:"GOL.1,required,maxlen=2"`
+
+	// ActionDateTime is GOL-2: Action Date/Time (TS, required, max length 26).
+	ActionDateTime string `hl7:"GOL.2,required,maxlen=26"`
+
+	// GoalID is GOL-3: Goal ID (CE, required, max length 250).
+	GoalID string `hl7:"GOL.3,required,maxlen=250"`
+
+	// GoalInstanceID is GOL-4: Goal Instance ID (EI, required, max length 60).
+	GoalInstanceID string `hl7:"GOL.4,required,maxlen=60"`
+
+	// EpisodeOfCareID is GOL-5: Episode of Care ID (EI, optional, max length 60).
+	EpisodeOfCareID string `hl7:"GOL.5,maxlen=60"`
+
+	// GoalListPriority is GOL-6: Goal List Priority (NM, optional, max length 60).
+	GoalListPriority string `hl7:"GOL.6,maxlen=60"`
+
+	// GoalEstablishedDateTime is GOL-7: Goal Established Date/Time (TS, optional, max length 26).
+	GoalEstablishedDateTime string `hl7:"GOL.7,maxlen=26"`
+
+	// ExpectedGoalAchieveDateTime is GOL-8: Expected Goal Achieve Date/Time (TS, optional, max length 26).
+	ExpectedGoalAchieveDateTime string `hl7:"GOL.8,maxlen=26"`
+
+	// GoalClassification is GOL-9: Goal Classification (CE, optional, max length 250).
+	GoalClassification string `hl7:"GOL.9,maxlen=250"`
+
+	// GoalManagementDiscipline is GOL-10: Goal Management Discipline (CE, optional, max length 250).
+	GoalManagementDiscipline string `hl7:"GOL.10,maxlen=250"`
+
+	// CurrentGoalReviewStatus is GOL-11: Current Goal Review Status (CE, optional, max length 250).
+	CurrentGoalReviewStatus string `hl7:"GOL.11,maxlen=250"`
+
+	// CurrentGoalReviewDateTime is GOL-12: Current Goal Review Date/Time (TS, optional, max length 26).
+	CurrentGoalReviewDateTime string `hl7:"GOL.12,maxlen=26"`
+
+	// NextGoalReviewDateTime is GOL-13: Next Goal Review Date/Time (TS, optional, max length 26).
+	NextGoalReviewDateTime string `hl7:"GOL.13,maxlen=26"`
+
+	// PreviousGoalReviewDateTime is GOL-14: Previous Goal Review Date/Time (TS, optional, max length 26).
+	PreviousGoalReviewDateTime string `hl7:"GOL.14,maxlen=26"`
+
+	// GoalReviewInterval is GOL-15: Goal Review Interval (TQ, optional, max length 200).
+	GoalReviewInterval string `hl7:"GOL.15,maxlen=200"`
+
+	// GoalEvaluation is GOL-16: Goal Evaluation (CE, optional, max length 250).
+	GoalEvaluation string `hl7:"GOL.16,maxlen=250"`
+
+	// GoalEvaluationComment is GOL-17: Goal Evaluation Comment (ST, optional, repeating, max length 300).
+	GoalEvaluationComment []string `hl7:"GOL.17,maxlen=300"`
+
+	// GoalLifeCycleStatus is GOL-18: Goal Life Cycle Status (CE, optional, max length 250).
+	GoalLifeCycleStatus string `hl7:"GOL.18,maxlen=250"`
+
+	// GoalLifeCycleStatusDateTime is GOL-19: Goal Life Cycle Status Date/Time (TS, optional, max length 26).
+	GoalLifeCycleStatusDateTime string `hl7:"GOL.19,maxlen=26"`
+
+	// GoalTargetType is GOL-20: Goal Target Type (CE, optional, repeating, max length 250).
+	GoalTargetType []string `hl7:"GOL.20,maxlen=250"`
+
+	// GoalTargetName is GOL-21: Goal Target Name (XPN, optional, repeating, max length 250).
+	GoalTargetName []string `hl7:"GOL.21,maxlen=250"`
+}
+
+// ErrNotGOLSegment indicates the segment is not a GOL segment.
+var ErrNotGOLSegment = fmt.Errorf("segment is not GOL")
+
+// ParseGOL extracts GOL segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a GOL segment.
+func ParseGOL(seg hl7.Segment) (*GOL, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "GOL" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotGOLSegment, seg.Name())
+	}
+
+	gol := &GOL{
+		ActionCode:                  getFieldValue(seg, 1),
+		ActionDateTime:              getFieldValue(seg, 2),
+		GoalID:                      getFieldValue(seg, 3),
+		GoalInstanceID:              getFieldValue(seg, 4),
+		EpisodeOfCareID:             getFieldValue(seg, 5),
+		GoalListPriority:            getFieldValue(seg, 6),
+		GoalEstablishedDateTime:     getFieldValue(seg, 7),
+		ExpectedGoalAchieveDateTime: getFieldValue(seg, 8),
+		GoalClassification:          getFieldValue(seg, 9),
+		GoalManagementDiscipline:    getFieldValue(seg, 10),
+		CurrentGoalReviewStatus:     getFieldValue(seg, 11),
+		CurrentGoalReviewDateTime:   getFieldValue(seg, 12),
+		NextGoalReviewDateTime:      getFieldValue(seg, 13),
+		PreviousGoalReviewDateTime:  getFieldValue(seg, 14),
+		GoalReviewInterval:          getFieldValue(seg, 15),
+		GoalEvaluation:              getFieldValue(seg, 16),
+		GoalEvaluationComment:       getFieldRepetitions(seg, 17),
+		GoalLifeCycleStatus:         getFieldValue(seg, 18),
+		GoalLifeCycleStatusDateTime: getFieldValue(seg, 19),
+		GoalTargetType:              getFieldRepetitions(seg, 20),
+		GoalTargetName:              getFieldRepetitions(seg, 21),
+	}
+
+	return gol, nil
+}
+
+// ToSegment converts the GOL struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (g *GOL) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		g.ActionCode,
+		g.ActionDateTime,
+		g.GoalID,
+		g.GoalInstanceID,
+		g.EpisodeOfCareID,
+		g.GoalListPriority,
+		g.GoalEstablishedDateTime,
+		g.ExpectedGoalAchieveDateTime,
+		g.GoalClassification,
+		g.GoalManagementDiscipline,
+		g.CurrentGoalReviewStatus,
+		g.CurrentGoalReviewDateTime,
+		g.NextGoalReviewDateTime,
+		g.PreviousGoalReviewDateTime,
+		g.GoalReviewInterval,
+		g.GoalEvaluation,
+		joinRepetitions(g.GoalEvaluationComment, delims),
+		g.GoalLifeCycleStatus,
+		g.GoalLifeCycleStatusDateTime,
+		joinRepetitions(g.GoalTargetType, delims),
+		joinRepetitions(g.GoalTargetName, delims),
+	}
+
+	data := buildSegmentData("GOL", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GOL segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,108 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseGOL(t *testing.T) {
+	input := "GOL|GOL-1|GOL-2|GOL-3|GOL-4|GOL-5|GOL-6|GOL-7|GOL-8|GOL-9|GOL-10|GOL-11|GOL-12|GOL-13|GOL-14|GOL-15|GOL-16|GOL-17a~GOL-17b|GOL-18|GOL-19|GOL-20a~GOL-20b|GOL-21a~GOL-21b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseGOL(seg)
+	if err != nil {
+		t.Fatalf("ParseGOL() unexpected error: %v", err)
+	}
+
+	want := GOL{
+		ActionCode:                  "GOL-1",
+		ActionDateTime:              "GOL-2",
+		GoalID:                      "GOL-3",
+		GoalInstanceID:              "GOL-4",
+		EpisodeOfCareID:             "GOL-5",
+		GoalListPriority:            "GOL-6",
+		GoalEstablishedDateTime:     "GOL-7",
+		ExpectedGoalAchieveDateTime: "GOL-8",
+		GoalClassification:          "GOL-9",
+		GoalManagementDiscipline:    "GOL-10",
+		CurrentGoalReviewStatus:     "GOL-11",
+		CurrentGoalReviewDateTime:   "GOL-12",
+		NextGoalReviewDateTime:      "GOL-13",
+		PreviousGoalReviewDateTime:  "GOL-14",
+		GoalReviewInterval:          "GOL-15",
+		GoalEvaluation:              "GOL-16",
+		GoalEvaluationComment:       []string{"GOL-17a", "GOL-17b"},
+		GoalLifeCycleStatus:         "GOL-18",
+		GoalLifeCycleStatusDateTime: "GOL-19",
+		GoalTargetType:              []string{"GOL-20a", "GOL-20b"},
+		GoalTargetName:              []string{"GOL-21a", "GOL-21b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseGOL() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseGOL_WrongSegment(t *testing.T) {
+	if _, err := ParseGOL(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseGOL(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseGOL(seg); !errors.Is(err, ErrNotGOLSegment) {
+		t.Errorf("ParseGOL() error = %v, want ErrNotGOLSegment", err)
+	}
+}
+
+func TestGOL_RoundTrip(t *testing.T) {
+	original := &GOL{
+		ActionCode:                  "GOL-1",
+		ActionDateTime:              "GOL-2",
+		GoalID:                      "GOL-3",
+		GoalInstanceID:              "GOL-4",
+		EpisodeOfCareID:             "GOL-5",
+		GoalListPriority:            "GOL-6",
+		GoalEstablishedDateTime:     "GOL-7",
+		ExpectedGoalAchieveDateTime: "GOL-8",
+		GoalClassification:          "GOL-9",
+		GoalManagementDiscipline:    "GOL-10",
+		CurrentGoalReviewStatus:     "GOL-11",
+		CurrentGoalReviewDateTime:   "GOL-12",
+		NextGoalReviewDateTime:      "GOL-13",
+		PreviousGoalReviewDateTime:  "GOL-14",
+		GoalReviewInterval:          "GOL-15",
+		GoalEvaluation:              "GOL-16",
+		GoalEvaluationComment:       []string{"GOL-17a", "GOL-17b"},
+		GoalLifeCycleStatus:         "GOL-18",
+		GoalLifeCycleStatusDateTime: "GOL-19",
+		GoalTargetType:              []string{"GOL-20a", "GOL-20b"},
+		GoalTargetName:              []string{"GOL-21a", "GOL-21b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "GOL" {
+		t.Errorf("segment name = %q, want GOL", seg.Name())
+	}
+
+	parsed, err := ParseGOL(seg)
+	if err != nil {
+		t.Fatalf("ParseGOL() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
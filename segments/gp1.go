@@ -0,0 +1,81 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// GP1 represents the Grouping/Reimbursement - Visit segment.
+//
+// Field positions follow the HL7 standard where GP1-1 is the first field
+// after the segment name.
+type GP1 struct {
+	// TypeOfBillCode is GP1-1: Type of Bill Code (IS, required, max length 3).
+	TypeOfBillCode string `hl7:"GP1.1,required,maxlen=3"`
+
+	// RevenueCode is GP1-2: Revenue Code (IS, optional, repeating, max length 3).
+	RevenueCode []string `hl7:"GP1.2,maxlen=3"`
+
+	// OverallClaimDispositionCode is GP1-3: Overall Claim Disposition Code (IS, optional, max length 1).
+	OverallClaimDispositionCode string `hl7:"GP1.3,maxlen=1"`
+
+	// OCEEditsPerVisitCode is GP1-4: OCE Edits per Visit Code (IS, optional, repeating, max length 2).
+	OCEEditsPerVisitCode []string `hl7:"GP1.4,maxlen=2"`
+
+	// OutlierCost is GP1-5: Outlier Cost (CP, optional, max length 12).
+	OutlierCost string `hl7:"GP1.5,maxlen=12"`
+}
+
+// ErrNotGP1Segment indicates the segment is not a GP1 segment.
+var ErrNotGP1Segment = fmt.Errorf("segment is not GP1")
+
+// ParseGP1 extracts GP1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a GP1 segment.
+func ParseGP1(seg hl7.Segment) (*GP1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "GP1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotGP1Segment, seg.Name())
+	}
+
+	gp1 := &GP1{
+		TypeOfBillCode:              getFieldValue(seg, 1),
+		RevenueCode:                 getFieldRepetitions(seg, 2),
+		OverallClaimDispositionCode: getFieldValue(seg, 3),
+		OCEEditsPerVisitCode:        getFieldRepetitions(seg, 4),
+		OutlierCost:                 getFieldValue(seg, 5),
+	}
+
+	return gp1, nil
+}
+
+// ToSegment converts the GP1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (g *GP1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		g.TypeOfBillCode,
+		joinRepetitions(g.RevenueCode, delims),
+		g.OverallClaimDispositionCode,
+		joinRepetitions(g.OCEEditsPerVisitCode, delims),
+		g.OutlierCost,
+	}
+
+	data := buildSegmentData("GP1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GP1 segment: %w", err)
+	}
+
+	return seg, nil
+}
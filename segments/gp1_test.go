@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseGP1(t *testing.T) {
+	input := "GP1|GP1-1|GP1-2a~GP1-2b|GP1-3|GP1-4a~GP1-4b|GP1-5"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseGP1(seg)
+	if err != nil {
+		t.Fatalf("ParseGP1() unexpected error: %v", err)
+	}
+
+	want := GP1{
+		TypeOfBillCode:              "GP1-1",
+		RevenueCode:                 []string{"GP1-2a", "GP1-2b"},
+		OverallClaimDispositionCode: "GP1-3",
+		OCEEditsPerVisitCode:        []string{"GP1-4a", "GP1-4b"},
+		OutlierCost:                 "GP1-5",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseGP1() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseGP1_WrongSegment(t *testing.T) {
+	if _, err := ParseGP1(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseGP1(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseGP1(seg); !errors.Is(err, ErrNotGP1Segment) {
+		t.Errorf("ParseGP1() error = %v, want ErrNotGP1Segment", err)
+	}
+}
+
+func TestGP1_RoundTrip(t *testing.T) {
+	original := &GP1{
+		TypeOfBillCode:              "GP1-1",
+		RevenueCode:                 []string{"GP1-2a", "GP1-2b"},
+		OverallClaimDispositionCode: "GP1-3",
+		OCEEditsPerVisitCode:        []string{"GP1-4a", "GP1-4b"},
+		OutlierCost:                 "GP1-5",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "GP1" {
+		t.Errorf("segment name = %q, want GP1", seg.Name())
+	}
+
+	parsed, err := ParseGP1(seg)
+	if err != nil {
+		t.Fatalf("ParseGP1() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
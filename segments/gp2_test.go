@@ -0,0 +1,94 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseGP2(t *testing.T) {
+	input := "GP2|GP2-1|GP2-2|GP2-3|GP2-4|GP2-5|GP2-6a~GP2-6b|GP2-7|GP2-8a~GP2-8b|GP2-9|GP2-10|GP2-11|GP2-12|GP2-13|GP2-14"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseGP2(seg)
+	if err != nil {
+		t.Fatalf("ParseGP2() unexpected error: %v", err)
+	}
+
+	want := GP2{
+		RevenueCode:                         "GP2-1",
+		NumberOfServiceUnits:                "GP2-2",
+		Charge:                              "GP2-3",
+		ReimbursementActionCode:             "GP2-4",
+		DenialOrRejectionCode:               "GP2-5",
+		OCEEditCode:                         []string{"GP2-6a", "GP2-6b"},
+		AmbulatoryPaymentClassificationCode: "GP2-7",
+		ModifierEditCode:                    []string{"GP2-8a", "GP2-8b"},
+		PaymentAdjustmentCode:               "GP2-9",
+		PackagingStatusCode:                 "GP2-10",
+		ExpectedCMSPaymentAmount:            "GP2-11",
+		ReimbursementTypeCode:               "GP2-12",
+		CoPayAmount:                         "GP2-13",
+		PayRatePerServiceUnit:               "GP2-14",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseGP2() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseGP2_WrongSegment(t *testing.T) {
+	if _, err := ParseGP2(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseGP2(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseGP2(seg); !errors.Is(err, ErrNotGP2Segment) {
+		t.Errorf("ParseGP2() error = %v, want ErrNotGP2Segment", err)
+	}
+}
+
+func TestGP2_RoundTrip(t *testing.T) {
+	original := &GP2{
+		RevenueCode:                         "GP2-1",
+		NumberOfServiceUnits:                "GP2-2",
+		Charge:                              "GP2-3",
+		ReimbursementActionCode:             "GP2-4",
+		DenialOrRejectionCode:               "GP2-5",
+		OCEEditCode:                         []string{"GP2-6a", "GP2-6b"},
+		AmbulatoryPaymentClassificationCode: "GP2-7",
+		ModifierEditCode:                    []string{"GP2-8a", "GP2-8b"},
+		PaymentAdjustmentCode:               "GP2-9",
+		PackagingStatusCode:                 "GP2-10",
+		ExpectedCMSPaymentAmount:            "GP2-11",
+		ReimbursementTypeCode:               "GP2-12",
+		CoPayAmount:                         "GP2-13",
+		PayRatePerServiceUnit:               "GP2-14",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "GP2" {
+		t.Errorf("segment name = %q, want GP2", seg.Name())
+	}
+
+	parsed, err := ParseGP2(seg)
+	if err != nil {
+		t.Fatalf("ParseGP2() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
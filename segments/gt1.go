@@ -0,0 +1,341 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// GT1 represents the Guarantor segment.
+//
+// Field positions follow the HL7 standard where GT1-1 is the first field
+// after the segment name.
+type GT1 struct {
+	// SetID is GT1-1: Set ID - GT1 (SI, required, max length 4).
+	SetID string `hl7:"GT1.1,required,maxlen=4"`
+
+	// GuarantorNumber is GT1-2: Guarantor Number (CX, optional, repeating, max length 250).
+	GuarantorNumber []string `hl7:"GT1.2,maxlen=250"`
+
+	// GuarantorName is GT1-3: Guarantor Name (XPN, required, repeating, max length 250).
+	GuarantorName []string `hl7:"GT1.3,required,maxlen=250"`
+
+	// GuarantorSpouseName is GT1-4: Guarantor Spouse Name (XPN, optional, repeating, max length 250).
+	GuarantorSpouseName []string `hl7:"GT1.4,maxlen=250"`
+
+	// GuarantorAddress is GT1-5: Guarantor Address (XAD, optional, repeating, max length 250).
+	GuarantorAddress []string `hl7:"GT1.5,maxlen=250"`
+
+	// GuarantorPhoneHome is GT1-6: Guarantor Ph Num - Home (XTN, optional, repeating, max length 250).
+	GuarantorPhoneHome []string `hl7:"GT1.6,maxlen=250"`
+
+	// GuarantorPhoneBusiness is GT1-7: Guarantor Ph Num - Business (XTN, optional, repeating, max length 250).
+	GuarantorPhoneBusiness []string `hl7:"GT1.7,maxlen=250"`
+
+	// GuarantorDateTimeOfBirth is GT1-8: Guarantor Date/Time Of Birth (TS, optional, max length 26).
+	GuarantorDateTimeOfBirth string `hl7:"GT1.8,maxlen=26"`
+
+	// GuarantorAdministrativeSex is GT1-9: Guarantor Administrative Sex (IS, optional, max length 1).
+	GuarantorAdministrativeSex string `hl7:"GT1.9,maxlen=1"`
+
+	// GuarantorType is GT1-10: Guarantor Type (IS, optional, max length 2).
+	GuarantorType string `hl7:"GT1.10,maxlen=2"`
+
+	// GuarantorRelationship is GT1-11: Guarantor Relationship (CE, optional, max length 250).
+	GuarantorRelationship string `hl7:"GT1.11,maxlen=250"`
+
+	// GuarantorSSN is GT1-12: Guarantor SSN (ST, optional, max length 11).
+	GuarantorSSN string `hl7:"GT1.12,maxlen=11"`
+
+	// GuarantorDateBegin is GT1-13: Guarantor Date - Begin (DT, optional, max length 8).
+	GuarantorDateBegin string `hl7:"GT1.13,maxlen=8"`
+
+	// GuarantorDateEnd is GT1-14: Guarantor Date - End (DT, optional, max length 8).
+	GuarantorDateEnd string `hl7:"GT1.14,maxlen=8"`
+
+	// GuarantorPriority is GT1-15: Guarantor Priority (NM, optional, max length 2).
+	GuarantorPriority string `hl7:"GT1.15,maxlen=2"`
+
+	// GuarantorEmployerName is GT1-16: Guarantor Employer Name (XPN, optional, repeating, max length 250).
+	GuarantorEmployerName []string `hl7:"GT1.16,maxlen=250"`
+
+	// GuarantorEmployerAddress is GT1-17: Guarantor Employer Address (XAD, optional, repeating, max length 250).
+	GuarantorEmployerAddress []string `hl7:"GT1.17,maxlen=250"`
+
+	// GuarantorEmployerPhoneNumber is GT1-18: Guarantor Employer Phone Number (XTN, optional, repeating, max length 250).
+	GuarantorEmployerPhoneNumber []string `hl7:"GT1.18,maxlen=250"`
+
+	// GuarantorEmployeeIDNumber is GT1-19: Guarantor Employee ID Number (CX, optional, repeating, max length 250).
+	GuarantorEmployeeIDNumber []string `hl7:"GT1.19,maxlen=250"`
+
+	// GuarantorEmploymentStatus is GT1-20: Guarantor Employment Status (IS, optional, max length 2).
+	GuarantorEmploymentStatus string `hl7:"GT1.20,maxlen=2"`
+
+	// GuarantorOrganizationName is GT1-21: Guarantor Organization Name (XON, optional, repeating, max length 250).
+	GuarantorOrganizationName []string `hl7:"GT1.21,maxlen=250"`
+
+	// GuarantorBillingHoldFlag is GT1-22: Guarantor Billing Hold Flag (ID, optional, max length 1).
+	GuarantorBillingHoldFlag string `hl7:"GT1.22,maxlen=1"`
+
+	// GuarantorCreditRatingCode is GT1-23: Guarantor Credit Rating Code (CE, optional, max length 250).
+	GuarantorCreditRatingCode string `hl7:"GT1.23,maxlen=250"`
+
+	// GuarantorDeathDateTime is GT1-24: Guarantor Death Date And Time (TS, optional, max length 26).
+	GuarantorDeathDateTime string `hl7:"GT1.24,maxlen=26"`
+
+	// GuarantorDeathFlag is GT1-25: Guarantor Death Flag (ID, optional, max length 1).
+	GuarantorDeathFlag string `hl7:"GT1.25,maxlen=1"`
+
+	// GuarantorChargeAdjustmentCode is GT1-26: Guarantor Charge Adjustment Code (CE, optional, max length 250).
+	GuarantorChargeAdjustmentCode string `hl7:"GT1.26,maxlen=250"`
+
+	// GuarantorHouseholdAnnualIncome is GT1-27: Guarantor Household Annual Income (CP, optional, max length 10).
+	GuarantorHouseholdAnnualIncome string `hl7:"GT1.27,maxlen=10"`
+
+	// GuarantorHouseholdSize is GT1-28: Guarantor Household Size (NM, optional, max length 3).
+	GuarantorHouseholdSize string `hl7:"GT1.28,maxlen=3"`
+
+	// GuarantorEmployerIDNumber is GT1-29: Guarantor Employer ID Number (CX, optional, repeating, max length 250).
+	GuarantorEmployerIDNumber []string `hl7:"GT1.29,maxlen=250"`
+
+	// GuarantorMaritalStatusCode is GT1-30: Guarantor Marital Status Code (CE, optional, max length 250).
+	GuarantorMaritalStatusCode string `hl7:"GT1.30,maxlen=250"`
+
+	// GuarantorHireEffectiveDate is GT1-31: Guarantor Hire Effective Date (DT, optional, max length 8).
+	GuarantorHireEffectiveDate string `hl7:"GT1.31,maxlen=8"`
+
+	// EmploymentStopDate is GT1-32: Employment Stop Date (DT, optional, max length 8).
+	EmploymentStopDate string `hl7:"GT1.32,maxlen=8"`
+
+	// LivingDependency is GT1-33: Living Dependency (IS, optional, max length 2).
+	LivingDependency string `hl7:"GT1.33,maxlen=2"`
+
+	// AmbulatoryStatus is GT1-34: Ambulatory Status (IS, optional, repeating, max length 2).
+	AmbulatoryStatus []string `hl7:"GT1.34,maxlen=2"`
+
+	// Citizenship is GT1-35: Citizenship (CE, optional, repeating, max length 250).
+	Citizenship []string `hl7:"GT1.35,maxlen=250"`
+
+	// PrimaryLanguage is GT1-36: Primary Language (CE, optional, max length 250).
+	PrimaryLanguage string `hl7:"GT1.36,maxlen=250"`
+
+	// LivingArrangement is GT1-37: Living Arrangement (IS, optional, max length 2).
+	LivingArrangement string `hl7:"GT1.37,maxlen=2"`
+
+	// PublicityCode is GT1-38: Publicity Code (CE, optional, max length 250).
+	PublicityCode string `hl7:"GT1.38,maxlen=250"`
+
+	// ProtectionIndicator is GT1-39: Protection Indicator (ID, optional, max length 1).
+	ProtectionIndicator string `hl7:"GT1.39,maxlen=1"`
+
+	// StudentIndicator is GT1-40: Student Indicator (IS, optional, max length 2).
+	StudentIndicator string `hl7:"GT1.40,maxlen=2"`
+
+	// Religion is GT1-41: Religion (CE, optional, max length 250).
+	Religion string `hl7:"GT1.41,maxlen=250"`
+
+	// MothersMaidenName is GT1-42: Mother's Maiden Name (XPN, optional, repeating, max length 250).
+	MothersMaidenName []string `hl7:"GT1.42,maxlen=250"`
+
+	// Nationality is GT1-43: Nationality (CE, optional, max length 250).
+	Nationality string `hl7:"GT1.43,maxlen=250"`
+
+	// EthnicGroup is GT1-44: Ethnic Group (CE, optional, repeating, max length 250).
+	EthnicGroup []string `hl7:"GT1.44,maxlen=250"`
+
+	// ContactPersonName is GT1-45: Contact Person's Name (XPN, optional, repeating, max length 250).
+	ContactPersonName []string `hl7:"GT1.45,maxlen=250"`
+
+	// ContactPersonTelephoneNumber is GT1-46: Contact Person's Telephone Number (XTN, optional, repeating, max length 250).
+	ContactPersonTelephoneNumber []string `hl7:"GT1.46,maxlen=250"`
+
+	// ContactReason is GT1-47: Contact Reason (CE, optional, max length 250).
+	ContactReason string `hl7:"GT1.47,maxlen=250"`
+
+	// ContactRelationship is GT1-48: Contact Relationship (IS, optional, max length 3).
+	ContactRelationship string `hl7:"GT1.48,maxlen=3"`
+
+	// JobTitle is GT1-49: Job Title (ST, optional, max length 20).
+	JobTitle string `hl7:"GT1.49,maxlen=20"`
+
+	// JobCodeClass is GT1-50: Job Code/Class (JCC, optional, max length 20).
+	JobCodeClass string `hl7:"GT1.50,maxlen=20"`
+
+	// GuarantorEmployerOrganizationName is GT1-51: Guarantor Employer's Organization Name (XON, optional, repeating, max length 250).
+	GuarantorEmployerOrganizationName []string `hl7:"GT1.51,maxlen=250"`
+
+	// Handicap is GT1-52: Handicap (IS, optional, max length 2).
+	Handicap string `hl7:"GT1.52,maxlen=2"`
+
+	// JobStatus is GT1-53: Job Status (IS, optional, max length 2).
+	JobStatus string `hl7:"GT1.53,maxlen=2"`
+
+	// GuarantorFinancialClass is GT1-54: Guarantor Financial Class (FC, optional, max length 50).
+	GuarantorFinancialClass string `hl7:"GT1.54,maxlen=50"`
+
+	// GuarantorRace is GT1-55: Guarantor Race (CE, optional, repeating, max length 250).
+	GuarantorRace []string `hl7:"GT1.55,maxlen=250"`
+
+	// GuarantorBirthPlace is GT1-56: Guarantor Birth Place (ST, optional, max length 250).
+	GuarantorBirthPlace string `hl7:"GT1.56,maxlen=250"`
+
+	// VIPIndicator is GT1-57: VIP Indicator (IS, optional, max length 2).
+	VIPIndicator string `hl7:"GT1.57,maxlen=2"`
+}
+
+// ErrNotGT1Segment indicates the segment is not a GT1 segment.
+var ErrNotGT1Segment = fmt.Errorf("segment is not GT1")
+
+// ParseGT1 extracts GT1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a GT1 segment.
+func ParseGT1(seg hl7.Segment) (*GT1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "GT1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotGT1Segment, seg.Name())
+	}
+
+	gt1 := &GT1{
+		SetID:                             getFieldValue(seg, 1),
+		GuarantorNumber:                   getFieldRepetitions(seg, 2),
+		GuarantorName:                     getFieldRepetitions(seg, 3),
+		GuarantorSpouseName:               getFieldRepetitions(seg, 4),
+		GuarantorAddress:                  getFieldRepetitions(seg, 5),
+		GuarantorPhoneHome:                getFieldRepetitions(seg, 6),
+		GuarantorPhoneBusiness:            getFieldRepetitions(seg, 7),
+		GuarantorDateTimeOfBirth:          getFieldValue(seg, 8),
+		GuarantorAdministrativeSex:        getFieldValue(seg, 9),
+		GuarantorType:                     getFieldValue(seg, 10),
+		GuarantorRelationship:             getFieldValue(seg, 11),
+		GuarantorSSN:                      getFieldValue(seg, 12),
+		GuarantorDateBegin:                getFieldValue(seg, 13),
+		GuarantorDateEnd:                  getFieldValue(seg, 14),
+		GuarantorPriority:                 getFieldValue(seg, 15),
+		GuarantorEmployerName:             getFieldRepetitions(seg, 16),
+		GuarantorEmployerAddress:          getFieldRepetitions(seg, 17),
+		GuarantorEmployerPhoneNumber:      getFieldRepetitions(seg, 18),
+		GuarantorEmployeeIDNumber:         getFieldRepetitions(seg, 19),
+		GuarantorEmploymentStatus:         getFieldValue(seg, 20),
+		GuarantorOrganizationName:         getFieldRepetitions(seg, 21),
+		GuarantorBillingHoldFlag:          getFieldValue(seg, 22),
+		GuarantorCreditRatingCode:         getFieldValue(seg, 23),
+		GuarantorDeathDateTime:            getFieldValue(seg, 24),
+		GuarantorDeathFlag:                getFieldValue(seg, 25),
+		GuarantorChargeAdjustmentCode:     getFieldValue(seg, 26),
+		GuarantorHouseholdAnnualIncome:    getFieldValue(seg, 27),
+		GuarantorHouseholdSize:            getFieldValue(seg, 28),
+		GuarantorEmployerIDNumber:         getFieldRepetitions(seg, 29),
+		GuarantorMaritalStatusCode:        getFieldValue(seg, 30),
+		GuarantorHireEffectiveDate:        getFieldValue(seg, 31),
+		EmploymentStopDate:                getFieldValue(seg, 32),
+		LivingDependency:                  getFieldValue(seg, 33),
+		AmbulatoryStatus:                  getFieldRepetitions(seg, 34),
+		Citizenship:                       getFieldRepetitions(seg, 35),
+		PrimaryLanguage:                   getFieldValue(seg, 36),
+		LivingArrangement:                 getFieldValue(seg, 37),
+		PublicityCode:                     getFieldValue(seg, 38),
+		ProtectionIndicator:               getFieldValue(seg, 39),
+		StudentIndicator:                  getFieldValue(seg, 40),
+		Religion:                          getFieldValue(seg, 41),
+		MothersMaidenName:                 getFieldRepetitions(seg, 42),
+		Nationality:                       getFieldValue(seg, 43),
+		EthnicGroup:                       getFieldRepetitions(seg, 44),
+		ContactPersonName:                 getFieldRepetitions(seg, 45),
+		ContactPersonTelephoneNumber:      getFieldRepetitions(seg, 46),
+		ContactReason:                     getFieldValue(seg, 47),
+		ContactRelationship:               getFieldValue(seg, 48),
+		JobTitle:                          getFieldValue(seg, 49),
+		JobCodeClass:                      getFieldValue(seg, 50),
+		GuarantorEmployerOrganizationName: getFieldRepetitions(seg, 51),
+		Handicap:                          getFieldValue(seg, 52),
+		JobStatus:                         getFieldValue(seg, 53),
+		GuarantorFinancialClass:           getFieldValue(seg, 54),
+		GuarantorRace:                     getFieldRepetitions(seg, 55),
+		GuarantorBirthPlace:               getFieldValue(seg, 56),
+		VIPIndicator:                      getFieldValue(seg, 57),
+	}
+
+	return gt1, nil
+}
+
+// ToSegment converts the GT1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (g *GT1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		g.SetID,
+		joinRepetitions(g.GuarantorNumber, delims),
+		joinRepetitions(g.GuarantorName, delims),
+		joinRepetitions(g.GuarantorSpouseName, delims),
+		joinRepetitions(g.GuarantorAddress, delims),
+		joinRepetitions(g.GuarantorPhoneHome, delims),
+		joinRepetitions(g.GuarantorPhoneBusiness, delims),
+		g.GuarantorDateTimeOfBirth,
+		g.GuarantorAdministrativeSex,
+		g.GuarantorType,
+		g.GuarantorRelationship,
+		g.GuarantorSSN,
+		g.GuarantorDateBegin,
+		g.GuarantorDateEnd,
+		g.GuarantorPriority,
+		joinRepetitions(g.GuarantorEmployerName, delims),
+		joinRepetitions(g.GuarantorEmployerAddress, delims),
+		joinRepetitions(g.GuarantorEmployerPhoneNumber, delims),
+		joinRepetitions(g.GuarantorEmployeeIDNumber, delims),
+		g.GuarantorEmploymentStatus,
+		joinRepetitions(g.GuarantorOrganizationName, delims),
+		g.GuarantorBillingHoldFlag,
+		g.GuarantorCreditRatingCode,
+		g.GuarantorDeathDateTime,
+		g.GuarantorDeathFlag,
+		g.GuarantorChargeAdjustmentCode,
+		g.GuarantorHouseholdAnnualIncome,
+		g.GuarantorHouseholdSize,
+		joinRepetitions(g.GuarantorEmployerIDNumber, delims),
+		g.GuarantorMaritalStatusCode,
+		g.GuarantorHireEffectiveDate,
+		g.EmploymentStopDate,
+		g.LivingDependency,
+		joinRepetitions(g.AmbulatoryStatus, delims),
+		joinRepetitions(g.Citizenship, delims),
+		g.PrimaryLanguage,
+		g.LivingArrangement,
+		g.PublicityCode,
+		g.ProtectionIndicator,
+		g.StudentIndicator,
+		g.Religion,
+		joinRepetitions(g.MothersMaidenName, delims),
+		g.Nationality,
+		joinRepetitions(g.EthnicGroup, delims),
+		joinRepetitions(g.ContactPersonName, delims),
+		joinRepetitions(g.ContactPersonTelephoneNumber, delims),
+		g.ContactReason,
+		g.ContactRelationship,
+		g.JobTitle,
+		g.JobCodeClass,
+		joinRepetitions(g.GuarantorEmployerOrganizationName, delims),
+		g.Handicap,
+		g.JobStatus,
+		g.GuarantorFinancialClass,
+		joinRepetitions(g.GuarantorRace, delims),
+		g.GuarantorBirthPlace,
+		g.VIPIndicator,
+	}
+
+	data := buildSegmentData("GT1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GT1 segment: %w", err)
+	}
+
+	return seg, nil
+}
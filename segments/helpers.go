@@ -1,6 +1,8 @@
 package segments
 
 import (
+	"strings"
+
 	"github.com/dshills/golevel7/hl7"
 )
 
@@ -41,3 +43,53 @@ func buildSegmentData(name string, fields []string, delims *hl7.Delimiters) stri
 
 	return data
 }
+
+// getFieldRepetitions extracts every repetition of a segment field, each
+// encoded like getFieldValue. Returns nil if the field does not exist or is empty.
+func getFieldRepetitions(seg hl7.Segment, fieldNum int) []string {
+	f, ok := seg.Field(fieldNum)
+	if !ok || f.String() == "" {
+		return nil
+	}
+
+	reps := f.Repetitions()
+	if len(reps) == 0 {
+		return []string{f.String()}
+	}
+
+	values := make([]string, len(reps))
+	for i, rep := range reps {
+		values[i] = rep.String()
+	}
+	return values
+}
+
+// joinRepetitions encodes repetition values as a single field value
+// separated by the repetition delimiter.
+func joinRepetitions(values []string, delims *hl7.Delimiters) string {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+	return strings.Join(values, string(delims.Repetition))
+}
+
+// buildHeaderSegmentData constructs a BHS or FHS segment string, where the
+// first field is the field separator itself and the second the encoding
+// characters, as in MSH. Either defaults to delims when empty.
+func buildHeaderSegmentData(name string, fields []string, delims *hl7.Delimiters) string {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fieldSep := string(delims.Field)
+	if fields[0] != "" {
+		fieldSep = fields[0]
+	}
+
+	encChars := delims.EncodingCharacters()
+	if fields[1] != "" {
+		encChars = fields[1]
+	}
+
+	return buildSegmentData(name+fieldSep+encChars, fields[2:], delims)
+}
@@ -0,0 +1,156 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// IAM represents the Patient Adverse Reaction Information segment.
+//
+// Field positions follow the HL7 standard where IAM-1 is the first field
+// after the segment name.
+type IAM struct {
+	// SetID is IAM-1: Set ID - IAM (SI, required, max length 4).
+	SetID string `hl7:"IAM.1,required,maxlen=4"`
+
+	// AllergenTypeCode is IAM-2: Allergen Type Code (CE, optional, max length 250).
+	AllergenTypeCode string `hl7:"IAM.2,maxlen=250"`
+
+	// AllergenCodeMnemonicDescription is IAM-3: Allergen Code/Mnemonic/Description (CE, required, max length 250).
+	AllergenCodeMnemonicDescription string `hl7:"IAM.3,required,maxlen=250"`
+
+	// AllergySeverityCode is IAM-4: Allergy Severity Code (CE, optional, max length 250).
+	AllergySeverityCode string `hl7:"IAM.4,maxlen=250"`
+
+	// AllergyReactionCode is IAM-5: Allergy Reaction Code (ST, optional, repeating, max length 15).
+	AllergyReactionCode []string `hl7:"IAM.5,maxlen=15"`
+
+	// AllergyActionCode is IAM-6: Allergy Action Code (CNE, required, max length 250).
+	AllergyActionCode string `hl7:"IAM.6,required,maxlen=250"`
+
+	// AllergyUniqueIdentifier is IAM-7: Allergy Unique Identifier (EI, conditional, max length 427).
+	AllergyUniqueIdentifier string `hl7:"IAM.7,maxlen=427"`
+
+	// ActionReason is IAM-8: Action Reason (ST, optional, max length 60).
+	ActionReason string `hl7:"IAM.8,maxlen=60"`
+
+	// SensitivityToCausativeAgentCode is IAM-9: Sensitivity to Causative Agent Code (CE, optional, max length 250).
+	SensitivityToCausativeAgentCode string `hl7:"IAM.9,maxlen=250"`
+
+	// AllergenGroupCodeMnemonicDescription is IAM-10: Allergen Group Code/Mnemonic/Description (CE, optional, max length 250).
+	AllergenGroupCodeMnemonicDescription string `hl7:"IAM.10,maxlen=250"`
+
+	// OnsetDate is IAM-11: Onset Date (DT, optional, max length 8).
+	OnsetDate string `hl7:"IAM.11,maxlen=8"`
+
+	// OnsetDateText is IAM-12: Onset Date Text (ST, optional, max length 60).
+	OnsetDateText string `hl7:"IAM.12,maxlen=60"`
+
+	// ReportedDateTime is IAM-13: Reported Date/Time (TS, optional, max length 8).
+	ReportedDateTime string `hl7:"IAM.13,maxlen=8"`
+
+	// ReportedBy is IAM-14: Reported By (XPN, optional, max length 250).
+	ReportedBy string `hl7:"IAM.14,maxlen=250"`
+
+	// RelationshipToPatientCode is IAM-15: Relationship to Patient Code (CE, optional, max length 250).
+	RelationshipToPatientCode string `hl7:"IAM.15,maxlen=250"`
+
+	// AlertDeviceCode is IAM-16: Alert Device Code (CE, optional, max length 250).
+	AlertDeviceCode string `hl7:"IAM.16,maxlen=250"`
+
+	// AllergyClinicalStatusCode is IAM-17: Allergy Clinical Status Code (CE, optional, max length 250).
+	AllergyClinicalStatusCode string `hl7:"IAM.17,maxlen=250"`
+
+	// StatusedByPerson is IAM-18: Statused by Person (XCN, optional, max length 250).
+	StatusedByPerson string `hl7:"IAM.18,maxlen=250"`
+
+	// StatusedByOrganization is IAM-19: Statused by Organization (XON, optional, max length 250).
+	StatusedByOrganization string `hl7:"IAM.19,maxlen=250"`
+
+	// StatusedAtDateTime is IAM-20: Statused at Date/Time (TS, optional, max length 8).
+	StatusedAtDateTime string `hl7:"IAM.20,maxlen=8"`
+}
+
+// ErrNotIAMSegment indicates the segment is not an IAM segment.
+var ErrNotIAMSegment = fmt.Errorf("segment is not IAM")
+
+// ParseIAM extracts IAM segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an IAM segment.
+func ParseIAM(seg hl7.Segment) (*IAM, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "IAM" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotIAMSegment, seg.Name())
+	}
+
+	iam := &IAM{
+		SetID:                                getFieldValue(seg, 1),
+		AllergenTypeCode:                     getFieldValue(seg, 2),
+		AllergenCodeMnemonicDescription:      getFieldValue(seg, 3),
+		AllergySeverityCode:                  getFieldValue(seg, 4),
+		AllergyReactionCode:                  getFieldRepetitions(seg, 5),
+		AllergyActionCode:                    getFieldValue(seg, 6),
+		AllergyUniqueIdentifier:              getFieldValue(seg, 7),
+		ActionReason:                         getFieldValue(seg, 8),
+		SensitivityToCausativeAgentCode:      getFieldValue(seg, 9),
+		AllergenGroupCodeMnemonicDescription: getFieldValue(seg, 10),
+		OnsetDate:                            getFieldValue(seg, 11),
+		OnsetDateText:                        getFieldValue(seg, 12),
+		ReportedDateTime:                     getFieldValue(seg, 13),
+		ReportedBy:                           getFieldValue(seg, 14),
+		RelationshipToPatientCode:            getFieldValue(seg, 15),
+		AlertDeviceCode:                      getFieldValue(seg, 16),
+		AllergyClinicalStatusCode:            getFieldValue(seg, 17),
+		StatusedByPerson:                     getFieldValue(seg, 18),
+		StatusedByOrganization:               getFieldValue(seg, 19),
+		StatusedAtDateTime:                   getFieldValue(seg, 20),
+	}
+
+	return iam, nil
+}
+
+// ToSegment converts the IAM struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (i *IAM) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		i.SetID,
+		i.AllergenTypeCode,
+		i.AllergenCodeMnemonicDescription,
+		i.AllergySeverityCode,
+		joinRepetitions(i.AllergyReactionCode, delims),
+		i.AllergyActionCode,
+		i.AllergyUniqueIdentifier,
+		i.ActionReason,
+		i.SensitivityToCausativeAgentCode,
+		i.AllergenGroupCodeMnemonicDescription,
+		i.OnsetDate,
+		i.OnsetDateText,
+		i.ReportedDateTime,
+		i.ReportedBy,
+		i.RelationshipToPatientCode,
+		i.AlertDeviceCode,
+		i.AllergyClinicalStatusCode,
+		i.StatusedByPerson,
+		i.StatusedByOrganization,
+		i.StatusedAtDateTime,
+	}
+
+	data := buildSegmentData("IAM", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IAM segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,106 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseIAM(t *testing.T) {
+	input := "IAM|IAM-1|IAM-2|IAM-3|IAM-4|IAM-5a~IAM-5b|IAM-6|IAM-7|IAM-8|IAM-9|IAM-10|IAM-11|IAM-12|IAM-13|IAM-14|IAM-15|IAM-16|IAM-17|IAM-18|IAM-19|IAM-20"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseIAM(seg)
+	if err != nil {
+		t.Fatalf("ParseIAM() unexpected error: %v", err)
+	}
+
+	want := IAM{
+		SetID:                                "IAM-1",
+		AllergenTypeCode:                     "IAM-2",
+		AllergenCodeMnemonicDescription:      "IAM-3",
+		AllergySeverityCode:                  "IAM-4",
+		AllergyReactionCode:                  []string{"IAM-5a", "IAM-5b"},
+		AllergyActionCode:                    "IAM-6",
+		AllergyUniqueIdentifier:              "IAM-7",
+		ActionReason:                         "IAM-8",
+		SensitivityToCausativeAgentCode:      "IAM-9",
+		AllergenGroupCodeMnemonicDescription: "IAM-10",
+		OnsetDate:                            "IAM-11",
+		OnsetDateText:                        "IAM-12",
+		ReportedDateTime:                     "IAM-13",
+		ReportedBy:                           "IAM-14",
+		RelationshipToPatientCode:            "IAM-15",
+		AlertDeviceCode:                      "IAM-16",
+		AllergyClinicalStatusCode:            "IAM-17",
+		StatusedByPerson:                     "IAM-18",
+		StatusedByOrganization:               "IAM-19",
+		StatusedAtDateTime:                   "IAM-20",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseIAM() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseIAM_WrongSegment(t *testing.T) {
+	if _, err := ParseIAM(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseIAM(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseIAM(seg); !errors.Is(err, ErrNotIAMSegment) {
+		t.Errorf("ParseIAM() error = %v, want ErrNotIAMSegment", err)
+	}
+}
+
+func TestIAM_RoundTrip(t *testing.T) {
+	original := &IAM{
+		SetID:                                "IAM-1",
+		AllergenTypeCode:                     "IAM-2",
+		AllergenCodeMnemonicDescription:      "IAM-3",
+		AllergySeverityCode:                  "IAM-4",
+		AllergyReactionCode:                  []string{"IAM-5a", "IAM-5b"},
+		AllergyActionCode:                    "IAM-6",
+		AllergyUniqueIdentifier:              "IAM-7",
+		ActionReason:                         "IAM-8",
+		SensitivityToCausativeAgentCode:      "IAM-9",
+		AllergenGroupCodeMnemonicDescription: "IAM-10",
+		OnsetDate:                            "IAM-11",
+		OnsetDateText:                        "IAM-12",
+		ReportedDateTime:                     "IAM-13",
+		ReportedBy:                           "IAM-14",
+		RelationshipToPatientCode:            "IAM-15",
+		AlertDeviceCode:                      "IAM-16",
+		AllergyClinicalStatusCode:            "IAM-17",
+		StatusedByPerson:                     "IAM-18",
+		StatusedByOrganization:               "IAM-19",
+		StatusedAtDateTime:                   "IAM-20",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "IAM" {
+		t.Errorf("segment name = %q, want IAM", seg.Name())
+	}
+
+	parsed, err := ParseIAM(seg)
+	if err != nil {
+		t.Fatalf("ParseIAM() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
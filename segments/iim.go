@@ -0,0 +1,131 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// IIM represents the Inventory Item Master segment.
+//
+// Field positions follow the HL7 standard where IIM-1 is the first field
+// after the segment name.
+type IIM struct {
+	// PrimaryKeyValue is IIM-1: Primary Key Value - IIM (CWE, required, max length 250).
+	PrimaryKeyValue string `hl7:"IIM.1,required,maxlen=250"`
+
+	// ServiceItemCode is IIM-2: Service Item Code (CWE, required, max length 250).
+	ServiceItemCode string `hl7:"IIM.2,required,maxlen=250"`
+
+	// InventoryLotNumber is IIM-3: Inventory Lot Number (ST, optional, max length 250).
+	InventoryLotNumber string `hl7:"IIM.3,maxlen=250"`
+
+	// InventoryExpirationDate is IIM-4: Inventory Expiration Date (TS, optional, max length 26).
+	InventoryExpirationDate string `hl7:"IIM.4,maxlen=26"`
+
+	// InventoryManufacturerName is IIM-5: Inventory Manufacturer Name (CWE, optional, max length 250).
+	InventoryManufacturerName string `hl7:"IIM.5,maxlen=250"`
+
+	// InventoryLocation is IIM-6: Inventory Location (CWE, optional, max length 250).
+	InventoryLocation string `hl7:"IIM.6,maxlen=250"`
+
+	// InventoryReceivedDate is IIM-7: Inventory Received Date (TS, optional, max length 26).
+	InventoryReceivedDate string `hl7:"IIM.7,maxlen=26"`
+
+	// InventoryReceivedQuantity is IIM-8: Inventory Received Quantity (NM, optional, max length 12).
+	InventoryReceivedQuantity string `hl7:"IIM.8,maxlen=12"`
+
+	// InventoryReceivedQuantityUnit is IIM-9: Inventory Received Quantity Unit (CWE, optional, max length 250).
+	InventoryReceivedQuantityUnit string `hl7:"IIM.9,maxlen=250"`
+
+	// InventoryReceivedItemCost is IIM-10: Inventory Received Item Cost (MO, optional, max length 12).
+	InventoryReceivedItemCost string `hl7:"IIM.10,maxlen=12"`
+
+	// InventoryOnHandDate is IIM-11: Inventory On Hand Date (TS, optional, max length 26).
+	InventoryOnHandDate string `hl7:"IIM.11,maxlen=26"`
+
+	// InventoryOnHandQuantity is IIM-12: Inventory On Hand Quantity (NM, optional, max length 12).
+	InventoryOnHandQuantity string `hl7:"IIM.12,maxlen=12"`
+
+	// InventoryOnHandQuantityUnit is IIM-13: Inventory On Hand Quantity Unit (CWE, optional, max length 250).
+	InventoryOnHandQuantityUnit string `hl7:"IIM.13,maxlen=250"`
+
+	// ProcedureCode is IIM-14: Procedure Code (CE, optional, max length 250).
+	ProcedureCode string `hl7:"IIM.14,maxlen=250"`
+
+	// ProcedureCodeModifier is IIM-15: Procedure Code Modifier (CE, optional, repeating, max length 250).
+	ProcedureCodeModifier []string `hl7:"IIM.15,maxlen=250"`
+}
+
+// ErrNotIIMSegment indicates the segment is not an IIM segment.
+var ErrNotIIMSegment = fmt.Errorf("segment is not IIM")
+
+// ParseIIM extracts IIM segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an IIM segment.
+func ParseIIM(seg hl7.Segment) (*IIM, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "IIM" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotIIMSegment, seg.Name())
+	}
+
+	iim := &IIM{
+		PrimaryKeyValue:               getFieldValue(seg, 1),
+		ServiceItemCode:               getFieldValue(seg, 2),
+		InventoryLotNumber:            getFieldValue(seg, 3),
+		InventoryExpirationDate:       getFieldValue(seg, 4),
+		InventoryManufacturerName:     getFieldValue(seg, 5),
+		InventoryLocation:             getFieldValue(seg, 6),
+		InventoryReceivedDate:         getFieldValue(seg, 7),
+		InventoryReceivedQuantity:     getFieldValue(seg, 8),
+		InventoryReceivedQuantityUnit: getFieldValue(seg, 9),
+		InventoryReceivedItemCost:     getFieldValue(seg, 10),
+		InventoryOnHandDate:           getFieldValue(seg, 11),
+		InventoryOnHandQuantity:       getFieldValue(seg, 12),
+		InventoryOnHandQuantityUnit:   getFieldValue(seg, 13),
+		ProcedureCode:                 getFieldValue(seg, 14),
+		ProcedureCodeModifier:         getFieldRepetitions(seg, 15),
+	}
+
+	return iim, nil
+}
+
+// ToSegment converts the IIM struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (i *IIM) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		i.PrimaryKeyValue,
+		i.ServiceItemCode,
+		i.InventoryLotNumber,
+		i.InventoryExpirationDate,
+		i.InventoryManufacturerName,
+		i.InventoryLocation,
+		i.InventoryReceivedDate,
+		i.InventoryReceivedQuantity,
+		i.InventoryReceivedQuantityUnit,
+		i.InventoryReceivedItemCost,
+		i.InventoryOnHandDate,
+		i.InventoryOnHandQuantity,
+		i.InventoryOnHandQuantityUnit,
+		i.ProcedureCode,
+		joinRepetitions(i.ProcedureCodeModifier, delims),
+	}
+
+	data := buildSegmentData("IIM", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IIM segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,321 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// IN1 represents the Insurance segment.
+//
+// Field positions follow the HL7 standard where IN1-1 is the first field
+// after the segment name.
+type IN1 struct {
+	// SetID is IN1-1: Set ID - IN1 (SI, required, max length 4).
+	SetID string `hl7:"IN1.1,required,maxlen=4"`
+
+	// InsurancePlanID is IN1-2: Insurance Plan ID (CE, required, max length 250).
+	InsurancePlanID string `hl7:"IN1.2,required,maxlen=250"`
+
+	// InsuranceCompanyID is IN1-3: Insurance Company ID (CX, required, repeating, max length 250).
+	InsuranceCompanyID []string `hl7:"IN1.3,required,maxlen=250"`
+
+	// InsuranceCompanyName is IN1-4: Insurance Company Name (XON, optional, repeating, max length 250).
+	InsuranceCompanyName []string `hl7:"IN1.4,maxlen=250"`
+
+	// InsuranceCompanyAddress is IN1-5: Insurance Company Address (XAD, optional, repeating, max length 250).
+	InsuranceCompanyAddress []string `hl7:"IN1.5,maxlen=250"`
+
+	// InsuranceCoContactPerson is IN1-6: Insurance Co Contact Person (XPN, optional, repeating, max length 250).
+	InsuranceCoContactPerson []string `hl7:"IN1.6,maxlen=250"`
+
+	// InsuranceCoPhoneNumber is IN1-7: Insurance Co Phone Number (XTN, optional, repeating, max length 250).
+	InsuranceCoPhoneNumber []string `hl7:"IN1.7,maxlen=250"`
+
+	// GroupNumber is IN1-8: Group Number (ST, optional, max length 12).
+	GroupNumber string `hl7:"IN1.8,maxlen=12"`
+
+	// GroupName is IN1-9: Group Name (XON, optional, repeating, max length 250).
+	GroupName []string `hl7:"IN1.9,maxlen=250"`
+
+	// InsuredGroupEmpID is IN1-10: Insured's Group Emp ID (CX, optional, repeating, max length 250).
+	InsuredGroupEmpID []string `hl7:"IN1.10,maxlen=250"`
+
+	// InsuredGroupEmpName is IN1-11: Insured's Group Emp Name (XON, optional, repeating, max length 250).
+	InsuredGroupEmpName []string `hl7:"IN1.11,maxlen=250"`
+
+	// PlanEffectiveDate is IN1-12: Plan Effective Date (DT, optional, max length 8).
+	PlanEffectiveDate string `hl7:"IN1.12,maxlen=8"`
+
+	// PlanExpirationDate is IN1-13: Plan Expiration Date (DT, optional, max length 8).
+	PlanExpirationDate string `hl7:"IN1.13,maxlen=8"`
+
+	// AuthorizationInformation is IN1-14: Authorization Information (AUI, optional, max length 239).
+	AuthorizationInformation string `hl7:"IN1.14,maxlen=239"`
+
+	// PlanType is IN1-15: Plan Type (IS, optional, max length 3).
+	PlanType string `hl7:"IN1.15,maxlen=3"`
+
+	// NameOfInsured is IN1-16: Name Of Insured (XPN, optional, repeating, max length 250).
+	NameOfInsured []string `hl7:"IN1.16,maxlen=250"`
+
+	// InsuredRelationshipToPatient is IN1-17: Insured's Relationship To Patient (CE, optional, max length 250).
+	InsuredRelationshipToPatient string `hl7:"IN1.17,maxlen=250"`
+
+	// InsuredDateOfBirth is IN1-18: Insured's Date Of Birth (TS, optional, max length 26).
+	InsuredDateOfBirth string `hl7:"IN1.18,maxlen=26"`
+
+	// InsuredAddress is IN1-19: Insured's Address (XAD, optional, repeating, max length 250).
+	InsuredAddress []string `hl7:"IN1.19,maxlen=250"`
+
+	// AssignmentOfBenefits is IN1-20: Assignment Of Benefits (IS, optional, max length 2).
+	AssignmentOfBenefits string `hl7:"IN1.20,maxlen=2"`
+
+	// CoordinationOfBenefits is IN1-21: Coordination Of Benefits (IS, optional, max length 2).
+	CoordinationOfBenefits string `hl7:"IN1.21,maxlen=2"`
+
+	// CoordOfBenPriority is IN1-22: Coord Of Ben. Priority (ST, optional, max length 2).
+	CoordOfBenPriority string `hl7:"IN1.22,maxlen=2"`
+
+	// NoticeOfAdmissionFlag is IN1-23: Notice Of Admission Flag (ID, optional, max length 1).
+	NoticeOfAdmissionFlag string `hl7:"IN1.23,maxlen=1"`
+
+	// NoticeOfAdmissionDate is IN1-24: Notice Of Admission Date (DT, optional, max length 8).
+	NoticeOfAdmissionDate string `hl7:"IN1.24,maxlen=8"`
+
+	// ReportOfEligibilityFlag is IN1-25: Report Of Eligibility Flag (ID, optional, max length 1).
+	ReportOfEligibilityFlag string `hl7:"IN1.25,maxlen=1"`
+
+	// ReportOfEligibilityDate is IN1-26: Report Of Eligibility Date (DT, optional, max length 8).
+	ReportOfEligibilityDate string `hl7:"IN1.26,maxlen=8"`
+
+	// ReleaseInformationCode is IN1-27: Release Information Code (IS, optional, max length 2).
+	ReleaseInformationCode string `hl7:"IN1.27,maxlen=2"`
+
+	// PreAdmitCert is IN1-28: Pre-Admit Cert (PAC) (ST, optional, max length 15).
+	PreAdmitCert string `hl7:"IN1.28,maxlen=15"`
+
+	// VerificationDateTime is IN1-29: Verification Date/Time (TS, optional, max length 26).
+	VerificationDateTime string `hl7:"IN1.29,maxlen=26"`
+
+	// VerificationBy is IN1-30: Verification By (XCN, optional, repeating, max length 250).
+	VerificationBy []string `hl7:"IN1.30,maxlen=250"`
+
+	// TypeOfAgreementCode is IN1-31: Type Of Agreement Code (IS, optional, max length 2).
+	TypeOfAgreementCode string `hl7:"IN1.31,maxlen=2"`
+
+	// BillingStatus is IN1-32: Billing Status (IS, optional, max length 2).
+	BillingStatus string `hl7:"IN1.32,maxlen=2"`
+
+	// LifetimeReserveDays is IN1-33: Lifetime Reserve Days (NM, optional, max length 4).
+	LifetimeReserveDays string `hl7:"IN1.33,maxlen=4"`
+
+	// DelayBeforeLRDay is IN1-34: Delay Before L.R. Day (NM, optional, max length 4).
+	DelayBeforeLRDay string `hl7:"IN1.34,maxlen=4"`
+
+	// CompanyPlanCode is IN1-35: Company Plan Code (IS, optional, max length 8).
+	CompanyPlanCode string `hl7:"IN1.35,maxlen=8"`
+
+	// PolicyNumber is IN1-36: Policy Number (ST, optional, max length 15).
+	PolicyNumber string `hl7:"IN1.36,maxlen=15"`
+
+	// PolicyDeductible is IN1-37: Policy Deductible (CP, optional, max length 12).
+	PolicyDeductible string `hl7:"IN1.37,maxlen=12"`
+
+	// PolicyLimitAmount is IN1-38: Policy Limit - Amount (CP, backward compatibility, max length 12).
+	PolicyLimitAmount string `hl7:"IN1.38,maxlen=12"`
+
+	// PolicyLimitDays is IN1-39: Policy Limit - Days (NM, optional, max length 4).
+	PolicyLimitDays string `hl7:"IN1.39,maxlen=4"`
+
+	// RoomRateSemiPrivate is IN1-40: Room Rate - Semi-Private (CP, backward compatibility, max length 12).
+	RoomRateSemiPrivate string `hl7:"IN1.40,maxlen=12"`
+
+	// RoomRatePrivate is IN1-41: Room Rate - Private (CP, backward compatibility, max length 12).
+	RoomRatePrivate string `hl7:"IN1.41,maxlen=12"`
+
+	// InsuredEmploymentStatus is IN1-42: Insured's Employment Status (CE, optional, max length 250).
+	InsuredEmploymentStatus string `hl7:"IN1.42,maxlen=250"`
+
+	// InsuredAdministrativeSex is IN1-43: Insured's Administrative Sex (IS, optional, max length 1).
+	InsuredAdministrativeSex string `hl7:"IN1.43,maxlen=1"`
+
+	// InsuredEmployerAddress is IN1-44: Insured's Employer's Address (XAD, optional, repeating, max length 250).
+	InsuredEmployerAddress []string `hl7:"IN1.44,maxlen=250"`
+
+	// VerificationStatus is IN1-45: Verification Status (ST, optional, max length 2).
+	VerificationStatus string `hl7:"IN1.45,maxlen=2"`
+
+	// PriorInsurancePlanID is IN1-46: Prior Insurance Plan ID (IS, optional, max length 8).
+	PriorInsurancePlanID string `hl7:"IN1.46,maxlen=8"`
+
+	// CoverageType is IN1-47: Coverage Type (IS, optional, max length 3).
+	CoverageType string `hl7:"IN1.47,maxlen=3"`
+
+	// Handicap is IN1-48: Handicap (IS, optional, max length 2).
+	Handicap string `hl7:"IN1.48,maxlen=2"`
+
+	// InsuredIDNumber is IN1-49: Insured's ID Number (CX, optional, repeating, max length 250).
+	InsuredIDNumber []string `hl7:"IN1.49,maxlen=250"`
+
+	// SignatureCode is IN1-50: Signature Code (IS, optional, max length 1).
+	SignatureCode string `hl7:"IN1.50,maxlen=1"`
+
+	// SignatureCodeDate is IN1-51: Signature Code Date (DT, optional, max length 8).
+	SignatureCodeDate string `hl7:"IN1.51,maxlen=8"`
+
+	// InsuredBirthPlace is IN1-52: Insured's Birth Place (ST, optional, max length 250).
+	InsuredBirthPlace string `hl7:"IN1.52,maxlen=250"`
+
+	// VIPIndicator is IN1-53: VIP Indicator (IS, optional, max length 2).
+	VIPIndicator string `hl7:"IN1.53,maxlen=2"`
+}
+
+// ErrNotIN1Segment indicates the segment is not an IN1 segment.
+var ErrNotIN1Segment = fmt.Errorf("segment is not IN1")
+
+// ParseIN1 extracts IN1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an IN1 segment.
+func ParseIN1(seg hl7.Segment) (*IN1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "IN1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotIN1Segment, seg.Name())
+	}
+
+	in1 := &IN1{
+		SetID:                        getFieldValue(seg, 1),
+		InsurancePlanID:              getFieldValue(seg, 2),
+		InsuranceCompanyID:           getFieldRepetitions(seg, 3),
+		InsuranceCompanyName:         getFieldRepetitions(seg, 4),
+		InsuranceCompanyAddress:      getFieldRepetitions(seg, 5),
+		InsuranceCoContactPerson:     getFieldRepetitions(seg, 6),
+		InsuranceCoPhoneNumber:       getFieldRepetitions(seg, 7),
+		GroupNumber:                  getFieldValue(seg, 8),
+		GroupName:                    getFieldRepetitions(seg, 9),
+		InsuredGroupEmpID:            getFieldRepetitions(seg, 10),
+		InsuredGroupEmpName:          getFieldRepetitions(seg, 11),
+		PlanEffectiveDate:            getFieldValue(seg, 12),
+		PlanExpirationDate:           getFieldValue(seg, 13),
+		AuthorizationInformation:     getFieldValue(seg, 14),
+		PlanType:                     getFieldValue(seg, 15),
+		NameOfInsured:                getFieldRepetitions(seg, 16),
+		InsuredRelationshipToPatient: getFieldValue(seg, 17),
+		InsuredDateOfBirth:           getFieldValue(seg, 18),
+		InsuredAddress:               getFieldRepetitions(seg, 19),
+		AssignmentOfBenefits:         getFieldValue(seg, 20),
+		CoordinationOfBenefits:       getFieldValue(seg, 21),
+		CoordOfBenPriority:           getFieldValue(seg, 22),
+		NoticeOfAdmissionFlag:        getFieldValue(seg, 23),
+		NoticeOfAdmissionDate:        getFieldValue(seg, 24),
+		ReportOfEligibilityFlag:      getFieldValue(seg, 25),
+		ReportOfEligibilityDate:      getFieldValue(seg, 26),
+		ReleaseInformationCode:       getFieldValue(seg, 27),
+		PreAdmitCert:                 getFieldValue(seg, 28),
+		VerificationDateTime:         getFieldValue(seg, 29),
+		VerificationBy:               getFieldRepetitions(seg, 30),
+		TypeOfAgreementCode:          getFieldValue(seg, 31),
+		BillingStatus:                getFieldValue(seg, 32),
+		LifetimeReserveDays:          getFieldValue(seg, 33),
+		DelayBeforeLRDay:             getFieldValue(seg, 34),
+		CompanyPlanCode:              getFieldValue(seg, 35),
+		PolicyNumber:                 getFieldValue(seg, 36),
+		PolicyDeductible:             getFieldValue(seg, 37),
+		PolicyLimitAmount:            getFieldValue(seg, 38),
+		PolicyLimitDays:              getFieldValue(seg, 39),
+		RoomRateSemiPrivate:          getFieldValue(seg, 40),
+		RoomRatePrivate:              getFieldValue(seg, 41),
+		InsuredEmploymentStatus:      getFieldValue(seg, 42),
+		InsuredAdministrativeSex:     getFieldValue(seg, 43),
+		InsuredEmployerAddress:       getFieldRepetitions(seg, 44),
+		VerificationStatus:           getFieldValue(seg, 45),
+		PriorInsurancePlanID:         getFieldValue(seg, 46),
+		CoverageType:                 getFieldValue(seg, 47),
+		Handicap:                     getFieldValue(seg, 48),
+		InsuredIDNumber:              getFieldRepetitions(seg, 49),
+		SignatureCode:                getFieldValue(seg, 50),
+		SignatureCodeDate:            getFieldValue(seg, 51),
+		InsuredBirthPlace:            getFieldValue(seg, 52),
+		VIPIndicator:                 getFieldValue(seg, 53),
+	}
+
+	return in1, nil
+}
+
+// ToSegment converts the IN1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (i *IN1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		i.SetID,
+		i.InsurancePlanID,
+		joinRepetitions(i.InsuranceCompanyID, delims),
+		joinRepetitions(i.InsuranceCompanyName, delims),
+		joinRepetitions(i.InsuranceCompanyAddress, delims),
+		joinRepetitions(i.InsuranceCoContactPerson, delims),
+		joinRepetitions(i.InsuranceCoPhoneNumber, delims),
+		i.GroupNumber,
+		joinRepetitions(i.GroupName, delims),
+		joinRepetitions(i.InsuredGroupEmpID, delims),
+		joinRepetitions(i.InsuredGroupEmpName, delims),
+		i.PlanEffectiveDate,
+		i.PlanExpirationDate,
+		i.AuthorizationInformation,
+		i.PlanType,
+		joinRepetitions(i.NameOfInsured, delims),
+		i.InsuredRelationshipToPatient,
+		i.InsuredDateOfBirth,
+		joinRepetitions(i.InsuredAddress, delims),
+		i.AssignmentOfBenefits,
+		i.CoordinationOfBenefits,
+		i.CoordOfBenPriority,
+		i.NoticeOfAdmissionFlag,
+		i.NoticeOfAdmissionDate,
+		i.ReportOfEligibilityFlag,
+		i.ReportOfEligibilityDate,
+		i.ReleaseInformationCode,
+		i.PreAdmitCert,
+		i.VerificationDateTime,
+		joinRepetitions(i.VerificationBy, delims),
+		i.TypeOfAgreementCode,
+		i.BillingStatus,
+		i.LifetimeReserveDays,
+		i.DelayBeforeLRDay,
+		i.CompanyPlanCode,
+		i.PolicyNumber,
+		i.PolicyDeductible,
+		i.PolicyLimitAmount,
+		i.PolicyLimitDays,
+		i.RoomRateSemiPrivate,
+		i.RoomRatePrivate,
+		i.InsuredEmploymentStatus,
+		i.InsuredAdministrativeSex,
+		joinRepetitions(i.InsuredEmployerAddress, delims),
+		i.VerificationStatus,
+		i.PriorInsurancePlanID,
+		i.CoverageType,
+		i.Handicap,
+		joinRepetitions(i.InsuredIDNumber, delims),
+		i.SignatureCode,
+		i.SignatureCodeDate,
+		i.InsuredBirthPlace,
+		i.VIPIndicator,
+	}
+
+	data := buildSegmentData("IN1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IN1 segment: %w", err)
+	}
+
+	return seg, nil
+}
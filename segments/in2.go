@@ -0,0 +1,416 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// IN2 represents the Insurance Additional Information segment.
+//
+// Field positions follow the HL7 standard where IN2-1 is the first field
+// after the segment name.
+type IN2 struct {
+	// InsuredsEmployeeID is IN2-1: Insured's Employee ID (CX, optional, repeating, max length 250).
+	InsuredsEmployeeID []string `hl7:"IN2.1,maxlen=250"`
+
+	// InsuredsSocialSecurityNumber is IN2-2: Insured's Social Security Number (ST, optional, max length 11).
+	InsuredsSocialSecurityNumber string `hl7:"IN2.2,maxlen=11"`
+
+	// InsuredsEmployersNameAndID is IN2-3: Insured's Employer's Name and ID (XCN, optional, repeating, max length 250).
+	InsuredsEmployersNameAndID []string `hl7:"IN2.3,maxlen=250"`
+
+	// EmployerInformationData is IN2-4: Employer Information Data (IS, optional, max length 1).
+	EmployerInformationData string `hl7:"IN2.4,maxlen=1"`
+
+	// MailClaimParty is IN2-5: Mail Claim Party (IS, optional, repeating, max length 1).
+	MailClaimParty []string `hl7:"IN2.5,maxlen=1"`
+
+	// MedicareHealthInsCardNumber is IN2-6: Medicare Health Ins Card Number (ST, optional, max length 15).
+	MedicareHealthInsCardNumber string `hl7:"IN2.6,maxlen=15"`
+
+	// MedicaidCaseName is IN2-7: Medicaid Case Name (XPN, optional, repeating, max length 250).
+	MedicaidCaseName []string `hl7:"IN2.7,maxlen=250"`
+
+	// MedicaidCaseNumber is IN2-8: Medicaid Case Number (ST, optional, max length 15).
+	MedicaidCaseNumber string `hl7:"IN2.8,maxlen=15"`
+
+	// MilitarySponsorName is IN2-9: Military Sponsor Name (XPN, optional, repeating, max length 250).
+	MilitarySponsorName []string `hl7:"IN2.9,maxlen=250"`
+
+	// MilitaryIDNumber is IN2-10: Military ID Number (ST, optional, max length 20).
+	MilitaryIDNumber string `hl7:"IN2.10,maxlen=20"`
+
+	// DependentOfMilitaryRecipient is IN2-11: Dependent Of Military Recipient (CE, optional, max length 250).
+	DependentOfMilitaryRecipient string `hl7:"IN2.11,maxlen=250"`
+
+	// MilitaryOrganization is IN2-12: Military Organization (ST, optional, max length 25).
+	MilitaryOrganization string `hl7:"IN2.12,maxlen=25"`
+
+	// MilitaryStation is IN2-13: Military Station (ST, optional, max length 25).
+	MilitaryStation string `hl7:"IN2.13,maxlen=25"`
+
+	// MilitaryService is IN2-14: Military Service (IS, optional, max length 14).
+	MilitaryService string `hl7:"IN2.14,maxlen=14"`
+
+	// MilitaryRankGrade is IN2-15: Military Rank/Grade (IS, optional, max length 2).
+	MilitaryRankGrade string `hl7:"IN2.15,maxlen=2"`
+
+	// MilitaryStatus is IN2-16: Military Status (IS, optional, max length 3).
+	MilitaryStatus string `hl7:"IN2.16,maxlen=3"`
+
+	// MilitaryRetireDate is IN2-17: Military Retire Date (DT, optional, max length 8).
+	MilitaryRetireDate string `hl7:"IN2.17,maxlen=8"`
+
+	// MilitaryNonAvailCertOnFile is IN2-18: Military Non-Avail Cert On File (ID, optional, max length 1).
+	MilitaryNonAvailCertOnFile string `hl7:"IN2.18,maxlen=1"`
+
+	// BabyCoverage is IN2-19: Baby Coverage (ID, optional, max length 1).
+	BabyCoverage string `hl7:"IN2.19,maxlen=1"`
+
+	// CombineBabyBill is IN2-20: Combine Baby Bill (ID, optional, max length 1).
+	CombineBabyBill string `hl7:"IN2.20,maxlen=1"`
+
+	// BloodDeductible is IN2-21: Blood Deductible (ST, optional, max length 1).
+	BloodDeductible string `hl7:"IN2.21,maxlen=1"`
+
+	// SpecialCoverageApprovalName is IN2-22: Special Coverage Approval Name (XPN, optional, repeating, max length 250).
+	SpecialCoverageApprovalName []string `hl7:"IN2.22,maxlen=250"`
+
+	// SpecialCoverageApprovalTitle is IN2-23: Special Coverage Approval Title (ST, optional, max length 30).
+	SpecialCoverageApprovalTitle string `hl7:"IN2.23,maxlen=30"`
+
+	// NonCoveredInsuranceCode is IN2-24: Non-Covered Insurance Code (IS, optional, repeating, max length 8).
+	NonCoveredInsuranceCode []string `hl7:"IN2.24,maxlen=8"`
+
+	// PayorID is IN2-25: Payor ID (CX, optional, repeating, max length 250).
+	PayorID []string `hl7:"IN2.25,maxlen=250"`
+
+	// PayorSubscriberID is IN2-26: Payor Subscriber ID (CX, optional, repeating, max length 250).
+	PayorSubscriberID []string `hl7:"IN2.26,maxlen=250"`
+
+	// EligibilitySource is IN2-27: Eligibility Source (IS, optional, max length 1).
+	EligibilitySource string `hl7:"IN2.27,maxlen=1"`
+
+	// RoomCoverageTypeAmount is IN2-28: Room Coverage Type/Amount (RMC, optional, repeating, max length 82).
+	RoomCoverageTypeAmount []string `hl7:"IN2.28,maxlen=82"`
+
+	// PolicyTypeAmount is IN2-29: Policy Type/Amount (PTA, optional, repeating, max length 56).
+	PolicyTypeAmount []string `hl7:"IN2.29,maxlen=56"`
+
+	// DailyDeductible is IN2-30: Daily Deductible (DDI, optional, max length 25).
+	DailyDeductible string `hl7:"IN2.30,maxlen=25"`
+
+	// LivingDependency is IN2-31: Living Dependency (IS, optional, max length 2).
+	LivingDependency string `hl7:"IN2.31,maxlen=2"`
+
+	// AmbulatoryStatus is IN2-32: Ambulatory Status (IS, optional, repeating, max length 2).
+	AmbulatoryStatus []string `hl7:"IN2.32,maxlen=2"`
+
+	// Citizenship is IN2-33: Citizenship (CE, optional, repeating, max length 250).
+	Citizenship []string `hl7:"IN2.33,maxlen=250"`
+
+	// PrimaryLanguage is IN2-34: Primary Language (CE, optional, max length 250).
+	PrimaryLanguage string `hl7:"IN2.34,maxlen=250"`
+
+	// LivingArrangement is IN2-35: Living Arrangement (IS, optional, max length 2).
+	LivingArrangement string `hl7:"IN2.35,maxlen=2"`
+
+	// PublicityCode is IN2-36: Publicity Code (CE, optional, max length 250).
+	PublicityCode string `hl7:"IN2.36,maxlen=250"`
+
+	// ProtectionIndicator is IN2-37: Protection Indicator (ID, optional, max length 1).
+	ProtectionIndicator string `hl7:"IN2.37,maxlen=1"`
+
+	// StudentIndicator is IN2-38: Student Indicator (IS, optional, max length 2).
+	StudentIndicator string `hl7:"IN2.38,maxlen=2"`
+
+	// Religion is IN2-39: Religion (CE, optional, max length 250).
+	Religion string `hl7:"IN2.39,maxlen=250"`
+
+	// MothersMaidenName is IN2-40: Mother's Maiden Name (XPN, optional, repeating, max length 250).
+	MothersMaidenName []string `hl7:"IN2.40,maxlen=250"`
+
+	// Nationality is IN2-41: Nationality (CE, optional, max length 250).
+	Nationality string `hl7:"IN2.41,maxlen=250"`
+
+	// EthnicGroup is IN2-42: Ethnic Group (CE, optional, repeating, max length 250).
+	EthnicGroup []string `hl7:"IN2.42,maxlen=250"`
+
+	// MaritalStatus is IN2-43: Marital Status (CE, optional, repeating, max length 250).
+	MaritalStatus []string `hl7:"IN2.43,maxlen=250"`
+
+	// InsuredsEmploymentStartDate is IN2-44: Insured's Employment Start Date (DT, optional, max length 8).
+	InsuredsEmploymentStartDate string `hl7:"IN2.44,maxlen=8"`
+
+	// EmploymentStopDate is IN2-45: Employment Stop Date (DT, optional, max length 8).
+	EmploymentStopDate string `hl7:"IN2.45,maxlen=8"`
+
+	// JobTitle is IN2-46: Job Title (ST, optional, max length 20).
+	JobTitle string `hl7:"IN2.46,maxlen=20"`
+
+	// JobCodeClass is IN2-47: Job Code/Class (JCC, optional, max length 20).
+	JobCodeClass string `hl7:"IN2.47,maxlen=20"`
+
+	// JobStatus is IN2-48: Job Status (IS, optional, max length 2).
+	JobStatus string `hl7:"IN2.48,maxlen=2"`
+
+	// EmployerContactPersonName is IN2-49: Employer Contact Person Name (XPN, optional, repeating, max length 250).
+	EmployerContactPersonName []string `hl7:"IN2.49,maxlen=250"`
+
+	// EmployerContactPersonPhoneNumber is IN2-50: Employer Contact Person Phone Number (XTN, optional, repeating, max length 250).
+	EmployerContactPersonPhoneNumber []string `hl7:"IN2.50,maxlen=250"`
+
+	// EmployerContactReason is IN2-51: Employer Contact Reason (IS, optional, max length 2).
+	EmployerContactReason string `hl7:"IN2.51,maxlen=2"`
+
+	// InsuredsContactPersonsName is IN2-52: Insured's Contact Person's Name (XPN, optional, repeating, max length 250).
+	InsuredsContactPersonsName []string `hl7:"IN2.52,maxlen=250"`
+
+	// InsuredsContactPersonPhoneNumber is IN2-53: Insured's Contact Person Phone Number (XTN, optional, repeating, max length 250).
+	InsuredsContactPersonPhoneNumber []string `hl7:"IN2.53,maxlen=250"`
+
+	// InsuredsContactPersonReason is IN2-54: Insured's Contact Person Reason (IS, optional, repeating, max length 2).
+	InsuredsContactPersonReason []string `hl7:"IN2.54,maxlen=2"`
+
+	// RelationshipToThePatientStartDate is IN2-55: Relationship to the Patient Start Date (DT, optional, max length 8).
+	RelationshipToThePatientStartDate string `hl7:"IN2.55,maxlen=8"`
+
+	// RelationshipToThePatientStopDate is IN2-56: Relationship to the Patient Stop Date (DT, optional, repeating, max length 8).
+	RelationshipToThePatientStopDate []string `hl7:"IN2.56,maxlen=8"`
+
+	// InsuranceCoContactReason is IN2-57: Insurance Co. Contact Reason (IS, optional, max length 2).
+	InsuranceCoContactReason string `hl7:"IN2.57,maxlen=2"`
+
+	// InsuranceCoContactPhoneNumber is IN2-58: Insurance Co Contact Phone Number (XTN, optional, max length 250).
+	InsuranceCoContactPhoneNumber string `hl7:"IN2.58,maxlen=250"`
+
+	// PolicyScope is IN2-59: Policy Scope (IS, optional, max length 2).
+	PolicyScope string `hl7:"IN2.59,maxlen=2"`
+
+	// PolicySource is IN2-60: Policy Source (IS, optional, max length 2).
+	PolicySource string `hl7:"IN2.60,maxlen=2"`
+
+	// PatientMemberNumber is IN2-61: Patient Member Number (CX, optional, max length 250).
+	PatientMemberNumber string `hl7:"IN2.61,maxlen=250"`
+
+	// GuarantorsRelationshipToInsured is IN2-62: Guarantor's Relationship To Insured (CE, optional, max length 250).
+	GuarantorsRelationshipToInsured string `hl7:"IN2.62,maxlen=250"`
+
+	// InsuredsPhoneNumberHome is IN2-63: Insured's Phone Number - Home (XTN, optional, repeating, max length 250).
+	InsuredsPhoneNumberHome []string `hl7:"IN2.63,maxlen=250"`
+
+	// InsuredsEmployerPhoneNumber is IN2-64: Insured's Employer Phone Number (XTN, optional, repeating, max length 250).
+	InsuredsEmployerPhoneNumber []string `hl7:"IN2.64,maxlen=250"`
+
+	// MilitaryHandicappedProgram is IN2-65: Military Handicapped Program (CE, optional, max length 250).
+	MilitaryHandicappedProgram string `hl7:"IN2.65,maxlen=250"`
+
+	// SuspendFlag is IN2-66: Suspend Flag (ID, optional, max length 1).
+	SuspendFlag string `hl7:"IN2.66,maxlen=1"`
+
+	// CopayLimitFlag is IN2-67: Copay Limit Flag (ID, optional, max length 1).
+	CopayLimitFlag string `hl7:"IN2.67,maxlen=1"`
+
+	// StoplossLimitFlag is IN2-68: Stoploss Limit Flag (ID, optional, max length 1).
+	StoplossLimitFlag string `hl7:"IN2.68,maxlen=1"`
+
+	// InsuredOrganizationNameAndID is IN2-69: Insured Organization Name and ID (XON, optional, repeating, max length 250).
+	InsuredOrganizationNameAndID []string `hl7:"IN2.69,maxlen=250"`
+
+	// InsuredEmployerOrganizationNameAndID is IN2-70: Insured Employer Organization Name and ID (XON, optional, repeating, max length 250).
+	InsuredEmployerOrganizationNameAndID []string `hl7:"IN2.70,maxlen=250"`
+
+	// Race is IN2-71: Race (CE, optional, repeating, max length 250).
+	Race []string `hl7:"IN2.71,maxlen=250"`
+
+	// CMSPatientsRelationshipToInsured is IN2-72: CMS Patient's Relationship to Insured (CE, optional, max length 250).
+	CMSPatientsRelationshipToInsured string `hl7:"IN2.72,maxlen=250"`
+}
+
+// ErrNotIN2Segment indicates the segment is not an IN2 segment.
+var ErrNotIN2Segment = fmt.Errorf("segment is not IN2")
+
+// ParseIN2 extracts IN2 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an IN2 segment.
+func ParseIN2(seg hl7.Segment) (*IN2, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "IN2" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotIN2Segment, seg.Name())
+	}
+
+	in2 := &IN2{
+		InsuredsEmployeeID:                   getFieldRepetitions(seg, 1),
+		InsuredsSocialSecurityNumber:         getFieldValue(seg, 2),
+		InsuredsEmployersNameAndID:           getFieldRepetitions(seg, 3),
+		EmployerInformationData:              getFieldValue(seg, 4),
+		MailClaimParty:                       getFieldRepetitions(seg, 5),
+		MedicareHealthInsCardNumber:          getFieldValue(seg, 6),
+		MedicaidCaseName:                     getFieldRepetitions(seg, 7),
+		MedicaidCaseNumber:                   getFieldValue(seg, 8),
+		MilitarySponsorName:                  getFieldRepetitions(seg, 9),
+		MilitaryIDNumber:                     getFieldValue(seg, 10),
+		DependentOfMilitaryRecipient:         getFieldValue(seg, 11),
+		MilitaryOrganization:                 getFieldValue(seg, 12),
+		MilitaryStation:                      getFieldValue(seg, 13),
+		MilitaryService:                      getFieldValue(seg, 14),
+		MilitaryRankGrade:                    getFieldValue(seg, 15),
+		MilitaryStatus:                       getFieldValue(seg, 16),
+		MilitaryRetireDate:                   getFieldValue(seg, 17),
+		MilitaryNonAvailCertOnFile:           getFieldValue(seg, 18),
+		BabyCoverage:                         getFieldValue(seg, 19),
+		CombineBabyBill:                      getFieldValue(seg, 20),
+		BloodDeductible:                      getFieldValue(seg, 21),
+		SpecialCoverageApprovalName:          getFieldRepetitions(seg, 22),
+		SpecialCoverageApprovalTitle:         getFieldValue(seg, 23),
+		NonCoveredInsuranceCode:              getFieldRepetitions(seg, 24),
+		PayorID:                              getFieldRepetitions(seg, 25),
+		PayorSubscriberID:                    getFieldRepetitions(seg, 26),
+		EligibilitySource:                    getFieldValue(seg, 27),
+		RoomCoverageTypeAmount:               getFieldRepetitions(seg, 28),
+		PolicyTypeAmount:                     getFieldRepetitions(seg, 29),
+		DailyDeductible:                      getFieldValue(seg, 30),
+		LivingDependency:                     getFieldValue(seg, 31),
+		AmbulatoryStatus:                     getFieldRepetitions(seg, 32),
+		Citizenship:                          getFieldRepetitions(seg, 33),
+		PrimaryLanguage:                      getFieldValue(seg, 34),
+		LivingArrangement:                    getFieldValue(seg, 35),
+		PublicityCode:                        getFieldValue(seg, 36),
+		ProtectionIndicator:                  getFieldValue(seg, 37),
+		StudentIndicator:                     getFieldValue(seg, 38),
+		Religion:                             getFieldValue(seg, 39),
+		MothersMaidenName:                    getFieldRepetitions(seg, 40),
+		Nationality:                          getFieldValue(seg, 41),
+		EthnicGroup:                          getFieldRepetitions(seg, 42),
+		MaritalStatus:                        getFieldRepetitions(seg, 43),
+		InsuredsEmploymentStartDate:          getFieldValue(seg, 44),
+		EmploymentStopDate:                   getFieldValue(seg, 45),
+		JobTitle:                             getFieldValue(seg, 46),
+		JobCodeClass:                         getFieldValue(seg, 47),
+		JobStatus:                            getFieldValue(seg, 48),
+		EmployerContactPersonName:            getFieldRepetitions(seg, 49),
+		EmployerContactPersonPhoneNumber:     getFieldRepetitions(seg, 50),
+		EmployerContactReason:                getFieldValue(seg, 51),
+		InsuredsContactPersonsName:           getFieldRepetitions(seg, 52),
+		InsuredsContactPersonPhoneNumber:     getFieldRepetitions(seg, 53),
+		InsuredsContactPersonReason:          getFieldRepetitions(seg, 54),
+		RelationshipToThePatientStartDate:    getFieldValue(seg, 55),
+		RelationshipToThePatientStopDate:     getFieldRepetitions(seg, 56),
+		InsuranceCoContactReason:             getFieldValue(seg, 57),
+		InsuranceCoContactPhoneNumber:        getFieldValue(seg, 58),
+		PolicyScope:                          getFieldValue(seg, 59),
+		PolicySource:                         getFieldValue(seg, 60),
+		PatientMemberNumber:                  getFieldValue(seg, 61),
+		GuarantorsRelationshipToInsured:      getFieldValue(seg, 62),
+		InsuredsPhoneNumberHome:              getFieldRepetitions(seg, 63),
+		InsuredsEmployerPhoneNumber:          getFieldRepetitions(seg, 64),
+		MilitaryHandicappedProgram:           getFieldValue(seg, 65),
+		SuspendFlag:                          getFieldValue(seg, 66),
+		CopayLimitFlag:                       getFieldValue(seg, 67),
+		StoplossLimitFlag:                    getFieldValue(seg, 68),
+		InsuredOrganizationNameAndID:         getFieldRepetitions(seg, 69),
+		InsuredEmployerOrganizationNameAndID: getFieldRepetitions(seg, 70),
+		Race:                                 getFieldRepetitions(seg, 71),
+		CMSPatientsRelationshipToInsured:     getFieldValue(seg, 72),
+	}
+
+	return in2, nil
+}
+
+// ToSegment converts the IN2 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (i *IN2) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		joinRepetitions(i.InsuredsEmployeeID, delims),
+		i.InsuredsSocialSecurityNumber,
+		joinRepetitions(i.InsuredsEmployersNameAndID, delims),
+		i.EmployerInformationData,
+		joinRepetitions(i.MailClaimParty, delims),
+		i.MedicareHealthInsCardNumber,
+		joinRepetitions(i.MedicaidCaseName, delims),
+		i.MedicaidCaseNumber,
+		joinRepetitions(i.MilitarySponsorName, delims),
+		i.MilitaryIDNumber,
+		i.DependentOfMilitaryRecipient,
+		i.MilitaryOrganization,
+		i.MilitaryStation,
+		i.MilitaryService,
+		i.MilitaryRankGrade,
+		i.MilitaryStatus,
+		i.MilitaryRetireDate,
+		i.MilitaryNonAvailCertOnFile,
+		i.BabyCoverage,
+		i.CombineBabyBill,
+		i.BloodDeductible,
+		joinRepetitions(i.SpecialCoverageApprovalName, delims),
+		i.SpecialCoverageApprovalTitle,
+		joinRepetitions(i.NonCoveredInsuranceCode, delims),
+		joinRepetitions(i.PayorID, delims),
+		joinRepetitions(i.PayorSubscriberID, delims),
+		i.EligibilitySource,
+		joinRepetitions(i.RoomCoverageTypeAmount, delims),
+		joinRepetitions(i.PolicyTypeAmount, delims),
+		i.DailyDeductible,
+		i.LivingDependency,
+		joinRepetitions(i.AmbulatoryStatus, delims),
+		joinRepetitions(i.Citizenship, delims),
+		i.PrimaryLanguage,
+		i.LivingArrangement,
+		i.PublicityCode,
+		i.ProtectionIndicator,
+		i.StudentIndicator,
+		i.Religion,
+		joinRepetitions(i.MothersMaidenName, delims),
+		i.Nationality,
+		joinRepetitions(i.EthnicGroup, delims),
+		joinRepetitions(i.MaritalStatus, delims),
+		i.InsuredsEmploymentStartDate,
+		i.EmploymentStopDate,
+		i.JobTitle,
+		i.JobCodeClass,
+		i.JobStatus,
+		joinRepetitions(i.EmployerContactPersonName, delims),
+		joinRepetitions(i.EmployerContactPersonPhoneNumber, delims),
+		i.EmployerContactReason,
+		joinRepetitions(i.InsuredsContactPersonsName, delims),
+		joinRepetitions(i.InsuredsContactPersonPhoneNumber, delims),
+		joinRepetitions(i.InsuredsContactPersonReason, delims),
+		i.RelationshipToThePatientStartDate,
+		joinRepetitions(i.RelationshipToThePatientStopDate, delims),
+		i.InsuranceCoContactReason,
+		i.InsuranceCoContactPhoneNumber,
+		i.PolicyScope,
+		i.PolicySource,
+		i.PatientMemberNumber,
+		i.GuarantorsRelationshipToInsured,
+		joinRepetitions(i.InsuredsPhoneNumberHome, delims),
+		joinRepetitions(i.InsuredsEmployerPhoneNumber, delims),
+		i.MilitaryHandicappedProgram,
+		i.SuspendFlag,
+		i.CopayLimitFlag,
+		i.StoplossLimitFlag,
+		joinRepetitions(i.InsuredOrganizationNameAndID, delims),
+		joinRepetitions(i.InsuredEmployerOrganizationNameAndID, delims),
+		joinRepetitions(i.Race, delims),
+		i.CMSPatientsRelationshipToInsured,
+	}
+
+	data := buildSegmentData("IN2", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IN2 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,210 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseIN2(t *testing.T) {
+	input := "IN2|IN2-1a~IN2-1b|IN2-2|IN2-3a~IN2-3b|IN2-4|IN2-5a~IN2-5b|IN2-6|IN2-7a~IN2-7b|IN2-8|IN2-9a~IN2-9b|IN2-10|IN2-11|IN2-12|IN2-13|IN2-14|IN2-15|IN2-16|IN2-17|IN2-18|IN2-19|IN2-20|IN2-21|IN2-22a~IN2-22b|IN2-23|IN2-24a~IN2-24b|IN2-25a~IN2-25b|IN2-26a~IN2-26b|IN2-27|IN2-28a~IN2-28b|IN2-29a~IN2-29b|IN2-30|IN2-31|IN2-32a~IN2-32b|IN2-33a~IN2-33b|IN2-34|IN2-35|IN2-36|IN2-37|IN2-38|IN2-39|IN2-40a~IN2-40b|IN2-41|IN2-42a~IN2-42b|IN2-43a~IN2-43b|IN2-44|IN2-45|IN2-46|IN2-47|IN2-48|IN2-49a~IN2-49b|IN2-50a~IN2-50b|IN2-51|IN2-52a~IN2-52b|IN2-53a~IN2-53b|IN2-54a~IN2-54b|IN2-55|IN2-56a~IN2-56b|IN2-57|IN2-58|IN2-59|IN2-60|IN2-61|IN2-62|IN2-63a~IN2-63b|IN2-64a~IN2-64b|IN2-65|IN2-66|IN2-67|IN2-68|IN2-69a~IN2-69b|IN2-70a~IN2-70b|IN2-71a~IN2-71b|IN2-72"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseIN2(seg)
+	if err != nil {
+		t.Fatalf("ParseIN2() unexpected error: %v", err)
+	}
+
+	want := IN2{
+		InsuredsEmployeeID:                   []string{"IN2-1a", "IN2-1b"},
+		InsuredsSocialSecurityNumber:         "IN2-2",
+		InsuredsEmployersNameAndID:           []string{"IN2-3a", "IN2-3b"},
+		EmployerInformationData:              "IN2-4",
+		MailClaimParty:                       []string{"IN2-5a", "IN2-5b"},
+		MedicareHealthInsCardNumber:          "IN2-6",
+		MedicaidCaseName:                     []string{"IN2-7a", "IN2-7b"},
+		MedicaidCaseNumber:                   "IN2-8",
+		MilitarySponsorName:                  []string{"IN2-9a", "IN2-9b"},
+		MilitaryIDNumber:                     "IN2-10",
+		DependentOfMilitaryRecipient:         "IN2-11",
+		MilitaryOrganization:                 "IN2-12",
+		MilitaryStation:                      "IN2-13",
+		MilitaryService:                      "IN2-14",
+		MilitaryRankGrade:                    "IN2-15",
+		MilitaryStatus:                       "IN2-16",
+		MilitaryRetireDate:                   "IN2-17",
+		MilitaryNonAvailCertOnFile:           "IN2-18",
+		BabyCoverage:                         "IN2-19",
+		CombineBabyBill:                      "IN2-20",
+		BloodDeductible:                      "IN2-21",
+		SpecialCoverageApprovalName:          []string{"IN2-22a", "IN2-22b"},
+		SpecialCoverageApprovalTitle:         "IN2-23",
+		NonCoveredInsuranceCode:              []string{"IN2-24a", "IN2-24b"},
+		PayorID:                              []string{"IN2-25a", "IN2-25b"},
+		PayorSubscriberID:                    []string{"IN2-26a", "IN2-26b"},
+		EligibilitySource:                    "IN2-27",
+		RoomCoverageTypeAmount:               []string{"IN2-28a", "IN2-28b"},
+		PolicyTypeAmount:                     []string{"IN2-29a", "IN2-29b"},
+		DailyDeductible:                      "IN2-30",
+		LivingDependency:                     "IN2-31",
+		AmbulatoryStatus:                     []string{"IN2-32a", "IN2-32b"},
+		Citizenship:                          []string{"IN2-33a", "IN2-33b"},
+		PrimaryLanguage:                      "IN2-34",
+		LivingArrangement:                    "IN2-35",
+		PublicityCode:                        "IN2-36",
+		ProtectionIndicator:                  "IN2-37",
+		StudentIndicator:                     "IN2-38",
+		Religion:                             "IN2-39",
+		MothersMaidenName:                    []string{"IN2-40a", "IN2-40b"},
+		Nationality:                          "IN2-41",
+		EthnicGroup:                          []string{"IN2-42a", "IN2-42b"},
+		MaritalStatus:                        []string{"IN2-43a", "IN2-43b"},
+		InsuredsEmploymentStartDate:          "IN2-44",
+		EmploymentStopDate:                   "IN2-45",
+		JobTitle:                             "IN2-46",
+		JobCodeClass:                         "IN2-47",
+		JobStatus:                            "IN2-48",
+		EmployerContactPersonName:            []string{"IN2-49a", "IN2-49b"},
+		EmployerContactPersonPhoneNumber:     []string{"IN2-50a", "IN2-50b"},
+		EmployerContactReason:                "IN2-51",
+		InsuredsContactPersonsName:           []string{"IN2-52a", "IN2-52b"},
+		InsuredsContactPersonPhoneNumber:     []string{"IN2-53a", "IN2-53b"},
+		InsuredsContactPersonReason:          []string{"IN2-54a", "IN2-54b"},
+		RelationshipToThePatientStartDate:    "IN2-55",
+		RelationshipToThePatientStopDate:     []string{"IN2-56a", "IN2-56b"},
+		InsuranceCoContactReason:             "IN2-57",
+		InsuranceCoContactPhoneNumber:        "IN2-58",
+		PolicyScope:                          "IN2-59",
+		PolicySource:                         "IN2-60",
+		PatientMemberNumber:                  "IN2-61",
+		GuarantorsRelationshipToInsured:      "IN2-62",
+		InsuredsPhoneNumberHome:              []string{"IN2-63a", "IN2-63b"},
+		InsuredsEmployerPhoneNumber:          []string{"IN2-64a", "IN2-64b"},
+		MilitaryHandicappedProgram:           "IN2-65",
+		SuspendFlag:                          "IN2-66",
+		CopayLimitFlag:                       "IN2-67",
+		StoplossLimitFlag:                    "IN2-68",
+		InsuredOrganizationNameAndID:         []string{"IN2-69a", "IN2-69b"},
+		InsuredEmployerOrganizationNameAndID: []string{"IN2-70a", "IN2-70b"},
+		Race:                                 []string{"IN2-71a", "IN2-71b"},
+		CMSPatientsRelationshipToInsured:     "IN2-72",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseIN2() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseIN2_WrongSegment(t *testing.T) {
+	if _, err := ParseIN2(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseIN2(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseIN2(seg); !errors.Is(err, ErrNotIN2Segment) {
+		t.Errorf("ParseIN2() error = %v, want ErrNotIN2Segment", err)
+	}
+}
+
+func TestIN2_RoundTrip(t *testing.T) {
+	original := &IN2{
+		InsuredsEmployeeID:                   []string{"IN2-1a", "IN2-1b"},
+		InsuredsSocialSecurityNumber:         "IN2-2",
+		InsuredsEmployersNameAndID:           []string{"IN2-3a", "IN2-3b"},
+		EmployerInformationData:              "IN2-4",
+		MailClaimParty:                       []string{"IN2-5a", "IN2-5b"},
+		MedicareHealthInsCardNumber:          "IN2-6",
+		MedicaidCaseName:                     []string{"IN2-7a", "IN2-7b"},
+		MedicaidCaseNumber:                   "IN2-8",
+		MilitarySponsorName:                  []string{"IN2-9a", "IN2-9b"},
+		MilitaryIDNumber:                     "IN2-10",
+		DependentOfMilitaryRecipient:         "IN2-11",
+		MilitaryOrganization:                 "IN2-12",
+		MilitaryStation:                      "IN2-13",
+		MilitaryService:                      "IN2-14",
+		MilitaryRankGrade:                    "IN2-15",
+		MilitaryStatus:                       "IN2-16",
+		MilitaryRetireDate:                   "IN2-17",
+		MilitaryNonAvailCertOnFile:           "IN2-18",
+		BabyCoverage:                         "IN2-19",
+		CombineBabyBill:                      "IN2-20",
+		BloodDeductible:                      "IN2-21",
+		SpecialCoverageApprovalName:          []string{"IN2-22a", "IN2-22b"},
+		SpecialCoverageApprovalTitle:         "IN2-23",
+		NonCoveredInsuranceCode:              []string{"IN2-24a", "IN2-24b"},
+		PayorID:                              []string{"IN2-25a", "IN2-25b"},
+		PayorSubscriberID:                    []string{"IN2-26a", "IN2-26b"},
+		EligibilitySource:                    "IN2-27",
+		RoomCoverageTypeAmount:               []string{"IN2-28a", "IN2-28b"},
+		PolicyTypeAmount:                     []string{"IN2-29a", "IN2-29b"},
+		DailyDeductible:                      "IN2-30",
+		LivingDependency:                     "IN2-31",
+		AmbulatoryStatus:                     []string{"IN2-32a", "IN2-32b"},
+		Citizenship:                          []string{"IN2-33a", "IN2-33b"},
+		PrimaryLanguage:                      "IN2-34",
+		LivingArrangement:                    "IN2-35",
+		PublicityCode:                        "IN2-36",
+		ProtectionIndicator:                  "IN2-37",
+		StudentIndicator:                     "IN2-38",
+		Religion:                             "IN2-39",
+		MothersMaidenName:                    []string{"IN2-40a", "IN2-40b"},
+		Nationality:                          "IN2-41",
+		EthnicGroup:                          []string{"IN2-42a", "IN2-42b"},
+		MaritalStatus:                        []string{"IN2-43a", "IN2-43b"},
+		InsuredsEmploymentStartDate:          "IN2-44",
+		EmploymentStopDate:                   "IN2-45",
+		JobTitle:                             "IN2-46",
+		JobCodeClass:                         "IN2-47",
+		JobStatus:                            "IN2-48",
+		EmployerContactPersonName:            []string{"IN2-49a", "IN2-49b"},
+		EmployerContactPersonPhoneNumber:     []string{"IN2-50a", "IN2-50b"},
+		EmployerContactReason:                "IN2-51",
+		InsuredsContactPersonsName:           []string{"IN2-52a", "IN2-52b"},
+		InsuredsContactPersonPhoneNumber:     []string{"IN2-53a", "IN2-53b"},
+		InsuredsContactPersonReason:          []string{"IN2-54a", "IN2-54b"},
+		RelationshipToThePatientStartDate:    "IN2-55",
+		RelationshipToThePatientStopDate:     []string{"IN2-56a", "IN2-56b"},
+		InsuranceCoContactReason:             "IN2-57",
+		InsuranceCoContactPhoneNumber:        "IN2-58",
+		PolicyScope:                          "IN2-59",
+		PolicySource:                         "IN2-60",
+		PatientMemberNumber:                  "IN2-61",
+		GuarantorsRelationshipToInsured:      "IN2-62",
+		InsuredsPhoneNumberHome:              []string{"IN2-63a", "IN2-63b"},
+		InsuredsEmployerPhoneNumber:          []string{"IN2-64a", "IN2-64b"},
+		MilitaryHandicappedProgram:           "IN2-65",
+		SuspendFlag:                          "IN2-66",
+		CopayLimitFlag:                       "IN2-67",
+		StoplossLimitFlag:                    "IN2-68",
+		InsuredOrganizationNameAndID:         []string{"IN2-69a", "IN2-69b"},
+		InsuredEmployerOrganizationNameAndID: []string{"IN2-70a", "IN2-70b"},
+		Race:                                 []string{"IN2-71a", "IN2-71b"},
+		CMSPatientsRelationshipToInsured:     "IN2-72",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "IN2" {
+		t.Errorf("segment name = %q, want IN2", seg.Name())
+	}
+
+	parsed, err := ParseIN2(seg)
+	if err != nil {
+		t.Fatalf("ParseIN2() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
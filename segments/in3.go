@@ -0,0 +1,181 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// IN3 represents the Insurance Additional Information, Certification segment.
+//
+// Field positions follow the HL7 standard where IN3-1 is the first field
+// after the segment name.
+type IN3 struct {
+	// SetID is IN3-1: Set ID - IN3 (SI, required, max length 4).
+	SetID string `hl7:"IN3.1,required,maxlen=4"`
+
+	// CertificationNumber is IN3-2: Certification Number (CX, optional, max length 250).
+	CertificationNumber string `hl7:"IN3.2,maxlen=250"`
+
+	// CertifiedBy is IN3-3: Certified By (XCN, optional, repeating, max length 250).
+	CertifiedBy []string `hl7:"IN3.3,maxlen=250"`
+
+	// CertificationRequired is IN3-4: Certification Required (ID, optional, max length 1).
+	CertificationRequired string `hl7:"IN3.4,maxlen=1"`
+
+	// Penalty is IN3-5: Penalty (MOP, optional, max length 23).
+	Penalty string `hl7:"IN3.5,maxlen=23"`
+
+	// CertificationDateTime is IN3-6: Certification Date/Time (TS, optional, max length 26).
+	CertificationDateTime string `hl7:"IN3.6,maxlen=26"`
+
+	// CertificationModifyDateTime is IN3-7: Certification Modify Date/Time (TS, optional, max length 26).
+	CertificationModifyDateTime string `hl7:"IN3.7,maxlen=26"`
+
+	// Operator is IN3-8: Operator (XCN, optional, repeating, max length 250).
+	Operator []string `hl7:"IN3.8,maxlen=250"`
+
+	// CertificationBeginDate is IN3-9: Certification Begin Date (DT, optional, max length 8).
+	CertificationBeginDate string `hl7:"IN3.9,maxlen=8"`
+
+	// CertificationEndDate is IN3-10: Certification End Date (DT, optional, max length 8).
+	CertificationEndDate string `hl7:"IN3.10,maxlen=8"`
+
+	// Days is IN3-11: Days (DTN, optional, max length 6).
+	Days string `hl7:"IN3.11,maxlen=6"`
+
+	// NonConcurCodeDescription is IN3-12: Non-Concur Code/Description (CE, optional, max length 250).
+	NonConcurCodeDescription string `hl7:"IN3.12,maxlen=250"`
+
+	// NonConcurEffectiveDateTime is IN3-13: Non-Concur Effective Date/Time (TS, optional, max length 26).
+	NonConcurEffectiveDateTime string `hl7:"IN3.13,maxlen=26"`
+
+	// PhysicianReviewer is IN3-14: Physician Reviewer (XCN, optional, repeating, max length 250).
+	PhysicianReviewer []string `hl7:"IN3.14,maxlen=250"`
+
+	// CertificationContact is IN3-15: Certification Contact (ST, optional, max length 48).
+	CertificationContact string `hl7:"IN3.15,maxlen=48"`
+
+	// CertificationContactPhoneNumber is IN3-16: Certification Contact Phone Number (XTN, optional, repeating, max length 250).
+	CertificationContactPhoneNumber []string `hl7:"IN3.16,maxlen=250"`
+
+	// AppealReason is IN3-17: Appeal Reason (CE, optional, max length 250).
+	AppealReason string `hl7:"IN3.17,maxlen=250"`
+
+	// CertificationAgency is IN3-18: Certification Agency (CE, optional, max length 250).
+	CertificationAgency string `hl7:"IN3.18,maxlen=250"`
+
+	// CertificationAgencyPhoneNumber is IN3-19: Certification Agency Phone Number (XTN, optional, repeating, max length 250).
+	CertificationAgencyPhoneNumber []string `hl7:"IN3.19,maxlen=250"`
+
+	// PreCertificationRequirement is IN3-20: Pre-Certification Requirement (ICD, optional, repeating, max length 40).
+	PreCertificationRequirement []string `hl7:"IN3.20,maxlen=40"`
+
+	// CaseManager is IN3-21: Case Manager (ST, optional, max length 48).
+	CaseManager string `hl7:"IN3.21,maxlen=48"`
+
+	// SecondOpinionDate is IN3-22: Second Opinion Date (DT, optional, max length 8).
+	SecondOpinionDate string `hl7:"IN3.22,maxlen=8"`
+
+	// SecondOpinionStatus is IN3-23: Second Opinion Status (IS, optional, max length 1).
+	SecondOpinionStatus string `hl7:"IN3.23,maxlen=1"`
+
+	// SecondOpinionDocumentationReceived is IN3-24: Second Opinion Documentation Received (IS, optional, repeating, max length 1).
+	SecondOpinionDocumentationReceived []string `hl7:"IN3.24,maxlen=1"`
+
+	// SecondOpinionPhysician is IN3-25: Second Opinion Physician (XCN, optional, repeating, max length 250).
+	SecondOpinionPhysician []string `hl7:"IN3.25,maxlen=250"`
+}
+
+// ErrNotIN3Segment indicates the segment is not an IN3 segment.
+var ErrNotIN3Segment = fmt.Errorf("segment is not IN3")
+
+// ParseIN3 extracts IN3 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an IN3 segment.
+func ParseIN3(seg hl7.Segment) (*IN3, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "IN3" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotIN3Segment, seg.Name())
+	}
+
+	in3 := &IN3{
+		SetID:                              getFieldValue(seg, 1),
+		CertificationNumber:                getFieldValue(seg, 2),
+		CertifiedBy:                        getFieldRepetitions(seg, 3),
+		CertificationRequired:              getFieldValue(seg, 4),
+		Penalty:                            getFieldValue(seg, 5),
+		CertificationDateTime:              getFieldValue(seg, 6),
+		CertificationModifyDateTime:        getFieldValue(seg, 7),
+		Operator:                           getFieldRepetitions(seg, 8),
+		CertificationBeginDate:             getFieldValue(seg, 9),
+		CertificationEndDate:               getFieldValue(seg, 10),
+		Days:                               getFieldValue(seg, 11),
+		NonConcurCodeDescription:           getFieldValue(seg, 12),
+		NonConcurEffectiveDateTime:         getFieldValue(seg, 13),
+		PhysicianReviewer:                  getFieldRepetitions(seg, 14),
+		CertificationContact:               getFieldValue(seg, 15),
+		CertificationContactPhoneNumber:    getFieldRepetitions(seg, 16),
+		AppealReason:                       getFieldValue(seg, 17),
+		CertificationAgency:                getFieldValue(seg, 18),
+		CertificationAgencyPhoneNumber:     getFieldRepetitions(seg, 19),
+		PreCertificationRequirement:        getFieldRepetitions(seg, 20),
+		CaseManager:                        getFieldValue(seg, 21),
+		SecondOpinionDate:                  getFieldValue(seg, 22),
+		SecondOpinionStatus:                getFieldValue(seg, 23),
+		SecondOpinionDocumentationReceived: getFieldRepetitions(seg, 24),
+		SecondOpinionPhysician:             getFieldRepetitions(seg, 25),
+	}
+
+	return in3, nil
+}
+
+// ToSegment converts the IN3 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (i *IN3) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		i.SetID,
+		i.CertificationNumber,
+		joinRepetitions(i.CertifiedBy, delims),
+		i.CertificationRequired,
+		i.Penalty,
+		i.CertificationDateTime,
+		i.CertificationModifyDateTime,
+		joinRepetitions(i.Operator, delims),
+		i.CertificationBeginDate,
+		i.CertificationEndDate,
+		i.Days,
+		i.NonConcurCodeDescription,
+		i.NonConcurEffectiveDateTime,
+		joinRepetitions(i.PhysicianReviewer, delims),
+		i.CertificationContact,
+		joinRepetitions(i.CertificationContactPhoneNumber, delims),
+		i.AppealReason,
+		i.CertificationAgency,
+		joinRepetitions(i.CertificationAgencyPhoneNumber, delims),
+		joinRepetitions(i.PreCertificationRequirement, delims),
+		i.CaseManager,
+		i.SecondOpinionDate,
+		i.SecondOpinionStatus,
+		joinRepetitions(i.SecondOpinionDocumentationReceived, delims),
+		joinRepetitions(i.SecondOpinionPhysician, delims),
+	}
+
+	data := buildSegmentData("IN3", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IN3 segment: %w", err)
+	}
+
+	return seg, nil
+}
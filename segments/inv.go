@@ -0,0 +1,156 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// INV represents the Inventory Detail segment.
+//
+// Field positions follow the HL7 standard where INV-1 is the first field
+// after the segment name.
+type INV struct {
+	// SubstanceIdentifier is INV-1: Substance Identifier (CE, required, max length 250).
+	SubstanceIdentifier string `hl7:"INV.1,required,maxlen=250"`
+
+	// SubstanceStatus is INV-2: Substance Status (CE, required, repeating, max length 250).
+	SubstanceStatus []string `hl7:"INV.2,required,maxlen=250"`
+
+	// SubstanceType is INV-3: Substance Type (CE, optional, max length 250).
+	SubstanceType string `hl7:"INV.3,maxlen=250"`
+
+	// InventoryContainerIdentifier is INV-4: Inventory Container Identifier (CE, optional, max length 250).
+	InventoryContainerIdentifier string `hl7:"INV.4,maxlen=250"`
+
+	// ContainerCarrierIdentifier is INV-5: Container Carrier Identifier (CE, optional, max length 250).
+	ContainerCarrierIdentifier string `hl7:"INV.5,maxlen=250"`
+
+	// PositionOnCarrier is INV-6: Position on Carrier (CE, optional, max length 250).
+	PositionOnCarrier string `hl7:"INV.6,maxlen=250"`
+
+	// InitialQuantity is INV-7: Initial Quantity (NM, optional, max length 20).
+	InitialQuantity string `hl7:"INV.7,maxlen=20"`
+
+	// CurrentQuantity is INV-8: Current Quantity (NM, optional, max length 20).
+	CurrentQuantity string `hl7:"INV.8,maxlen=20"`
+
+	// AvailableQuantity is INV-9: Available Quantity (NM, optional, max length 20).
+	AvailableQuantity string `hl7:"INV.9,maxlen=20"`
+
+	// ConsumptionQuantity is INV-10: Consumption Quantity (NM, optional, max length 20).
+	ConsumptionQuantity string `hl7:"INV.10,maxlen=20"`
+
+	// QuantityUnits is INV-11: Quantity Units (CE, optional, max length 250).
+	QuantityUnits string `hl7:"INV.11,maxlen=250"`
+
+	// ExpirationDateTime is INV-12: Expiration Date/Time (TS, optional, max length 26).
+	ExpirationDateTime string `hl7:"INV.12,maxlen=26"`
+
+	// FirstUsedDateTime is INV-13: First Used Date/Time (TS, optional, max length 26).
+	FirstUsedDateTime string `hl7:"INV.13,maxlen=26"`
+
+	// OnBoardStabilityDuration is INV-14: On Board Stability Duration (TQ, backward compatibility, max length 200).
+	OnBoardStabilityDuration string `hl7:"INV.14,maxlen=200"`
+
+	// TestFluidIdentifier is INV-15: Test/Fluid Identifier(s) (CE, optional, repeating, max length 250).
+	TestFluidIdentifier []string `hl7:"INV.15,maxlen=250"`
+
+	// ManufacturerLotNumber is INV-16: Manufacturer Lot Number (ST, optional, max length 200).
+	ManufacturerLotNumber string `hl7:"INV.16,maxlen=200"`
+
+	// ManufacturerIdentifier is INV-17: Manufacturer Identifier (CE, optional, max length 250).
+	ManufacturerIdentifier string `hl7:"INV.17,maxlen=250"`
+
+	// SupplierIdentifier is INV-18: Supplier Identifier (CE, optional, max length 250).
+	SupplierIdentifier string `hl7:"INV.18,maxlen=250"`
+
+	// OnBoardStabilityTime is INV-19: On Board Stability Time (CQ, optional, max length 20).
+	OnBoardStabilityTime string `hl7:"INV.19,maxlen=20"`
+
+	// TargetValue is INV-20: Target Value (CQ, optional, max length 20).
+	TargetValue string `hl7:"INV.20,maxlen=20"`
+}
+
+// ErrNotINVSegment indicates the segment is not an INV segment.
+var ErrNotINVSegment = fmt.Errorf("segment is not INV")
+
+// ParseINV extracts INV segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an INV segment.
+func ParseINV(seg hl7.Segment) (*INV, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "INV" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotINVSegment, seg.Name())
+	}
+
+	inv := &INV{
+		SubstanceIdentifier:          getFieldValue(seg, 1),
+		SubstanceStatus:              getFieldRepetitions(seg, 2),
+		SubstanceType:                getFieldValue(seg, 3),
+		InventoryContainerIdentifier: getFieldValue(seg, 4),
+		ContainerCarrierIdentifier:   getFieldValue(seg, 5),
+		PositionOnCarrier:            getFieldValue(seg, 6),
+		InitialQuantity:              getFieldValue(seg, 7),
+		CurrentQuantity:              getFieldValue(seg, 8),
+		AvailableQuantity:            getFieldValue(seg, 9),
+		ConsumptionQuantity:          getFieldValue(seg, 10),
+		QuantityUnits:                getFieldValue(seg, 11),
+		ExpirationDateTime:           getFieldValue(seg, 12),
+		FirstUsedDateTime:            getFieldValue(seg, 13),
+		OnBoardStabilityDuration:     getFieldValue(seg, 14),
+		TestFluidIdentifier:          getFieldRepetitions(seg, 15),
+		ManufacturerLotNumber:        getFieldValue(seg, 16),
+		ManufacturerIdentifier:       getFieldValue(seg, 17),
+		SupplierIdentifier:           getFieldValue(seg, 18),
+		OnBoardStabilityTime:         getFieldValue(seg, 19),
+		TargetValue:                  getFieldValue(seg, 20),
+	}
+
+	return inv, nil
+}
+
+// ToSegment converts the INV struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (i *INV) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		i.SubstanceIdentifier,
+		joinRepetitions(i.SubstanceStatus, delims),
+		i.SubstanceType,
+		i.InventoryContainerIdentifier,
+		i.ContainerCarrierIdentifier,
+		i.PositionOnCarrier,
+		i.InitialQuantity,
+		i.CurrentQuantity,
+		i.AvailableQuantity,
+		i.ConsumptionQuantity,
+		i.QuantityUnits,
+		i.ExpirationDateTime,
+		i.FirstUsedDateTime,
+		i.OnBoardStabilityDuration,
+		joinRepetitions(i.TestFluidIdentifier, delims),
+		i.ManufacturerLotNumber,
+		i.ManufacturerIdentifier,
+		i.SupplierIdentifier,
+		i.OnBoardStabilityTime,
+		i.TargetValue,
+	}
+
+	data := buildSegmentData("INV", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create INV segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,106 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseINV(t *testing.T) {
+	input := "INV|INV-1|INV-2a~INV-2b|INV-3|INV-4|INV-5|INV-6|INV-7|INV-8|INV-9|INV-10|INV-11|INV-12|INV-13|INV-14|INV-15a~INV-15b|INV-16|INV-17|INV-18|INV-19|INV-20"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseINV(seg)
+	if err != nil {
+		t.Fatalf("ParseINV() unexpected error: %v", err)
+	}
+
+	want := INV{
+		SubstanceIdentifier:          "INV-1",
+		SubstanceStatus:              []string{"INV-2a", "INV-2b"},
+		SubstanceType:                "INV-3",
+		InventoryContainerIdentifier: "INV-4",
+		ContainerCarrierIdentifier:   "INV-5",
+		PositionOnCarrier:            "INV-6",
+		InitialQuantity:              "INV-7",
+		CurrentQuantity:              "INV-8",
+		AvailableQuantity:            "INV-9",
+		ConsumptionQuantity:          "INV-10",
+		QuantityUnits:                "INV-11",
+		ExpirationDateTime:           "INV-12",
+		FirstUsedDateTime:            "INV-13",
+		OnBoardStabilityDuration:     "INV-14",
+		TestFluidIdentifier:          []string{"INV-15a", "INV-15b"},
+		ManufacturerLotNumber:        "INV-16",
+		ManufacturerIdentifier:       "INV-17",
+		SupplierIdentifier:           "INV-18",
+		OnBoardStabilityTime:         "INV-19",
+		TargetValue:                  "INV-20",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseINV() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseINV_WrongSegment(t *testing.T) {
+	if _, err := ParseINV(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseINV(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseINV(seg); !errors.Is(err, ErrNotINVSegment) {
+		t.Errorf("ParseINV() error = %v, want ErrNotINVSegment", err)
+	}
+}
+
+func TestINV_RoundTrip(t *testing.T) {
+	original := &INV{
+		SubstanceIdentifier:          "INV-1",
+		SubstanceStatus:              []string{"INV-2a", "INV-2b"},
+		SubstanceType:                "INV-3",
+		InventoryContainerIdentifier: "INV-4",
+		ContainerCarrierIdentifier:   "INV-5",
+		PositionOnCarrier:            "INV-6",
+		InitialQuantity:              "INV-7",
+		CurrentQuantity:              "INV-8",
+		AvailableQuantity:            "INV-9",
+		ConsumptionQuantity:          "INV-10",
+		QuantityUnits:                "INV-11",
+		ExpirationDateTime:           "INV-12",
+		FirstUsedDateTime:            "INV-13",
+		OnBoardStabilityDuration:     "INV-14",
+		TestFluidIdentifier:          []string{"INV-15a", "INV-15b"},
+		ManufacturerLotNumber:        "INV-16",
+		ManufacturerIdentifier:       "INV-17",
+		SupplierIdentifier:           "INV-18",
+		OnBoardStabilityTime:         "INV-19",
+		TargetValue:                  "INV-20",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "INV" {
+		t.Errorf("segment name = %q, want INV", seg.Name())
+	}
+
+	parsed, err := ParseINV(seg)
+	if err != nil {
+		t.Fatalf("ParseINV() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseIPC(t *testing.T) {
+	input := "IPC|IPC-1|IPC-2|IPC-3|IPC-4|IPC-5|IPC-6a~IPC-6b|IPC-7|IPC-8a~IPC-8b|IPC-9"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseIPC(seg)
+	if err != nil {
+		t.Fatalf("ParseIPC() unexpected error: %v", err)
+	}
+
+	want := IPC{
+		AccessionIdentifier:            "IPC-1",
+		RequestedProcedureID:           "IPC-2",
+		StudyInstanceUID:               "IPC-3",
+		ScheduledProcedureStepID:       "IPC-4",
+		Modality:                       "IPC-5",
+		ProtocolCode:                   []string{"IPC-6a", "IPC-6b"},
+		ScheduledStationName:           "IPC-7",
+		ScheduledProcedureStepLocation: []string{"IPC-8a", "IPC-8b"},
+		ScheduledAETitle:               "IPC-9",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseIPC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseIPC_WrongSegment(t *testing.T) {
+	if _, err := ParseIPC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseIPC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseIPC(seg); !errors.Is(err, ErrNotIPCSegment) {
+		t.Errorf("ParseIPC() error = %v, want ErrNotIPCSegment", err)
+	}
+}
+
+func TestIPC_RoundTrip(t *testing.T) {
+	original := &IPC{
+		AccessionIdentifier:            "IPC-1",
+		RequestedProcedureID:           "IPC-2",
+		StudyInstanceUID:               "IPC-3",
+		ScheduledProcedureStepID:       "IPC-4",
+		Modality:                       "IPC-5",
+		ProtocolCode:                   []string{"IPC-6a", "IPC-6b"},
+		ScheduledStationName:           "IPC-7",
+		ScheduledProcedureStepLocation: []string{"IPC-8a", "IPC-8b"},
+		ScheduledAETitle:               "IPC-9",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "IPC" {
+		t.Errorf("segment name = %q, want IPC", seg.Name())
+	}
+
+	parsed, err := ParseIPC(seg)
+	if err != nil {
+		t.Fatalf("ParseIPC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
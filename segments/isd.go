@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ISD represents the Interaction Status Detail segment.
+//
+// Field positions follow the HL7 standard where ISD-1 is the first field
+// after the segment name.
+type ISD struct {
+	// ReferenceInteractionNumber is ISD-1: Reference Interaction Number (unique identifier) (NM, required, max length 20).
+	ReferenceInteractionNumber string `hl7:"ISD.1,required,maxlen=20"`
+
+	// InteractionTypeIdentifier is ISD-2: Interaction Type Identifier (CE, optional, max length 250).
+	InteractionTypeIdentifier string `hl7:"ISD.2,maxlen=250"`
+
+	// InteractionActiveState is ISD-3: Interaction Active State (CE, required, max length 250).
+	InteractionActiveState string `hl7:"ISD.3,required,maxlen=250"`
+}
+
+// ErrNotISDSegment indicates the segment is not an ISD segment.
+var ErrNotISDSegment = fmt.Errorf("segment is not ISD")
+
+// ParseISD extracts ISD segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an ISD segment.
+func ParseISD(seg hl7.Segment) (*ISD, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "ISD" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotISDSegment, seg.Name())
+	}
+
+	isd := &ISD{
+		ReferenceInteractionNumber: getFieldValue(seg, 1),
+		InteractionTypeIdentifier:  getFieldValue(seg, 2),
+		InteractionActiveState:     getFieldValue(seg, 3),
+	}
+
+	return isd, nil
+}
+
+// ToSegment converts the ISD struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (i *ISD) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		i.ReferenceInteractionNumber,
+		i.InteractionTypeIdentifier,
+		i.InteractionActiveState,
+	}
+
+	data := buildSegmentData("ISD", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ISD segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseISD(t *testing.T) {
+	input := "ISD|ISD-1|ISD-2|ISD-3"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseISD(seg)
+	if err != nil {
+		t.Fatalf("ParseISD() unexpected error: %v", err)
+	}
+
+	want := ISD{
+		ReferenceInteractionNumber: "ISD-1",
+		InteractionTypeIdentifier:  "ISD-2",
+		InteractionActiveState:     "ISD-3",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseISD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseISD_WrongSegment(t *testing.T) {
+	if _, err := ParseISD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseISD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseISD(seg); !errors.Is(err, ErrNotISDSegment) {
+		t.Errorf("ParseISD() error = %v, want ErrNotISDSegment", err)
+	}
+}
+
+func TestISD_RoundTrip(t *testing.T) {
+	original := &ISD{
+		ReferenceInteractionNumber: "ISD-1",
+		InteractionTypeIdentifier:  "ISD-2",
+		InteractionActiveState:     "ISD-3",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ISD" {
+		t.Errorf("segment name = %q, want ISD", seg.Name())
+	}
+
+	parsed, err := ParseISD(seg)
+	if err != nil {
+		t.Fatalf("ParseISD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// LAN represents the Language Detail segment.
+//
+// Field positions follow the HL7 standard where LAN-1 is the first field
+// after the segment name.
+type LAN struct {
+	// SetID is LAN-1: Set ID - LAN (SI, required, max length 60).
+	SetID string `hl7:"LAN.1,required,maxlen=60"`
+
+	// LanguageCode is LAN-2: Language Code (CE, required, max length 250).
+	LanguageCode string `hl7:"LAN.2,required,maxlen=250"`
+
+	// LanguageAbilityCode is LAN-3: Language Ability Code (CE, optional, repeating, max length 250).
+	LanguageAbilityCode []string `hl7:"LAN.3,maxlen=250"`
+
+	// LanguageProficiencyCode is LAN-4: Language Proficiency Code (CE, optional, max length 250).
+	LanguageProficiencyCode string `hl7:"LAN.4,maxlen=250"`
+}
+
+// ErrNotLANSegment indicates the segment is not an LAN segment.
+var ErrNotLANSegment = fmt.Errorf("segment is not LAN")
+
+// ParseLAN extracts LAN segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an LAN segment.
+func ParseLAN(seg hl7.Segment) (*LAN, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "LAN" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotLANSegment, seg.Name())
+	}
+
+	lan := &LAN{
+		SetID:                   getFieldValue(seg, 1),
+		LanguageCode:            getFieldValue(seg, 2),
+		LanguageAbilityCode:     getFieldRepetitions(seg, 3),
+		LanguageProficiencyCode: getFieldValue(seg, 4),
+	}
+
+	return lan, nil
+}
+
+// ToSegment converts the LAN struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (l *LAN) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		l.SetID,
+		l.LanguageCode,
+		joinRepetitions(l.LanguageAbilityCode, delims),
+		l.LanguageProficiencyCode,
+	}
+
+	data := buildSegmentData("LAN", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LAN segment: %w", err)
+	}
+
+	return seg, nil
+}
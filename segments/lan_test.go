@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseLAN(t *testing.T) {
+	input := "LAN|LAN-1|LAN-2|LAN-3a~LAN-3b|LAN-4"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseLAN(seg)
+	if err != nil {
+		t.Fatalf("ParseLAN() unexpected error: %v", err)
+	}
+
+	want := LAN{
+		SetID:                   "LAN-1",
+		LanguageCode:            "LAN-2",
+		LanguageAbilityCode:     []string{"LAN-3a", "LAN-3b"},
+		LanguageProficiencyCode: "LAN-4",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseLAN() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseLAN_WrongSegment(t *testing.T) {
+	if _, err := ParseLAN(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseLAN(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseLAN(seg); !errors.Is(err, ErrNotLANSegment) {
+		t.Errorf("ParseLAN() error = %v, want ErrNotLANSegment", err)
+	}
+}
+
+func TestLAN_RoundTrip(t *testing.T) {
+	original := &LAN{
+		SetID:                   "LAN-1",
+		LanguageCode:            "LAN-2",
+		LanguageAbilityCode:     []string{"LAN-3a", "LAN-3b"},
+		LanguageProficiencyCode: "LAN-4",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "LAN" {
+		t.Errorf("segment name = %q, want LAN", seg.Name())
+	}
+
+	parsed, err := ParseLAN(seg)
+	if err != nil {
+		t.Fatalf("ParseLAN() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
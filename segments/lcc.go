@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// LCC represents the Location Charge Code segment.
+//
+// Field positions follow the HL7 standard where LCC-1 is the first field
+// after the segment name.
+type LCC struct {
+	// PrimaryKeyValue is LCC-1: Primary Key Value - LCC (PL, required, max length 200).
+	PrimaryKeyValue string `hl7:"LCC.1,required,maxlen=200"`
+
+	// LocationDepartment is LCC-2: Location Department (CE, required, max length 250).
+	LocationDepartment string `hl7:"LCC.2,required,maxlen=250"`
+
+	// AccommodationType is LCC-3: Accommodation Type (CE, optional, repeating, max length 250).
+	AccommodationType []string `hl7:"LCC.3,maxlen=250"`
+
+	// ChargeCode is LCC-4: Charge Code (CE, required, repeating, max length 250).
+	ChargeCode []string `hl7:"LCC.4,required,maxlen=250"`
+}
+
+// ErrNotLCCSegment indicates the segment is not an LCC segment.
+var ErrNotLCCSegment = fmt.Errorf("segment is not LCC")
+
+// ParseLCC extracts LCC segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an LCC segment.
+func ParseLCC(seg hl7.Segment) (*LCC, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "LCC" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotLCCSegment, seg.Name())
+	}
+
+	lcc := &LCC{
+		PrimaryKeyValue:    getFieldValue(seg, 1),
+		LocationDepartment: getFieldValue(seg, 2),
+		AccommodationType:  getFieldRepetitions(seg, 3),
+		ChargeCode:         getFieldRepetitions(seg, 4),
+	}
+
+	return lcc, nil
+}
+
+// ToSegment converts the LCC struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (l *LCC) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		l.PrimaryKeyValue,
+		l.LocationDepartment,
+		joinRepetitions(l.AccommodationType, delims),
+		joinRepetitions(l.ChargeCode, delims),
+	}
+
+	data := buildSegmentData("LCC", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LCC segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseLCC(t *testing.T) {
+	input := "LCC|LCC-1|LCC-2|LCC-3a~LCC-3b|LCC-4a~LCC-4b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseLCC(seg)
+	if err != nil {
+		t.Fatalf("ParseLCC() unexpected error: %v", err)
+	}
+
+	want := LCC{
+		PrimaryKeyValue:    "LCC-1",
+		LocationDepartment: "LCC-2",
+		AccommodationType:  []string{"LCC-3a", "LCC-3b"},
+		ChargeCode:         []string{"LCC-4a", "LCC-4b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseLCC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseLCC_WrongSegment(t *testing.T) {
+	if _, err := ParseLCC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseLCC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseLCC(seg); !errors.Is(err, ErrNotLCCSegment) {
+		t.Errorf("ParseLCC() error = %v, want ErrNotLCCSegment", err)
+	}
+}
+
+func TestLCC_RoundTrip(t *testing.T) {
+	original := &LCC{
+		PrimaryKeyValue:    "LCC-1",
+		LocationDepartment: "LCC-2",
+		AccommodationType:  []string{"LCC-3a", "LCC-3b"},
+		ChargeCode:         []string{"LCC-4a", "LCC-4b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "LCC" {
+		t.Errorf("segment name = %q, want LCC", seg.Name())
+	}
+
+	parsed, err := ParseLCC(seg)
+	if err != nil {
+		t.Fatalf("ParseLCC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
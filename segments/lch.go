@@ -0,0 +1,81 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// LCH represents the Location Characteristic segment.
+//
+// Field positions follow the HL7 standard where LCH-1 is the first field
+// after the segment name.
+type LCH struct {
+	// PrimaryKeyValue is LCH-1: Primary Key Value - LCH (PL, required, max length 200).
+	PrimaryKeyValue string `hl7:"LCH.1,required,maxlen=200"`
+
+	// SegmentActionCode is LCH-2: Segment Action Code (ID, optional, max length 3).
+	SegmentActionCode string `hl7:"LCH.2,maxlen=3"`
+
+	// SegmentUniqueKey is LCH-3: Segment Unique Key (EI, optional, max length 80).
+	SegmentUniqueKey string `hl7:"LCH.3,maxlen=80"`
+
+	// LocationCharacteristicID is LCH-4: Location Characteristic ID (CE, required, max length 250).
+	LocationCharacteristicID string `hl7:"LCH.4,required,maxlen=250"`
+
+	// LocationCharacteristicValue is LCH-5: Location Characteristic Value-LCH (CE, required, max length 250).
+	LocationCharacteristicValue string `hl7:"LCH.5,required,maxlen=250"`
+}
+
+// ErrNotLCHSegment indicates the segment is not an LCH segment.
+var ErrNotLCHSegment = fmt.Errorf("segment is not LCH")
+
+// ParseLCH extracts LCH segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an LCH segment.
+func ParseLCH(seg hl7.Segment) (*LCH, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "LCH" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotLCHSegment, seg.Name())
+	}
+
+	lch := &LCH{
+		PrimaryKeyValue:             getFieldValue(seg, 1),
+		SegmentActionCode:           getFieldValue(seg, 2),
+		SegmentUniqueKey:            getFieldValue(seg, 3),
+		LocationCharacteristicID:    getFieldValue(seg, 4),
+		LocationCharacteristicValue: getFieldValue(seg, 5),
+	}
+
+	return lch, nil
+}
+
+// ToSegment converts the LCH struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (l *LCH) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		l.PrimaryKeyValue,
+		l.SegmentActionCode,
+		l.SegmentUniqueKey,
+		l.LocationCharacteristicID,
+		l.LocationCharacteristicValue,
+	}
+
+	data := buildSegmentData("LCH", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LCH segment: %w", err)
+	}
+
+	return seg, nil
+}
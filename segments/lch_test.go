@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseLCH(t *testing.T) {
+	input := "LCH|LCH-1|LCH-2|LCH-3|LCH-4|LCH-5"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseLCH(seg)
+	if err != nil {
+		t.Fatalf("ParseLCH() unexpected error: %v", err)
+	}
+
+	want := LCH{
+		PrimaryKeyValue:             "LCH-1",
+		SegmentActionCode:           "LCH-2",
+		SegmentUniqueKey:            "LCH-3",
+		LocationCharacteristicID:    "LCH-4",
+		LocationCharacteristicValue: "LCH-5",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseLCH() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseLCH_WrongSegment(t *testing.T) {
+	if _, err := ParseLCH(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseLCH(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseLCH(seg); !errors.Is(err, ErrNotLCHSegment) {
+		t.Errorf("ParseLCH() error = %v, want ErrNotLCHSegment", err)
+	}
+}
+
+func TestLCH_RoundTrip(t *testing.T) {
+	original := &LCH{
+		PrimaryKeyValue:             "LCH-1",
+		SegmentActionCode:           "LCH-2",
+		SegmentUniqueKey:            "LCH-3",
+		LocationCharacteristicID:    "LCH-4",
+		LocationCharacteristicValue: "LCH-5",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "LCH" {
+		t.Errorf("segment name = %q, want LCH", seg.Name())
+	}
+
+	parsed, err := ParseLCH(seg)
+	if err != nil {
+		t.Fatalf("ParseLCH() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseLDP(t *testing.T) {
+	input := "LDP|LDP-1|LDP-2|LDP-3a~LDP-3b|LDP-4a~LDP-4b|LDP-5a~LDP-5b|LDP-6|LDP-7|LDP-8|LDP-9|LDP-10a~LDP-10b|LDP-11|LDP-12"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseLDP(seg)
+	if err != nil {
+		t.Fatalf("ParseLDP() unexpected error: %v", err)
+	}
+
+	want := LDP{
+		PrimaryKeyValue:     "LDP-1",
+		LocationDepartment:  "LDP-2",
+		LocationService:     []string{"LDP-3a", "LDP-3b"},
+		SpecialtyType:       []string{"LDP-4a", "LDP-4b"},
+		ValidPatientClasses: []string{"LDP-5a", "LDP-5b"},
+		ActiveInactiveFlag:  "LDP-6",
+		ActivationDate:      "LDP-7",
+		InactivationDate:    "LDP-8",
+		InactivatedReason:   "LDP-9",
+		VisitingHours:       []string{"LDP-10a", "LDP-10b"},
+		ContactPhone:        "LDP-11",
+		LocationCostCenter:  "LDP-12",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseLDP() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseLDP_WrongSegment(t *testing.T) {
+	if _, err := ParseLDP(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseLDP(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseLDP(seg); !errors.Is(err, ErrNotLDPSegment) {
+		t.Errorf("ParseLDP() error = %v, want ErrNotLDPSegment", err)
+	}
+}
+
+func TestLDP_RoundTrip(t *testing.T) {
+	original := &LDP{
+		PrimaryKeyValue:     "LDP-1",
+		LocationDepartment:  "LDP-2",
+		LocationService:     []string{"LDP-3a", "LDP-3b"},
+		SpecialtyType:       []string{"LDP-4a", "LDP-4b"},
+		ValidPatientClasses: []string{"LDP-5a", "LDP-5b"},
+		ActiveInactiveFlag:  "LDP-6",
+		ActivationDate:      "LDP-7",
+		InactivationDate:    "LDP-8",
+		InactivatedReason:   "LDP-9",
+		VisitingHours:       []string{"LDP-10a", "LDP-10b"},
+		ContactPhone:        "LDP-11",
+		LocationCostCenter:  "LDP-12",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "LDP" {
+		t.Errorf("segment name = %q, want LDP", seg.Name())
+	}
+
+	parsed, err := ParseLDP(seg)
+	if err != nil {
+		t.Fatalf("ParseLDP() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
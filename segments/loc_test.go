@@ -0,0 +1,84 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseLOC(t *testing.T) {
+	input := "LOC|LOC-1|LOC-2|LOC-3a~LOC-3b|LOC-4a~LOC-4b|LOC-5a~LOC-5b|LOC-6a~LOC-6b|LOC-7a~LOC-7b|LOC-8a~LOC-8b|LOC-9"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseLOC(seg)
+	if err != nil {
+		t.Fatalf("ParseLOC() unexpected error: %v", err)
+	}
+
+	want := LOC{
+		PrimaryKeyValue:     "LOC-1",
+		LocationDescription: "LOC-2",
+		LocationType:        []string{"LOC-3a", "LOC-3b"},
+		OrganizationName:    []string{"LOC-4a", "LOC-4b"},
+		LocationAddress:     []string{"LOC-5a", "LOC-5b"},
+		LocationPhone:       []string{"LOC-6a", "LOC-6b"},
+		LicenseNumber:       []string{"LOC-7a", "LOC-7b"},
+		LocationEquipment:   []string{"LOC-8a", "LOC-8b"},
+		LocationServiceCode: "LOC-9",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseLOC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseLOC_WrongSegment(t *testing.T) {
+	if _, err := ParseLOC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseLOC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseLOC(seg); !errors.Is(err, ErrNotLOCSegment) {
+		t.Errorf("ParseLOC() error = %v, want ErrNotLOCSegment", err)
+	}
+}
+
+func TestLOC_RoundTrip(t *testing.T) {
+	original := &LOC{
+		PrimaryKeyValue:     "LOC-1",
+		LocationDescription: "LOC-2",
+		LocationType:        []string{"LOC-3a", "LOC-3b"},
+		OrganizationName:    []string{"LOC-4a", "LOC-4b"},
+		LocationAddress:     []string{"LOC-5a", "LOC-5b"},
+		LocationPhone:       []string{"LOC-6a", "LOC-6b"},
+		LicenseNumber:       []string{"LOC-7a", "LOC-7b"},
+		LocationEquipment:   []string{"LOC-8a", "LOC-8b"},
+		LocationServiceCode: "LOC-9",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "LOC" {
+		t.Errorf("segment name = %q, want LOC", seg.Name())
+	}
+
+	parsed, err := ParseLOC(seg)
+	if err != nil {
+		t.Fatalf("ParseLOC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
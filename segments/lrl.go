@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// LRL represents the Location Relationship segment.
+//
+// Field positions follow the HL7 standard where LRL-1 is the first field
+// after the segment name.
+type LRL struct {
+	// PrimaryKeyValue is LRL-1: Primary Key Value - LRL (PL, required, max length 200).
+	PrimaryKeyValue string `hl7:"LRL.1,required,maxlen=200"`
+
+	// SegmentActionCode is LRL-2: Segment Action Code (ID, optional, max length 3).
+	SegmentActionCode string `hl7:"LRL.2,maxlen=3"`
+
+	// SegmentUniqueKey is LRL-3: Segment Unique Key (EI, optional, max length 80).
+	SegmentUniqueKey string `hl7:"LRL.3,maxlen=80"`
+
+	// LocationRelationshipID is LRL-4: Location Relationship ID (CE, required, max length 250).
+	LocationRelationshipID string `hl7:"LRL.4,required,maxlen=250"`
+
+	// OrganizationalLocationRelationshipValue is LRL-5: Organizational Location Relationship Value (XON, conditional, repeating, max length 250).
+	OrganizationalLocationRelationshipValue []string `hl7:"LRL.5,maxlen=250"`
+
+	// PatientLocationRelationshipValue is LRL-6: Patient Location Relationship Value (PL, conditional, max length 80).
+	PatientLocationRelationshipValue string `hl7:"LRL.6,maxlen=80"`
+}
+
+// ErrNotLRLSegment indicates the segment is not an LRL segment.
+var ErrNotLRLSegment = fmt.Errorf("segment is not LRL")
+
+// ParseLRL extracts LRL segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an LRL segment.
+func ParseLRL(seg hl7.Segment) (*LRL, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "LRL" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotLRLSegment, seg.Name())
+	}
+
+	lrl := &LRL{
+		PrimaryKeyValue:                         getFieldValue(seg, 1),
+		SegmentActionCode:                       getFieldValue(seg, 2),
+		SegmentUniqueKey:                        getFieldValue(seg, 3),
+		LocationRelationshipID:                  getFieldValue(seg, 4),
+		OrganizationalLocationRelationshipValue: getFieldRepetitions(seg, 5),
+		PatientLocationRelationshipValue:        getFieldValue(seg, 6),
+	}
+
+	return lrl, nil
+}
+
+// ToSegment converts the LRL struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (l *LRL) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		l.PrimaryKeyValue,
+		l.SegmentActionCode,
+		l.SegmentUniqueKey,
+		l.LocationRelationshipID,
+		joinRepetitions(l.OrganizationalLocationRelationshipValue, delims),
+		l.PatientLocationRelationshipValue,
+	}
+
+	data := buildSegmentData("LRL", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LRL segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,78 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseLRL(t *testing.T) {
+	input := "LRL|LRL-1|LRL-2|LRL-3|LRL-4|LRL-5a~LRL-5b|LRL-6"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseLRL(seg)
+	if err != nil {
+		t.Fatalf("ParseLRL() unexpected error: %v", err)
+	}
+
+	want := LRL{
+		PrimaryKeyValue:                         "LRL-1",
+		SegmentActionCode:                       "LRL-2",
+		SegmentUniqueKey:                        "LRL-3",
+		LocationRelationshipID:                  "LRL-4",
+		OrganizationalLocationRelationshipValue: []string{"LRL-5a", "LRL-5b"},
+		PatientLocationRelationshipValue:        "LRL-6",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseLRL() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseLRL_WrongSegment(t *testing.T) {
+	if _, err := ParseLRL(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseLRL(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseLRL(seg); !errors.Is(err, ErrNotLRLSegment) {
+		t.Errorf("ParseLRL() error = %v, want ErrNotLRLSegment", err)
+	}
+}
+
+func TestLRL_RoundTrip(t *testing.T) {
+	original := &LRL{
+		PrimaryKeyValue:                         "LRL-1",
+		SegmentActionCode:                       "LRL-2",
+		SegmentUniqueKey:                        "LRL-3",
+		LocationRelationshipID:                  "LRL-4",
+		OrganizationalLocationRelationshipValue: []string{"LRL-5a", "LRL-5b"},
+		PatientLocationRelationshipValue:        "LRL-6",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "LRL" {
+		t.Errorf("segment name = %q, want LRL", seg.Name())
+	}
+
+	parsed, err := ParseLRL(seg)
+	if err != nil {
+		t.Fatalf("ParseLRL() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
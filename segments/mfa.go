@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// MFA represents the Master File Acknowledgment segment.
+//
+// Field positions follow the HL7 standard where MFA-1 is the first field
+// after the segment name.
+type MFA struct {
+	// RecordLevelEventCode is MFA-1: Record-Level Event Code (ID, required, max length 3).
+	RecordLevelEventCode string `hl7:"MFA.1,required,maxlen=3"`
+
+	// MFNControlID is MFA-2: MFN Control ID (ST, conditional, max length 20).
+	MFNControlID string `hl7:"MFA.2,maxlen=20"`
+
+	// EventCompletionDateTime is MFA-3: Event Completion Date/Time (TS, optional, max length 26).
+	EventCompletionDateTime string `hl7:"MFA.3,maxlen=26"`
+
+	// MFNRecordLevelErrorReturn is MFA-4: MFN Record Level Error Return (CE, required, max length 250).
+	MFNRecordLevelErrorReturn string `hl7:"MFA.4,required,maxlen=250"`
+
+	// PrimaryKeyValue is MFA-5: Primary Key Value - MFA (VARIES, required, repeating, max length 250).
+	PrimaryKeyValue []string `hl7:"MFA.5,required,maxlen=250"`
+
+	// PrimaryKeyValueType is MFA-6: Primary Key Value Type - MFA (ID, required, repeating, max length 3).
+	PrimaryKeyValueType []string `hl7:"MFA.6,required,maxlen=3"`
+}
+
+// ErrNotMFASegment indicates the segment is not an MFA segment.
+var ErrNotMFASegment = fmt.Errorf("segment is not MFA")
+
+// ParseMFA extracts MFA segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an MFA segment.
+func ParseMFA(seg hl7.Segment) (*MFA, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "MFA" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotMFASegment, seg.Name())
+	}
+
+	mfa := &MFA{
+		RecordLevelEventCode:      getFieldValue(seg, 1),
+		MFNControlID:              getFieldValue(seg, 2),
+		EventCompletionDateTime:   getFieldValue(seg, 3),
+		MFNRecordLevelErrorReturn: getFieldValue(seg, 4),
+		PrimaryKeyValue:           getFieldRepetitions(seg, 5),
+		PrimaryKeyValueType:       getFieldRepetitions(seg, 6),
+	}
+
+	return mfa, nil
+}
+
+// ToSegment converts the MFA struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (m *MFA) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		m.RecordLevelEventCode,
+		m.MFNControlID,
+		m.EventCompletionDateTime,
+		m.MFNRecordLevelErrorReturn,
+		joinRepetitions(m.PrimaryKeyValue, delims),
+		joinRepetitions(m.PrimaryKeyValueType, delims),
+	}
+
+	data := buildSegmentData("MFA", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MFA segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,78 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseMFA(t *testing.T) {
+	input := "MFA|MFA-1|MFA-2|MFA-3|MFA-4|MFA-5a~MFA-5b|MFA-6a~MFA-6b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseMFA(seg)
+	if err != nil {
+		t.Fatalf("ParseMFA() unexpected error: %v", err)
+	}
+
+	want := MFA{
+		RecordLevelEventCode:      "MFA-1",
+		MFNControlID:              "MFA-2",
+		EventCompletionDateTime:   "MFA-3",
+		MFNRecordLevelErrorReturn: "MFA-4",
+		PrimaryKeyValue:           []string{"MFA-5a", "MFA-5b"},
+		PrimaryKeyValueType:       []string{"MFA-6a", "MFA-6b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseMFA() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseMFA_WrongSegment(t *testing.T) {
+	if _, err := ParseMFA(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseMFA(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseMFA(seg); !errors.Is(err, ErrNotMFASegment) {
+		t.Errorf("ParseMFA() error = %v, want ErrNotMFASegment", err)
+	}
+}
+
+func TestMFA_RoundTrip(t *testing.T) {
+	original := &MFA{
+		RecordLevelEventCode:      "MFA-1",
+		MFNControlID:              "MFA-2",
+		EventCompletionDateTime:   "MFA-3",
+		MFNRecordLevelErrorReturn: "MFA-4",
+		PrimaryKeyValue:           []string{"MFA-5a", "MFA-5b"},
+		PrimaryKeyValueType:       []string{"MFA-6a", "MFA-6b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "MFA" {
+		t.Errorf("segment name = %q, want MFA", seg.Name())
+	}
+
+	parsed, err := ParseMFA(seg)
+	if err != nil {
+		t.Fatalf("ParseMFA() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
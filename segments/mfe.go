@@ -0,0 +1,81 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// MFE represents the Master File Entry segment.
+//
+// Field positions follow the HL7 standard where MFE-1 is the first field
+// after the segment name.
+type MFE struct {
+	// RecordLevelEventCode is MFE-1: Record-Level Event Code (ID, required, max length 3).
+	RecordLevelEventCode string `hl7:"MFE.1,required,maxlen=3"`
+
+	// MFNControlID is MFE-2: MFN Control ID (ST, conditional, max length 20).
+	MFNControlID string `hl7:"MFE.2,maxlen=20"`
+
+	// EffectiveDateTime is MFE-3: Effective Date/Time (TS, optional, max length 26).
+	EffectiveDateTime string `hl7:"MFE.3,maxlen=26"`
+
+	// PrimaryKeyValue is MFE-4: Primary Key Value - MFE (VARIES, required, repeating, max length 200).
+	PrimaryKeyValue []string `hl7:"MFE.4,required,maxlen=200"`
+
+	// PrimaryKeyValueType is MFE-5: Primary Key Value Type (ID, required, repeating, max length 3).
+	PrimaryKeyValueType []string `hl7:"MFE.5,required,maxlen=3"`
+}
+
+// ErrNotMFESegment indicates the segment is not an MFE segment.
+var ErrNotMFESegment = fmt.Errorf("segment is not MFE")
+
+// ParseMFE extracts MFE segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an MFE segment.
+func ParseMFE(seg hl7.Segment) (*MFE, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "MFE" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotMFESegment, seg.Name())
+	}
+
+	mfe := &MFE{
+		RecordLevelEventCode: getFieldValue(seg, 1),
+		MFNControlID:         getFieldValue(seg, 2),
+		EffectiveDateTime:    getFieldValue(seg, 3),
+		PrimaryKeyValue:      getFieldRepetitions(seg, 4),
+		PrimaryKeyValueType:  getFieldRepetitions(seg, 5),
+	}
+
+	return mfe, nil
+}
+
+// ToSegment converts the MFE struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (m *MFE) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		m.RecordLevelEventCode,
+		m.MFNControlID,
+		m.EffectiveDateTime,
+		joinRepetitions(m.PrimaryKeyValue, delims),
+		joinRepetitions(m.PrimaryKeyValueType, delims),
+	}
+
+	data := buildSegmentData("MFE", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MFE segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseMFE(t *testing.T) {
+	input := "MFE|MFE-1|MFE-2|MFE-3|MFE-4a~MFE-4b|MFE-5a~MFE-5b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseMFE(seg)
+	if err != nil {
+		t.Fatalf("ParseMFE() unexpected error: %v", err)
+	}
+
+	want := MFE{
+		RecordLevelEventCode: "MFE-1",
+		MFNControlID:         "MFE-2",
+		EffectiveDateTime:    "MFE-3",
+		PrimaryKeyValue:      []string{"MFE-4a", "MFE-4b"},
+		PrimaryKeyValueType:  []string{"MFE-5a", "MFE-5b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseMFE() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseMFE_WrongSegment(t *testing.T) {
+	if _, err := ParseMFE(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseMFE(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseMFE(seg); !errors.Is(err, ErrNotMFESegment) {
+		t.Errorf("ParseMFE() error = %v, want ErrNotMFESegment", err)
+	}
+}
+
+func TestMFE_RoundTrip(t *testing.T) {
+	original := &MFE{
+		RecordLevelEventCode: "MFE-1",
+		MFNControlID:         "MFE-2",
+		EffectiveDateTime:    "MFE-3",
+		PrimaryKeyValue:      []string{"MFE-4a", "MFE-4b"},
+		PrimaryKeyValueType:  []string{"MFE-5a", "MFE-5b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "MFE" {
+		t.Errorf("segment name = %q, want MFE", seg.Name())
+	}
+
+	parsed, err := ParseMFE(seg)
+	if err != nil {
+		t.Fatalf("ParseMFE() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
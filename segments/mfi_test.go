@@ -0,0 +1,78 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseMFI(t *testing.T) {
+	input := "MFI|MFI-1|MFI-2|MFI-3|MFI-4|MFI-5|MFI-6"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseMFI(seg)
+	if err != nil {
+		t.Fatalf("ParseMFI() unexpected error: %v", err)
+	}
+
+	want := MFI{
+		MasterFileIdentifier:            "MFI-1",
+		MasterFileApplicationIdentifier: "MFI-2",
+		FileLevelEventCode:              "MFI-3",
+		EnteredDateTime:                 "MFI-4",
+		EffectiveDateTime:               "MFI-5",
+		ResponseLevelCode:               "MFI-6",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseMFI() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseMFI_WrongSegment(t *testing.T) {
+	if _, err := ParseMFI(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseMFI(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseMFI(seg); !errors.Is(err, ErrNotMFISegment) {
+		t.Errorf("ParseMFI() error = %v, want ErrNotMFISegment", err)
+	}
+}
+
+func TestMFI_RoundTrip(t *testing.T) {
+	original := &MFI{
+		MasterFileIdentifier:            "MFI-1",
+		MasterFileApplicationIdentifier: "MFI-2",
+		FileLevelEventCode:              "MFI-3",
+		EnteredDateTime:                 "MFI-4",
+		EffectiveDateTime:               "MFI-5",
+		ResponseLevelCode:               "MFI-6",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "MFI" {
+		t.Errorf("segment name = %q, want MFI", seg.Name())
+	}
+
+	parsed, err := ParseMFI(seg)
+	if err != nil {
+		t.Fatalf("ParseMFI() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
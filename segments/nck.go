@@ -0,0 +1,61 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// NCK represents the System Clock segment.
+//
+// Field positions follow the HL7 standard where NCK-1 is the first field
+// after the segment name.
+type NCK struct {
+	// SystemDateTime is NCK-1: System Date/Time (TS, required, max length 26).
+	SystemDateTime string `hl7:"NCK.1,required,maxlen=26"`
+}
+
+// ErrNotNCKSegment indicates the segment is not an NCK segment.
+var ErrNotNCKSegment = fmt.Errorf("segment is not NCK")
+
+// ParseNCK extracts NCK segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an NCK segment.
+func ParseNCK(seg hl7.Segment) (*NCK, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "NCK" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotNCKSegment, seg.Name())
+	}
+
+	nck := &NCK{
+		SystemDateTime: getFieldValue(seg, 1),
+	}
+
+	return nck, nil
+}
+
+// ToSegment converts the NCK struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (n *NCK) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		n.SystemDateTime,
+	}
+
+	data := buildSegmentData("NCK", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NCK segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,68 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseNCK(t *testing.T) {
+	input := "NCK|NCK-1"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseNCK(seg)
+	if err != nil {
+		t.Fatalf("ParseNCK() unexpected error: %v", err)
+	}
+
+	want := NCK{
+		SystemDateTime: "NCK-1",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseNCK() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseNCK_WrongSegment(t *testing.T) {
+	if _, err := ParseNCK(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseNCK(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseNCK(seg); !errors.Is(err, ErrNotNCKSegment) {
+		t.Errorf("ParseNCK() error = %v, want ErrNotNCKSegment", err)
+	}
+}
+
+func TestNCK_RoundTrip(t *testing.T) {
+	original := &NCK{
+		SystemDateTime: "NCK-1",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "NCK" {
+		t.Errorf("segment name = %q, want NCK", seg.Name())
+	}
+
+	parsed, err := ParseNCK(seg)
+	if err != nil {
+		t.Fatalf("ParseNCK() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// NDS represents the Notification Detail segment.
+//
+// Field positions follow the HL7 standard where NDS-1 is the first field
+// after the segment name.
+type NDS struct {
+	// NotificationReferenceNumber is NDS-1: Notification Reference Number (NM, required, max length 20).
+	NotificationReferenceNumber string `hl7:"NDS.1,required,maxlen=20"`
+
+	// NotificationDateTime is NDS-2: Notification Date/Time (TS, required, max length 26).
+	NotificationDateTime string `hl7:"NDS.2,required,maxlen=26"`
+
+	// NotificationAlertSeverity is NDS-3: Notification Alert Severity (CE, required, max length 250).
+	NotificationAlertSeverity string `hl7:"NDS.3,required,maxlen=250"`
+
+	// NotificationCode is NDS-4: Notification Code (CE, required, max length 250).
+	NotificationCode string `hl7:"NDS.4,required,maxlen=250"`
+}
+
+// ErrNotNDSSegment indicates the segment is not an NDS segment.
+var ErrNotNDSSegment = fmt.Errorf("segment is not NDS")
+
+// ParseNDS extracts NDS segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an NDS segment.
+func ParseNDS(seg hl7.Segment) (*NDS, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "NDS" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotNDSSegment, seg.Name())
+	}
+
+	nds := &NDS{
+		NotificationReferenceNumber: getFieldValue(seg, 1),
+		NotificationDateTime:        getFieldValue(seg, 2),
+		NotificationAlertSeverity:   getFieldValue(seg, 3),
+		NotificationCode:            getFieldValue(seg, 4),
+	}
+
+	return nds, nil
+}
+
+// ToSegment converts the NDS struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (n *NDS) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		n.NotificationReferenceNumber,
+		n.NotificationDateTime,
+		n.NotificationAlertSeverity,
+		n.NotificationCode,
+	}
+
+	data := buildSegmentData("NDS", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NDS segment: %w", err)
+	}
+
+	return seg, nil
+}
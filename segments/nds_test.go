@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseNDS(t *testing.T) {
+	input := "NDS|NDS-1|NDS-2|NDS-3|NDS-4"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseNDS(seg)
+	if err != nil {
+		t.Fatalf("ParseNDS() unexpected error: %v", err)
+	}
+
+	want := NDS{
+		NotificationReferenceNumber: "NDS-1",
+		NotificationDateTime:        "NDS-2",
+		NotificationAlertSeverity:   "NDS-3",
+		NotificationCode:            "NDS-4",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseNDS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseNDS_WrongSegment(t *testing.T) {
+	if _, err := ParseNDS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseNDS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseNDS(seg); !errors.Is(err, ErrNotNDSSegment) {
+		t.Errorf("ParseNDS() error = %v, want ErrNotNDSSegment", err)
+	}
+}
+
+func TestNDS_RoundTrip(t *testing.T) {
+	original := &NDS{
+		NotificationReferenceNumber: "NDS-1",
+		NotificationDateTime:        "NDS-2",
+		NotificationAlertSeverity:   "NDS-3",
+		NotificationCode:            "NDS-4",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "NDS" {
+		t.Errorf("segment name = %q, want NDS", seg.Name())
+	}
+
+	parsed, err := ParseNDS(seg)
+	if err != nil {
+		t.Fatalf("ParseNDS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,251 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// NK1 represents the Next of Kin / Associated Parties segment.
+//
+// Field positions follow the HL7 standard where NK1-1 is the first field
+// after the segment name.
+type NK1 struct {
+	// SetID is NK1-1: Set ID - NK1 (SI, required, max length 4).
+	SetID string `hl7:"NK1.1,required,maxlen=4"`
+
+	// Name is NK1-2: Name (XPN, optional, repeating, max length 250).
+	Name []string `hl7:"NK1.2,maxlen=250"`
+
+	// Relationship is NK1-3: Relationship (CE, optional, max length 250).
+	Relationship string `hl7:"NK1.3,maxlen=250"`
+
+	// Address is NK1-4: Address (XAD, optional, repeating, max length 250).
+	Address []string `hl7:"NK1.4,maxlen=250"`
+
+	// PhoneNumber is NK1-5: Phone Number (XTN, optional, repeating, max length 250).
+	PhoneNumber []string `hl7:"NK1.5,maxlen=250"`
+
+	// BusinessPhoneNumber is NK1-6: Business Phone Number (XTN, optional, repeating, max length 250).
+	BusinessPhoneNumber []string `hl7:"NK1.6,maxlen=250"`
+
+	// ContactRole is NK1-7: Contact Role (CE, optional, max length 250).
+	ContactRole string `hl7:"NK1.7,maxlen=250"`
+
+	// StartDate is NK1-8: Start Date (DT, optional, max length 8).
+	StartDate string `hl7:"NK1.8,maxlen=8"`
+
+	// EndDate is NK1-9: End Date (DT, optional, max length 8).
+	EndDate string `hl7:"NK1.9,maxlen=8"`
+
+	// JobTitle is NK1-10: Next of Kin / Associated Parties Job Title (ST, optional, max length 60).
+	JobTitle string `hl7:"NK1.10,maxlen=60"`
+
+	// JobCodeClass is NK1-11: Next of Kin / Associated Parties Job Code/Class (JCC, optional, max length 20).
+	JobCodeClass string `hl7:"NK1.11,maxlen=20"`
+
+	// EmployeeNumber is NK1-12: Next of Kin / Associated Parties Employee Number (CX, optional, max length 250).
+	EmployeeNumber string `hl7:"NK1.12,maxlen=250"`
+
+	// OrganizationName is NK1-13: Organization Name - NK1 (XON, optional, repeating, max length 250).
+	OrganizationName []string `hl7:"NK1.13,maxlen=250"`
+
+	// MaritalStatus is NK1-14: Marital Status (CE, optional, max length 250).
+	MaritalStatus string `hl7:"NK1.14,maxlen=250"`
+
+	// AdministrativeSex is NK1-15: Administrative Sex (IS, optional, max length 1).
+	AdministrativeSex string `hl7:"NK1.15,maxlen=1"`
+
+	// DateTimeOfBirth is NK1-16: Date/Time of Birth (TS, optional, max length 26).
+	DateTimeOfBirth string `hl7:"NK1.16,maxlen=26"`
+
+	// LivingDependency is NK1-17: Living Dependency (IS, optional, repeating, max length 2).
+	LivingDependency []string `hl7:"NK1.17,maxlen=2"`
+
+	// AmbulatoryStatus is NK1-18: Ambulatory Status (IS, optional, repeating, max length 2).
+	AmbulatoryStatus []string `hl7:"NK1.18,maxlen=2"`
+
+	// Citizenship is NK1-19: Citizenship (CE, optional, repeating, max length 250).
+	Citizenship []string `hl7:"NK1.19,maxlen=250"`
+
+	// PrimaryLanguage is NK1-20: Primary Language (CE, optional, max length 250).
+	PrimaryLanguage string `hl7:"NK1.20,maxlen=250"`
+
+	// LivingArrangement is NK1-21: Living Arrangement (IS, optional, max length 2).
+	LivingArrangement string `hl7:"NK1.21,maxlen=2"`
+
+	// PublicityCode is NK1-22: Publicity Code (CE, optional, max length 250).
+	PublicityCode string `hl7:"NK1.22,maxlen=250"`
+
+	// ProtectionIndicator is NK1-23: Protection Indicator (ID, optional, max length 1).
+	ProtectionIndicator string `hl7:"NK1.23,maxlen=1"`
+
+	// StudentIndicator is NK1-24: Student Indicator (IS, optional, max length 2).
+	StudentIndicator string `hl7:"NK1.24,maxlen=2"`
+
+	// Religion is NK1-25: Religion (CE, optional, max length 250).
+	Religion string `hl7:"NK1.25,maxlen=250"`
+
+	// MothersMaidenName is NK1-26: Mother's Maiden Name (XPN, optional, repeating, max length 250).
+	MothersMaidenName []string `hl7:"NK1.26,maxlen=250"`
+
+	// Nationality is NK1-27: Nationality (CE, optional, max length 250).
+	Nationality string `hl7:"NK1.27,maxlen=250"`
+
+	// EthnicGroup is NK1-28: Ethnic Group (CE, optional, repeating, max length 250).
+	EthnicGroup []string `hl7:"NK1.28,maxlen=250"`
+
+	// ContactReason is NK1-29: Contact Reason (CE, optional, repeating, max length 250).
+	ContactReason []string `hl7:"NK1.29,maxlen=250"`
+
+	// ContactPersonName is NK1-30: Contact Person's Name (XPN, optional, repeating, max length 250).
+	ContactPersonName []string `hl7:"NK1.30,maxlen=250"`
+
+	// ContactPersonTelephoneNumber is NK1-31: Contact Person's Telephone Number (XTN, optional, repeating, max length 250).
+	ContactPersonTelephoneNumber []string `hl7:"NK1.31,maxlen=250"`
+
+	// ContactPersonAddress is NK1-32: Contact Person's Address (XAD, optional, repeating, max length 250).
+	ContactPersonAddress []string `hl7:"NK1.32,maxlen=250"`
+
+	// AssociatedPartyIdentifiers is NK1-33: Next of Kin/Associated Party's Identifiers (CX, optional, repeating, max length 250).
+	AssociatedPartyIdentifiers []string `hl7:"NK1.33,maxlen=250"`
+
+	// JobStatus is NK1-34: Job Status (IS, optional, max length 2).
+	JobStatus string `hl7:"NK1.34,maxlen=2"`
+
+	// Race is NK1-35: Race (CE, optional, repeating, max length 250).
+	Race []string `hl7:"NK1.35,maxlen=250"`
+
+	// Handicap is NK1-36: Handicap (IS, optional, max length 2).
+	Handicap string `hl7:"NK1.36,maxlen=2"`
+
+	// ContactPersonSSN is NK1-37: Contact Person Social Security Number (ST, optional, max length 16).
+	ContactPersonSSN string `hl7:"NK1.37,maxlen=16"`
+
+	// BirthPlace is NK1-38: Next of Kin Birth Place (ST, optional, max length 250).
+	BirthPlace string `hl7:"NK1.38,maxlen=250"`
+
+	// VIPIndicator is NK1-39: VIP Indicator (IS, optional, max length 2).
+	VIPIndicator string `hl7:"NK1.39,maxlen=2"`
+}
+
+// ErrNotNK1Segment indicates the segment is not an NK1 segment.
+var ErrNotNK1Segment = fmt.Errorf("segment is not NK1")
+
+// ParseNK1 extracts NK1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an NK1 segment.
+func ParseNK1(seg hl7.Segment) (*NK1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "NK1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotNK1Segment, seg.Name())
+	}
+
+	nk1 := &NK1{
+		SetID:                        getFieldValue(seg, 1),
+		Name:                         getFieldRepetitions(seg, 2),
+		Relationship:                 getFieldValue(seg, 3),
+		Address:                      getFieldRepetitions(seg, 4),
+		PhoneNumber:                  getFieldRepetitions(seg, 5),
+		BusinessPhoneNumber:          getFieldRepetitions(seg, 6),
+		ContactRole:                  getFieldValue(seg, 7),
+		StartDate:                    getFieldValue(seg, 8),
+		EndDate:                      getFieldValue(seg, 9),
+		JobTitle:                     getFieldValue(seg, 10),
+		JobCodeClass:                 getFieldValue(seg, 11),
+		EmployeeNumber:               getFieldValue(seg, 12),
+		OrganizationName:             getFieldRepetitions(seg, 13),
+		MaritalStatus:                getFieldValue(seg, 14),
+		AdministrativeSex:            getFieldValue(seg, 15),
+		DateTimeOfBirth:              getFieldValue(seg, 16),
+		LivingDependency:             getFieldRepetitions(seg, 17),
+		AmbulatoryStatus:             getFieldRepetitions(seg, 18),
+		Citizenship:                  getFieldRepetitions(seg, 19),
+		PrimaryLanguage:              getFieldValue(seg, 20),
+		LivingArrangement:            getFieldValue(seg, 21),
+		PublicityCode:                getFieldValue(seg, 22),
+		ProtectionIndicator:          getFieldValue(seg, 23),
+		StudentIndicator:             getFieldValue(seg, 24),
+		Religion:                     getFieldValue(seg, 25),
+		MothersMaidenName:            getFieldRepetitions(seg, 26),
+		Nationality:                  getFieldValue(seg, 27),
+		EthnicGroup:                  getFieldRepetitions(seg, 28),
+		ContactReason:                getFieldRepetitions(seg, 29),
+		ContactPersonName:            getFieldRepetitions(seg, 30),
+		ContactPersonTelephoneNumber: getFieldRepetitions(seg, 31),
+		ContactPersonAddress:         getFieldRepetitions(seg, 32),
+		AssociatedPartyIdentifiers:   getFieldRepetitions(seg, 33),
+		JobStatus:                    getFieldValue(seg, 34),
+		Race:                         getFieldRepetitions(seg, 35),
+		Handicap:                     getFieldValue(seg, 36),
+		ContactPersonSSN:             getFieldValue(seg, 37),
+		BirthPlace:                   getFieldValue(seg, 38),
+		VIPIndicator:                 getFieldValue(seg, 39),
+	}
+
+	return nk1, nil
+}
+
+// ToSegment converts the NK1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (n *NK1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		n.SetID,
+		joinRepetitions(n.Name, delims),
+		n.Relationship,
+		joinRepetitions(n.Address, delims),
+		joinRepetitions(n.PhoneNumber, delims),
+		joinRepetitions(n.BusinessPhoneNumber, delims),
+		n.ContactRole,
+		n.StartDate,
+		n.EndDate,
+		n.JobTitle,
+		n.JobCodeClass,
+		n.EmployeeNumber,
+		joinRepetitions(n.OrganizationName, delims),
+		n.MaritalStatus,
+		n.AdministrativeSex,
+		n.DateTimeOfBirth,
+		joinRepetitions(n.LivingDependency, delims),
+		joinRepetitions(n.AmbulatoryStatus, delims),
+		joinRepetitions(n.Citizenship, delims),
+		n.PrimaryLanguage,
+		n.LivingArrangement,
+		n.PublicityCode,
+		n.ProtectionIndicator,
+		n.StudentIndicator,
+		n.Religion,
+		joinRepetitions(n.MothersMaidenName, delims),
+		n.Nationality,
+		joinRepetitions(n.EthnicGroup, delims),
+		joinRepetitions(n.ContactReason, delims),
+		joinRepetitions(n.ContactPersonName, delims),
+		joinRepetitions(n.ContactPersonTelephoneNumber, delims),
+		joinRepetitions(n.ContactPersonAddress, delims),
+		joinRepetitions(n.AssociatedPartyIdentifiers, delims),
+		n.JobStatus,
+		joinRepetitions(n.Race, delims),
+		n.Handicap,
+		n.ContactPersonSSN,
+		n.BirthPlace,
+		n.VIPIndicator,
+	}
+
+	data := buildSegmentData("NK1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NK1 segment: %w", err)
+	}
+
+	return seg, nil
+}
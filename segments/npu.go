@@ -0,0 +1,66 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// NPU represents the Bed Status Update segment.
+//
+// Field positions follow the HL7 standard where NPU-1 is the first field
+// after the segment name.
+type NPU struct {
+	// BedLocation is NPU-1: Bed Location (PL, required, max length 80).
+	BedLocation string `hl7:"NPU.1,required,maxlen=80"`
+
+	// BedStatus is NPU-2: Bed Status (IS, optional, max length 1).
+	BedStatus string `hl7:"NPU.2,maxlen=1"`
+}
+
+// ErrNotNPUSegment indicates the segment is not an NPU segment.
+var ErrNotNPUSegment = fmt.Errorf("segment is not NPU")
+
+// ParseNPU extracts NPU segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an NPU segment.
+func ParseNPU(seg hl7.Segment) (*NPU, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "NPU" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotNPUSegment, seg.Name())
+	}
+
+	npu := &NPU{
+		BedLocation: getFieldValue(seg, 1),
+		BedStatus:   getFieldValue(seg, 2),
+	}
+
+	return npu, nil
+}
+
+// ToSegment converts the NPU struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (n *NPU) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		n.BedLocation,
+		n.BedStatus,
+	}
+
+	data := buildSegmentData("NPU", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NPU segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,70 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseNPU(t *testing.T) {
+	input := "NPU|NPU-1|NPU-2"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseNPU(seg)
+	if err != nil {
+		t.Fatalf("ParseNPU() unexpected error: %v", err)
+	}
+
+	want := NPU{
+		BedLocation: "NPU-1",
+		BedStatus:   "NPU-2",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseNPU() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseNPU_WrongSegment(t *testing.T) {
+	if _, err := ParseNPU(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseNPU(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseNPU(seg); !errors.Is(err, ErrNotNPUSegment) {
+		t.Errorf("ParseNPU() error = %v, want ErrNotNPUSegment", err)
+	}
+}
+
+func TestNPU_RoundTrip(t *testing.T) {
+	original := &NPU{
+		BedLocation: "NPU-1",
+		BedStatus:   "NPU-2",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "NPU" {
+		t.Errorf("segment name = %q, want NPU", seg.Name())
+	}
+
+	parsed, err := ParseNPU(seg)
+	if err != nil {
+		t.Fatalf("ParseNPU() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
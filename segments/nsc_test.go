@@ -0,0 +1,84 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseNSC(t *testing.T) {
+	input := "NSC|NSC-1|NSC-2|NSC-3|NSC-4|NSC-5|NSC-6|NSC-7|NSC-8|NSC-9"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseNSC(seg)
+	if err != nil {
+		t.Fatalf("ParseNSC() unexpected error: %v", err)
+	}
+
+	want := NSC{
+		ApplicationChangeType: "NSC-1",
+		CurrentCPU:            "NSC-2",
+		CurrentFileserver:     "NSC-3",
+		CurrentApplication:    "NSC-4",
+		CurrentFacility:       "NSC-5",
+		NewCPU:                "NSC-6",
+		NewFileserver:         "NSC-7",
+		NewApplication:        "NSC-8",
+		NewFacility:           "NSC-9",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseNSC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseNSC_WrongSegment(t *testing.T) {
+	if _, err := ParseNSC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseNSC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseNSC(seg); !errors.Is(err, ErrNotNSCSegment) {
+		t.Errorf("ParseNSC() error = %v, want ErrNotNSCSegment", err)
+	}
+}
+
+func TestNSC_RoundTrip(t *testing.T) {
+	original := &NSC{
+		ApplicationChangeType: "NSC-1",
+		CurrentCPU:            "NSC-2",
+		CurrentFileserver:     "NSC-3",
+		CurrentApplication:    "NSC-4",
+		CurrentFacility:       "NSC-5",
+		NewCPU:                "NSC-6",
+		NewFileserver:         "NSC-7",
+		NewApplication:        "NSC-8",
+		NewFacility:           "NSC-9",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "NSC" {
+		t.Errorf("segment name = %q, want NSC", seg.Name())
+	}
+
+	parsed, err := ParseNSC(seg)
+	if err != nil {
+		t.Fatalf("ParseNSC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
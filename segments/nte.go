@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// NTE represents the Notes and Comments segment.
+//
+// Field positions follow the HL7 standard where NTE-1 is the first field
+// after the segment name.
+type NTE struct {
+	// SetID is NTE-1: Set ID - NTE (SI, optional, max length 4).
+	SetID string `hl7:"NTE.1,maxlen=4"`
+
+	// SourceOfComment is NTE-2: Source of Comment (ID, optional, max length 8).
+	SourceOfComment string `hl7:"NTE.2,maxlen=8"`
+
+	// Comment is NTE-3: Comment (FT, optional, repeating, max length 65536).
+	Comment []string `hl7:"NTE.3,maxlen=65536"`
+
+	// CommentType is NTE-4: Comment Type (CE, optional, max length 250).
+	CommentType string `hl7:"NTE.4,maxlen=250"`
+}
+
+// ErrNotNTESegment indicates the segment is not an NTE segment.
+var ErrNotNTESegment = fmt.Errorf("segment is not NTE")
+
+// ParseNTE extracts NTE segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an NTE segment.
+func ParseNTE(seg hl7.Segment) (*NTE, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "NTE" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotNTESegment, seg.Name())
+	}
+
+	nte := &NTE{
+		SetID:           getFieldValue(seg, 1),
+		SourceOfComment: getFieldValue(seg, 2),
+		Comment:         getFieldRepetitions(seg, 3),
+		CommentType:     getFieldValue(seg, 4),
+	}
+
+	return nte, nil
+}
+
+// ToSegment converts the NTE struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (n *NTE) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		n.SetID,
+		n.SourceOfComment,
+		joinRepetitions(n.Comment, delims),
+		n.CommentType,
+	}
+
+	data := buildSegmentData("NTE", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NTE segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ODS represents the Dietary Orders, Supplements, and Preferences segment.
+//
+// Field positions follow the HL7 standard where ODS-1 is the first field
+// after the segment name.
+type ODS struct {
+	// Type is ODS-1: Type (ID, required, max length 1).
+	Type string `hl7:"ODS.1,required,maxlen=1"`
+
+	// ServicePeriod is ODS-2: Service Period (CE, optional, repeating, max length 250).
+	ServicePeriod []string `hl7:"ODS.2,maxlen=250"`
+
+	// DietSupplementOrPreferenceCode is ODS-3: Diet, Supplement, or Preference Code (CE, required, repeating, max length 250).
+	DietSupplementOrPreferenceCode []string `hl7:"ODS.3,required,maxlen=250"`
+
+	// TextInstruction is ODS-4: Text Instruction (ST, optional, repeating, max length 80).
+	TextInstruction []string `hl7:"ODS.4,maxlen=80"`
+}
+
+// ErrNotODSSegment indicates the segment is not an ODS segment.
+var ErrNotODSSegment = fmt.Errorf("segment is not ODS")
+
+// ParseODS extracts ODS segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an ODS segment.
+func ParseODS(seg hl7.Segment) (*ODS, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "ODS" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotODSSegment, seg.Name())
+	}
+
+	ods := &ODS{
+		Type:                           getFieldValue(seg, 1),
+		ServicePeriod:                  getFieldRepetitions(seg, 2),
+		DietSupplementOrPreferenceCode: getFieldRepetitions(seg, 3),
+		TextInstruction:                getFieldRepetitions(seg, 4),
+	}
+
+	return ods, nil
+}
+
+// ToSegment converts the ODS struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (o *ODS) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		o.Type,
+		joinRepetitions(o.ServicePeriod, delims),
+		joinRepetitions(o.DietSupplementOrPreferenceCode, delims),
+		joinRepetitions(o.TextInstruction, delims),
+	}
+
+	data := buildSegmentData("ODS", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ODS segment: %w", err)
+	}
+
+	return seg, nil
+}
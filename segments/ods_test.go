@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseODS(t *testing.T) {
+	input := "ODS|ODS-1|ODS-2a~ODS-2b|ODS-3a~ODS-3b|ODS-4a~ODS-4b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseODS(seg)
+	if err != nil {
+		t.Fatalf("ParseODS() unexpected error: %v", err)
+	}
+
+	want := ODS{
+		Type:                           "ODS-1",
+		ServicePeriod:                  []string{"ODS-2a", "ODS-2b"},
+		DietSupplementOrPreferenceCode: []string{"ODS-3a", "ODS-3b"},
+		TextInstruction:                []string{"ODS-4a", "ODS-4b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseODS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseODS_WrongSegment(t *testing.T) {
+	if _, err := ParseODS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseODS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseODS(seg); !errors.Is(err, ErrNotODSSegment) {
+		t.Errorf("ParseODS() error = %v, want ErrNotODSSegment", err)
+	}
+}
+
+func TestODS_RoundTrip(t *testing.T) {
+	original := &ODS{
+		Type:                           "ODS-1",
+		ServicePeriod:                  []string{"ODS-2a", "ODS-2b"},
+		DietSupplementOrPreferenceCode: []string{"ODS-3a", "ODS-3b"},
+		TextInstruction:                []string{"ODS-4a", "ODS-4b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ODS" {
+		t.Errorf("segment name = %q, want ODS", seg.Name())
+	}
+
+	parsed, err := ParseODS(seg)
+	if err != nil {
+		t.Fatalf("ParseODS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
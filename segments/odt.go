@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ODT represents the Diet Tray Instructions segment.
+//
+// Field positions follow the HL7 standard where ODT-1 is the first field
+// after the segment name.
+type ODT struct {
+	// TrayType is ODT-1: Tray Type (CE, required, max length 250).
+	TrayType string `hl7:"ODT.1,required,maxlen=250"`
+
+	// ServicePeriod is ODT-2: Service Period (CE, optional, repeating, max length 250).
+	ServicePeriod []string `hl7:"ODT.2,maxlen=250"`
+
+	// TextInstruction is ODT-3: Text Instruction (ST, optional, max length 80).
+	TextInstruction string `hl7:"ODT.3,maxlen=80"`
+}
+
+// ErrNotODTSegment indicates the segment is not an ODT segment.
+var ErrNotODTSegment = fmt.Errorf("segment is not ODT")
+
+// ParseODT extracts ODT segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an ODT segment.
+func ParseODT(seg hl7.Segment) (*ODT, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "ODT" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotODTSegment, seg.Name())
+	}
+
+	odt := &ODT{
+		TrayType:        getFieldValue(seg, 1),
+		ServicePeriod:   getFieldRepetitions(seg, 2),
+		TextInstruction: getFieldValue(seg, 3),
+	}
+
+	return odt, nil
+}
+
+// ToSegment converts the ODT struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (o *ODT) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		o.TrayType,
+		joinRepetitions(o.ServicePeriod, delims),
+		o.TextInstruction,
+	}
+
+	data := buildSegmentData("ODT", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ODT segment: %w", err)
+	}
+
+	return seg, nil
+}
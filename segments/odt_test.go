@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseODT(t *testing.T) {
+	input := "ODT|ODT-1|ODT-2a~ODT-2b|ODT-3"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseODT(seg)
+	if err != nil {
+		t.Fatalf("ParseODT() unexpected error: %v", err)
+	}
+
+	want := ODT{
+		TrayType:        "ODT-1",
+		ServicePeriod:   []string{"ODT-2a", "ODT-2b"},
+		TextInstruction: "ODT-3",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseODT() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseODT_WrongSegment(t *testing.T) {
+	if _, err := ParseODT(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseODT(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseODT(seg); !errors.Is(err, ErrNotODTSegment) {
+		t.Errorf("ParseODT() error = %v, want ErrNotODTSegment", err)
+	}
+}
+
+func TestODT_RoundTrip(t *testing.T) {
+	original := &ODT{
+		TrayType:        "ODT-1",
+		ServicePeriod:   []string{"ODT-2a", "ODT-2b"},
+		TextInstruction: "ODT-3",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ODT" {
+		t.Errorf("segment name = %q, want ODT", seg.Name())
+	}
+
+	parsed, err := ParseODT(seg)
+	if err != nil {
+		t.Fatalf("ParseODT() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
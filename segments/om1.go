@@ -0,0 +1,291 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// OM1 represents the General segment.
+//
+// Field positions follow the HL7 standard where OM1-1 is the first field
+// after the segment name.
+type OM1 struct {
+	// SequenceNumberTestObservationMasterFile is OM1-1: Sequence Number - Test/Observation Master File (NM, required, max length 4).
+	SequenceNumberTestObservationMasterFile string `hl7:"OM1.1,required,maxlen=4"`
+
+	// ProducersServiceTestObservationID is OM1-2: Producer's Service/Test/Observation ID (CE, required, max length 250).
+	ProducersServiceTestObservationID string `hl7:"OM1.2,required,maxlen=250"`
+
+	// PermittedDataTypes is OM1-3: Permitted Data Types (ID, optional, repeating, max length 12).
+	PermittedDataTypes []string `hl7:"OM1.3,maxlen=12"`
+
+	// SpecimenRequired is OM1-4: Specimen Required (ID, required, max length 1).
+	SpecimenRequired string `hl7:"OM1.4,required,maxlen=1"`
+
+	// ProducerID is OM1-5: Producer ID (CE, required, max length 250).
+	ProducerID string `hl7:"OM1.5,required,maxlen=250"`
+
+	// ObservationDescription is OM1-6: Observation Description (TX, optional, max length 200).
+	ObservationDescription string `hl7:"OM1.6,maxlen=200"`
+
+	// OtherServiceTestObservationIDsForTheObservation is OM1-7: Other Service/Test/Observation IDs for the Observation (CE, optional, max length 250).
+	OtherServiceTestObservationIDsForTheObservation string `hl7:"OM1.7,maxlen=250"`
+
+	// OtherNames is OM1-8: Other Names (ST, required, repeating, max length 200).
+	OtherNames []string `hl7:"OM1.8,required,maxlen=200"`
+
+	// PreferredReportNameForTheObservation is OM1-9: Preferred Report Name for the Observation (ST, optional, max length 30).
+	PreferredReportNameForTheObservation string `hl7:"OM1.9,maxlen=30"`
+
+	// PreferredShortNameOrMnemonicForObservation is OM1-10: Preferred Short Name or Mnemonic for Observation (ST, optional, max length 8).
+	PreferredShortNameOrMnemonicForObservation string `hl7:"OM1.10,maxlen=8"`
+
+	// PreferredLongNameForTheObservation is OM1-11: Preferred Long Name for the Observation (ST, optional, max length 200).
+	PreferredLongNameForTheObservation string `hl7:"OM1.11,maxlen=200"`
+
+	// Orderability is OM1-12: Orderability (ID, optional, max length 1).
+	Orderability string `hl7:"OM1.12,maxlen=1"`
+
+	// IdentityOfInstrumentUsedToPerformThisStudy is OM1-13: Identity of Instrument Used to Perform this Study (CE, optional, repeating, max length 250).
+	IdentityOfInstrumentUsedToPerformThisStudy []string `hl7:"OM1.13,maxlen=250"`
+
+	// CodedRepresentationOfMethod is OM1-14: Coded Representation of Method (CE, optional, repeating, max length 250).
+	CodedRepresentationOfMethod []string `hl7:"OM1.14,maxlen=250"`
+
+	// PortableDeviceIndicator is OM1-15: Portable Device Indicator (ID, optional, max length 1).
+	PortableDeviceIndicator string `hl7:"OM1.15,maxlen=1"`
+
+	// ObservationProducingDepartmentSection is OM1-16: Observation Producing Department/Section (CE, optional, repeating, max length 250).
+	ObservationProducingDepartmentSection []string `hl7:"OM1.16,maxlen=250"`
+
+	// TelephoneNumberOfSection is OM1-17: Telephone Number of Section (XTN, optional, max length 250).
+	TelephoneNumberOfSection string `hl7:"OM1.17,maxlen=250"`
+
+	// NatureOfServiceTestObservation is OM1-18: Nature of Service/Test/Observation (IS, required, max length 1).
+	NatureOfServiceTestObservation string `hl7:"OM1.18,required,maxlen=1"`
+
+	// ReportSubheader is OM1-19: Report Subheader (CE, optional, max length 250).
+	ReportSubheader string `hl7:"OM1.19,maxlen=250"`
+
+	// ReportDisplayOrder is OM1-20: Report Display Order (ST, optional, max length 20).
+	ReportDisplayOrder string `hl7:"OM1.20,maxlen=20"`
+
+	// DateTimeStampForAnyChangeInDefinitionForTheObservation is OM1-21: Date/Time Stamp for any change in Definition for the Observation (TS, optional, max length 26).
+	DateTimeStampForAnyChangeInDefinitionForTheObservation string `hl7:"OM1.21,maxlen=26"`
+
+	// EffectiveDateTimeOfChange is OM1-22: Effective Date/Time of Change (TS, optional, max length 26).
+	EffectiveDateTimeOfChange string `hl7:"OM1.22,maxlen=26"`
+
+	// TypicalTurnAroundTime is OM1-23: Typical Turn-Around Time (NM, optional, max length 20).
+	TypicalTurnAroundTime string `hl7:"OM1.23,maxlen=20"`
+
+	// ProcessingTime is OM1-24: Processing Time (NM, optional, max length 20).
+	ProcessingTime string `hl7:"OM1.24,maxlen=20"`
+
+	// ProcessingPriority is OM1-25: Processing Priority (ID, optional, repeating, max length 40).
+	ProcessingPriority []string `hl7:"OM1.25,maxlen=40"`
+
+	// ReportingPriority is OM1-26: Reporting Priority (ID, optional, max length 5).
+	ReportingPriority string `hl7:"OM1.26,maxlen=5"`
+
+	// OutsideSiteWhereObservationMayBePerformed is OM1-27: Outside Site(s) Where Observation may be Performed (CE, optional, repeating, max length 250).
+	OutsideSiteWhereObservationMayBePerformed []string `hl7:"OM1.27,maxlen=250"`
+
+	// AddressOfOutsideSite is OM1-28: Address of Outside Site(s) (XAD, optional, repeating, max length 250).
+	AddressOfOutsideSite []string `hl7:"OM1.28,maxlen=250"`
+
+	// PhoneNumberOfOutsideSite is OM1-29: Phone Number of Outside Site (XTN, optional, max length 250).
+	PhoneNumberOfOutsideSite string `hl7:"OM1.29,maxlen=250"`
+
+	// ConfidentialityCode is OM1-30: Confidentiality Code (CWE, optional, max length 250).
+	ConfidentialityCode string `hl7:"OM1.30,maxlen=250"`
+
+	// ObservationsRequiredToInterpretTheObservation is OM1-31: Observations Required to Interpret the Observation (CE, optional, max length 250).
+	ObservationsRequiredToInterpretTheObservation string `hl7:"OM1.31,maxlen=250"`
+
+	// InterpretationOfObservations is OM1-32: Interpretation of Observations (TX, optional, max length 65536).
+	InterpretationOfObservations string `hl7:"OM1.32,maxlen=65536"`
+
+	// ContraindicationsToObservations is OM1-33: Contraindications to Observations (CE, optional, max length 250).
+	ContraindicationsToObservations string `hl7:"OM1.33,maxlen=250"`
+
+	// ReflexTestsObservations is OM1-34: Reflex Tests/Observations (CE, optional, repeating, max length 250).
+	ReflexTestsObservations []string `hl7:"OM1.34,maxlen=250"`
+
+	// RulesThatTriggerReflexTesting is OM1-35: Rules that Trigger Reflex Testing (TX, optional, max length 80).
+	RulesThatTriggerReflexTesting string `hl7:"OM1.35,maxlen=80"`
+
+	// FixedCannedMessage is OM1-36: Fixed Canned Message (CE, optional, max length 250).
+	FixedCannedMessage string `hl7:"OM1.36,maxlen=250"`
+
+	// PatientPreparation is OM1-37: Patient Preparation (TX, optional, max length 200).
+	PatientPreparation string `hl7:"OM1.37,maxlen=200"`
+
+	// ProcedureMedication is OM1-38: Procedure Medication (CE, optional, max length 250).
+	ProcedureMedication string `hl7:"OM1.38,maxlen=250"`
+
+	// FactorsThatMayAffectTheObservation is OM1-39: Factors that may Affect the Observation (TX, optional, max length 200).
+	FactorsThatMayAffectTheObservation string `hl7:"OM1.39,maxlen=200"`
+
+	// ServiceTestObservationPerformanceSchedule is OM1-40: Service/Test/Observation Performance Schedule (ST, optional, repeating, max length 60).
+	ServiceTestObservationPerformanceSchedule []string `hl7:"OM1.40,maxlen=60"`
+
+	// DescriptionOfTestMethods is OM1-41: Description of Test Methods (TX, optional, max length 65536).
+	DescriptionOfTestMethods string `hl7:"OM1.41,maxlen=65536"`
+
+	// KindOfQuantityObserved is OM1-42: Kind of Quantity Observed (CE, optional, max length 250).
+	KindOfQuantityObserved string `hl7:"OM1.42,maxlen=250"`
+
+	// PointVersusInterval is OM1-43: Point Versus Interval (CE, optional, max length 250).
+	PointVersusInterval string `hl7:"OM1.43,maxlen=250"`
+
+	// ChallengeInformation is OM1-44: Challenge Information (TX, optional, max length 200).
+	ChallengeInformation string `hl7:"OM1.44,maxlen=200"`
+
+	// RelationshipModifier is OM1-45: Relationship Modifier (CE, optional, max length 250).
+	RelationshipModifier string `hl7:"OM1.45,maxlen=250"`
+
+	// TargetAnatomicSiteOfTest is OM1-46: Target Anatomic Site Of Test (CE, optional, max length 250).
+	TargetAnatomicSiteOfTest string `hl7:"OM1.46,maxlen=250"`
+
+	// ModalityOfImagingMeasurement is OM1-47: Modality Of Imaging Measurement (CE, optional, max length 250).
+	ModalityOfImagingMeasurement string `hl7:"OM1.47,maxlen=250"`
+}
+
+// ErrNotOM1Segment indicates the segment is not an OM1 segment.
+var ErrNotOM1Segment = fmt.Errorf("segment is not OM1")
+
+// ParseOM1 extracts OM1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an OM1 segment.
+func ParseOM1(seg hl7.Segment) (*OM1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "OM1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotOM1Segment, seg.Name())
+	}
+
+	om1 := &OM1{
+		SequenceNumberTestObservationMasterFile:                getFieldValue(seg, 1),
+		ProducersServiceTestObservationID:                      getFieldValue(seg, 2),
+		PermittedDataTypes:                                     getFieldRepetitions(seg, 3),
+		SpecimenRequired:                                       getFieldValue(seg, 4),
+		ProducerID:                                             getFieldValue(seg, 5),
+		ObservationDescription:                                 getFieldValue(seg, 6),
+		OtherServiceTestObservationIDsForTheObservation:        getFieldValue(seg, 7),
+		OtherNames:                                             getFieldRepetitions(seg, 8),
+		PreferredReportNameForTheObservation:                   getFieldValue(seg, 9),
+		PreferredShortNameOrMnemonicForObservation:             getFieldValue(seg, 10),
+		PreferredLongNameForTheObservation:                     getFieldValue(seg, 11),
+		Orderability:                                           getFieldValue(seg, 12),
+		IdentityOfInstrumentUsedToPerformThisStudy:             getFieldRepetitions(seg, 13),
+		CodedRepresentationOfMethod:                            getFieldRepetitions(seg, 14),
+		PortableDeviceIndicator:                                getFieldValue(seg, 15),
+		ObservationProducingDepartmentSection:                  getFieldRepetitions(seg, 16),
+		TelephoneNumberOfSection:                               getFieldValue(seg, 17),
+		NatureOfServiceTestObservation:                         getFieldValue(seg, 18),
+		ReportSubheader:                                        getFieldValue(seg, 19),
+		ReportDisplayOrder:                                     getFieldValue(seg, 20),
+		DateTimeStampForAnyChangeInDefinitionForTheObservation: getFieldValue(seg, 21),
+		EffectiveDateTimeOfChange:                              getFieldValue(seg, 22),
+		TypicalTurnAroundTime:                                  getFieldValue(seg, 23),
+		ProcessingTime:                                         getFieldValue(seg, 24),
+		ProcessingPriority:                                     getFieldRepetitions(seg, 25),
+		ReportingPriority:                                      getFieldValue(seg, 26),
+		OutsideSiteWhereObservationMayBePerformed:              getFieldRepetitions(seg, 27),
+		AddressOfOutsideSite:                                   getFieldRepetitions(seg, 28),
+		PhoneNumberOfOutsideSite:                               getFieldValue(seg, 29),
+		ConfidentialityCode:                                    getFieldValue(seg, 30),
+		ObservationsRequiredToInterpretTheObservation:          getFieldValue(seg, 31),
+		InterpretationOfObservations:                           getFieldValue(seg, 32),
+		ContraindicationsToObservations:                        getFieldValue(seg, 33),
+		ReflexTestsObservations:                                getFieldRepetitions(seg, 34),
+		RulesThatTriggerReflexTesting:                          getFieldValue(seg, 35),
+		FixedCannedMessage:                                     getFieldValue(seg, 36),
+		PatientPreparation:                                     getFieldValue(seg, 37),
+		ProcedureMedication:                                    getFieldValue(seg, 38),
+		FactorsThatMayAffectTheObservation:                     getFieldValue(seg, 39),
+		ServiceTestObservationPerformanceSchedule:              getFieldRepetitions(seg, 40),
+		DescriptionOfTestMethods:                               getFieldValue(seg, 41),
+		KindOfQuantityObserved:                                 getFieldValue(seg, 42),
+		PointVersusInterval:                                    getFieldValue(seg, 43),
+		ChallengeInformation:                                   getFieldValue(seg, 44),
+		RelationshipModifier:                                   getFieldValue(seg, 45),
+		TargetAnatomicSiteOfTest:                               getFieldValue(seg, 46),
+		ModalityOfImagingMeasurement:                           getFieldValue(seg, 47),
+	}
+
+	return om1, nil
+}
+
+// ToSegment converts the OM1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (o *OM1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		o.SequenceNumberTestObservationMasterFile,
+		o.ProducersServiceTestObservationID,
+		joinRepetitions(o.PermittedDataTypes, delims),
+		o.SpecimenRequired,
+		o.ProducerID,
+		o.ObservationDescription,
+		o.OtherServiceTestObservationIDsForTheObservation,
+		joinRepetitions(o.OtherNames, delims),
+		o.PreferredReportNameForTheObservation,
+		o.PreferredShortNameOrMnemonicForObservation,
+		o.PreferredLongNameForTheObservation,
+		o.Orderability,
+		joinRepetitions(o.IdentityOfInstrumentUsedToPerformThisStudy, delims),
+		joinRepetitions(o.CodedRepresentationOfMethod, delims),
+		o.PortableDeviceIndicator,
+		joinRepetitions(o.ObservationProducingDepartmentSection, delims),
+		o.TelephoneNumberOfSection,
+		o.NatureOfServiceTestObservation,
+		o.ReportSubheader,
+		o.ReportDisplayOrder,
+		o.DateTimeStampForAnyChangeInDefinitionForTheObservation,
+		o.EffectiveDateTimeOfChange,
+		o.TypicalTurnAroundTime,
+		o.ProcessingTime,
+		joinRepetitions(o.ProcessingPriority, delims),
+		o.ReportingPriority,
+		joinRepetitions(o.OutsideSiteWhereObservationMayBePerformed, delims),
+		joinRepetitions(o.AddressOfOutsideSite, delims),
+		o.PhoneNumberOfOutsideSite,
+		o.ConfidentialityCode,
+		o.ObservationsRequiredToInterpretTheObservation,
+		o.InterpretationOfObservations,
+		o.ContraindicationsToObservations,
+		joinRepetitions(o.ReflexTestsObservations, delims),
+		o.RulesThatTriggerReflexTesting,
+		o.FixedCannedMessage,
+		o.PatientPreparation,
+		o.ProcedureMedication,
+		o.FactorsThatMayAffectTheObservation,
+		joinRepetitions(o.ServiceTestObservationPerformanceSchedule, delims),
+		o.DescriptionOfTestMethods,
+		o.KindOfQuantityObserved,
+		o.PointVersusInterval,
+		o.ChallengeInformation,
+		o.RelationshipModifier,
+		o.TargetAnatomicSiteOfTest,
+		o.ModalityOfImagingMeasurement,
+	}
+
+	data := buildSegmentData("OM1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OM1 segment: %w", err)
+	}
+
+	return seg, nil
+}
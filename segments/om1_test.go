@@ -0,0 +1,160 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseOM1(t *testing.T) {
+	input := "OM1|OM1-1|OM1-2|OM1-3a~OM1-3b|OM1-4|OM1-5|OM1-6|OM1-7|OM1-8a~OM1-8b|OM1-9|OM1-10|OM1-11|OM1-12|OM1-13a~OM1-13b|OM1-14a~OM1-14b|OM1-15|OM1-16a~OM1-16b|OM1-17|OM1-18|OM1-19|OM1-20|OM1-21|OM1-22|OM1-23|OM1-24|OM1-25a~OM1-25b|OM1-26|OM1-27a~OM1-27b|OM1-28a~OM1-28b|OM1-29|OM1-30|OM1-31|OM1-32|OM1-33|OM1-34a~OM1-34b|OM1-35|OM1-36|OM1-37|OM1-38|OM1-39|OM1-40a~OM1-40b|OM1-41|OM1-42|OM1-43|OM1-44|OM1-45|OM1-46|OM1-47"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseOM1(seg)
+	if err != nil {
+		t.Fatalf("ParseOM1() unexpected error: %v", err)
+	}
+
+	want := OM1{
+		SequenceNumberTestObservationMasterFile:                "OM1-1",
+		ProducersServiceTestObservationID:                      "OM1-2",
+		PermittedDataTypes:                                     []string{"OM1-3a", "OM1-3b"},
+		SpecimenRequired:                                       "OM1-4",
+		ProducerID:                                             "OM1-5",
+		ObservationDescription:                                 "OM1-6",
+		OtherServiceTestObservationIDsForTheObservation:        "OM1-7",
+		OtherNames:                                             []string{"OM1-8a", "OM1-8b"},
+		PreferredReportNameForTheObservation:                   "OM1-9",
+		PreferredShortNameOrMnemonicForObservation:             "OM1-10",
+		PreferredLongNameForTheObservation:                     "OM1-11",
+		Orderability:                                           "OM1-12",
+		IdentityOfInstrumentUsedToPerformThisStudy:             []string{"OM1-13a", "OM1-13b"},
+		CodedRepresentationOfMethod:                            []string{"OM1-14a", "OM1-14b"},
+		PortableDeviceIndicator:                                "OM1-15",
+		ObservationProducingDepartmentSection:                  []string{"OM1-16a", "OM1-16b"},
+		TelephoneNumberOfSection:                               "OM1-17",
+		NatureOfServiceTestObservation:                         "OM1-18",
+		ReportSubheader:                                        "OM1-19",
+		ReportDisplayOrder:                                     "OM1-20",
+		DateTimeStampForAnyChangeInDefinitionForTheObservation: "OM1-21",
+		EffectiveDateTimeOfChange:                              "OM1-22",
+		TypicalTurnAroundTime:                                  "OM1-23",
+		ProcessingTime:                                         "OM1-24",
+		ProcessingPriority:                                     []string{"OM1-25a", "OM1-25b"},
+		ReportingPriority:                                      "OM1-26",
+		OutsideSiteWhereObservationMayBePerformed:              []string{"OM1-27a", "OM1-27b"},
+		AddressOfOutsideSite:                                   []string{"OM1-28a", "OM1-28b"},
+		PhoneNumberOfOutsideSite:                               "OM1-29",
+		ConfidentialityCode:                                    "OM1-30",
+		ObservationsRequiredToInterpretTheObservation:          "OM1-31",
+		InterpretationOfObservations:                           "OM1-32",
+		ContraindicationsToObservations:                        "OM1-33",
+		ReflexTestsObservations:                                []string{"OM1-34a", "OM1-34b"},
+		RulesThatTriggerReflexTesting:                          "OM1-35",
+		FixedCannedMessage:                                     "OM1-36",
+		PatientPreparation:                                     "OM1-37",
+		ProcedureMedication:                                    "OM1-38",
+		FactorsThatMayAffectTheObservation:                     "OM1-39",
+		ServiceTestObservationPerformanceSchedule:              []string{"OM1-40a", "OM1-40b"},
+		DescriptionOfTestMethods:                               "OM1-41",
+		KindOfQuantityObserved:                                 "OM1-42",
+		PointVersusInterval:                                    "OM1-43",
+		ChallengeInformation:                                   "OM1-44",
+		RelationshipModifier:                                   "OM1-45",
+		TargetAnatomicSiteOfTest:                               "OM1-46",
+		ModalityOfImagingMeasurement:                           "OM1-47",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseOM1() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseOM1_WrongSegment(t *testing.T) {
+	if _, err := ParseOM1(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseOM1(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseOM1(seg); !errors.Is(err, ErrNotOM1Segment) {
+		t.Errorf("ParseOM1() error = %v, want ErrNotOM1Segment", err)
+	}
+}
+
+func TestOM1_RoundTrip(t *testing.T) {
+	original := &OM1{
+		SequenceNumberTestObservationMasterFile:                "OM1-1",
+		ProducersServiceTestObservationID:                      "OM1-2",
+		PermittedDataTypes:                                     []string{"OM1-3a", "OM1-3b"},
+		SpecimenRequired:                                       "OM1-4",
+		ProducerID:                                             "OM1-5",
+		ObservationDescription:                                 "OM1-6",
+		OtherServiceTestObservationIDsForTheObservation:        "OM1-7",
+		OtherNames:                                             []string{"OM1-8a", "OM1-8b"},
+		PreferredReportNameForTheObservation:                   "OM1-9",
+		PreferredShortNameOrMnemonicForObservation:             "OM1-10",
+		PreferredLongNameForTheObservation:                     "OM1-11",
+		Orderability:                                           "OM1-12",
+		IdentityOfInstrumentUsedToPerformThisStudy:             []string{"OM1-13a", "OM1-13b"},
+		CodedRepresentationOfMethod:                            []string{"OM1-14a", "OM1-14b"},
+		PortableDeviceIndicator:                                "OM1-15",
+		ObservationProducingDepartmentSection:                  []string{"OM1-16a", "OM1-16b"},
+		TelephoneNumberOfSection:                               "OM1-17",
+		NatureOfServiceTestObservation:                         "OM1-18",
+		ReportSubheader:                                        "OM1-19",
+		ReportDisplayOrder:                                     "OM1-20",
+		DateTimeStampForAnyChangeInDefinitionForTheObservation: "OM1-21",
+		EffectiveDateTimeOfChange:                              "OM1-22",
+		TypicalTurnAroundTime:                                  "OM1-23",
+		ProcessingTime:                                         "OM1-24",
+		ProcessingPriority:                                     []string{"OM1-25a", "OM1-25b"},
+		ReportingPriority:                                      "OM1-26",
+		OutsideSiteWhereObservationMayBePerformed:              []string{"OM1-27a", "OM1-27b"},
+		AddressOfOutsideSite:                                   []string{"OM1-28a", "OM1-28b"},
+		PhoneNumberOfOutsideSite:                               "OM1-29",
+		ConfidentialityCode:                                    "OM1-30",
+		ObservationsRequiredToInterpretTheObservation:          "OM1-31",
+		InterpretationOfObservations:                           "OM1-32",
+		ContraindicationsToObservations:                        "OM1-33",
+		ReflexTestsObservations:                                []string{"OM1-34a", "OM1-34b"},
+		RulesThatTriggerReflexTesting:                          "OM1-35",
+		FixedCannedMessage:                                     "OM1-36",
+		PatientPreparation:                                     "OM1-37",
+		ProcedureMedication:                                    "OM1-38",
+		FactorsThatMayAffectTheObservation:                     "OM1-39",
+		ServiceTestObservationPerformanceSchedule:              []string{"OM1-40a", "OM1-40b"},
+		DescriptionOfTestMethods:                               "OM1-41",
+		KindOfQuantityObserved:                                 "OM1-42",
+		PointVersusInterval:                                    "OM1-43",
+		ChallengeInformation:                                   "OM1-44",
+		RelationshipModifier:                                   "OM1-45",
+		TargetAnatomicSiteOfTest:                               "OM1-46",
+		ModalityOfImagingMeasurement:                           "OM1-47",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "OM1" {
+		t.Errorf("segment name = %q, want OM1", seg.Name())
+	}
+
+	parsed, err := ParseOM1(seg)
+	if err != nil {
+		t.Fatalf("ParseOM1() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
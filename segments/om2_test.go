@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseOM2(t *testing.T) {
+	input := "OM2|OM2-1|OM2-2|OM2-3a~OM2-3b|OM2-4|OM2-5|OM2-6a~OM2-6b|OM2-7a~OM2-7b|OM2-8|OM2-9a~OM2-9b|OM2-10"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseOM2(seg)
+	if err != nil {
+		t.Fatalf("ParseOM2() unexpected error: %v", err)
+	}
+
+	want := OM2{
+		SequenceNumberTestObservationMasterFile:          "OM2-1",
+		UnitsOfMeasure:                                   "OM2-2",
+		RangeOfDecimalPrecision:                          []string{"OM2-3a", "OM2-3b"},
+		CorrespondingSIUnitsOfMeasure:                    "OM2-4",
+		SIConversionFactor:                               "OM2-5",
+		ReferenceRangeOrdinalAndContinuousObservations:   []string{"OM2-6a", "OM2-6b"},
+		CriticalRangeForOrdinalAndContinuousObservations: []string{"OM2-7a", "OM2-7b"},
+		AbsoluteRangeForOrdinalAndContinuousObservations: "OM2-8",
+		DeltaCheckCriteria:                               []string{"OM2-9a", "OM2-9b"},
+		MinimumMeaningfulIncrements:                      "OM2-10",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseOM2() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseOM2_WrongSegment(t *testing.T) {
+	if _, err := ParseOM2(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseOM2(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseOM2(seg); !errors.Is(err, ErrNotOM2Segment) {
+		t.Errorf("ParseOM2() error = %v, want ErrNotOM2Segment", err)
+	}
+}
+
+func TestOM2_RoundTrip(t *testing.T) {
+	original := &OM2{
+		SequenceNumberTestObservationMasterFile:          "OM2-1",
+		UnitsOfMeasure:                                   "OM2-2",
+		RangeOfDecimalPrecision:                          []string{"OM2-3a", "OM2-3b"},
+		CorrespondingSIUnitsOfMeasure:                    "OM2-4",
+		SIConversionFactor:                               "OM2-5",
+		ReferenceRangeOrdinalAndContinuousObservations:   []string{"OM2-6a", "OM2-6b"},
+		CriticalRangeForOrdinalAndContinuousObservations: []string{"OM2-7a", "OM2-7b"},
+		AbsoluteRangeForOrdinalAndContinuousObservations: "OM2-8",
+		DeltaCheckCriteria:                               []string{"OM2-9a", "OM2-9b"},
+		MinimumMeaningfulIncrements:                      "OM2-10",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "OM2" {
+		t.Errorf("segment name = %q, want OM2", seg.Name())
+	}
+
+	parsed, err := ParseOM2(seg)
+	if err != nil {
+		t.Fatalf("ParseOM2() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// OM3 represents the Categorical Service/Test/Observation segment.
+//
+// Field positions follow the HL7 standard where OM3-1 is the first field
+// after the segment name.
+type OM3 struct {
+	// SequenceNumberTestObservationMasterFile is OM3-1: Sequence Number - Test/Observation Master File (NM, optional, max length 4).
+	SequenceNumberTestObservationMasterFile string `hl7:"OM3.1,maxlen=4"`
+
+	// PreferredCodingSystem is OM3-2: Preferred Coding System (CE, optional, max length 250).
+	PreferredCodingSystem string `hl7:"OM3.2,maxlen=250"`
+
+	// ValidCodedAnswers is OM3-3: Valid Coded 'Answers' (CE, optional, max length 250).
+	ValidCodedAnswers string `hl7:"OM3.3,maxlen=250"`
+
+	// NormalTextCodesForCategoricalObservations is OM3-4: Normal Text/Codes for Categorical Observations (CE, optional, repeating, max length 250).
+	NormalTextCodesForCategoricalObservations []string `hl7:"OM3.4,maxlen=250"`
+
+	// AbnormalTextCodesForCategoricalObservations is OM3-5: Abnormal Text/Codes for Categorical Observations (CE, optional, repeating, max length 250).
+	AbnormalTextCodesForCategoricalObservations []string `hl7:"OM3.5,maxlen=250"`
+
+	// CriticalTextCodesForCategoricalObservations is OM3-6: Critical Text/Codes for Categorical Observations (CE, optional, repeating, max length 250).
+	CriticalTextCodesForCategoricalObservations []string `hl7:"OM3.6,maxlen=250"`
+
+	// ValueType is OM3-7: Value Type (ID, optional, max length 2).
+	ValueType string `hl7:"OM3.7,maxlen=2"`
+}
+
+// ErrNotOM3Segment indicates the segment is not an OM3 segment.
+var ErrNotOM3Segment = fmt.Errorf("segment is not OM3")
+
+// ParseOM3 extracts OM3 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an OM3 segment.
+func ParseOM3(seg hl7.Segment) (*OM3, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "OM3" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotOM3Segment, seg.Name())
+	}
+
+	om3 := &OM3{
+		SequenceNumberTestObservationMasterFile:     getFieldValue(seg, 1),
+		PreferredCodingSystem:                       getFieldValue(seg, 2),
+		ValidCodedAnswers:                           getFieldValue(seg, 3),
+		NormalTextCodesForCategoricalObservations:   getFieldRepetitions(seg, 4),
+		AbnormalTextCodesForCategoricalObservations: getFieldRepetitions(seg, 5),
+		CriticalTextCodesForCategoricalObservations: getFieldRepetitions(seg, 6),
+		ValueType: getFieldValue(seg, 7),
+	}
+
+	return om3, nil
+}
+
+// ToSegment converts the OM3 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (o *OM3) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		o.SequenceNumberTestObservationMasterFile,
+		o.PreferredCodingSystem,
+		o.ValidCodedAnswers,
+		joinRepetitions(o.NormalTextCodesForCategoricalObservations, delims),
+		joinRepetitions(o.AbnormalTextCodesForCategoricalObservations, delims),
+		joinRepetitions(o.CriticalTextCodesForCategoricalObservations, delims),
+		o.ValueType,
+	}
+
+	data := buildSegmentData("OM3", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OM3 segment: %w", err)
+	}
+
+	return seg, nil
+}
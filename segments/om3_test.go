@@ -0,0 +1,80 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseOM3(t *testing.T) {
+	input := "OM3|OM3-1|OM3-2|OM3-3|OM3-4a~OM3-4b|OM3-5a~OM3-5b|OM3-6a~OM3-6b|OM3-7"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseOM3(seg)
+	if err != nil {
+		t.Fatalf("ParseOM3() unexpected error: %v", err)
+	}
+
+	want := OM3{
+		SequenceNumberTestObservationMasterFile:     "OM3-1",
+		PreferredCodingSystem:                       "OM3-2",
+		ValidCodedAnswers:                           "OM3-3",
+		NormalTextCodesForCategoricalObservations:   []string{"OM3-4a", "OM3-4b"},
+		AbnormalTextCodesForCategoricalObservations: []string{"OM3-5a", "OM3-5b"},
+		CriticalTextCodesForCategoricalObservations: []string{"OM3-6a", "OM3-6b"},
+		ValueType: "OM3-7",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseOM3() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseOM3_WrongSegment(t *testing.T) {
+	if _, err := ParseOM3(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseOM3(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseOM3(seg); !errors.Is(err, ErrNotOM3Segment) {
+		t.Errorf("ParseOM3() error = %v, want ErrNotOM3Segment", err)
+	}
+}
+
+func TestOM3_RoundTrip(t *testing.T) {
+	original := &OM3{
+		SequenceNumberTestObservationMasterFile:     "OM3-1",
+		PreferredCodingSystem:                       "OM3-2",
+		ValidCodedAnswers:                           "OM3-3",
+		NormalTextCodesForCategoricalObservations:   []string{"OM3-4a", "OM3-4b"},
+		AbnormalTextCodesForCategoricalObservations: []string{"OM3-5a", "OM3-5b"},
+		CriticalTextCodesForCategoricalObservations: []string{"OM3-6a", "OM3-6b"},
+		ValueType: "OM3-7",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "OM3" {
+		t.Errorf("segment name = %q, want OM3", seg.Name())
+	}
+
+	parsed, err := ParseOM3(seg)
+	if err != nil {
+		t.Fatalf("ParseOM3() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,94 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseOM4(t *testing.T) {
+	input := "OM4|OM4-1|OM4-2|OM4-3|OM4-4|OM4-5|OM4-6|OM4-7|OM4-8|OM4-9|OM4-10|OM4-11|OM4-12|OM4-13a~OM4-13b|OM4-14"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseOM4(seg)
+	if err != nil {
+		t.Fatalf("ParseOM4() unexpected error: %v", err)
+	}
+
+	want := OM4{
+		SequenceNumberTestObservationMasterFile: "OM4-1",
+		DerivedSpecimen:                         "OM4-2",
+		ContainerDescription:                    "OM4-3",
+		ContainerVolume:                         "OM4-4",
+		ContainerUnits:                          "OM4-5",
+		Specimen:                                "OM4-6",
+		Additive:                                "OM4-7",
+		Preparation:                             "OM4-8",
+		SpecialHandlingRequirements:             "OM4-9",
+		NormalCollectionVolume:                  "OM4-10",
+		MinimumCollectionVolume:                 "OM4-11",
+		SpecimenRequirements:                    "OM4-12",
+		SpecimenPriorities:                      []string{"OM4-13a", "OM4-13b"},
+		SpecimenRetentionTime:                   "OM4-14",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseOM4() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseOM4_WrongSegment(t *testing.T) {
+	if _, err := ParseOM4(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseOM4(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseOM4(seg); !errors.Is(err, ErrNotOM4Segment) {
+		t.Errorf("ParseOM4() error = %v, want ErrNotOM4Segment", err)
+	}
+}
+
+func TestOM4_RoundTrip(t *testing.T) {
+	original := &OM4{
+		SequenceNumberTestObservationMasterFile: "OM4-1",
+		DerivedSpecimen:                         "OM4-2",
+		ContainerDescription:                    "OM4-3",
+		ContainerVolume:                         "OM4-4",
+		ContainerUnits:                          "OM4-5",
+		Specimen:                                "OM4-6",
+		Additive:                                "OM4-7",
+		Preparation:                             "OM4-8",
+		SpecialHandlingRequirements:             "OM4-9",
+		NormalCollectionVolume:                  "OM4-10",
+		MinimumCollectionVolume:                 "OM4-11",
+		SpecimenRequirements:                    "OM4-12",
+		SpecimenPriorities:                      []string{"OM4-13a", "OM4-13b"},
+		SpecimenRetentionTime:                   "OM4-14",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "OM4" {
+		t.Errorf("segment name = %q, want OM4", seg.Name())
+	}
+
+	parsed, err := ParseOM4(seg)
+	if err != nil {
+		t.Fatalf("ParseOM4() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
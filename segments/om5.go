@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// OM5 represents the Observation Batteries (Sets) segment.
+//
+// Field positions follow the HL7 standard where OM5-1 is the first field
+// after the segment name.
+type OM5 struct {
+	// SequenceNumberTestObservationMasterFile is OM5-1: Sequence Number - Test/Observation Master File (NM, optional, max length 4).
+	SequenceNumberTestObservationMasterFile string `hl7:"OM5.1,maxlen=4"`
+
+	// TestObservationsIncludedWithinAnOrderedTestBattery is OM5-2: Test/Observations Included within an Ordered Test Battery (CE, optional, repeating, max length 250).
+	TestObservationsIncludedWithinAnOrderedTestBattery []string `hl7:"OM5.2,maxlen=250"`
+
+	// ObservationIDSuffixes is OM5-3: Observation ID Suffixes (ST, optional, max length 250).
+	ObservationIDSuffixes string `hl7:"OM5.3,maxlen=250"`
+}
+
+// ErrNotOM5Segment indicates the segment is not an OM5 segment.
+var ErrNotOM5Segment = fmt.Errorf("segment is not OM5")
+
+// ParseOM5 extracts OM5 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an OM5 segment.
+func ParseOM5(seg hl7.Segment) (*OM5, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "OM5" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotOM5Segment, seg.Name())
+	}
+
+	om5 := &OM5{
+		SequenceNumberTestObservationMasterFile:            getFieldValue(seg, 1),
+		TestObservationsIncludedWithinAnOrderedTestBattery: getFieldRepetitions(seg, 2),
+		ObservationIDSuffixes:                              getFieldValue(seg, 3),
+	}
+
+	return om5, nil
+}
+
+// ToSegment converts the OM5 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (o *OM5) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		o.SequenceNumberTestObservationMasterFile,
+		joinRepetitions(o.TestObservationsIncludedWithinAnOrderedTestBattery, delims),
+		o.ObservationIDSuffixes,
+	}
+
+	data := buildSegmentData("OM5", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OM5 segment: %w", err)
+	}
+
+	return seg, nil
+}
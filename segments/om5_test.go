@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseOM5(t *testing.T) {
+	input := "OM5|OM5-1|OM5-2a~OM5-2b|OM5-3"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseOM5(seg)
+	if err != nil {
+		t.Fatalf("ParseOM5() unexpected error: %v", err)
+	}
+
+	want := OM5{
+		SequenceNumberTestObservationMasterFile:            "OM5-1",
+		TestObservationsIncludedWithinAnOrderedTestBattery: []string{"OM5-2a", "OM5-2b"},
+		ObservationIDSuffixes:                              "OM5-3",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseOM5() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseOM5_WrongSegment(t *testing.T) {
+	if _, err := ParseOM5(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseOM5(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseOM5(seg); !errors.Is(err, ErrNotOM5Segment) {
+		t.Errorf("ParseOM5() error = %v, want ErrNotOM5Segment", err)
+	}
+}
+
+func TestOM5_RoundTrip(t *testing.T) {
+	original := &OM5{
+		SequenceNumberTestObservationMasterFile:            "OM5-1",
+		TestObservationsIncludedWithinAnOrderedTestBattery: []string{"OM5-2a", "OM5-2b"},
+		ObservationIDSuffixes:                              "OM5-3",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "OM5" {
+		t.Errorf("segment name = %q, want OM5", seg.Name())
+	}
+
+	parsed, err := ParseOM5(seg)
+	if err != nil {
+		t.Fatalf("ParseOM5() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
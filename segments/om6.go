@@ -0,0 +1,66 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// OM6 represents the Observations that are Calculated from Other Observations segment.
+//
+// Field positions follow the HL7 standard where OM6-1 is the first field
+// after the segment name.
+type OM6 struct {
+	// SequenceNumberTestObservationMasterFile is OM6-1: Sequence Number - Test/Observation Master File (NM, optional, max length 4).
+	SequenceNumberTestObservationMasterFile string `hl7:"OM6.1,maxlen=4"`
+
+	// DerivationRule is OM6-2: Derivation Rule (TX, optional, max length 10240).
+	DerivationRule string `hl7:"OM6.2,maxlen=10240"`
+}
+
+// ErrNotOM6Segment indicates the segment is not an OM6 segment.
+var ErrNotOM6Segment = fmt.Errorf("segment is not OM6")
+
+// ParseOM6 extracts OM6 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an OM6 segment.
+func ParseOM6(seg hl7.Segment) (*OM6, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "OM6" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotOM6Segment, seg.Name())
+	}
+
+	om6 := &OM6{
+		SequenceNumberTestObservationMasterFile: getFieldValue(seg, 1),
+		DerivationRule:                          getFieldValue(seg, 2),
+	}
+
+	return om6, nil
+}
+
+// ToSegment converts the OM6 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (o *OM6) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		o.SequenceNumberTestObservationMasterFile,
+		o.DerivationRule,
+	}
+
+	data := buildSegmentData("OM6", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OM6 segment: %w", err)
+	}
+
+	return seg, nil
+}
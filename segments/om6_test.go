@@ -0,0 +1,70 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseOM6(t *testing.T) {
+	input := "OM6|OM6-1|OM6-2"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseOM6(seg)
+	if err != nil {
+		t.Fatalf("ParseOM6() unexpected error: %v", err)
+	}
+
+	want := OM6{
+		SequenceNumberTestObservationMasterFile: "OM6-1",
+		DerivationRule:                          "OM6-2",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseOM6() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseOM6_WrongSegment(t *testing.T) {
+	if _, err := ParseOM6(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseOM6(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseOM6(seg); !errors.Is(err, ErrNotOM6Segment) {
+		t.Errorf("ParseOM6() error = %v, want ErrNotOM6Segment", err)
+	}
+}
+
+func TestOM6_RoundTrip(t *testing.T) {
+	original := &OM6{
+		SequenceNumberTestObservationMasterFile: "OM6-1",
+		DerivationRule:                          "OM6-2",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "OM6" {
+		t.Errorf("segment name = %q, want OM6", seg.Name())
+	}
+
+	parsed, err := ParseOM6(seg)
+	if err != nil {
+		t.Fatalf("ParseOM6() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
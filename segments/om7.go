@@ -0,0 +1,176 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// OM7 represents the Additional Basic Attributes segment.
+//
+// Field positions follow the HL7 standard where OM7-1 is the first field
+// after the segment name.
+type OM7 struct {
+	// SequenceNumberTestObservationMasterFile is OM7-1: Sequence Number - Test/Observation Master File (NM, required, max length 4).
+	SequenceNumberTestObservationMasterFile string `hl7:"OM7.1,required,maxlen=4"`
+
+	// UniversalServiceIdentifier is OM7-2: Universal Service Identifier (CE, required, max length 250).
+	UniversalServiceIdentifier string `hl7:"OM7.2,required,maxlen=250"`
+
+	// CategoryIdentifier is OM7-3: Category Identifier (CE, optional, repeating, max length 250).
+	CategoryIdentifier []string `hl7:"OM7.3,maxlen=250"`
+
+	// CategoryDescription is OM7-4: Category Description (TX, optional, max length 200).
+	CategoryDescription string `hl7:"OM7.4,maxlen=200"`
+
+	// CategorySynonym is OM7-5: Category Synonym (ST, optional, repeating, max length 200).
+	CategorySynonym []string `hl7:"OM7.5,maxlen=200"`
+
+	// EffectiveTestServiceStartDateTime is OM7-6: Effective Test/Service Start Date/Time (TS, optional, max length 26).
+	EffectiveTestServiceStartDateTime string `hl7:"OM7.6,maxlen=26"`
+
+	// EffectiveTestServiceEndDateTime is OM7-7: Effective Test/Service End Date/Time (TS, optional, max length 26).
+	EffectiveTestServiceEndDateTime string `hl7:"OM7.7,maxlen=26"`
+
+	// TestServiceDefaultDurationQuantity is OM7-8: Test/Service Default Duration Quantity (NM, optional, max length 5).
+	TestServiceDefaultDurationQuantity string `hl7:"OM7.8,maxlen=5"`
+
+	// TestServiceDefaultDurationUnits is OM7-9: Test/Service Default Duration Units (CE, optional, max length 250).
+	TestServiceDefaultDurationUnits string `hl7:"OM7.9,maxlen=250"`
+
+	// TestServiceDefaultFrequency is OM7-10: Test/Service Default Frequency (IS, optional, max length 60).
+	TestServiceDefaultFrequency string `hl7:"OM7.10,maxlen=60"`
+
+	// ConsentIndicator is OM7-11: Consent Indicator (ID, optional, max length 1).
+	ConsentIndicator string `hl7:"OM7.11,maxlen=1"`
+
+	// ConsentIdentifier is OM7-12: Consent Identifier (CE, optional, max length 250).
+	ConsentIdentifier string `hl7:"OM7.12,maxlen=250"`
+
+	// ConsentEffectiveStartDateTime is OM7-13: Consent Effective Start Date/Time (TS, optional, max length 26).
+	ConsentEffectiveStartDateTime string `hl7:"OM7.13,maxlen=26"`
+
+	// ConsentEffectiveEndDateTime is OM7-14: Consent Effective End Date/Time (TS, optional, max length 26).
+	ConsentEffectiveEndDateTime string `hl7:"OM7.14,maxlen=26"`
+
+	// ConsentIntervalQuantity is OM7-15: Consent Interval Quantity (NM, optional, max length 5).
+	ConsentIntervalQuantity string `hl7:"OM7.15,maxlen=5"`
+
+	// ConsentIntervalUnits is OM7-16: Consent Interval Units (CE, conditional, max length 250).
+	ConsentIntervalUnits string `hl7:"OM7.16,maxlen=250"`
+
+	// ConsentWaitingPeriodQuantity is OM7-17: Consent Waiting Period Quantity (NM, optional, max length 5).
+	ConsentWaitingPeriodQuantity string `hl7:"OM7.17,maxlen=5"`
+
+	// ConsentWaitingPeriodUnits is OM7-18: Consent Waiting Period Units (CE, conditional, max length 250).
+	ConsentWaitingPeriodUnits string `hl7:"OM7.18,maxlen=250"`
+
+	// EffectiveDateTimeOfChange is OM7-19: Effective Date/Time of Change (TS, optional, max length 26).
+	EffectiveDateTimeOfChange string `hl7:"OM7.19,maxlen=26"`
+
+	// EnteredBy is OM7-20: Entered By (XCN, optional, max length 250).
+	EnteredBy string `hl7:"OM7.20,maxlen=250"`
+
+	// OrderableAtLocation is OM7-21: Orderable-at Location (PL, optional, repeating, max length 200).
+	OrderableAtLocation []string `hl7:"OM7.21,maxlen=200"`
+
+	// FormularyStatus is OM7-22: Formulary Status (IS, optional, max length 1).
+	FormularyStatus string `hl7:"OM7.22,maxlen=1"`
+
+	// SpecialOrderIndicator is OM7-23: Special Order Indicator (ID, optional, max length 1).
+	SpecialOrderIndicator string `hl7:"OM7.23,maxlen=1"`
+
+	// PrimaryKeyValueCDM is OM7-24: Primary Key Value - CDM (CE, optional, repeating, max length 250).
+	PrimaryKeyValueCDM []string `hl7:"OM7.24,maxlen=250"`
+}
+
+// ErrNotOM7Segment indicates the segment is not an OM7 segment.
+var ErrNotOM7Segment = fmt.Errorf("segment is not OM7")
+
+// ParseOM7 extracts OM7 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an OM7 segment.
+func ParseOM7(seg hl7.Segment) (*OM7, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "OM7" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotOM7Segment, seg.Name())
+	}
+
+	om7 := &OM7{
+		SequenceNumberTestObservationMasterFile: getFieldValue(seg, 1),
+		UniversalServiceIdentifier:              getFieldValue(seg, 2),
+		CategoryIdentifier:                      getFieldRepetitions(seg, 3),
+		CategoryDescription:                     getFieldValue(seg, 4),
+		CategorySynonym:                         getFieldRepetitions(seg, 5),
+		EffectiveTestServiceStartDateTime:       getFieldValue(seg, 6),
+		EffectiveTestServiceEndDateTime:         getFieldValue(seg, 7),
+		TestServiceDefaultDurationQuantity:      getFieldValue(seg, 8),
+		TestServiceDefaultDurationUnits:         getFieldValue(seg, 9),
+		TestServiceDefaultFrequency:             getFieldValue(seg, 10),
+		ConsentIndicator:                        getFieldValue(seg, 11),
+		ConsentIdentifier:                       getFieldValue(seg, 12),
+		ConsentEffectiveStartDateTime:           getFieldValue(seg, 13),
+		ConsentEffectiveEndDateTime:             getFieldValue(seg, 14),
+		ConsentIntervalQuantity:                 getFieldValue(seg, 15),
+		ConsentIntervalUnits:                    getFieldValue(seg, 16),
+		ConsentWaitingPeriodQuantity:            getFieldValue(seg, 17),
+		ConsentWaitingPeriodUnits:               getFieldValue(seg, 18),
+		EffectiveDateTimeOfChange:               getFieldValue(seg, 19),
+		EnteredBy:                               getFieldValue(seg, 20),
+		OrderableAtLocation:                     getFieldRepetitions(seg, 21),
+		FormularyStatus:                         getFieldValue(seg, 22),
+		SpecialOrderIndicator:                   getFieldValue(seg, 23),
+		PrimaryKeyValueCDM:                      getFieldRepetitions(seg, 24),
+	}
+
+	return om7, nil
+}
+
+// ToSegment converts the OM7 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (o *OM7) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		o.SequenceNumberTestObservationMasterFile,
+		o.UniversalServiceIdentifier,
+		joinRepetitions(o.CategoryIdentifier, delims),
+		o.CategoryDescription,
+		joinRepetitions(o.CategorySynonym, delims),
+		o.EffectiveTestServiceStartDateTime,
+		o.EffectiveTestServiceEndDateTime,
+		o.TestServiceDefaultDurationQuantity,
+		o.TestServiceDefaultDurationUnits,
+		o.TestServiceDefaultFrequency,
+		o.ConsentIndicator,
+		o.ConsentIdentifier,
+		o.ConsentEffectiveStartDateTime,
+		o.ConsentEffectiveEndDateTime,
+		o.ConsentIntervalQuantity,
+		o.ConsentIntervalUnits,
+		o.ConsentWaitingPeriodQuantity,
+		o.ConsentWaitingPeriodUnits,
+		o.EffectiveDateTimeOfChange,
+		o.EnteredBy,
+		joinRepetitions(o.OrderableAtLocation, delims),
+		o.FormularyStatus,
+		o.SpecialOrderIndicator,
+		joinRepetitions(o.PrimaryKeyValueCDM, delims),
+	}
+
+	data := buildSegmentData("OM7", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OM7 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,90 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseORG(t *testing.T) {
+	input := "ORG|ORG-1|ORG-2|ORG-3|ORG-4|ORG-5|ORG-6|ORG-7|ORG-8|ORG-9|ORG-10|ORG-11|ORG-12"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseORG(seg)
+	if err != nil {
+		t.Fatalf("ParseORG() unexpected error: %v", err)
+	}
+
+	want := ORG{
+		SetID:                                      "ORG-1",
+		OrganizationUnitCode:                       "ORG-2",
+		OrganizationUnitTypeCode:                   "ORG-3",
+		PrimaryOrgUnitIndicator:                    "ORG-4",
+		PractitionerOrgUnitIdentifier:              "ORG-5",
+		HealthCareProviderTypeCode:                 "ORG-6",
+		HealthCareProviderClassificationCode:       "ORG-7",
+		HealthCareProviderAreaOfSpecializationCode: "ORG-8",
+		EffectiveDateRange:                         "ORG-9",
+		EmploymentStatusCode:                       "ORG-10",
+		BoardApprovalIndicator:                     "ORG-11",
+		PrimaryCarePhysicianIndicator:              "ORG-12",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseORG() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseORG_WrongSegment(t *testing.T) {
+	if _, err := ParseORG(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseORG(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseORG(seg); !errors.Is(err, ErrNotORGSegment) {
+		t.Errorf("ParseORG() error = %v, want ErrNotORGSegment", err)
+	}
+}
+
+func TestORG_RoundTrip(t *testing.T) {
+	original := &ORG{
+		SetID:                                      "ORG-1",
+		OrganizationUnitCode:                       "ORG-2",
+		OrganizationUnitTypeCode:                   "ORG-3",
+		PrimaryOrgUnitIndicator:                    "ORG-4",
+		PractitionerOrgUnitIdentifier:              "ORG-5",
+		HealthCareProviderTypeCode:                 "ORG-6",
+		HealthCareProviderClassificationCode:       "ORG-7",
+		HealthCareProviderAreaOfSpecializationCode: "ORG-8",
+		EffectiveDateRange:                         "ORG-9",
+		EmploymentStatusCode:                       "ORG-10",
+		BoardApprovalIndicator:                     "ORG-11",
+		PrimaryCarePhysicianIndicator:              "ORG-12",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "ORG" {
+		t.Errorf("segment name = %q, want ORG", seg.Name())
+	}
+
+	parsed, err := ParseORG(seg)
+	if err != nil {
+		t.Fatalf("ParseORG() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// OVR represents the Override segment.
+//
+// Field positions follow the HL7 standard where OVR-1 is the first field
+// after the segment name.
+type OVR struct {
+	// BusinessRuleOverrideType is OVR-1: Business Rule Override Type (CWE, optional, max length 705).
+	BusinessRuleOverrideType string `hl7:"OVR.1,maxlen=705"`
+
+	// BusinessRuleOverrideCode is OVR-2: Business Rule Override Code (CWE, optional, max length 705).
+	BusinessRuleOverrideCode string `hl7:"OVR.2,maxlen=705"`
+
+	// OverrideComments is OVR-3: Override Comments (TX, optional, max length 200).
+	OverrideComments string `hl7:"OVR.3,maxlen=200"`
+
+	// OverrideEnteredBy is OVR-4: Override Entered By (XCN, optional, max length 250).
+	OverrideEnteredBy string `hl7:"OVR.4,maxlen=250"`
+
+	// OverrideAuthorizedBy is OVR-5: Override Authorized By (XCN, optional, max length 250).
+	OverrideAuthorizedBy string `hl7:"OVR.5,maxlen=250"`
+}
+
+// ErrNotOVRSegment indicates the segment is not an OVR segment.
+var ErrNotOVRSegment = fmt.Errorf("segment is not OVR")
+
+// ParseOVR extracts OVR segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an OVR segment.
+func ParseOVR(seg hl7.Segment) (*OVR, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "OVR" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotOVRSegment, seg.Name())
+	}
+
+	ovr := &OVR{
+		BusinessRuleOverrideType: getFieldValue(seg, 1),
+		BusinessRuleOverrideCode: getFieldValue(seg, 2),
+		OverrideComments:         getFieldValue(seg, 3),
+		OverrideEnteredBy:        getFieldValue(seg, 4),
+		OverrideAuthorizedBy:     getFieldValue(seg, 5),
+	}
+
+	return ovr, nil
+}
+
+// ToSegment converts the OVR struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (o *OVR) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		o.BusinessRuleOverrideType,
+		o.BusinessRuleOverrideCode,
+		o.OverrideComments,
+		o.OverrideEnteredBy,
+		o.OverrideAuthorizedBy,
+	}
+
+	data := buildSegmentData("OVR", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OVR segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseOVR(t *testing.T) {
+	input := "OVR|OVR-1|OVR-2|OVR-3|OVR-4|OVR-5"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseOVR(seg)
+	if err != nil {
+		t.Fatalf("ParseOVR() unexpected error: %v", err)
+	}
+
+	want := OVR{
+		BusinessRuleOverrideType: "OVR-1",
+		BusinessRuleOverrideCode: "OVR-2",
+		OverrideComments:         "OVR-3",
+		OverrideEnteredBy:        "OVR-4",
+		OverrideAuthorizedBy:     "OVR-5",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseOVR() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseOVR_WrongSegment(t *testing.T) {
+	if _, err := ParseOVR(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseOVR(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseOVR(seg); !errors.Is(err, ErrNotOVRSegment) {
+		t.Errorf("ParseOVR() error = %v, want ErrNotOVRSegment", err)
+	}
+}
+
+func TestOVR_RoundTrip(t *testing.T) {
+	original := &OVR{
+		BusinessRuleOverrideType: "OVR-1",
+		BusinessRuleOverrideCode: "OVR-2",
+		OverrideComments:         "OVR-3",
+		OverrideEnteredBy:        "OVR-4",
+		OverrideAuthorizedBy:     "OVR-5",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "OVR" {
+		t.Errorf("segment name = %q, want OVR", seg.Name())
+	}
+
+	parsed, err := ParseOVR(seg)
+	if err != nil {
+		t.Fatalf("ParseOVR() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
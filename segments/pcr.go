@@ -0,0 +1,171 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// PCR represents the Possible Causal Relationship segment.
+//
+// Field positions follow the HL7 standard where PCR-1 is the first field
+// after the segment name.
+type PCR struct {
+	// ImplicatedProduct is PCR-1: Implicated Product (CE, required, max length 250).
+	ImplicatedProduct string `hl7:"PCR.1,required,maxlen=250"`
+
+	// GenericProduct is PCR-2: Generic Product (IS, optional, max length 1).
+	GenericProduct string `hl7:"PCR.2,maxlen=1"`
+
+	// ProductClass is PCR-3: Product Class (CE, optional, max length 250).
+	ProductClass string `hl7:"PCR.3,maxlen=250"`
+
+	// TotalDurationOfTherapy is PCR-4: Total Duration Of Therapy (CQ, optional, max length 8).
+	TotalDurationOfTherapy string `hl7:"PCR.4,maxlen=8"`
+
+	// ProductManufactureDate is PCR-5: Product Manufacture Date (TS, optional, max length 26).
+	ProductManufactureDate string `hl7:"PCR.5,maxlen=26"`
+
+	// ProductExpirationDate is PCR-6: Product Expiration Date (TS, optional, max length 26).
+	ProductExpirationDate string `hl7:"PCR.6,maxlen=26"`
+
+	// ProductImplantationDate is PCR-7: Product Implantation Date (TS, optional, max length 26).
+	ProductImplantationDate string `hl7:"PCR.7,maxlen=26"`
+
+	// ProductExplantationDate is PCR-8: Product Explantation Date (TS, optional, max length 26).
+	ProductExplantationDate string `hl7:"PCR.8,maxlen=26"`
+
+	// SingleUseDevice is PCR-9: Single Use Device (IS, optional, max length 8).
+	SingleUseDevice string `hl7:"PCR.9,maxlen=8"`
+
+	// IndicationForProductUse is PCR-10: Indication For Product Use (CE, optional, max length 250).
+	IndicationForProductUse string `hl7:"PCR.10,maxlen=250"`
+
+	// ProductProblem is PCR-11: Product Problem (IS, optional, max length 8).
+	ProductProblem string `hl7:"PCR.11,maxlen=8"`
+
+	// ProductSerialLotNumber is PCR-12: Product Serial/Lot Number (ST, optional, repeating, max length 30).
+	ProductSerialLotNumber []string `hl7:"PCR.12,maxlen=30"`
+
+	// ProductAvailableForInspection is PCR-13: Product Available For Inspection (IS, optional, max length 1).
+	ProductAvailableForInspection string `hl7:"PCR.13,maxlen=1"`
+
+	// ProductEvaluationPerformed is PCR-14: Product Evaluation Performed (CE, optional, max length 250).
+	ProductEvaluationPerformed string `hl7:"PCR.14,maxlen=250"`
+
+	// ProductEvaluationStatus is PCR-15: Product Evaluation Status (CE, optional, max length 250).
+	ProductEvaluationStatus string `hl7:"PCR.15,maxlen=250"`
+
+	// ProductEvaluationResults is PCR-16: Product Evaluation Results (CE, optional, max length 250).
+	ProductEvaluationResults string `hl7:"PCR.16,maxlen=250"`
+
+	// EvaluatedProductSource is PCR-17: Evaluated Product Source (ID, optional, max length 8).
+	EvaluatedProductSource string `hl7:"PCR.17,maxlen=8"`
+
+	// DateProductReturnedToManufacturer is PCR-18: Date Product Returned To Manufacturer (TS, optional, max length 26).
+	DateProductReturnedToManufacturer string `hl7:"PCR.18,maxlen=26"`
+
+	// DeviceOperatorQualifications is PCR-19: Device Operator Qualifications (ID, optional, max length 1).
+	DeviceOperatorQualifications string `hl7:"PCR.19,maxlen=1"`
+
+	// RelatednessAssessment is PCR-20: Relatedness Assessment (ID, optional, max length 1).
+	RelatednessAssessment string `hl7:"PCR.20,maxlen=1"`
+
+	// ActionTakenInResponseToTheEvent is PCR-21: Action Taken In Response To The Event (ID, optional, repeating, max length 2).
+	ActionTakenInResponseToTheEvent []string `hl7:"PCR.21,maxlen=2"`
+
+	// EventCausalityObservations is PCR-22: Event Causality Observations (ID, optional, repeating, max length 2).
+	EventCausalityObservations []string `hl7:"PCR.22,maxlen=2"`
+
+	// IndirectExposureMechanism is PCR-23: Indirect Exposure Mechanism (ID, optional, repeating, max length 1).
+	IndirectExposureMechanism []string `hl7:"PCR.23,maxlen=1"`
+}
+
+// ErrNotPCRSegment indicates the segment is not a PCR segment.
+var ErrNotPCRSegment = fmt.Errorf("segment is not PCR")
+
+// ParsePCR extracts PCR segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a PCR segment.
+func ParsePCR(seg hl7.Segment) (*PCR, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "PCR" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotPCRSegment, seg.Name())
+	}
+
+	pcr := &PCR{
+		ImplicatedProduct:                 getFieldValue(seg, 1),
+		GenericProduct:                    getFieldValue(seg, 2),
+		ProductClass:                      getFieldValue(seg, 3),
+		TotalDurationOfTherapy:            getFieldValue(seg, 4),
+		ProductManufactureDate:            getFieldValue(seg, 5),
+		ProductExpirationDate:             getFieldValue(seg, 6),
+		ProductImplantationDate:           getFieldValue(seg, 7),
+		ProductExplantationDate:           getFieldValue(seg, 8),
+		SingleUseDevice:                   getFieldValue(seg, 9),
+		IndicationForProductUse:           getFieldValue(seg, 10),
+		ProductProblem:                    getFieldValue(seg, 11),
+		ProductSerialLotNumber:            getFieldRepetitions(seg, 12),
+		ProductAvailableForInspection:     getFieldValue(seg, 13),
+		ProductEvaluationPerformed:        getFieldValue(seg, 14),
+		ProductEvaluationStatus:           getFieldValue(seg, 15),
+		ProductEvaluationResults:          getFieldValue(seg, 16),
+		EvaluatedProductSource:            getFieldValue(seg, 17),
+		DateProductReturnedToManufacturer: getFieldValue(seg, 18),
+		DeviceOperatorQualifications:      getFieldValue(seg, 19),
+		RelatednessAssessment:             getFieldValue(seg, 20),
+		ActionTakenInResponseToTheEvent:   getFieldRepetitions(seg, 21),
+		EventCausalityObservations:        getFieldRepetitions(seg, 22),
+		IndirectExposureMechanism:         getFieldRepetitions(seg, 23),
+	}
+
+	return pcr, nil
+}
+
+// ToSegment converts the PCR struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (p *PCR) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		p.ImplicatedProduct,
+		p.GenericProduct,
+		p.ProductClass,
+		p.TotalDurationOfTherapy,
+		p.ProductManufactureDate,
+		p.ProductExpirationDate,
+		p.ProductImplantationDate,
+		p.ProductExplantationDate,
+		p.SingleUseDevice,
+		p.IndicationForProductUse,
+		p.ProductProblem,
+		joinRepetitions(p.ProductSerialLotNumber, delims),
+		p.ProductAvailableForInspection,
+		p.ProductEvaluationPerformed,
+		p.ProductEvaluationStatus,
+		p.ProductEvaluationResults,
+		p.EvaluatedProductSource,
+		p.DateProductReturnedToManufacturer,
+		p.DeviceOperatorQualifications,
+		p.RelatednessAssessment,
+		joinRepetitions(p.ActionTakenInResponseToTheEvent, delims),
+		joinRepetitions(p.EventCausalityObservations, delims),
+		joinRepetitions(p.IndirectExposureMechanism, delims),
+	}
+
+	data := buildSegmentData("PCR", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PCR segment: %w", err)
+	}
+
+	return seg, nil
+}
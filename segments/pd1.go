@@ -0,0 +1,161 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// PD1 represents the Patient Additional Demographic segment.
+//
+// Field positions follow the HL7 standard where PD1-1 is the first field
+// after the segment name.
+type PD1 struct {
+	// LivingDependency is PD1-1: Living Dependency (IS, optional, repeating, max length 2).
+	LivingDependency []string `hl7:"PD1.1,maxlen=2"`
+
+	// LivingArrangement is PD1-2: Living Arrangement (IS, optional, max length 2).
+	LivingArrangement string `hl7:"PD1.2,maxlen=2"`
+
+	// PatientPrimaryFacility is PD1-3: Patient Primary Facility (XON, optional, repeating, max length 250).
+	PatientPrimaryFacility []string `hl7:"PD1.3,maxlen=250"`
+
+	// PatientPrimaryCareProvider is PD1-4: Patient Primary Care Provider Name & ID No. (XCN, backward compatibility, repeating, max length 250).
+	PatientPrimaryCareProvider []string `hl7:"PD1.4,maxlen=250"`
+
+	// StudentIndicator is PD1-5: Student Indicator (IS, optional, max length 2).
+	StudentIndicator string `hl7:"PD1.5,maxlen=2"`
+
+	// Handicap is PD1-6: Handicap (IS, optional, max length 2).
+	Handicap string `hl7:"PD1.6,maxlen=2"`
+
+	// LivingWillCode is PD1-7: Living Will Code (IS, optional, max length 2).
+	LivingWillCode string `hl7:"PD1.7,maxlen=2"`
+
+	// OrganDonorCode is PD1-8: Organ Donor Code (IS, optional, max length 2).
+	OrganDonorCode string `hl7:"PD1.8,maxlen=2"`
+
+	// SeparateBill is PD1-9: Separate Bill (ID, optional, max length 1).
+	SeparateBill string `hl7:"PD1.9,maxlen=1"`
+
+	// DuplicatePatient is PD1-10: Duplicate Patient (CX, optional, repeating, max length 250).
+	DuplicatePatient []string `hl7:"PD1.10,maxlen=250"`
+
+	// PublicityCode is PD1-11: Publicity Code (CE, optional, max length 250).
+	PublicityCode string `hl7:"PD1.11,maxlen=250"`
+
+	// ProtectionIndicator is PD1-12: Protection Indicator (ID, optional, max length 1).
+	ProtectionIndicator string `hl7:"PD1.12,maxlen=1"`
+
+	// ProtectionIndicatorEffectiveDate is PD1-13: Protection Indicator Effective Date (DT, optional, max length 8).
+	ProtectionIndicatorEffectiveDate string `hl7:"PD1.13,maxlen=8"`
+
+	// PlaceOfWorship is PD1-14: Place of Worship (XON, optional, repeating, max length 250).
+	PlaceOfWorship []string `hl7:"PD1.14,maxlen=250"`
+
+	// AdvanceDirectiveCode is PD1-15: Advance Directive Code (CE, optional, repeating, max length 250).
+	AdvanceDirectiveCode []string `hl7:"PD1.15,maxlen=250"`
+
+	// ImmunizationRegistryStatus is PD1-16: Immunization Registry Status (IS, optional, max length 1).
+	ImmunizationRegistryStatus string `hl7:"PD1.16,maxlen=1"`
+
+	// ImmunizationRegistryStatusEffectiveDate is PD1-17: Immunization Registry Status Effective Date (DT, optional, max length 8).
+	ImmunizationRegistryStatusEffectiveDate string `hl7:"PD1.17,maxlen=8"`
+
+	// PublicityCodeEffectiveDate is PD1-18: Publicity Code Effective Date (DT, optional, max length 8).
+	PublicityCodeEffectiveDate string `hl7:"PD1.18,maxlen=8"`
+
+	// MilitaryBranch is PD1-19: Military Branch (IS, optional, max length 5).
+	MilitaryBranch string `hl7:"PD1.19,maxlen=5"`
+
+	// MilitaryRankGrade is PD1-20: Military Rank/Grade (IS, optional, max length 2).
+	MilitaryRankGrade string `hl7:"PD1.20,maxlen=2"`
+
+	// MilitaryStatus is PD1-21: Military Status (IS, optional, max length 3).
+	MilitaryStatus string `hl7:"PD1.21,maxlen=3"`
+}
+
+// ErrNotPD1Segment indicates the segment is not a PD1 segment.
+var ErrNotPD1Segment = fmt.Errorf("segment is not PD1")
+
+// ParsePD1 extracts PD1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a PD1 segment.
+func ParsePD1(seg hl7.Segment) (*PD1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "PD1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotPD1Segment, seg.Name())
+	}
+
+	pd1 := &PD1{
+		LivingDependency:                        getFieldRepetitions(seg, 1),
+		LivingArrangement:                       getFieldValue(seg, 2),
+		PatientPrimaryFacility:                  getFieldRepetitions(seg, 3),
+		PatientPrimaryCareProvider:              getFieldRepetitions(seg, 4),
+		StudentIndicator:                        getFieldValue(seg, 5),
+		Handicap:                                getFieldValue(seg, 6),
+		LivingWillCode:                          getFieldValue(seg, 7),
+		OrganDonorCode:                          getFieldValue(seg, 8),
+		SeparateBill:                            getFieldValue(seg, 9),
+		DuplicatePatient:                        getFieldRepetitions(seg, 10),
+		PublicityCode:                           getFieldValue(seg, 11),
+		ProtectionIndicator:                     getFieldValue(seg, 12),
+		ProtectionIndicatorEffectiveDate:        getFieldValue(seg, 13),
+		PlaceOfWorship:                          getFieldRepetitions(seg, 14),
+		AdvanceDirectiveCode:                    getFieldRepetitions(seg, 15),
+		ImmunizationRegistryStatus:              getFieldValue(seg, 16),
+		ImmunizationRegistryStatusEffectiveDate: getFieldValue(seg, 17),
+		PublicityCodeEffectiveDate:              getFieldValue(seg, 18),
+		MilitaryBranch:                          getFieldValue(seg, 19),
+		MilitaryRankGrade:                       getFieldValue(seg, 20),
+		MilitaryStatus:                          getFieldValue(seg, 21),
+	}
+
+	return pd1, nil
+}
+
+// ToSegment converts the PD1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (p *PD1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		joinRepetitions(p.LivingDependency, delims),
+		p.LivingArrangement,
+		joinRepetitions(p.PatientPrimaryFacility, delims),
+		joinRepetitions(p.PatientPrimaryCareProvider, delims),
+		p.StudentIndicator,
+		p.Handicap,
+		p.LivingWillCode,
+		p.OrganDonorCode,
+		p.SeparateBill,
+		joinRepetitions(p.DuplicatePatient, delims),
+		p.PublicityCode,
+		p.ProtectionIndicator,
+		p.ProtectionIndicatorEffectiveDate,
+		joinRepetitions(p.PlaceOfWorship, delims),
+		joinRepetitions(p.AdvanceDirectiveCode, delims),
+		p.ImmunizationRegistryStatus,
+		p.ImmunizationRegistryStatusEffectiveDate,
+		p.PublicityCodeEffectiveDate,
+		p.MilitaryBranch,
+		p.MilitaryRankGrade,
+		p.MilitaryStatus,
+	}
+
+	data := buildSegmentData("PD1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PD1 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,84 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParsePDA(t *testing.T) {
+	input := "PDA|PDA-1a~PDA-1b|PDA-2|PDA-3|PDA-4|PDA-5|PDA-6|PDA-7|PDA-8|PDA-9"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParsePDA(seg)
+	if err != nil {
+		t.Fatalf("ParsePDA() unexpected error: %v", err)
+	}
+
+	want := PDA{
+		DeathCauseCode:                 []string{"PDA-1a", "PDA-1b"},
+		DeathLocation:                  "PDA-2",
+		DeathCertifiedIndicator:        "PDA-3",
+		DeathCertificateSignedDateTime: "PDA-4",
+		DeathCertifiedBy:               "PDA-5",
+		AutopsyIndicator:               "PDA-6",
+		AutopsyStartAndEndDateTime:     "PDA-7",
+		AutopsyPerformedBy:             "PDA-8",
+		CoronerIndicator:               "PDA-9",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParsePDA() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParsePDA_WrongSegment(t *testing.T) {
+	if _, err := ParsePDA(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParsePDA(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParsePDA(seg); !errors.Is(err, ErrNotPDASegment) {
+		t.Errorf("ParsePDA() error = %v, want ErrNotPDASegment", err)
+	}
+}
+
+func TestPDA_RoundTrip(t *testing.T) {
+	original := &PDA{
+		DeathCauseCode:                 []string{"PDA-1a", "PDA-1b"},
+		DeathLocation:                  "PDA-2",
+		DeathCertifiedIndicator:        "PDA-3",
+		DeathCertificateSignedDateTime: "PDA-4",
+		DeathCertifiedBy:               "PDA-5",
+		AutopsyIndicator:               "PDA-6",
+		AutopsyStartAndEndDateTime:     "PDA-7",
+		AutopsyPerformedBy:             "PDA-8",
+		CoronerIndicator:               "PDA-9",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "PDA" {
+		t.Errorf("segment name = %q, want PDA", seg.Name())
+	}
+
+	parsed, err := ParsePDA(seg)
+	if err != nil {
+		t.Fatalf("ParsePDA() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,181 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// PEO represents the Product Experience Observation segment.
+//
+// Field positions follow the HL7 standard where PEO-1 is the first field
+// after the segment name.
+type PEO struct {
+	// EventIdentifiersUsed is PEO-1: Event Identifiers Used (CE, optional, repeating, max length 250).
+	EventIdentifiersUsed []string `hl7:"PEO.1,maxlen=250"`
+
+	// EventSymptomDiagnosisCode is PEO-2: Event Symptom/Diagnosis Code (CE, optional, repeating, max length 250).
+	EventSymptomDiagnosisCode []string `hl7:"PEO.2,maxlen=250"`
+
+	// EventOnsetDateTime is PEO-3: Event Onset Date/Time (TS, required, max length 26).
+	EventOnsetDateTime string `hl7:"PEO.3,required,maxlen=26"`
+
+	// EventExacerbationDateTime is PEO-4: Event Exacerbation Date/Time (TS, optional, max length 26).
+	EventExacerbationDateTime string `hl7:"PEO.4,maxlen=26"`
+
+	// EventImprovedDateTime is PEO-5: Event Improved Date/Time (TS, optional, max length 26).
+	EventImprovedDateTime string `hl7:"PEO.5,maxlen=26"`
+
+	// EventEndedDataTime is PEO-6: Event Ended Data/Time (TS, optional, max length 26).
+	EventEndedDataTime string `hl7:"PEO.6,maxlen=26"`
+
+	// EventLocationOccurredAddress is PEO-7: Event Location Occurred Address (XAD, optional, repeating, max length 250).
+	EventLocationOccurredAddress []string `hl7:"PEO.7,maxlen=250"`
+
+	// EventQualification is PEO-8: Event Qualification (ID, optional, repeating, max length 1).
+	EventQualification []string `hl7:"PEO.8,maxlen=1"`
+
+	// EventSerious is PEO-9: Event Serious (ID, optional, max length 1).
+	EventSerious string `hl7:"PEO.9,maxlen=1"`
+
+	// EventExpected is PEO-10: Event Expected (ID, optional, max length 1).
+	EventExpected string `hl7:"PEO.10,maxlen=1"`
+
+	// EventOutcome is PEO-11: Event Outcome (ID, optional, repeating, max length 1).
+	EventOutcome []string `hl7:"PEO.11,maxlen=1"`
+
+	// PatientOutcome is PEO-12: Patient Outcome (ID, optional, max length 1).
+	PatientOutcome string `hl7:"PEO.12,maxlen=1"`
+
+	// EventDescriptionFromOthers is PEO-13: Event Description From Others (FT, optional, repeating, max length 600).
+	EventDescriptionFromOthers []string `hl7:"PEO.13,maxlen=600"`
+
+	// EventFromOriginalReporter is PEO-14: Event From Original Reporter (FT, optional, repeating, max length 600).
+	EventFromOriginalReporter []string `hl7:"PEO.14,maxlen=600"`
+
+	// EventDescriptionFromPatient is PEO-15: Event Description From Patient (FT, optional, repeating, max length 600).
+	EventDescriptionFromPatient []string `hl7:"PEO.15,maxlen=600"`
+
+	// EventDescriptionFromPractitioner is PEO-16: Event Description From Practitioner (FT, optional, repeating, max length 600).
+	EventDescriptionFromPractitioner []string `hl7:"PEO.16,maxlen=600"`
+
+	// EventDescriptionFromAutopsy is PEO-17: Event Description From Autopsy (FT, optional, repeating, max length 600).
+	EventDescriptionFromAutopsy []string `hl7:"PEO.17,maxlen=600"`
+
+	// CauseOfDeath is PEO-18: Cause Of Death (CE, optional, repeating, max length 250).
+	CauseOfDeath []string `hl7:"PEO.18,maxlen=250"`
+
+	// PrimaryObserverName is PEO-19: Primary Observer Name (XPN, optional, repeating, max length 250).
+	PrimaryObserverName []string `hl7:"PEO.19,maxlen=250"`
+
+	// PrimaryObserverAddress is PEO-20: Primary Observer Address (XAD, optional, repeating, max length 250).
+	PrimaryObserverAddress []string `hl7:"PEO.20,maxlen=250"`
+
+	// PrimaryObserverTelephone is PEO-21: Primary Observer Telephone (XTN, optional, repeating, max length 250).
+	PrimaryObserverTelephone []string `hl7:"PEO.21,maxlen=250"`
+
+	// PrimaryObserversQualification is PEO-22: Primary Observer's Qualification (ID, optional, max length 1).
+	PrimaryObserversQualification string `hl7:"PEO.22,maxlen=1"`
+
+	// ConfirmationProvidedBy is PEO-23: Confirmation Provided By (ID, optional, max length 1).
+	ConfirmationProvidedBy string `hl7:"PEO.23,maxlen=1"`
+
+	// PrimaryObserverAwareDateTime is PEO-24: Primary Observer Aware Date/Time (TS, optional, max length 26).
+	PrimaryObserverAwareDateTime string `hl7:"PEO.24,maxlen=26"`
+
+	// PrimaryObserversIdentityMayBeDivulged is PEO-25: Primary Observer's identity May Be Divulged (ID, optional, max length 1).
+	PrimaryObserversIdentityMayBeDivulged string `hl7:"PEO.25,maxlen=1"`
+}
+
+// ErrNotPEOSegment indicates the segment is not a PEO segment.
+var ErrNotPEOSegment = fmt.Errorf("segment is not PEO")
+
+// ParsePEO extracts PEO segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a PEO segment.
+func ParsePEO(seg hl7.Segment) (*PEO, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "PEO" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotPEOSegment, seg.Name())
+	}
+
+	peo := &PEO{
+		EventIdentifiersUsed:                  getFieldRepetitions(seg, 1),
+		EventSymptomDiagnosisCode:             getFieldRepetitions(seg, 2),
+		EventOnsetDateTime:                    getFieldValue(seg, 3),
+		EventExacerbationDateTime:             getFieldValue(seg, 4),
+		EventImprovedDateTime:                 getFieldValue(seg, 5),
+		EventEndedDataTime:                    getFieldValue(seg, 6),
+		EventLocationOccurredAddress:          getFieldRepetitions(seg, 7),
+		EventQualification:                    getFieldRepetitions(seg, 8),
+		EventSerious:                          getFieldValue(seg, 9),
+		EventExpected:                         getFieldValue(seg, 10),
+		EventOutcome:                          getFieldRepetitions(seg, 11),
+		PatientOutcome:                        getFieldValue(seg, 12),
+		EventDescriptionFromOthers:            getFieldRepetitions(seg, 13),
+		EventFromOriginalReporter:             getFieldRepetitions(seg, 14),
+		EventDescriptionFromPatient:           getFieldRepetitions(seg, 15),
+		EventDescriptionFromPractitioner:      getFieldRepetitions(seg, 16),
+		EventDescriptionFromAutopsy:           getFieldRepetitions(seg, 17),
+		CauseOfDeath:                          getFieldRepetitions(seg, 18),
+		PrimaryObserverName:                   getFieldRepetitions(seg, 19),
+		PrimaryObserverAddress:                getFieldRepetitions(seg, 20),
+		PrimaryObserverTelephone:              getFieldRepetitions(seg, 21),
+		PrimaryObserversQualification:         getFieldValue(seg, 22),
+		ConfirmationProvidedBy:                getFieldValue(seg, 23),
+		PrimaryObserverAwareDateTime:          getFieldValue(seg, 24),
+		PrimaryObserversIdentityMayBeDivulged: getFieldValue(seg, 25),
+	}
+
+	return peo, nil
+}
+
+// ToSegment converts the PEO struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (p *PEO) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		joinRepetitions(p.EventIdentifiersUsed, delims),
+		joinRepetitions(p.EventSymptomDiagnosisCode, delims),
+		p.EventOnsetDateTime,
+		p.EventExacerbationDateTime,
+		p.EventImprovedDateTime,
+		p.EventEndedDataTime,
+		joinRepetitions(p.EventLocationOccurredAddress, delims),
+		joinRepetitions(p.EventQualification, delims),
+		p.EventSerious,
+		p.EventExpected,
+		joinRepetitions(p.EventOutcome, delims),
+		p.PatientOutcome,
+		joinRepetitions(p.EventDescriptionFromOthers, delims),
+		joinRepetitions(p.EventFromOriginalReporter, delims),
+		joinRepetitions(p.EventDescriptionFromPatient, delims),
+		joinRepetitions(p.EventDescriptionFromPractitioner, delims),
+		joinRepetitions(p.EventDescriptionFromAutopsy, delims),
+		joinRepetitions(p.CauseOfDeath, delims),
+		joinRepetitions(p.PrimaryObserverName, delims),
+		joinRepetitions(p.PrimaryObserverAddress, delims),
+		joinRepetitions(p.PrimaryObserverTelephone, delims),
+		p.PrimaryObserversQualification,
+		p.ConfirmationProvidedBy,
+		p.PrimaryObserverAwareDateTime,
+		p.PrimaryObserversIdentityMayBeDivulged,
+	}
+
+	data := buildSegmentData("PEO", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PEO segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,156 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// PR1 represents the Procedures segment.
+//
+// Field positions follow the HL7 standard where PR1-1 is the first field
+// after the segment name.
+type PR1 struct {
+	// SetID is PR1-1: Set ID - PR1 (SI, required, max length 4).
+	SetID string `hl7:"PR1.1,required,maxlen=4"`
+
+	// ProcedureCodingMethod is PR1-2: Procedure Coding Method (IS, backward compatibility, max length 3).
+	ProcedureCodingMethod string `hl7:"PR1.2,maxlen=3"`
+
+	// ProcedureCode is PR1-3: Procedure Code (CE, required, max length 250).
+	ProcedureCode string `hl7:"PR1.3,required,maxlen=250"`
+
+	// ProcedureDescription is PR1-4: Procedure Description (ST, backward compatibility, max length 40).
+	ProcedureDescription string `hl7:"PR1.4,maxlen=40"`
+
+	// ProcedureDateTime is PR1-5: Procedure Date/Time (TS, required, max length 26).
+	ProcedureDateTime string `hl7:"PR1.5,required,maxlen=26"`
+
+	// ProcedureFunctionalType is PR1-6: Procedure Functional Type (IS, optional, max length 2).
+	ProcedureFunctionalType string `hl7:"PR1.6,maxlen=2"`
+
+	// ProcedureMinutes is PR1-7: Procedure Minutes (NM, optional, max length 4).
+	ProcedureMinutes string `hl7:"PR1.7,maxlen=4"`
+
+	// Anesthesiologist is PR1-8: Anesthesiologist (XCN, backward compatibility, repeating, max length 250).
+	Anesthesiologist []string `hl7:"PR1.8,maxlen=250"`
+
+	// AnesthesiaCode is PR1-9: Anesthesia Code (IS, optional, max length 2).
+	AnesthesiaCode string `hl7:"PR1.9,maxlen=2"`
+
+	// AnesthesiaMinutes is PR1-10: Anesthesia Minutes (NM, optional, max length 4).
+	AnesthesiaMinutes string `hl7:"PR1.10,maxlen=4"`
+
+	// Surgeon is PR1-11: Surgeon (XCN, backward compatibility, repeating, max length 250).
+	Surgeon []string `hl7:"PR1.11,maxlen=250"`
+
+	// ProcedurePractitioner is PR1-12: Procedure Practitioner (XCN, backward compatibility, repeating, max length 250).
+	ProcedurePractitioner []string `hl7:"PR1.12,maxlen=250"`
+
+	// ConsentCode is PR1-13: Consent Code (CE, optional, max length 250).
+	ConsentCode string `hl7:"PR1.13,maxlen=250"`
+
+	// ProcedurePriority is PR1-14: Procedure Priority (ID, optional, max length 2).
+	ProcedurePriority string `hl7:"PR1.14,maxlen=2"`
+
+	// AssociatedDiagnosisCode is PR1-15: Associated Diagnosis Code (CE, optional, max length 250).
+	AssociatedDiagnosisCode string `hl7:"PR1.15,maxlen=250"`
+
+	// ProcedureCodeModifier is PR1-16: Procedure Code Modifier (CE, optional, repeating, max length 250).
+	ProcedureCodeModifier []string `hl7:"PR1.16,maxlen=250"`
+
+	// ProcedureDRGType is PR1-17: Procedure DRG Type (IS, optional, max length 20).
+	ProcedureDRGType string `hl7:"PR1.17,maxlen=20"`
+
+	// TissueTypeCode is PR1-18: Tissue Type Code (CE, optional, repeating, max length 250).
+	TissueTypeCode []string `hl7:"PR1.18,maxlen=250"`
+
+	// ProcedureIdentifier is PR1-19: Procedure Identifier (EI, conditional, max length 427).
+	ProcedureIdentifier string `hl7:"PR1.19,maxlen=427"`
+
+	// ProcedureActionCode is PR1-20: Procedure Action Code (ID, conditional, max length 1).
+	ProcedureActionCode string `hl7:"PR1.20,maxlen=1"`
+}
+
+// ErrNotPR1Segment indicates the segment is not a PR1 segment.
+var ErrNotPR1Segment = fmt.Errorf("segment is not PR1")
+
+// ParsePR1 extracts PR1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a PR1 segment.
+func ParsePR1(seg hl7.Segment) (*PR1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "PR1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotPR1Segment, seg.Name())
+	}
+
+	pr1 := &PR1{
+		SetID:                   getFieldValue(seg, 1),
+		ProcedureCodingMethod:   getFieldValue(seg, 2),
+		ProcedureCode:           getFieldValue(seg, 3),
+		ProcedureDescription:    getFieldValue(seg, 4),
+		ProcedureDateTime:       getFieldValue(seg, 5),
+		ProcedureFunctionalType: getFieldValue(seg, 6),
+		ProcedureMinutes:        getFieldValue(seg, 7),
+		Anesthesiologist:        getFieldRepetitions(seg, 8),
+		AnesthesiaCode:          getFieldValue(seg, 9),
+		AnesthesiaMinutes:       getFieldValue(seg, 10),
+		Surgeon:                 getFieldRepetitions(seg, 11),
+		ProcedurePractitioner:   getFieldRepetitions(seg, 12),
+		ConsentCode:             getFieldValue(seg, 13),
+		ProcedurePriority:       getFieldValue(seg, 14),
+		AssociatedDiagnosisCode: getFieldValue(seg, 15),
+		ProcedureCodeModifier:   getFieldRepetitions(seg, 16),
+		ProcedureDRGType:        getFieldValue(seg, 17),
+		TissueTypeCode:          getFieldRepetitions(seg, 18),
+		ProcedureIdentifier:     getFieldValue(seg, 19),
+		ProcedureActionCode:     getFieldValue(seg, 20),
+	}
+
+	return pr1, nil
+}
+
+// ToSegment converts the PR1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (p *PR1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		p.SetID,
+		p.ProcedureCodingMethod,
+		p.ProcedureCode,
+		p.ProcedureDescription,
+		p.ProcedureDateTime,
+		p.ProcedureFunctionalType,
+		p.ProcedureMinutes,
+		joinRepetitions(p.Anesthesiologist, delims),
+		p.AnesthesiaCode,
+		p.AnesthesiaMinutes,
+		joinRepetitions(p.Surgeon, delims),
+		joinRepetitions(p.ProcedurePractitioner, delims),
+		p.ConsentCode,
+		p.ProcedurePriority,
+		p.AssociatedDiagnosisCode,
+		joinRepetitions(p.ProcedureCodeModifier, delims),
+		p.ProcedureDRGType,
+		joinRepetitions(p.TissueTypeCode, delims),
+		p.ProcedureIdentifier,
+		p.ProcedureActionCode,
+	}
+
+	data := buildSegmentData("PR1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PR1 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,90 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParsePRA(t *testing.T) {
+	input := "PRA|PRA-1|PRA-2a~PRA-2b|PRA-3a~PRA-3b|PRA-4|PRA-5a~PRA-5b|PRA-6a~PRA-6b|PRA-7a~PRA-7b|PRA-8|PRA-9|PRA-10|PRA-11a~PRA-11b|PRA-12"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParsePRA(seg)
+	if err != nil {
+		t.Fatalf("ParsePRA() unexpected error: %v", err)
+	}
+
+	want := PRA{
+		PrimaryKeyValue:       "PRA-1",
+		PractitionerGroup:     []string{"PRA-2a", "PRA-2b"},
+		PractitionerCategory:  []string{"PRA-3a", "PRA-3b"},
+		ProviderBilling:       "PRA-4",
+		Specialty:             []string{"PRA-5a", "PRA-5b"},
+		PractitionerIDNumbers: []string{"PRA-6a", "PRA-6b"},
+		Privileges:            []string{"PRA-7a", "PRA-7b"},
+		DateEnteredPractice:   "PRA-8",
+		Institution:           "PRA-9",
+		DateLeftPractice:      "PRA-10",
+		GovernmentReimbursementBillingEligibility: []string{"PRA-11a", "PRA-11b"},
+		SetID: "PRA-12",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParsePRA() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParsePRA_WrongSegment(t *testing.T) {
+	if _, err := ParsePRA(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParsePRA(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParsePRA(seg); !errors.Is(err, ErrNotPRASegment) {
+		t.Errorf("ParsePRA() error = %v, want ErrNotPRASegment", err)
+	}
+}
+
+func TestPRA_RoundTrip(t *testing.T) {
+	original := &PRA{
+		PrimaryKeyValue:       "PRA-1",
+		PractitionerGroup:     []string{"PRA-2a", "PRA-2b"},
+		PractitionerCategory:  []string{"PRA-3a", "PRA-3b"},
+		ProviderBilling:       "PRA-4",
+		Specialty:             []string{"PRA-5a", "PRA-5b"},
+		PractitionerIDNumbers: []string{"PRA-6a", "PRA-6b"},
+		Privileges:            []string{"PRA-7a", "PRA-7b"},
+		DateEnteredPractice:   "PRA-8",
+		Institution:           "PRA-9",
+		DateLeftPractice:      "PRA-10",
+		GovernmentReimbursementBillingEligibility: []string{"PRA-11a", "PRA-11b"},
+		SetID: "PRA-12",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "PRA" {
+		t.Errorf("segment name = %q, want PRA", seg.Name())
+	}
+
+	parsed, err := ParsePRA(seg)
+	if err != nil {
+		t.Fatalf("ParsePRA() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,181 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// PRB represents the Problem Details segment.
+//
+// Field positions follow the HL7 standard where PRB-1 is the first field
+// after the segment name.
+type PRB struct {
+	// ActionCode is PRB-1: Action Code (ID, required, max length 2).
+	ActionCode string `hl7:"PRB.1,required,maxlen=2"`
+
+	// ActionDateTime is PRB-2: Action Date/Time (TS, required, max length 26).
+	ActionDateTime string `hl7:"PRB.2,required,maxlen=26"`
+
+	// ProblemID is PRB-3: Problem ID (CE, required, max length 250).
+	ProblemID string `hl7:"PRB.3,required,maxlen=250"`
+
+	// ProblemInstanceID is PRB-4: Problem Instance ID (EI, required, max length 60).
+	ProblemInstanceID string `hl7:"PRB.4,required,maxlen=60"`
+
+	// EpisodeOfCareID is PRB-5: Episode of Care ID (EI, optional, max length 60).
+	EpisodeOfCareID string `hl7:"PRB.5,maxlen=60"`
+
+	// ProblemListPriority is PRB-6: Problem List Priority (NM, optional, max length 60).
+	ProblemListPriority string `hl7:"PRB.6,maxlen=60"`
+
+	// ProblemEstablishedDateTime is PRB-7: Problem Established Date/Time (TS, optional, max length 26).
+	ProblemEstablishedDateTime string `hl7:"PRB.7,maxlen=26"`
+
+	// AnticipatedProblemResolutionDateTime is PRB-8: Anticipated Problem Resolution Date/Time (TS, optional, max length 26).
+	AnticipatedProblemResolutionDateTime string `hl7:"PRB.8,maxlen=26"`
+
+	// ActualProblemResolutionDateTime is PRB-9: Actual Problem Resolution Date/Time (TS, optional, max length 26).
+	ActualProblemResolutionDateTime string `hl7:"PRB.9,maxlen=26"`
+
+	// ProblemClassification is PRB-10: Problem Classification (CE, optional, max length 250).
+	ProblemClassification string `hl7:"PRB.10,maxlen=250"`
+
+	// ProblemManagementDiscipline is PRB-11: Problem Management Discipline (CE, optional, repeating, max length 250).
+	ProblemManagementDiscipline []string `hl7:"PRB.11,maxlen=250"`
+
+	// ProblemPersistence is PRB-12: Problem Persistence (CE, optional, max length 250).
+	ProblemPersistence string `hl7:"PRB.12,maxlen=250"`
+
+	// ProblemConfirmationStatus is PRB-13: Problem Confirmation Status (CE, optional, max length 250).
+	ProblemConfirmationStatus string `hl7:"PRB.13,maxlen=250"`
+
+	// ProblemLifeCycleStatus is PRB-14: Problem Life Cycle Status (CE, optional, max length 250).
+	ProblemLifeCycleStatus string `hl7:"PRB.14,maxlen=250"`
+
+	// ProblemLifeCycleStatusDateTime is PRB-15: Problem Life Cycle Status Date/Time (TS, optional, max length 26).
+	ProblemLifeCycleStatusDateTime string `hl7:"PRB.15,maxlen=26"`
+
+	// ProblemDateOfOnset is PRB-16: Problem Date of Onset (TS, optional, max length 26).
+	ProblemDateOfOnset string `hl7:"PRB.16,maxlen=26"`
+
+	// ProblemOnsetText is PRB-17: Problem Onset Text (ST, optional, max length 80).
+	ProblemOnsetText string `hl7:"PRB.17,maxlen=80"`
+
+	// ProblemRanking is PRB-18: Problem Ranking (CE, optional, max length 250).
+	ProblemRanking string `hl7:"PRB.18,maxlen=250"`
+
+	// CertaintyOfProblem is PRB-19: Certainty of Problem (0-1) (CE, optional, max length 250).
+	CertaintyOfProblem string `hl7:"PRB.19,maxlen=250"`
+
+	// ProbabilityOfProblem is PRB-20: Probability of Problem (NM, optional, max length 5).
+	ProbabilityOfProblem string `hl7:"PRB.20,maxlen=5"`
+
+	// IndividualAwarenessOfProblem is PRB-21: Individual Awareness of Problem (CE, optional, max length 250).
+	IndividualAwarenessOfProblem string `hl7:"PRB.21,maxlen=250"`
+
+	// ProblemPrognosis is PRB-22: Problem Prognosis (CE, optional, max length 250).
+	ProblemPrognosis string `hl7:"PRB.22,maxlen=250"`
+
+	// IndividualAwarenessOfPrognosis is PRB-23: Individual Awareness of Prognosis (CE, optional, max length 250).
+	IndividualAwarenessOfPrognosis string `hl7:"PRB.23,maxlen=250"`
+
+	// FamilySignificantOtherAwarenessOfProblemPrognosis is PRB-24: Family/Significant Other Awareness of Problem/Prognosis (ST, optional, max length 200).
+	FamilySignificantOtherAwarenessOfProblemPrognosis string `hl7:"PRB.24,maxlen=200"`
+
+	// SecuritySensitivity is PRB-25: Security/Sensitivity (CE, optional, max length 250).
+	SecuritySensitivity string `hl7:"PRB.25,maxlen=250"`
+}
+
+// ErrNotPRBSegment indicates the segment is not a PRB segment.
+var ErrNotPRBSegment = fmt.Errorf("segment is not PRB")
+
+// ParsePRB extracts PRB segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a PRB segment.
+func ParsePRB(seg hl7.Segment) (*PRB, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "PRB" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotPRBSegment, seg.Name())
+	}
+
+	prb := &PRB{
+		ActionCode:                                        getFieldValue(seg, 1),
+		ActionDateTime:                                    getFieldValue(seg, 2),
+		ProblemID:                                         getFieldValue(seg, 3),
+		ProblemInstanceID:                                 getFieldValue(seg, 4),
+		EpisodeOfCareID:                                   getFieldValue(seg, 5),
+		ProblemListPriority:                               getFieldValue(seg, 6),
+		ProblemEstablishedDateTime:                        getFieldValue(seg, 7),
+		AnticipatedProblemResolutionDateTime:              getFieldValue(seg, 8),
+		ActualProblemResolutionDateTime:                   getFieldValue(seg, 9),
+		ProblemClassification:                             getFieldValue(seg, 10),
+		ProblemManagementDiscipline:                       getFieldRepetitions(seg, 11),
+		ProblemPersistence:                                getFieldValue(seg, 12),
+		ProblemConfirmationStatus:                         getFieldValue(seg, 13),
+		ProblemLifeCycleStatus:                            getFieldValue(seg, 14),
+		ProblemLifeCycleStatusDateTime:                    getFieldValue(seg, 15),
+		ProblemDateOfOnset:                                getFieldValue(seg, 16),
+		ProblemOnsetText:                                  getFieldValue(seg, 17),
+		ProblemRanking:                                    getFieldValue(seg, 18),
+		CertaintyOfProblem:                                getFieldValue(seg, 19),
+		ProbabilityOfProblem:                              getFieldValue(seg, 20),
+		IndividualAwarenessOfProblem:                      getFieldValue(seg, 21),
+		ProblemPrognosis:                                  getFieldValue(seg, 22),
+		IndividualAwarenessOfPrognosis:                    getFieldValue(seg, 23),
+		FamilySignificantOtherAwarenessOfProblemPrognosis: getFieldValue(seg, 24),
+		SecuritySensitivity:                               getFieldValue(seg, 25),
+	}
+
+	return prb, nil
+}
+
+// ToSegment converts the PRB struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (p *PRB) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		p.ActionCode,
+		p.ActionDateTime,
+		p.ProblemID,
+		p.ProblemInstanceID,
+		p.EpisodeOfCareID,
+		p.ProblemListPriority,
+		p.ProblemEstablishedDateTime,
+		p.AnticipatedProblemResolutionDateTime,
+		p.ActualProblemResolutionDateTime,
+		p.ProblemClassification,
+		joinRepetitions(p.ProblemManagementDiscipline, delims),
+		p.ProblemPersistence,
+		p.ProblemConfirmationStatus,
+		p.ProblemLifeCycleStatus,
+		p.ProblemLifeCycleStatusDateTime,
+		p.ProblemDateOfOnset,
+		p.ProblemOnsetText,
+		p.ProblemRanking,
+		p.CertaintyOfProblem,
+		p.ProbabilityOfProblem,
+		p.IndividualAwarenessOfProblem,
+		p.ProblemPrognosis,
+		p.IndividualAwarenessOfPrognosis,
+		p.FamilySignificantOtherAwarenessOfProblemPrognosis,
+		p.SecuritySensitivity,
+	}
+
+	data := buildSegmentData("PRB", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PRB segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,146 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// PRC represents the Pricing segment.
+//
+// Field positions follow the HL7 standard where PRC-1 is the first field
+// after the segment name.
+type PRC struct {
+	// PrimaryKeyValue is PRC-1: Primary Key Value - PRC (CE, required, max length 250).
+	PrimaryKeyValue string `hl7:"PRC.1,required,maxlen=250"`
+
+	// FacilityID is PRC-2: Facility ID - PRC (CE, optional, repeating, max length 250).
+	FacilityID []string `hl7:"PRC.2,maxlen=250"`
+
+	// Department is PRC-3: Department (CE, optional, repeating, max length 250).
+	Department []string `hl7:"PRC.3,maxlen=250"`
+
+	// ValidPatientClasses is PRC-4: Valid Patient Classes (IS, optional, repeating, max length 1).
+	ValidPatientClasses []string `hl7:"PRC.4,maxlen=1"`
+
+	// Price is PRC-5: Price (CP, conditional, repeating, max length 12).
+	Price []string `hl7:"PRC.5,maxlen=12"`
+
+	// Formula is PRC-6: Formula (ST, optional, repeating, max length 200).
+	Formula []string `hl7:"PRC.6,maxlen=200"`
+
+	// MinimumQuantity is PRC-7: Minimum Quantity (NM, optional, max length 4).
+	MinimumQuantity string `hl7:"PRC.7,maxlen=4"`
+
+	// MaximumQuantity is PRC-8: Maximum Quantity (NM, optional, max length 4).
+	MaximumQuantity string `hl7:"PRC.8,maxlen=4"`
+
+	// MinimumPrice is PRC-9: Minimum Price (MO, optional, max length 12).
+	MinimumPrice string `hl7:"PRC.9,maxlen=12"`
+
+	// MaximumPrice is PRC-10: Maximum Price (MO, optional, max length 12).
+	MaximumPrice string `hl7:"PRC.10,maxlen=12"`
+
+	// EffectiveStartDate is PRC-11: Effective Start Date (TS, optional, max length 26).
+	EffectiveStartDate string `hl7:"PRC.11,maxlen=26"`
+
+	// EffectiveEndDate is PRC-12: Effective End Date (TS, optional, max length 26).
+	EffectiveEndDate string `hl7:"PRC.12,maxlen=26"`
+
+	// PriceOverrideFlag is PRC-13: Price Override Flag (IS, optional, max length 1).
+	PriceOverrideFlag string `hl7:"PRC.13,maxlen=1"`
+
+	// BillingCategory is PRC-14: Billing Category (CE, optional, repeating, max length 250).
+	BillingCategory []string `hl7:"PRC.14,maxlen=250"`
+
+	// ChargeableFlag is PRC-15: Chargeable Flag (ID, optional, max length 1).
+	ChargeableFlag string `hl7:"PRC.15,maxlen=1"`
+
+	// ActiveInactiveFlag is PRC-16: Active/Inactive Flag (ID, optional, max length 1).
+	ActiveInactiveFlag string `hl7:"PRC.16,maxlen=1"`
+
+	// Cost is PRC-17: Cost (MO, optional, max length 12).
+	Cost string `hl7:"PRC.17,maxlen=12"`
+
+	// ChargeOnIndicator is PRC-18: Charge On Indicator (IS, optional, max length 1).
+	ChargeOnIndicator string `hl7:"PRC.18,maxlen=1"`
+}
+
+// ErrNotPRCSegment indicates the segment is not a PRC segment.
+var ErrNotPRCSegment = fmt.Errorf("segment is not PRC")
+
+// ParsePRC extracts PRC segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a PRC segment.
+func ParsePRC(seg hl7.Segment) (*PRC, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "PRC" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotPRCSegment, seg.Name())
+	}
+
+	prc := &PRC{
+		PrimaryKeyValue:     getFieldValue(seg, 1),
+		FacilityID:          getFieldRepetitions(seg, 2),
+		Department:          getFieldRepetitions(seg, 3),
+		ValidPatientClasses: getFieldRepetitions(seg, 4),
+		Price:               getFieldRepetitions(seg, 5),
+		Formula:             getFieldRepetitions(seg, 6),
+		MinimumQuantity:     getFieldValue(seg, 7),
+		MaximumQuantity:     getFieldValue(seg, 8),
+		MinimumPrice:        getFieldValue(seg, 9),
+		MaximumPrice:        getFieldValue(seg, 10),
+		EffectiveStartDate:  getFieldValue(seg, 11),
+		EffectiveEndDate:    getFieldValue(seg, 12),
+		PriceOverrideFlag:   getFieldValue(seg, 13),
+		BillingCategory:     getFieldRepetitions(seg, 14),
+		ChargeableFlag:      getFieldValue(seg, 15),
+		ActiveInactiveFlag:  getFieldValue(seg, 16),
+		Cost:                getFieldValue(seg, 17),
+		ChargeOnIndicator:   getFieldValue(seg, 18),
+	}
+
+	return prc, nil
+}
+
+// ToSegment converts the PRC struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (p *PRC) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		p.PrimaryKeyValue,
+		joinRepetitions(p.FacilityID, delims),
+		joinRepetitions(p.Department, delims),
+		joinRepetitions(p.ValidPatientClasses, delims),
+		joinRepetitions(p.Price, delims),
+		joinRepetitions(p.Formula, delims),
+		p.MinimumQuantity,
+		p.MaximumQuantity,
+		p.MinimumPrice,
+		p.MaximumPrice,
+		p.EffectiveStartDate,
+		p.EffectiveEndDate,
+		p.PriceOverrideFlag,
+		joinRepetitions(p.BillingCategory, delims),
+		p.ChargeableFlag,
+		p.ActiveInactiveFlag,
+		p.Cost,
+		p.ChargeOnIndicator,
+	}
+
+	data := buildSegmentData("PRC", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PRC segment: %w", err)
+	}
+
+	return seg, nil
+}
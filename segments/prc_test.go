@@ -0,0 +1,102 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParsePRC(t *testing.T) {
+	input := "PRC|PRC-1|PRC-2a~PRC-2b|PRC-3a~PRC-3b|PRC-4a~PRC-4b|PRC-5a~PRC-5b|PRC-6a~PRC-6b|PRC-7|PRC-8|PRC-9|PRC-10|PRC-11|PRC-12|PRC-13|PRC-14a~PRC-14b|PRC-15|PRC-16|PRC-17|PRC-18"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParsePRC(seg)
+	if err != nil {
+		t.Fatalf("ParsePRC() unexpected error: %v", err)
+	}
+
+	want := PRC{
+		PrimaryKeyValue:     "PRC-1",
+		FacilityID:          []string{"PRC-2a", "PRC-2b"},
+		Department:          []string{"PRC-3a", "PRC-3b"},
+		ValidPatientClasses: []string{"PRC-4a", "PRC-4b"},
+		Price:               []string{"PRC-5a", "PRC-5b"},
+		Formula:             []string{"PRC-6a", "PRC-6b"},
+		MinimumQuantity:     "PRC-7",
+		MaximumQuantity:     "PRC-8",
+		MinimumPrice:        "PRC-9",
+		MaximumPrice:        "PRC-10",
+		EffectiveStartDate:  "PRC-11",
+		EffectiveEndDate:    "PRC-12",
+		PriceOverrideFlag:   "PRC-13",
+		BillingCategory:     []string{"PRC-14a", "PRC-14b"},
+		ChargeableFlag:      "PRC-15",
+		ActiveInactiveFlag:  "PRC-16",
+		Cost:                "PRC-17",
+		ChargeOnIndicator:   "PRC-18",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParsePRC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParsePRC_WrongSegment(t *testing.T) {
+	if _, err := ParsePRC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParsePRC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParsePRC(seg); !errors.Is(err, ErrNotPRCSegment) {
+		t.Errorf("ParsePRC() error = %v, want ErrNotPRCSegment", err)
+	}
+}
+
+func TestPRC_RoundTrip(t *testing.T) {
+	original := &PRC{
+		PrimaryKeyValue:     "PRC-1",
+		FacilityID:          []string{"PRC-2a", "PRC-2b"},
+		Department:          []string{"PRC-3a", "PRC-3b"},
+		ValidPatientClasses: []string{"PRC-4a", "PRC-4b"},
+		Price:               []string{"PRC-5a", "PRC-5b"},
+		Formula:             []string{"PRC-6a", "PRC-6b"},
+		MinimumQuantity:     "PRC-7",
+		MaximumQuantity:     "PRC-8",
+		MinimumPrice:        "PRC-9",
+		MaximumPrice:        "PRC-10",
+		EffectiveStartDate:  "PRC-11",
+		EffectiveEndDate:    "PRC-12",
+		PriceOverrideFlag:   "PRC-13",
+		BillingCategory:     []string{"PRC-14a", "PRC-14b"},
+		ChargeableFlag:      "PRC-15",
+		ActiveInactiveFlag:  "PRC-16",
+		Cost:                "PRC-17",
+		ChargeOnIndicator:   "PRC-18",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "PRC" {
+		t.Errorf("segment name = %q, want PRC", seg.Name())
+	}
+
+	parsed, err := ParsePRC(seg)
+	if err != nil {
+		t.Fatalf("ParsePRC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParsePRD(t *testing.T) {
+	input := "PRD|PRD-1a~PRD-1b|PRD-2a~PRD-2b|PRD-3a~PRD-3b|PRD-4|PRD-5a~PRD-5b|PRD-6|PRD-7a~PRD-7b|PRD-8|PRD-9"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParsePRD(seg)
+	if err != nil {
+		t.Fatalf("ParsePRD() unexpected error: %v", err)
+	}
+
+	want := PRD{
+		ProviderRole:                     []string{"PRD-1a", "PRD-1b"},
+		ProviderName:                     []string{"PRD-2a", "PRD-2b"},
+		ProviderAddress:                  []string{"PRD-3a", "PRD-3b"},
+		ProviderLocation:                 "PRD-4",
+		ProviderCommunicationInformation: []string{"PRD-5a", "PRD-5b"},
+		PreferredMethodOfContact:         "PRD-6",
+		ProviderIdentifiers:              []string{"PRD-7a", "PRD-7b"},
+		EffectiveStartDateOfProviderRole: "PRD-8",
+		EffectiveEndDateOfProviderRole:   "PRD-9",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParsePRD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParsePRD_WrongSegment(t *testing.T) {
+	if _, err := ParsePRD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParsePRD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParsePRD(seg); !errors.Is(err, ErrNotPRDSegment) {
+		t.Errorf("ParsePRD() error = %v, want ErrNotPRDSegment", err)
+	}
+}
+
+func TestPRD_RoundTrip(t *testing.T) {
+	original := &PRD{
+		ProviderRole:                     []string{"PRD-1a", "PRD-1b"},
+		ProviderName:                     []string{"PRD-2a", "PRD-2b"},
+		ProviderAddress:                  []string{"PRD-3a", "PRD-3b"},
+		ProviderLocation:                 "PRD-4",
+		ProviderCommunicationInformation: []string{"PRD-5a", "PRD-5b"},
+		PreferredMethodOfContact:         "PRD-6",
+		ProviderIdentifiers:              []string{"PRD-7a", "PRD-7b"},
+		EffectiveStartDateOfProviderRole: "PRD-8",
+		EffectiveEndDateOfProviderRole:   "PRD-9",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "PRD" {
+		t.Errorf("segment name = %q, want PRD", seg.Name())
+	}
+
+	parsed, err := ParsePRD(seg)
+	if err != nil {
+		t.Fatalf("ParsePRD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
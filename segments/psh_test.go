@@ -0,0 +1,94 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParsePSH(t *testing.T) {
+	input := "PSH|PSH-1|PSH-2|PSH-3|PSH-4|PSH-5|PSH-6|PSH-7|PSH-8|PSH-9|PSH-10|PSH-11|PSH-12|PSH-13a~PSH-13b|PSH-14a~PSH-14b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParsePSH(seg)
+	if err != nil {
+		t.Fatalf("ParsePSH() unexpected error: %v", err)
+	}
+
+	want := PSH{
+		ReportType:                 "PSH-1",
+		ReportFormIdentifier:       "PSH-2",
+		ReportDate:                 "PSH-3",
+		ReportIntervalStartDate:    "PSH-4",
+		ReportIntervalEndDate:      "PSH-5",
+		QuantityManufactured:       "PSH-6",
+		QuantityDistributed:        "PSH-7",
+		QuantityDistributedMethod:  "PSH-8",
+		QuantityDistributedComment: "PSH-9",
+		QuantityInUse:              "PSH-10",
+		QuantityInUseMethod:        "PSH-11",
+		QuantityInUseComment:       "PSH-12",
+		NumberOfProductExperienceReportsFiledByFacility:    []string{"PSH-13a", "PSH-13b"},
+		NumberOfProductExperienceReportsFiledByDistributor: []string{"PSH-14a", "PSH-14b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParsePSH() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParsePSH_WrongSegment(t *testing.T) {
+	if _, err := ParsePSH(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParsePSH(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParsePSH(seg); !errors.Is(err, ErrNotPSHSegment) {
+		t.Errorf("ParsePSH() error = %v, want ErrNotPSHSegment", err)
+	}
+}
+
+func TestPSH_RoundTrip(t *testing.T) {
+	original := &PSH{
+		ReportType:                 "PSH-1",
+		ReportFormIdentifier:       "PSH-2",
+		ReportDate:                 "PSH-3",
+		ReportIntervalStartDate:    "PSH-4",
+		ReportIntervalEndDate:      "PSH-5",
+		QuantityManufactured:       "PSH-6",
+		QuantityDistributed:        "PSH-7",
+		QuantityDistributedMethod:  "PSH-8",
+		QuantityDistributedComment: "PSH-9",
+		QuantityInUse:              "PSH-10",
+		QuantityInUseMethod:        "PSH-11",
+		QuantityInUseComment:       "PSH-12",
+		NumberOfProductExperienceReportsFiledByFacility:    []string{"PSH-13a", "PSH-13b"},
+		NumberOfProductExperienceReportsFiledByDistributor: []string{"PSH-14a", "PSH-14b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "PSH" {
+		t.Errorf("segment name = %q, want PSH", seg.Name())
+	}
+
+	parsed, err := ParsePSH(seg)
+	if err != nil {
+		t.Fatalf("ParsePSH() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
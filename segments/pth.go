@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// PTH represents the Pathway segment.
+//
+// Field positions follow the HL7 standard where PTH-1 is the first field
+// after the segment name.
+type PTH struct {
+	// ActionCode is PTH-1: Action Code (ID, required, max length 2).
+	ActionCode string `hl7:"PTH.1,required,maxlen=2"`
+
+	// PathwayID is PTH-2: Pathway ID (CE, required, max length 250).
+	PathwayID string `hl7:"PTH.2,required,maxlen=250"`
+
+	// PathwayInstanceID is PTH-3: Pathway Instance ID (EI, required, max length 60).
+	PathwayInstanceID string `hl7:"PTH.3,required,maxlen=60"`
+
+	// PathwayEstablishedDateTime is PTH-4: Pathway Established Date/Time (TS, required, max length 26).
+	PathwayEstablishedDateTime string `hl7:"PTH.4,required,maxlen=26"`
+
+	// PathwayLifeCycleStatus is PTH-5: Pathway Life Cycle Status (CE, optional, max length 250).
+	PathwayLifeCycleStatus string `hl7:"PTH.5,maxlen=250"`
+
+	// ChangePathwayLifeCycleStatusDateTime is PTH-6: Change Pathway Life Cycle Status Date/Time (TS, conditional, max length 26).
+	ChangePathwayLifeCycleStatusDateTime string `hl7:"PTH.6,maxlen=26"`
+}
+
+// ErrNotPTHSegment indicates the segment is not a PTH segment.
+var ErrNotPTHSegment = fmt.Errorf("segment is not PTH")
+
+// ParsePTH extracts PTH segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a PTH segment.
+func ParsePTH(seg hl7.Segment) (*PTH, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "PTH" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotPTHSegment, seg.Name())
+	}
+
+	pth := &PTH{
+		ActionCode:                           getFieldValue(seg, 1),
+		PathwayID:                            getFieldValue(seg, 2),
+		PathwayInstanceID:                    getFieldValue(seg, 3),
+		PathwayEstablishedDateTime:           getFieldValue(seg, 4),
+		PathwayLifeCycleStatus:               getFieldValue(seg, 5),
+		ChangePathwayLifeCycleStatusDateTime: getFieldValue(seg, 6),
+	}
+
+	return pth, nil
+}
+
+// ToSegment converts the PTH struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (p *PTH) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		p.ActionCode,
+		p.PathwayID,
+		p.PathwayInstanceID,
+		p.PathwayEstablishedDateTime,
+		p.PathwayLifeCycleStatus,
+		p.ChangePathwayLifeCycleStatusDateTime,
+	}
+
+	data := buildSegmentData("PTH", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PTH segment: %w", err)
+	}
+
+	return seg, nil
+}
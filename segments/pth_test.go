@@ -0,0 +1,78 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParsePTH(t *testing.T) {
+	input := "PTH|PTH-1|PTH-2|PTH-3|PTH-4|PTH-5|PTH-6"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParsePTH(seg)
+	if err != nil {
+		t.Fatalf("ParsePTH() unexpected error: %v", err)
+	}
+
+	want := PTH{
+		ActionCode:                           "PTH-1",
+		PathwayID:                            "PTH-2",
+		PathwayInstanceID:                    "PTH-3",
+		PathwayEstablishedDateTime:           "PTH-4",
+		PathwayLifeCycleStatus:               "PTH-5",
+		ChangePathwayLifeCycleStatusDateTime: "PTH-6",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParsePTH() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParsePTH_WrongSegment(t *testing.T) {
+	if _, err := ParsePTH(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParsePTH(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParsePTH(seg); !errors.Is(err, ErrNotPTHSegment) {
+		t.Errorf("ParsePTH() error = %v, want ErrNotPTHSegment", err)
+	}
+}
+
+func TestPTH_RoundTrip(t *testing.T) {
+	original := &PTH{
+		ActionCode:                           "PTH-1",
+		PathwayID:                            "PTH-2",
+		PathwayInstanceID:                    "PTH-3",
+		PathwayEstablishedDateTime:           "PTH-4",
+		PathwayLifeCycleStatus:               "PTH-5",
+		ChangePathwayLifeCycleStatusDateTime: "PTH-6",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "PTH" {
+		t.Errorf("segment name = %q, want PTH", seg.Name())
+	}
+
+	parsed, err := ParsePTH(seg)
+	if err != nil {
+		t.Fatalf("ParsePTH() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
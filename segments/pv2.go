@@ -0,0 +1,301 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// PV2 represents the Patient Visit - Additional Information segment.
+//
+// Field positions follow the HL7 standard where PV2-1 is the first field
+// after the segment name.
+type PV2 struct {
+	// PriorPendingLocation is PV2-1: Prior Pending Location (PL, conditional, max length 80).
+	PriorPendingLocation string `hl7:"PV2.1,maxlen=80"`
+
+	// AccommodationCode is PV2-2: Accommodation Code (CE, optional, max length 250).
+	AccommodationCode string `hl7:"PV2.2,maxlen=250"`
+
+	// AdmitReason is PV2-3: Admit Reason (CE, optional, max length 250).
+	AdmitReason string `hl7:"PV2.3,maxlen=250"`
+
+	// TransferReason is PV2-4: Transfer Reason (CE, optional, max length 250).
+	TransferReason string `hl7:"PV2.4,maxlen=250"`
+
+	// PatientValuables is PV2-5: Patient Valuables (ST, optional, repeating, max length 25).
+	PatientValuables []string `hl7:"PV2.5,maxlen=25"`
+
+	// PatientValuablesLocation is PV2-6: Patient Valuables Location (ST, optional, max length 25).
+	PatientValuablesLocation string `hl7:"PV2.6,maxlen=25"`
+
+	// VisitUserCode is PV2-7: Visit User Code (IS, optional, repeating, max length 2).
+	VisitUserCode []string `hl7:"PV2.7,maxlen=2"`
+
+	// ExpectedAdmitDateTime is PV2-8: Expected Admit Date/Time (TS, optional, max length 26).
+	ExpectedAdmitDateTime string `hl7:"PV2.8,maxlen=26"`
+
+	// ExpectedDischargeDateTime is PV2-9: Expected Discharge Date/Time (TS, optional, max length 26).
+	ExpectedDischargeDateTime string `hl7:"PV2.9,maxlen=26"`
+
+	// EstimatedLengthOfInpatientStay is PV2-10: Estimated Length of Inpatient Stay (NM, optional, max length 3).
+	EstimatedLengthOfInpatientStay string `hl7:"PV2.10,maxlen=3"`
+
+	// ActualLengthOfInpatientStay is PV2-11: Actual Length of Inpatient Stay (NM, optional, max length 3).
+	ActualLengthOfInpatientStay string `hl7:"PV2.11,maxlen=3"`
+
+	// VisitDescription is PV2-12: Visit Description (ST, optional, max length 50).
+	VisitDescription string `hl7:"PV2.12,maxlen=50"`
+
+	// ReferralSourceCode is PV2-13: Referral Source Code (XCN, optional, repeating, max length 250).
+	ReferralSourceCode []string `hl7:"PV2.13,maxlen=250"`
+
+	// PreviousServiceDate is PV2-14: Previous Service Date (DT, optional, max length 8).
+	PreviousServiceDate string `hl7:"PV2.14,maxlen=8"`
+
+	// EmploymentIllnessRelatedIndicator is PV2-15: Employment Illness Related Indicator (ID, optional, max length 1).
+	EmploymentIllnessRelatedIndicator string `hl7:"PV2.15,maxlen=1"`
+
+	// PurgeStatusCode is PV2-16: Purge Status Code (IS, optional, max length 1).
+	PurgeStatusCode string `hl7:"PV2.16,maxlen=1"`
+
+	// PurgeStatusDate is PV2-17: Purge Status Date (DT, optional, max length 8).
+	PurgeStatusDate string `hl7:"PV2.17,maxlen=8"`
+
+	// SpecialProgramCode is PV2-18: Special Program Code (IS, optional, max length 2).
+	SpecialProgramCode string `hl7:"PV2.18,maxlen=2"`
+
+	// RetentionIndicator is PV2-19: Retention Indicator (ID, optional, max length 1).
+	RetentionIndicator string `hl7:"PV2.19,maxlen=1"`
+
+	// ExpectedNumberOfInsurancePlans is PV2-20: Expected Number of Insurance Plans (NM, optional, max length 1).
+	ExpectedNumberOfInsurancePlans string `hl7:"PV2.20,maxlen=1"`
+
+	// VisitPublicityCode is PV2-21: Visit Publicity Code (IS, optional, max length 1).
+	VisitPublicityCode string `hl7:"PV2.21,maxlen=1"`
+
+	// VisitProtectionIndicator is PV2-22: Visit Protection Indicator (ID, optional, max length 1).
+	VisitProtectionIndicator string `hl7:"PV2.22,maxlen=1"`
+
+	// ClinicOrganizationName is PV2-23: Clinic Organization Name (XON, optional, repeating, max length 250).
+	ClinicOrganizationName []string `hl7:"PV2.23,maxlen=250"`
+
+	// PatientStatusCode is PV2-24: Patient Status Code (IS, optional, max length 2).
+	PatientStatusCode string `hl7:"PV2.24,maxlen=2"`
+
+	// VisitPriorityCode is PV2-25: Visit Priority Code (IS, optional, max length 1).
+	VisitPriorityCode string `hl7:"PV2.25,maxlen=1"`
+
+	// PreviousTreatmentDate is PV2-26: Previous Treatment Date (DT, optional, max length 8).
+	PreviousTreatmentDate string `hl7:"PV2.26,maxlen=8"`
+
+	// ExpectedDischargeDisposition is PV2-27: Expected Discharge Disposition (IS, optional, max length 2).
+	ExpectedDischargeDisposition string `hl7:"PV2.27,maxlen=2"`
+
+	// SignatureOnFileDate is PV2-28: Signature on File Date (DT, optional, max length 8).
+	SignatureOnFileDate string `hl7:"PV2.28,maxlen=8"`
+
+	// FirstSimilarIllnessDate is PV2-29: First Similar Illness Date (DT, optional, max length 8).
+	FirstSimilarIllnessDate string `hl7:"PV2.29,maxlen=8"`
+
+	// PatientChargeAdjustmentCode is PV2-30: Patient Charge Adjustment Code (CE, optional, max length 250).
+	PatientChargeAdjustmentCode string `hl7:"PV2.30,maxlen=250"`
+
+	// RecurringServiceCode is PV2-31: Recurring Service Code (IS, optional, max length 2).
+	RecurringServiceCode string `hl7:"PV2.31,maxlen=2"`
+
+	// BillingMediaCode is PV2-32: Billing Media Code (ID, optional, max length 1).
+	BillingMediaCode string `hl7:"PV2.32,maxlen=1"`
+
+	// ExpectedSurgeryDateTime is PV2-33: Expected Surgery Date and Time (TS, optional, max length 26).
+	ExpectedSurgeryDateTime string `hl7:"PV2.33,maxlen=26"`
+
+	// MilitaryPartnershipCode is PV2-34: Military Partnership Code (ID, optional, max length 1).
+	MilitaryPartnershipCode string `hl7:"PV2.34,maxlen=1"`
+
+	// MilitaryNonAvailabilityCode is PV2-35: Military Non-Availability Code (ID, optional, max length 1).
+	MilitaryNonAvailabilityCode string `hl7:"PV2.35,maxlen=1"`
+
+	// NewbornBabyIndicator is PV2-36: Newborn Baby Indicator (ID, optional, max length 1).
+	NewbornBabyIndicator string `hl7:"PV2.36,maxlen=1"`
+
+	// BabyDetainedIndicator is PV2-37: Baby Detained Indicator (ID, optional, max length 1).
+	BabyDetainedIndicator string `hl7:"PV2.37,maxlen=1"`
+
+	// ModeOfArrivalCode is PV2-38: Mode of Arrival Code (CE, optional, max length 250).
+	ModeOfArrivalCode string `hl7:"PV2.38,maxlen=250"`
+
+	// RecreationalDrugUseCode is PV2-39: Recreational Drug Use Code (CE, optional, repeating, max length 250).
+	RecreationalDrugUseCode []string `hl7:"PV2.39,maxlen=250"`
+
+	// AdmissionLevelOfCareCode is PV2-40: Admission Level of Care Code (CE, optional, max length 250).
+	AdmissionLevelOfCareCode string `hl7:"PV2.40,maxlen=250"`
+
+	// PrecautionCode is PV2-41: Precaution Code (CE, optional, repeating, max length 250).
+	PrecautionCode []string `hl7:"PV2.41,maxlen=250"`
+
+	// PatientConditionCode is PV2-42: Patient Condition Code (CE, optional, max length 250).
+	PatientConditionCode string `hl7:"PV2.42,maxlen=250"`
+
+	// LivingWillCode is PV2-43: Living Will Code (IS, optional, max length 2).
+	LivingWillCode string `hl7:"PV2.43,maxlen=2"`
+
+	// OrganDonorCode is PV2-44: Organ Donor Code (IS, optional, max length 2).
+	OrganDonorCode string `hl7:"PV2.44,maxlen=2"`
+
+	// AdvanceDirectiveCode is PV2-45: Advance Directive Code (CE, optional, repeating, max length 250).
+	AdvanceDirectiveCode []string `hl7:"PV2.45,maxlen=250"`
+
+	// PatientStatusEffectiveDate is PV2-46: Patient Status Effective Date (DT, optional, max length 8).
+	PatientStatusEffectiveDate string `hl7:"PV2.46,maxlen=8"`
+
+	// ExpectedLOAReturnDateTime is PV2-47: Expected LOA Return Date/Time (TS, conditional, max length 26).
+	ExpectedLOAReturnDateTime string `hl7:"PV2.47,maxlen=26"`
+
+	// ExpectedPreAdmissionTestingDateTime is PV2-48: Expected Pre-admission Testing Date/Time (TS, optional, max length 26).
+	ExpectedPreAdmissionTestingDateTime string `hl7:"PV2.48,maxlen=26"`
+
+	// NotifyClergyCode is PV2-49: Notify Clergy Code (IS, optional, repeating, max length 20).
+	NotifyClergyCode []string `hl7:"PV2.49,maxlen=20"`
+}
+
+// ErrNotPV2Segment indicates the segment is not a PV2 segment.
+var ErrNotPV2Segment = fmt.Errorf("segment is not PV2")
+
+// ParsePV2 extracts PV2 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a PV2 segment.
+func ParsePV2(seg hl7.Segment) (*PV2, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "PV2" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotPV2Segment, seg.Name())
+	}
+
+	pv2 := &PV2{
+		PriorPendingLocation:                getFieldValue(seg, 1),
+		AccommodationCode:                   getFieldValue(seg, 2),
+		AdmitReason:                         getFieldValue(seg, 3),
+		TransferReason:                      getFieldValue(seg, 4),
+		PatientValuables:                    getFieldRepetitions(seg, 5),
+		PatientValuablesLocation:            getFieldValue(seg, 6),
+		VisitUserCode:                       getFieldRepetitions(seg, 7),
+		ExpectedAdmitDateTime:               getFieldValue(seg, 8),
+		ExpectedDischargeDateTime:           getFieldValue(seg, 9),
+		EstimatedLengthOfInpatientStay:      getFieldValue(seg, 10),
+		ActualLengthOfInpatientStay:         getFieldValue(seg, 11),
+		VisitDescription:                    getFieldValue(seg, 12),
+		ReferralSourceCode:                  getFieldRepetitions(seg, 13),
+		PreviousServiceDate:                 getFieldValue(seg, 14),
+		EmploymentIllnessRelatedIndicator:   getFieldValue(seg, 15),
+		PurgeStatusCode:                     getFieldValue(seg, 16),
+		PurgeStatusDate:                     getFieldValue(seg, 17),
+		SpecialProgramCode:                  getFieldValue(seg, 18),
+		RetentionIndicator:                  getFieldValue(seg, 19),
+		ExpectedNumberOfInsurancePlans:      getFieldValue(seg, 20),
+		VisitPublicityCode:                  getFieldValue(seg, 21),
+		VisitProtectionIndicator:            getFieldValue(seg, 22),
+		ClinicOrganizationName:              getFieldRepetitions(seg, 23),
+		PatientStatusCode:                   getFieldValue(seg, 24),
+		VisitPriorityCode:                   getFieldValue(seg, 25),
+		PreviousTreatmentDate:               getFieldValue(seg, 26),
+		ExpectedDischargeDisposition:        getFieldValue(seg, 27),
+		SignatureOnFileDate:                 getFieldValue(seg, 28),
+		FirstSimilarIllnessDate:             getFieldValue(seg, 29),
+		PatientChargeAdjustmentCode:         getFieldValue(seg, 30),
+		RecurringServiceCode:                getFieldValue(seg, 31),
+		BillingMediaCode:                    getFieldValue(seg, 32),
+		ExpectedSurgeryDateTime:             getFieldValue(seg, 33),
+		MilitaryPartnershipCode:             getFieldValue(seg, 34),
+		MilitaryNonAvailabilityCode:         getFieldValue(seg, 35),
+		NewbornBabyIndicator:                getFieldValue(seg, 36),
+		BabyDetainedIndicator:               getFieldValue(seg, 37),
+		ModeOfArrivalCode:                   getFieldValue(seg, 38),
+		RecreationalDrugUseCode:             getFieldRepetitions(seg, 39),
+		AdmissionLevelOfCareCode:            getFieldValue(seg, 40),
+		PrecautionCode:                      getFieldRepetitions(seg, 41),
+		PatientConditionCode:                getFieldValue(seg, 42),
+		LivingWillCode:                      getFieldValue(seg, 43),
+		OrganDonorCode:                      getFieldValue(seg, 44),
+		AdvanceDirectiveCode:                getFieldRepetitions(seg, 45),
+		PatientStatusEffectiveDate:          getFieldValue(seg, 46),
+		ExpectedLOAReturnDateTime:           getFieldValue(seg, 47),
+		ExpectedPreAdmissionTestingDateTime: getFieldValue(seg, 48),
+		NotifyClergyCode:                    getFieldRepetitions(seg, 49),
+	}
+
+	return pv2, nil
+}
+
+// ToSegment converts the PV2 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (p *PV2) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		p.PriorPendingLocation,
+		p.AccommodationCode,
+		p.AdmitReason,
+		p.TransferReason,
+		joinRepetitions(p.PatientValuables, delims),
+		p.PatientValuablesLocation,
+		joinRepetitions(p.VisitUserCode, delims),
+		p.ExpectedAdmitDateTime,
+		p.ExpectedDischargeDateTime,
+		p.EstimatedLengthOfInpatientStay,
+		p.ActualLengthOfInpatientStay,
+		p.VisitDescription,
+		joinRepetitions(p.ReferralSourceCode, delims),
+		p.PreviousServiceDate,
+		p.EmploymentIllnessRelatedIndicator,
+		p.PurgeStatusCode,
+		p.PurgeStatusDate,
+		p.SpecialProgramCode,
+		p.RetentionIndicator,
+		p.ExpectedNumberOfInsurancePlans,
+		p.VisitPublicityCode,
+		p.VisitProtectionIndicator,
+		joinRepetitions(p.ClinicOrganizationName, delims),
+		p.PatientStatusCode,
+		p.VisitPriorityCode,
+		p.PreviousTreatmentDate,
+		p.ExpectedDischargeDisposition,
+		p.SignatureOnFileDate,
+		p.FirstSimilarIllnessDate,
+		p.PatientChargeAdjustmentCode,
+		p.RecurringServiceCode,
+		p.BillingMediaCode,
+		p.ExpectedSurgeryDateTime,
+		p.MilitaryPartnershipCode,
+		p.MilitaryNonAvailabilityCode,
+		p.NewbornBabyIndicator,
+		p.BabyDetainedIndicator,
+		p.ModeOfArrivalCode,
+		joinRepetitions(p.RecreationalDrugUseCode, delims),
+		p.AdmissionLevelOfCareCode,
+		joinRepetitions(p.PrecautionCode, delims),
+		p.PatientConditionCode,
+		p.LivingWillCode,
+		p.OrganDonorCode,
+		joinRepetitions(p.AdvanceDirectiveCode, delims),
+		p.PatientStatusEffectiveDate,
+		p.ExpectedLOAReturnDateTime,
+		p.ExpectedPreAdmissionTestingDateTime,
+		joinRepetitions(p.NotifyClergyCode, delims),
+	}
+
+	data := buildSegmentData("PV2", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PV2 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,66 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// QID represents the Query Identification segment.
+//
+// Field positions follow the HL7 standard where QID-1 is the first field
+// after the segment name.
+type QID struct {
+	// QueryTag is QID-1: Query Tag (ST, required, max length 32).
+	QueryTag string `hl7:"QID.1,required,maxlen=32"`
+
+	// MessageQueryName is QID-2: Message Query Name (CE, required, max length 250).
+	MessageQueryName string `hl7:"QID.2,required,maxlen=250"`
+}
+
+// ErrNotQIDSegment indicates the segment is not a QID segment.
+var ErrNotQIDSegment = fmt.Errorf("segment is not QID")
+
+// ParseQID extracts QID segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a QID segment.
+func ParseQID(seg hl7.Segment) (*QID, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "QID" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotQIDSegment, seg.Name())
+	}
+
+	qid := &QID{
+		QueryTag:         getFieldValue(seg, 1),
+		MessageQueryName: getFieldValue(seg, 2),
+	}
+
+	return qid, nil
+}
+
+// ToSegment converts the QID struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (q *QID) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		q.QueryTag,
+		q.MessageQueryName,
+	}
+
+	data := buildSegmentData("QID", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create QID segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,70 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseQID(t *testing.T) {
+	input := "QID|QID-1|QID-2"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseQID(seg)
+	if err != nil {
+		t.Fatalf("ParseQID() unexpected error: %v", err)
+	}
+
+	want := QID{
+		QueryTag:         "QID-1",
+		MessageQueryName: "QID-2",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseQID() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseQID_WrongSegment(t *testing.T) {
+	if _, err := ParseQID(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseQID(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseQID(seg); !errors.Is(err, ErrNotQIDSegment) {
+		t.Errorf("ParseQID() error = %v, want ErrNotQIDSegment", err)
+	}
+}
+
+func TestQID_RoundTrip(t *testing.T) {
+	original := &QID{
+		QueryTag:         "QID-1",
+		MessageQueryName: "QID-2",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "QID" {
+		t.Errorf("segment name = %q, want QID", seg.Name())
+	}
+
+	parsed, err := ParseQID(seg)
+	if err != nil {
+		t.Fatalf("ParseQID() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseQRD(t *testing.T) {
+	input := "QRD|QRD-1|QRD-2|QRD-3|QRD-4|QRD-5|QRD-6|QRD-7|QRD-8a~QRD-8b|QRD-9a~QRD-9b|QRD-10a~QRD-10b|QRD-11a~QRD-11b|QRD-12"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseQRD(seg)
+	if err != nil {
+		t.Fatalf("ParseQRD() unexpected error: %v", err)
+	}
+
+	want := QRD{
+		QueryDateTime:            "QRD-1",
+		QueryFormatCode:          "QRD-2",
+		QueryPriority:            "QRD-3",
+		QueryID:                  "QRD-4",
+		DeferredResponseType:     "QRD-5",
+		DeferredResponseDateTime: "QRD-6",
+		QuantityLimitedRequest:   "QRD-7",
+		WhoSubjectFilter:         []string{"QRD-8a", "QRD-8b"},
+		WhatSubjectFilter:        []string{"QRD-9a", "QRD-9b"},
+		WhatDepartmentDataCode:   []string{"QRD-10a", "QRD-10b"},
+		WhatDataCodeValueQual:    []string{"QRD-11a", "QRD-11b"},
+		QueryResultsLevel:        "QRD-12",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseQRD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseQRD_WrongSegment(t *testing.T) {
+	if _, err := ParseQRD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseQRD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseQRD(seg); !errors.Is(err, ErrNotQRDSegment) {
+		t.Errorf("ParseQRD() error = %v, want ErrNotQRDSegment", err)
+	}
+}
+
+func TestQRD_RoundTrip(t *testing.T) {
+	original := &QRD{
+		QueryDateTime:            "QRD-1",
+		QueryFormatCode:          "QRD-2",
+		QueryPriority:            "QRD-3",
+		QueryID:                  "QRD-4",
+		DeferredResponseType:     "QRD-5",
+		DeferredResponseDateTime: "QRD-6",
+		QuantityLimitedRequest:   "QRD-7",
+		WhoSubjectFilter:         []string{"QRD-8a", "QRD-8b"},
+		WhatSubjectFilter:        []string{"QRD-9a", "QRD-9b"},
+		WhatDepartmentDataCode:   []string{"QRD-10a", "QRD-10b"},
+		WhatDataCodeValueQual:    []string{"QRD-11a", "QRD-11b"},
+		QueryResultsLevel:        "QRD-12",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "QRD" {
+		t.Errorf("segment name = %q, want QRD", seg.Name())
+	}
+
+	parsed, err := ParseQRD(seg)
+	if err != nil {
+		t.Fatalf("ParseQRD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseQRF(t *testing.T) {
+	input := "QRF|QRF-1a~QRF-1b|QRF-2|QRF-3|QRF-4a~QRF-4b|QRF-5a~QRF-5b|QRF-6a~QRF-6b|QRF-7a~QRF-7b|QRF-8a~QRF-8b|QRF-9|QRF-10"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseQRF(seg)
+	if err != nil {
+		t.Fatalf("ParseQRF() unexpected error: %v", err)
+	}
+
+	want := QRF{
+		WhereSubjectFilter:           []string{"QRF-1a", "QRF-1b"},
+		WhenDataStartDateTime:        "QRF-2",
+		WhenDataEndDateTime:          "QRF-3",
+		WhatUserQualifier:            []string{"QRF-4a", "QRF-4b"},
+		OtherQRYSubjectFilter:        []string{"QRF-5a", "QRF-5b"},
+		WhichDateTimeQualifier:       []string{"QRF-6a", "QRF-6b"},
+		WhichDateTimeStatusQualifier: []string{"QRF-7a", "QRF-7b"},
+		DateTimeSelectionQualifier:   []string{"QRF-8a", "QRF-8b"},
+		WhenQuantityTimingQualifier:  "QRF-9",
+		SearchConfidenceThreshold:    "QRF-10",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseQRF() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseQRF_WrongSegment(t *testing.T) {
+	if _, err := ParseQRF(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseQRF(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseQRF(seg); !errors.Is(err, ErrNotQRFSegment) {
+		t.Errorf("ParseQRF() error = %v, want ErrNotQRFSegment", err)
+	}
+}
+
+func TestQRF_RoundTrip(t *testing.T) {
+	original := &QRF{
+		WhereSubjectFilter:           []string{"QRF-1a", "QRF-1b"},
+		WhenDataStartDateTime:        "QRF-2",
+		WhenDataEndDateTime:          "QRF-3",
+		WhatUserQualifier:            []string{"QRF-4a", "QRF-4b"},
+		OtherQRYSubjectFilter:        []string{"QRF-5a", "QRF-5b"},
+		WhichDateTimeQualifier:       []string{"QRF-6a", "QRF-6b"},
+		WhichDateTimeStatusQualifier: []string{"QRF-7a", "QRF-7b"},
+		DateTimeSelectionQualifier:   []string{"QRF-8a", "QRF-8b"},
+		WhenQuantityTimingQualifier:  "QRF-9",
+		SearchConfidenceThreshold:    "QRF-10",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "QRF" {
+		t.Errorf("segment name = %q, want QRF", seg.Name())
+	}
+
+	parsed, err := ParseQRF(seg)
+	if err != nil {
+		t.Fatalf("ParseQRF() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// QRI represents the Query Response Instance segment.
+//
+// Field positions follow the HL7 standard where QRI-1 is the first field
+// after the segment name.
+type QRI struct {
+	// CandidateConfidence is QRI-1: Candidate Confidence (NM, optional, max length 10).
+	CandidateConfidence string `hl7:"QRI.1,maxlen=10"`
+
+	// MatchReasonCode is QRI-2: Match Reason Code (IS, optional, repeating, max length 2).
+	MatchReasonCode []string `hl7:"QRI.2,maxlen=2"`
+
+	// AlgorithmDescriptor is QRI-3: Algorithm Descriptor (CE, optional, max length 250).
+	AlgorithmDescriptor string `hl7:"QRI.3,maxlen=250"`
+}
+
+// ErrNotQRISegment indicates the segment is not a QRI segment.
+var ErrNotQRISegment = fmt.Errorf("segment is not QRI")
+
+// ParseQRI extracts QRI segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a QRI segment.
+func ParseQRI(seg hl7.Segment) (*QRI, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "QRI" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotQRISegment, seg.Name())
+	}
+
+	qri := &QRI{
+		CandidateConfidence: getFieldValue(seg, 1),
+		MatchReasonCode:     getFieldRepetitions(seg, 2),
+		AlgorithmDescriptor: getFieldValue(seg, 3),
+	}
+
+	return qri, nil
+}
+
+// ToSegment converts the QRI struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (q *QRI) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		q.CandidateConfidence,
+		joinRepetitions(q.MatchReasonCode, delims),
+		q.AlgorithmDescriptor,
+	}
+
+	data := buildSegmentData("QRI", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create QRI segment: %w", err)
+	}
+
+	return seg, nil
+}
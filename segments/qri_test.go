@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseQRI(t *testing.T) {
+	input := "QRI|QRI-1|QRI-2a~QRI-2b|QRI-3"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseQRI(seg)
+	if err != nil {
+		t.Fatalf("ParseQRI() unexpected error: %v", err)
+	}
+
+	want := QRI{
+		CandidateConfidence: "QRI-1",
+		MatchReasonCode:     []string{"QRI-2a", "QRI-2b"},
+		AlgorithmDescriptor: "QRI-3",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseQRI() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseQRI_WrongSegment(t *testing.T) {
+	if _, err := ParseQRI(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseQRI(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseQRI(seg); !errors.Is(err, ErrNotQRISegment) {
+		t.Errorf("ParseQRI() error = %v, want ErrNotQRISegment", err)
+	}
+}
+
+func TestQRI_RoundTrip(t *testing.T) {
+	original := &QRI{
+		CandidateConfidence: "QRI-1",
+		MatchReasonCode:     []string{"QRI-2a", "QRI-2b"},
+		AlgorithmDescriptor: "QRI-3",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "QRI" {
+		t.Errorf("segment name = %q, want QRI", seg.Name())
+	}
+
+	parsed, err := ParseQRI(seg)
+	if err != nil {
+		t.Fatalf("ParseQRI() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RDF represents the Table Row Definition segment.
+//
+// Field positions follow the HL7 standard where RDF-1 is the first field
+// after the segment name.
+type RDF struct {
+	// NumberOfColumnsPerRow is RDF-1: Number of Columns per Row (NM, required, max length 3).
+	NumberOfColumnsPerRow string `hl7:"RDF.1,required,maxlen=3"`
+
+	// ColumnDescription is RDF-2: Column Description (RCD, required, repeating, max length 40).
+	ColumnDescription []string `hl7:"RDF.2,required,maxlen=40"`
+}
+
+// ErrNotRDFSegment indicates the segment is not an RDF segment.
+var ErrNotRDFSegment = fmt.Errorf("segment is not RDF")
+
+// ParseRDF extracts RDF segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RDF segment.
+func ParseRDF(seg hl7.Segment) (*RDF, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RDF" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRDFSegment, seg.Name())
+	}
+
+	rdf := &RDF{
+		NumberOfColumnsPerRow: getFieldValue(seg, 1),
+		ColumnDescription:     getFieldRepetitions(seg, 2),
+	}
+
+	return rdf, nil
+}
+
+// ToSegment converts the RDF struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RDF) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.NumberOfColumnsPerRow,
+		joinRepetitions(r.ColumnDescription, delims),
+	}
+
+	data := buildSegmentData("RDF", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RDF segment: %w", err)
+	}
+
+	return seg, nil
+}
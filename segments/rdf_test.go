@@ -0,0 +1,70 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseRDF(t *testing.T) {
+	input := "RDF|RDF-1|RDF-2a~RDF-2b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseRDF(seg)
+	if err != nil {
+		t.Fatalf("ParseRDF() unexpected error: %v", err)
+	}
+
+	want := RDF{
+		NumberOfColumnsPerRow: "RDF-1",
+		ColumnDescription:     []string{"RDF-2a", "RDF-2b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseRDF() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseRDF_WrongSegment(t *testing.T) {
+	if _, err := ParseRDF(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseRDF(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseRDF(seg); !errors.Is(err, ErrNotRDFSegment) {
+		t.Errorf("ParseRDF() error = %v, want ErrNotRDFSegment", err)
+	}
+}
+
+func TestRDF_RoundTrip(t *testing.T) {
+	original := &RDF{
+		NumberOfColumnsPerRow: "RDF-1",
+		ColumnDescription:     []string{"RDF-2a", "RDF-2b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "RDF" {
+		t.Errorf("segment name = %q, want RDF", seg.Name())
+	}
+
+	parsed, err := ParseRDF(seg)
+	if err != nil {
+		t.Fatalf("ParseRDF() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
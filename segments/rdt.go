@@ -0,0 +1,61 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RDT represents the Table Row Data segment.
+//
+// Field positions follow the HL7 standard where RDT-1 is the first field
+// after the segment name.
+type RDT struct {
+	// ColumnValue is RDT-1: Column Value (VARIES, required, max length 99999).
+	ColumnValue string `hl7:"RDT.1,required,maxlen=99999"`
+}
+
+// ErrNotRDTSegment indicates the segment is not an RDT segment.
+var ErrNotRDTSegment = fmt.Errorf("segment is not RDT")
+
+// ParseRDT extracts RDT segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RDT segment.
+func ParseRDT(seg hl7.Segment) (*RDT, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RDT" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRDTSegment, seg.Name())
+	}
+
+	rdt := &RDT{
+		ColumnValue: getFieldValue(seg, 1),
+	}
+
+	return rdt, nil
+}
+
+// ToSegment converts the RDT struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RDT) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.ColumnValue,
+	}
+
+	data := buildSegmentData("RDT", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RDT segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,68 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseRDT(t *testing.T) {
+	input := "RDT|RDT-1"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseRDT(seg)
+	if err != nil {
+		t.Fatalf("ParseRDT() unexpected error: %v", err)
+	}
+
+	want := RDT{
+		ColumnValue: "RDT-1",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseRDT() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseRDT_WrongSegment(t *testing.T) {
+	if _, err := ParseRDT(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseRDT(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseRDT(seg); !errors.Is(err, ErrNotRDTSegment) {
+		t.Errorf("ParseRDT() error = %v, want ErrNotRDTSegment", err)
+	}
+}
+
+func TestRDT_RoundTrip(t *testing.T) {
+	original := &RDT{
+		ColumnValue: "RDT-1",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "RDT" {
+		t.Errorf("segment name = %q, want RDT", seg.Name())
+	}
+
+	parsed, err := ParseRDT(seg)
+	if err != nil {
+		t.Fatalf("ParseRDT() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseRF1(t *testing.T) {
+	input := "RF1|RF1-1|RF1-2|RF1-3|RF1-4a~RF1-4b|RF1-5|RF1-6|RF1-7|RF1-8|RF1-9|RF1-10a~RF1-10b|RF1-11a~RF1-11b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseRF1(seg)
+	if err != nil {
+		t.Fatalf("ParseRF1() unexpected error: %v", err)
+	}
+
+	want := RF1{
+		ReferralStatus:                "RF1-1",
+		ReferralPriority:              "RF1-2",
+		ReferralType:                  "RF1-3",
+		ReferralDisposition:           []string{"RF1-4a", "RF1-4b"},
+		ReferralCategory:              "RF1-5",
+		OriginatingReferralIdentifier: "RF1-6",
+		EffectiveDate:                 "RF1-7",
+		ExpirationDate:                "RF1-8",
+		ProcessDate:                   "RF1-9",
+		ReferralReason:                []string{"RF1-10a", "RF1-10b"},
+		ExternalReferralIdentifier:    []string{"RF1-11a", "RF1-11b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseRF1() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseRF1_WrongSegment(t *testing.T) {
+	if _, err := ParseRF1(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseRF1(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseRF1(seg); !errors.Is(err, ErrNotRF1Segment) {
+		t.Errorf("ParseRF1() error = %v, want ErrNotRF1Segment", err)
+	}
+}
+
+func TestRF1_RoundTrip(t *testing.T) {
+	original := &RF1{
+		ReferralStatus:                "RF1-1",
+		ReferralPriority:              "RF1-2",
+		ReferralType:                  "RF1-3",
+		ReferralDisposition:           []string{"RF1-4a", "RF1-4b"},
+		ReferralCategory:              "RF1-5",
+		OriginatingReferralIdentifier: "RF1-6",
+		EffectiveDate:                 "RF1-7",
+		ExpirationDate:                "RF1-8",
+		ProcessDate:                   "RF1-9",
+		ReferralReason:                []string{"RF1-10a", "RF1-10b"},
+		ExternalReferralIdentifier:    []string{"RF1-11a", "RF1-11b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "RF1" {
+		t.Errorf("segment name = %q, want RF1", seg.Name())
+	}
+
+	parsed, err := ParseRF1(seg)
+	if err != nil {
+		t.Fatalf("ParseRF1() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
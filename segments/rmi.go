@@ -0,0 +1,71 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RMI represents the Risk Management Incident segment.
+//
+// Field positions follow the HL7 standard where RMI-1 is the first field
+// after the segment name.
+type RMI struct {
+	// RiskManagementIncidentCode is RMI-1: Risk Management Incident Code (CE, optional, max length 250).
+	RiskManagementIncidentCode string `hl7:"RMI.1,maxlen=250"`
+
+	// DateTimeIncident is RMI-2: Date/Time Incident (TS, optional, max length 26).
+	DateTimeIncident string `hl7:"RMI.2,maxlen=26"`
+
+	// IncidentTypeCode is RMI-3: Incident Type Code (CE, optional, max length 250).
+	IncidentTypeCode string `hl7:"RMI.3,maxlen=250"`
+}
+
+// ErrNotRMISegment indicates the segment is not an RMI segment.
+var ErrNotRMISegment = fmt.Errorf("segment is not RMI")
+
+// ParseRMI extracts RMI segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RMI segment.
+func ParseRMI(seg hl7.Segment) (*RMI, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RMI" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRMISegment, seg.Name())
+	}
+
+	rmi := &RMI{
+		RiskManagementIncidentCode: getFieldValue(seg, 1),
+		DateTimeIncident:           getFieldValue(seg, 2),
+		IncidentTypeCode:           getFieldValue(seg, 3),
+	}
+
+	return rmi, nil
+}
+
+// ToSegment converts the RMI struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RMI) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.RiskManagementIncidentCode,
+		r.DateTimeIncident,
+		r.IncidentTypeCode,
+	}
+
+	data := buildSegmentData("RMI", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RMI segment: %w", err)
+	}
+
+	return seg, nil
+}
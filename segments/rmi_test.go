@@ -0,0 +1,72 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseRMI(t *testing.T) {
+	input := "RMI|RMI-1|RMI-2|RMI-3"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseRMI(seg)
+	if err != nil {
+		t.Fatalf("ParseRMI() unexpected error: %v", err)
+	}
+
+	want := RMI{
+		RiskManagementIncidentCode: "RMI-1",
+		DateTimeIncident:           "RMI-2",
+		IncidentTypeCode:           "RMI-3",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseRMI() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseRMI_WrongSegment(t *testing.T) {
+	if _, err := ParseRMI(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseRMI(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseRMI(seg); !errors.Is(err, ErrNotRMISegment) {
+		t.Errorf("ParseRMI() error = %v, want ErrNotRMISegment", err)
+	}
+}
+
+func TestRMI_RoundTrip(t *testing.T) {
+	original := &RMI{
+		RiskManagementIncidentCode: "RMI-1",
+		DateTimeIncident:           "RMI-2",
+		IncidentTypeCode:           "RMI-3",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "RMI" {
+		t.Errorf("segment name = %q, want RMI", seg.Name())
+	}
+
+	parsed, err := ParseRMI(seg)
+	if err != nil {
+		t.Fatalf("ParseRMI() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RQ1 represents the Requisition Detail-1 segment.
+//
+// Field positions follow the HL7 standard where RQ1-1 is the first field
+// after the segment name.
+type RQ1 struct {
+	// AnticipatedPrice is RQ1-1: Anticipated Price (ST, optional, max length 10).
+	AnticipatedPrice string `hl7:"RQ1.1,maxlen=10"`
+
+	// ManufacturerIdentifier is RQ1-2: Manufacturer Identifier (CE, conditional, max length 250).
+	ManufacturerIdentifier string `hl7:"RQ1.2,maxlen=250"`
+
+	// ManufacturersCatalog is RQ1-3: Manufacturer's Catalog (ST, conditional, max length 16).
+	ManufacturersCatalog string `hl7:"RQ1.3,maxlen=16"`
+
+	// VendorID is RQ1-4: Vendor ID (CE, conditional, max length 250).
+	VendorID string `hl7:"RQ1.4,maxlen=250"`
+
+	// VendorCatalog is RQ1-5: Vendor Catalog (ST, conditional, max length 16).
+	VendorCatalog string `hl7:"RQ1.5,maxlen=16"`
+
+	// Taxable is RQ1-6: Taxable (ID, optional, max length 1).
+	Taxable string `hl7:"RQ1.6,maxlen=1"`
+
+	// SubstituteAllowed is RQ1-7: Substitute Allowed (ID, optional, max length 1).
+	SubstituteAllowed string `hl7:"RQ1.7,maxlen=1"`
+}
+
+// ErrNotRQ1Segment indicates the segment is not an RQ1 segment.
+var ErrNotRQ1Segment = fmt.Errorf("segment is not RQ1")
+
+// ParseRQ1 extracts RQ1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RQ1 segment.
+func ParseRQ1(seg hl7.Segment) (*RQ1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RQ1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRQ1Segment, seg.Name())
+	}
+
+	rq1 := &RQ1{
+		AnticipatedPrice:       getFieldValue(seg, 1),
+		ManufacturerIdentifier: getFieldValue(seg, 2),
+		ManufacturersCatalog:   getFieldValue(seg, 3),
+		VendorID:               getFieldValue(seg, 4),
+		VendorCatalog:          getFieldValue(seg, 5),
+		Taxable:                getFieldValue(seg, 6),
+		SubstituteAllowed:      getFieldValue(seg, 7),
+	}
+
+	return rq1, nil
+}
+
+// ToSegment converts the RQ1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RQ1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.AnticipatedPrice,
+		r.ManufacturerIdentifier,
+		r.ManufacturersCatalog,
+		r.VendorID,
+		r.VendorCatalog,
+		r.Taxable,
+		r.SubstituteAllowed,
+	}
+
+	data := buildSegmentData("RQ1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RQ1 segment: %w", err)
+	}
+
+	return seg, nil
+}
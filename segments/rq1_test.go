@@ -0,0 +1,80 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseRQ1(t *testing.T) {
+	input := "RQ1|RQ1-1|RQ1-2|RQ1-3|RQ1-4|RQ1-5|RQ1-6|RQ1-7"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseRQ1(seg)
+	if err != nil {
+		t.Fatalf("ParseRQ1() unexpected error: %v", err)
+	}
+
+	want := RQ1{
+		AnticipatedPrice:       "RQ1-1",
+		ManufacturerIdentifier: "RQ1-2",
+		ManufacturersCatalog:   "RQ1-3",
+		VendorID:               "RQ1-4",
+		VendorCatalog:          "RQ1-5",
+		Taxable:                "RQ1-6",
+		SubstituteAllowed:      "RQ1-7",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseRQ1() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseRQ1_WrongSegment(t *testing.T) {
+	if _, err := ParseRQ1(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseRQ1(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseRQ1(seg); !errors.Is(err, ErrNotRQ1Segment) {
+		t.Errorf("ParseRQ1() error = %v, want ErrNotRQ1Segment", err)
+	}
+}
+
+func TestRQ1_RoundTrip(t *testing.T) {
+	original := &RQ1{
+		AnticipatedPrice:       "RQ1-1",
+		ManufacturerIdentifier: "RQ1-2",
+		ManufacturersCatalog:   "RQ1-3",
+		VendorID:               "RQ1-4",
+		VendorCatalog:          "RQ1-5",
+		Taxable:                "RQ1-6",
+		SubstituteAllowed:      "RQ1-7",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "RQ1" {
+		t.Errorf("segment name = %q, want RQ1", seg.Name())
+	}
+
+	parsed, err := ParseRQ1(seg)
+	if err != nil {
+		t.Fatalf("ParseRQ1() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
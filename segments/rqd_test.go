@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseRQD(t *testing.T) {
+	input := "RQD|RQD-1|RQD-2|RQD-3|RQD-4|RQD-5|RQD-6|RQD-7|RQD-8|RQD-9|RQD-10"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseRQD(seg)
+	if err != nil {
+		t.Fatalf("ParseRQD() unexpected error: %v", err)
+	}
+
+	want := RQD{
+		RequisitionLineNumber:    "RQD-1",
+		ItemCodeInternal:         "RQD-2",
+		ItemCodeExternal:         "RQD-3",
+		HospitalItemCode:         "RQD-4",
+		RequisitionQuantity:      "RQD-5",
+		RequisitionUnitOfMeasure: "RQD-6",
+		DeptCostCenter:           "RQD-7",
+		ItemNaturalAccountCode:   "RQD-8",
+		DeliverToID:              "RQD-9",
+		DateNeeded:               "RQD-10",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseRQD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseRQD_WrongSegment(t *testing.T) {
+	if _, err := ParseRQD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseRQD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseRQD(seg); !errors.Is(err, ErrNotRQDSegment) {
+		t.Errorf("ParseRQD() error = %v, want ErrNotRQDSegment", err)
+	}
+}
+
+func TestRQD_RoundTrip(t *testing.T) {
+	original := &RQD{
+		RequisitionLineNumber:    "RQD-1",
+		ItemCodeInternal:         "RQD-2",
+		ItemCodeExternal:         "RQD-3",
+		HospitalItemCode:         "RQD-4",
+		RequisitionQuantity:      "RQD-5",
+		RequisitionUnitOfMeasure: "RQD-6",
+		DeptCostCenter:           "RQD-7",
+		ItemNaturalAccountCode:   "RQD-8",
+		DeliverToID:              "RQD-9",
+		DateNeeded:               "RQD-10",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "RQD" {
+		t.Errorf("segment name = %q, want RQD", seg.Name())
+	}
+
+	parsed, err := ParseRQD(seg)
+	if err != nil {
+		t.Fatalf("ParseRQD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
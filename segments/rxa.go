@@ -0,0 +1,186 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RXA represents the Pharmacy/Treatment Administration segment.
+//
+// Field positions follow the HL7 standard where RXA-1 is the first field
+// after the segment name.
+type RXA struct {
+	// GiveSubIDCounter is RXA-1: Give Sub-ID Counter (NM, required, max length 4).
+	GiveSubIDCounter string `hl7:"RXA.1,required,maxlen=4"`
+
+	// AdministrationSubIDCounter is RXA-2: Administration Sub-ID Counter (NM, required, max length 4).
+	AdministrationSubIDCounter string `hl7:"RXA.2,required,maxlen=4"`
+
+	// StartOfAdministration is RXA-3: Date/Time Start of Administration (TS, required, max length 26).
+	StartOfAdministration string `hl7:"RXA.3,required,maxlen=26"`
+
+	// EndOfAdministration is RXA-4: Date/Time End of Administration (TS, required, max length 26).
+	EndOfAdministration string `hl7:"RXA.4,required,maxlen=26"`
+
+	// AdministeredCode is RXA-5: Administered Code (CE, required, max length 250).
+	AdministeredCode string `hl7:"RXA.5,required,maxlen=250"`
+
+	// AdministeredAmount is RXA-6: Administered Amount (NM, required, max length 20).
+	AdministeredAmount string `hl7:"RXA.6,required,maxlen=20"`
+
+	// AdministeredUnits is RXA-7: Administered Units (CE, conditional, max length 250).
+	AdministeredUnits string `hl7:"RXA.7,maxlen=250"`
+
+	// AdministeredDosageForm is RXA-8: Administered Dosage Form (CE, optional, max length 250).
+	AdministeredDosageForm string `hl7:"RXA.8,maxlen=250"`
+
+	// AdministrationNotes is RXA-9: Administration Notes (CE, optional, repeating, max length 250).
+	AdministrationNotes []string `hl7:"RXA.9,maxlen=250"`
+
+	// AdministeringProvider is RXA-10: Administering Provider (XCN, optional, repeating, max length 250).
+	AdministeringProvider []string `hl7:"RXA.10,maxlen=250"`
+
+	// AdministeredAtLocation is RXA-11: Administered-at Location (LA2, conditional, max length 200).
+	AdministeredAtLocation string `hl7:"RXA.11,maxlen=200"`
+
+	// AdministeredPerTimeUnit is RXA-12: Administered Per (Time Unit) (ST, conditional, max length 20).
+	AdministeredPerTimeUnit string `hl7:"RXA.12,maxlen=20"`
+
+	// AdministeredStrength is RXA-13: Administered Strength (NM, optional, max length 20).
+	AdministeredStrength string `hl7:"RXA.13,maxlen=20"`
+
+	// AdministeredStrengthUnits is RXA-14: Administered Strength Units (CE, optional, max length 250).
+	AdministeredStrengthUnits string `hl7:"RXA.14,maxlen=250"`
+
+	// SubstanceLotNumber is RXA-15: Substance Lot Number (ST, optional, repeating, max length 20).
+	SubstanceLotNumber []string `hl7:"RXA.15,maxlen=20"`
+
+	// SubstanceExpirationDate is RXA-16: Substance Expiration Date (TS, optional, repeating, max length 26).
+	SubstanceExpirationDate []string `hl7:"RXA.16,maxlen=26"`
+
+	// SubstanceManufacturerName is RXA-17: Substance Manufacturer Name (CE, optional, repeating, max length 250).
+	SubstanceManufacturerName []string `hl7:"RXA.17,maxlen=250"`
+
+	// SubstanceRefusalReason is RXA-18: Substance/Treatment Refusal Reason (CE, optional, repeating, max length 250).
+	SubstanceRefusalReason []string `hl7:"RXA.18,maxlen=250"`
+
+	// Indication is RXA-19: Indication (CE, optional, repeating, max length 250).
+	Indication []string `hl7:"RXA.19,maxlen=250"`
+
+	// CompletionStatus is RXA-20: Completion Status (ID, optional, max length 2).
+	CompletionStatus string `hl7:"RXA.20,maxlen=2"`
+
+	// ActionCode is RXA-21: Action Code - RXA (ID, optional, max length 2).
+	ActionCode string `hl7:"RXA.21,maxlen=2"`
+
+	// SystemEntryDateTime is RXA-22: System Entry Date/Time (TS, optional, max length 26).
+	SystemEntryDateTime string `hl7:"RXA.22,maxlen=26"`
+
+	// AdministeredDrugStrengthVolume is RXA-23: Administered Drug Strength Volume (NM, optional, max length 5).
+	AdministeredDrugStrengthVolume string `hl7:"RXA.23,maxlen=5"`
+
+	// AdministeredDrugStrengthVolumeUnits is RXA-24: Administered Drug Strength Volume Units (CWE, optional, max length 250).
+	AdministeredDrugStrengthVolumeUnits string `hl7:"RXA.24,maxlen=250"`
+
+	// AdministeredBarcodeIdentifier is RXA-25: Administered Barcode Identifier (CWE, optional, max length 60).
+	AdministeredBarcodeIdentifier string `hl7:"RXA.25,maxlen=60"`
+
+	// PharmacyOrderType is RXA-26: Pharmacy Order Type (ID, optional, max length 1).
+	PharmacyOrderType string `hl7:"RXA.26,maxlen=1"`
+}
+
+// ErrNotRXASegment indicates the segment is not an RXA segment.
+var ErrNotRXASegment = fmt.Errorf("segment is not RXA")
+
+// ParseRXA extracts RXA segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RXA segment.
+func ParseRXA(seg hl7.Segment) (*RXA, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RXA" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRXASegment, seg.Name())
+	}
+
+	rxa := &RXA{
+		GiveSubIDCounter:                    getFieldValue(seg, 1),
+		AdministrationSubIDCounter:          getFieldValue(seg, 2),
+		StartOfAdministration:               getFieldValue(seg, 3),
+		EndOfAdministration:                 getFieldValue(seg, 4),
+		AdministeredCode:                    getFieldValue(seg, 5),
+		AdministeredAmount:                  getFieldValue(seg, 6),
+		AdministeredUnits:                   getFieldValue(seg, 7),
+		AdministeredDosageForm:              getFieldValue(seg, 8),
+		AdministrationNotes:                 getFieldRepetitions(seg, 9),
+		AdministeringProvider:               getFieldRepetitions(seg, 10),
+		AdministeredAtLocation:              getFieldValue(seg, 11),
+		AdministeredPerTimeUnit:             getFieldValue(seg, 12),
+		AdministeredStrength:                getFieldValue(seg, 13),
+		AdministeredStrengthUnits:           getFieldValue(seg, 14),
+		SubstanceLotNumber:                  getFieldRepetitions(seg, 15),
+		SubstanceExpirationDate:             getFieldRepetitions(seg, 16),
+		SubstanceManufacturerName:           getFieldRepetitions(seg, 17),
+		SubstanceRefusalReason:              getFieldRepetitions(seg, 18),
+		Indication:                          getFieldRepetitions(seg, 19),
+		CompletionStatus:                    getFieldValue(seg, 20),
+		ActionCode:                          getFieldValue(seg, 21),
+		SystemEntryDateTime:                 getFieldValue(seg, 22),
+		AdministeredDrugStrengthVolume:      getFieldValue(seg, 23),
+		AdministeredDrugStrengthVolumeUnits: getFieldValue(seg, 24),
+		AdministeredBarcodeIdentifier:       getFieldValue(seg, 25),
+		PharmacyOrderType:                   getFieldValue(seg, 26),
+	}
+
+	return rxa, nil
+}
+
+// ToSegment converts the RXA struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RXA) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.GiveSubIDCounter,
+		r.AdministrationSubIDCounter,
+		r.StartOfAdministration,
+		r.EndOfAdministration,
+		r.AdministeredCode,
+		r.AdministeredAmount,
+		r.AdministeredUnits,
+		r.AdministeredDosageForm,
+		joinRepetitions(r.AdministrationNotes, delims),
+		joinRepetitions(r.AdministeringProvider, delims),
+		r.AdministeredAtLocation,
+		r.AdministeredPerTimeUnit,
+		r.AdministeredStrength,
+		r.AdministeredStrengthUnits,
+		joinRepetitions(r.SubstanceLotNumber, delims),
+		joinRepetitions(r.SubstanceExpirationDate, delims),
+		joinRepetitions(r.SubstanceManufacturerName, delims),
+		joinRepetitions(r.SubstanceRefusalReason, delims),
+		joinRepetitions(r.Indication, delims),
+		r.CompletionStatus,
+		r.ActionCode,
+		r.SystemEntryDateTime,
+		r.AdministeredDrugStrengthVolume,
+		r.AdministeredDrugStrengthVolumeUnits,
+		r.AdministeredBarcodeIdentifier,
+		r.PharmacyOrderType,
+	}
+
+	data := buildSegmentData("RXA", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RXA segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,84 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseRXC(t *testing.T) {
+	input := "RXC|RXC-1|RXC-2|RXC-3|RXC-4|RXC-5|RXC-6|RXC-7a~RXC-7b|RXC-8|RXC-9"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseRXC(seg)
+	if err != nil {
+		t.Fatalf("ParseRXC() unexpected error: %v", err)
+	}
+
+	want := RXC{
+		RXComponentType:                  "RXC-1",
+		ComponentCode:                    "RXC-2",
+		ComponentAmount:                  "RXC-3",
+		ComponentUnits:                   "RXC-4",
+		ComponentStrength:                "RXC-5",
+		ComponentStrengthUnits:           "RXC-6",
+		SupplementaryCode:                []string{"RXC-7a", "RXC-7b"},
+		ComponentDrugStrengthVolume:      "RXC-8",
+		ComponentDrugStrengthVolumeUnits: "RXC-9",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseRXC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseRXC_WrongSegment(t *testing.T) {
+	if _, err := ParseRXC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseRXC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseRXC(seg); !errors.Is(err, ErrNotRXCSegment) {
+		t.Errorf("ParseRXC() error = %v, want ErrNotRXCSegment", err)
+	}
+}
+
+func TestRXC_RoundTrip(t *testing.T) {
+	original := &RXC{
+		RXComponentType:                  "RXC-1",
+		ComponentCode:                    "RXC-2",
+		ComponentAmount:                  "RXC-3",
+		ComponentUnits:                   "RXC-4",
+		ComponentStrength:                "RXC-5",
+		ComponentStrengthUnits:           "RXC-6",
+		SupplementaryCode:                []string{"RXC-7a", "RXC-7b"},
+		ComponentDrugStrengthVolume:      "RXC-8",
+		ComponentDrugStrengthVolumeUnits: "RXC-9",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "RXC" {
+		t.Errorf("segment name = %q, want RXC", seg.Name())
+	}
+
+	parsed, err := ParseRXC(seg)
+	if err != nil {
+		t.Fatalf("ParseRXC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
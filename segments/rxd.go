@@ -0,0 +1,221 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RXD represents the Pharmacy/Treatment Dispense segment.
+//
+// Field positions follow the HL7 standard where RXD-1 is the first field
+// after the segment name.
+type RXD struct {
+	// DispenseSubIDCounter is RXD-1: Dispense Sub-ID Counter (NM, required, max length 4).
+	DispenseSubIDCounter string `hl7:"RXD.1,required,maxlen=4"`
+
+	// DispenseGiveCode is RXD-2: Dispense/Give Code (CE, required, max length 250).
+	DispenseGiveCode string `hl7:"RXD.2,required,maxlen=250"`
+
+	// DateTimeDispensed is RXD-3: Date/Time Dispensed (TS, required, max length 26).
+	DateTimeDispensed string `hl7:"RXD.3,required,maxlen=26"`
+
+	// ActualDispenseAmount is RXD-4: Actual Dispense Amount (NM, required, max length 20).
+	ActualDispenseAmount string `hl7:"RXD.4,required,maxlen=20"`
+
+	// ActualDispenseUnits is RXD-5: Actual Dispense Units (CE, conditional, max length 250).
+	ActualDispenseUnits string `hl7:"RXD.5,maxlen=250"`
+
+	// ActualDosageForm is RXD-6: Actual Dosage Form (CE, optional, max length 250).
+	ActualDosageForm string `hl7:"RXD.6,maxlen=250"`
+
+	// PrescriptionNumber is RXD-7: Prescription Number (ST, required, max length 20).
+	PrescriptionNumber string `hl7:"RXD.7,required,maxlen=20"`
+
+	// NumberOfRefillsRemaining is RXD-8: Number of Refills Remaining (NM, conditional, max length 20).
+	NumberOfRefillsRemaining string `hl7:"RXD.8,maxlen=20"`
+
+	// DispenseNotes is RXD-9: Dispense Notes (ST, optional, repeating, max length 200).
+	DispenseNotes []string `hl7:"RXD.9,maxlen=200"`
+
+	// DispensingProvider is RXD-10: Dispensing Provider (XCN, optional, repeating, max length 200).
+	DispensingProvider []string `hl7:"RXD.10,maxlen=200"`
+
+	// SubstitutionStatus is RXD-11: Substitution Status (ID, optional, max length 1).
+	SubstitutionStatus string `hl7:"RXD.11,maxlen=1"`
+
+	// TotalDailyDose is RXD-12: Total Daily Dose (CQ, optional, max length 10).
+	TotalDailyDose string `hl7:"RXD.12,maxlen=10"`
+
+	// DispenseToLocation is RXD-13: Dispense-to Location (LA2, conditional, max length 200).
+	DispenseToLocation string `hl7:"RXD.13,maxlen=200"`
+
+	// NeedsHumanReview is RXD-14: Needs Human Review (ID, optional, max length 1).
+	NeedsHumanReview string `hl7:"RXD.14,maxlen=1"`
+
+	// PharmacyTreatmentSuppliersSpecialDispensingInstructions is RXD-15: Pharmacy/Treatment Supplier's Special Dispensing Instructions (CE, optional, repeating, max length 250).
+	PharmacyTreatmentSuppliersSpecialDispensingInstructions []string `hl7:"RXD.15,maxlen=250"`
+
+	// ActualStrength is RXD-16: Actual Strength (NM, optional, max length 20).
+	ActualStrength string `hl7:"RXD.16,maxlen=20"`
+
+	// ActualStrengthUnit is RXD-17: Actual Strength Unit (CE, optional, max length 250).
+	ActualStrengthUnit string `hl7:"RXD.17,maxlen=250"`
+
+	// SubstanceLotNumber is RXD-18: Substance Lot Number (ST, optional, repeating, max length 20).
+	SubstanceLotNumber []string `hl7:"RXD.18,maxlen=20"`
+
+	// SubstanceExpirationDate is RXD-19: Substance Expiration Date (TS, optional, repeating, max length 26).
+	SubstanceExpirationDate []string `hl7:"RXD.19,maxlen=26"`
+
+	// SubstanceManufacturerName is RXD-20: Substance Manufacturer Name (CE, optional, repeating, max length 250).
+	SubstanceManufacturerName []string `hl7:"RXD.20,maxlen=250"`
+
+	// Indication is RXD-21: Indication (CE, optional, repeating, max length 250).
+	Indication []string `hl7:"RXD.21,maxlen=250"`
+
+	// DispensePackageSize is RXD-22: Dispense Package Size (NM, optional, max length 20).
+	DispensePackageSize string `hl7:"RXD.22,maxlen=20"`
+
+	// DispensePackageSizeUnit is RXD-23: Dispense Package Size Unit (CE, optional, max length 250).
+	DispensePackageSizeUnit string `hl7:"RXD.23,maxlen=250"`
+
+	// DispensePackageMethod is RXD-24: Dispense Package Method (ID, optional, max length 2).
+	DispensePackageMethod string `hl7:"RXD.24,maxlen=2"`
+
+	// SupplementaryCode is RXD-25: Supplementary Code (CE, optional, repeating, max length 250).
+	SupplementaryCode []string `hl7:"RXD.25,maxlen=250"`
+
+	// InitiatingLocation is RXD-26: Initiating Location (CE, optional, max length 250).
+	InitiatingLocation string `hl7:"RXD.26,maxlen=250"`
+
+	// PackagingAssemblyLocation is RXD-27: Packaging/Assembly Location (CE, optional, max length 250).
+	PackagingAssemblyLocation string `hl7:"RXD.27,maxlen=250"`
+
+	// ActualDrugStrengthVolume is RXD-28: Actual Drug Strength Volume (NM, optional, max length 5).
+	ActualDrugStrengthVolume string `hl7:"RXD.28,maxlen=5"`
+
+	// ActualDrugStrengthVolumeUnits is RXD-29: Actual Drug Strength Volume Units (CWE, optional, max length 250).
+	ActualDrugStrengthVolumeUnits string `hl7:"RXD.29,maxlen=250"`
+
+	// DispenseToPharmacy is RXD-30: Dispense to Pharmacy (CWE, optional, max length 180).
+	DispenseToPharmacy string `hl7:"RXD.30,maxlen=180"`
+
+	// DispenseToPharmacyAddress is RXD-31: Dispense to Pharmacy Address (XAD, optional, max length 106).
+	DispenseToPharmacyAddress string `hl7:"RXD.31,maxlen=106"`
+
+	// PharmacyOrderType is RXD-32: Pharmacy Order Type (ID, optional, max length 1).
+	PharmacyOrderType string `hl7:"RXD.32,maxlen=1"`
+
+	// DispenseType is RXD-33: Dispense Type (CWE, optional, max length 250).
+	DispenseType string `hl7:"RXD.33,maxlen=250"`
+}
+
+// ErrNotRXDSegment indicates the segment is not an RXD segment.
+var ErrNotRXDSegment = fmt.Errorf("segment is not RXD")
+
+// ParseRXD extracts RXD segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RXD segment.
+func ParseRXD(seg hl7.Segment) (*RXD, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RXD" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRXDSegment, seg.Name())
+	}
+
+	rxd := &RXD{
+		DispenseSubIDCounter:     getFieldValue(seg, 1),
+		DispenseGiveCode:         getFieldValue(seg, 2),
+		DateTimeDispensed:        getFieldValue(seg, 3),
+		ActualDispenseAmount:     getFieldValue(seg, 4),
+		ActualDispenseUnits:      getFieldValue(seg, 5),
+		ActualDosageForm:         getFieldValue(seg, 6),
+		PrescriptionNumber:       getFieldValue(seg, 7),
+		NumberOfRefillsRemaining: getFieldValue(seg, 8),
+		DispenseNotes:            getFieldRepetitions(seg, 9),
+		DispensingProvider:       getFieldRepetitions(seg, 10),
+		SubstitutionStatus:       getFieldValue(seg, 11),
+		TotalDailyDose:           getFieldValue(seg, 12),
+		DispenseToLocation:       getFieldValue(seg, 13),
+		NeedsHumanReview:         getFieldValue(seg, 14),
+		PharmacyTreatmentSuppliersSpecialDispensingInstructions: getFieldRepetitions(seg, 15),
+		ActualStrength:                getFieldValue(seg, 16),
+		ActualStrengthUnit:            getFieldValue(seg, 17),
+		SubstanceLotNumber:            getFieldRepetitions(seg, 18),
+		SubstanceExpirationDate:       getFieldRepetitions(seg, 19),
+		SubstanceManufacturerName:     getFieldRepetitions(seg, 20),
+		Indication:                    getFieldRepetitions(seg, 21),
+		DispensePackageSize:           getFieldValue(seg, 22),
+		DispensePackageSizeUnit:       getFieldValue(seg, 23),
+		DispensePackageMethod:         getFieldValue(seg, 24),
+		SupplementaryCode:             getFieldRepetitions(seg, 25),
+		InitiatingLocation:            getFieldValue(seg, 26),
+		PackagingAssemblyLocation:     getFieldValue(seg, 27),
+		ActualDrugStrengthVolume:      getFieldValue(seg, 28),
+		ActualDrugStrengthVolumeUnits: getFieldValue(seg, 29),
+		DispenseToPharmacy:            getFieldValue(seg, 30),
+		DispenseToPharmacyAddress:     getFieldValue(seg, 31),
+		PharmacyOrderType:             getFieldValue(seg, 32),
+		DispenseType:                  getFieldValue(seg, 33),
+	}
+
+	return rxd, nil
+}
+
+// ToSegment converts the RXD struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RXD) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.DispenseSubIDCounter,
+		r.DispenseGiveCode,
+		r.DateTimeDispensed,
+		r.ActualDispenseAmount,
+		r.ActualDispenseUnits,
+		r.ActualDosageForm,
+		r.PrescriptionNumber,
+		r.NumberOfRefillsRemaining,
+		joinRepetitions(r.DispenseNotes, delims),
+		joinRepetitions(r.DispensingProvider, delims),
+		r.SubstitutionStatus,
+		r.TotalDailyDose,
+		r.DispenseToLocation,
+		r.NeedsHumanReview,
+		joinRepetitions(r.PharmacyTreatmentSuppliersSpecialDispensingInstructions, delims),
+		r.ActualStrength,
+		r.ActualStrengthUnit,
+		joinRepetitions(r.SubstanceLotNumber, delims),
+		joinRepetitions(r.SubstanceExpirationDate, delims),
+		joinRepetitions(r.SubstanceManufacturerName, delims),
+		joinRepetitions(r.Indication, delims),
+		r.DispensePackageSize,
+		r.DispensePackageSizeUnit,
+		r.DispensePackageMethod,
+		joinRepetitions(r.SupplementaryCode, delims),
+		r.InitiatingLocation,
+		r.PackagingAssemblyLocation,
+		r.ActualDrugStrengthVolume,
+		r.ActualDrugStrengthVolumeUnits,
+		r.DispenseToPharmacy,
+		r.DispenseToPharmacyAddress,
+		r.PharmacyOrderType,
+		r.DispenseType,
+	}
+
+	data := buildSegmentData("RXD", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RXD segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,276 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RXE represents the Pharmacy/Treatment Encoded Order segment.
+//
+// Field positions follow the HL7 standard where RXE-1 is the first field
+// after the segment name.
+type RXE struct {
+	// QuantityTiming is RXE-1: Quantity/Timing (TQ, backward compatibility, max length 200).
+	QuantityTiming string `hl7:"RXE.1,maxlen=200"`
+
+	// GiveCode is RXE-2: Give Code (CE, required, max length 250).
+	GiveCode string `hl7:"RXE.2,required,maxlen=250"`
+
+	// GiveAmountMinimum is RXE-3: Give Amount - Minimum (NM, required, max length 20).
+	GiveAmountMinimum string `hl7:"RXE.3,required,maxlen=20"`
+
+	// GiveAmountMaximum is RXE-4: Give Amount - Maximum (NM, optional, max length 20).
+	GiveAmountMaximum string `hl7:"RXE.4,maxlen=20"`
+
+	// GiveUnits is RXE-5: Give Units (CE, required, max length 250).
+	GiveUnits string `hl7:"RXE.5,required,maxlen=250"`
+
+	// GiveDosageForm is RXE-6: Give Dosage Form (CE, optional, max length 250).
+	GiveDosageForm string `hl7:"RXE.6,maxlen=250"`
+
+	// ProviderAdministrationInstructions is RXE-7: Provider's Administration Instructions (CE, optional, repeating, max length 250).
+	ProviderAdministrationInstructions []string `hl7:"RXE.7,maxlen=250"`
+
+	// DeliverToLocation is RXE-8: Deliver-To Location (LA1, backward compatibility, max length 200).
+	DeliverToLocation string `hl7:"RXE.8,maxlen=200"`
+
+	// SubstitutionStatus is RXE-9: Substitution Status (ID, optional, max length 1).
+	SubstitutionStatus string `hl7:"RXE.9,maxlen=1"`
+
+	// DispenseAmount is RXE-10: Dispense Amount (NM, conditional, max length 20).
+	DispenseAmount string `hl7:"RXE.10,maxlen=20"`
+
+	// DispenseUnits is RXE-11: Dispense Units (CE, conditional, max length 250).
+	DispenseUnits string `hl7:"RXE.11,maxlen=250"`
+
+	// NumberOfRefills is RXE-12: Number Of Refills (NM, optional, max length 3).
+	NumberOfRefills string `hl7:"RXE.12,maxlen=3"`
+
+	// OrderingProviderDEANumber is RXE-13: Ordering Provider's DEA Number (XCN, conditional, repeating, max length 250).
+	OrderingProviderDEANumber []string `hl7:"RXE.13,maxlen=250"`
+
+	// PharmacistVerifierID is RXE-14: Pharmacist/Treatment Supplier's Verifier ID (XCN, optional, repeating, max length 250).
+	PharmacistVerifierID []string `hl7:"RXE.14,maxlen=250"`
+
+	// PrescriptionNumber is RXE-15: Prescription Number (ST, conditional, max length 20).
+	PrescriptionNumber string `hl7:"RXE.15,maxlen=20"`
+
+	// NumberOfRefillsRemaining is RXE-16: Number of Refills Remaining (NM, conditional, max length 20).
+	NumberOfRefillsRemaining string `hl7:"RXE.16,maxlen=20"`
+
+	// NumberOfRefillsDosesDispensed is RXE-17: Number of Refills/Doses Dispensed (NM, conditional, max length 20).
+	NumberOfRefillsDosesDispensed string `hl7:"RXE.17,maxlen=20"`
+
+	// MostRecentRefillDateTime is RXE-18: D/T of Most Recent Refill or Dose Dispensed (TS, conditional, max length 26).
+	MostRecentRefillDateTime string `hl7:"RXE.18,maxlen=26"`
+
+	// TotalDailyDose is RXE-19: Total Daily Dose (CQ, conditional, max length 10).
+	TotalDailyDose string `hl7:"RXE.19,maxlen=10"`
+
+	// NeedsHumanReview is RXE-20: Needs Human Review (ID, optional, max length 1).
+	NeedsHumanReview string `hl7:"RXE.20,maxlen=1"`
+
+	// SpecialDispensingInstructions is RXE-21: Pharmacy/Treatment Supplier's Special Dispensing Instructions (CE, optional, repeating, max length 250).
+	SpecialDispensingInstructions []string `hl7:"RXE.21,maxlen=250"`
+
+	// GivePerTimeUnit is RXE-22: Give Per (Time Unit) (ST, conditional, max length 20).
+	GivePerTimeUnit string `hl7:"RXE.22,maxlen=20"`
+
+	// GiveRateAmount is RXE-23: Give Rate Amount (ST, optional, max length 6).
+	GiveRateAmount string `hl7:"RXE.23,maxlen=6"`
+
+	// GiveRateUnits is RXE-24: Give Rate Units (CE, optional, max length 250).
+	GiveRateUnits string `hl7:"RXE.24,maxlen=250"`
+
+	// GiveStrength is RXE-25: Give Strength (NM, optional, max length 20).
+	GiveStrength string `hl7:"RXE.25,maxlen=20"`
+
+	// GiveStrengthUnits is RXE-26: Give Strength Units (CE, optional, max length 250).
+	GiveStrengthUnits string `hl7:"RXE.26,maxlen=250"`
+
+	// GiveIndication is RXE-27: Give Indication (CE, optional, repeating, max length 250).
+	GiveIndication []string `hl7:"RXE.27,maxlen=250"`
+
+	// DispensePackageSize is RXE-28: Dispense Package Size (NM, optional, max length 20).
+	DispensePackageSize string `hl7:"RXE.28,maxlen=20"`
+
+	// DispensePackageSizeUnit is RXE-29: Dispense Package Size Unit (CE, optional, max length 250).
+	DispensePackageSizeUnit string `hl7:"RXE.29,maxlen=250"`
+
+	// DispensePackageMethod is RXE-30: Dispense Package Method (ID, optional, max length 2).
+	DispensePackageMethod string `hl7:"RXE.30,maxlen=2"`
+
+	// SupplementaryCode is RXE-31: Supplementary Code (CE, optional, repeating, max length 250).
+	SupplementaryCode []string `hl7:"RXE.31,maxlen=250"`
+
+	// OriginalOrderDateTime is RXE-32: Original Order Date/Time (TS, optional, max length 26).
+	OriginalOrderDateTime string `hl7:"RXE.32,maxlen=26"`
+
+	// GiveDrugStrengthVolume is RXE-33: Give Drug Strength Volume (NM, optional, max length 5).
+	GiveDrugStrengthVolume string `hl7:"RXE.33,maxlen=5"`
+
+	// GiveDrugStrengthVolumeUnits is RXE-34: Give Drug Strength Volume Units (CWE, optional, max length 250).
+	GiveDrugStrengthVolumeUnits string `hl7:"RXE.34,maxlen=250"`
+
+	// ControlledSubstanceSchedule is RXE-35: Controlled Substance Schedule (CWE, optional, max length 60).
+	ControlledSubstanceSchedule string `hl7:"RXE.35,maxlen=60"`
+
+	// FormularyStatus is RXE-36: Formulary Status (ID, optional, max length 1).
+	FormularyStatus string `hl7:"RXE.36,maxlen=1"`
+
+	// PharmaceuticalSubstanceAlternative is RXE-37: Pharmaceutical Substance Alternative (CWE, optional, repeating, max length 60).
+	PharmaceuticalSubstanceAlternative []string `hl7:"RXE.37,maxlen=60"`
+
+	// PharmacyOfMostRecentFill is RXE-38: Pharmacy of Most Recent Fill (CWE, optional, max length 250).
+	PharmacyOfMostRecentFill string `hl7:"RXE.38,maxlen=250"`
+
+	// InitialDispenseAmount is RXE-39: Initial Dispense Amount (NM, optional, max length 250).
+	InitialDispenseAmount string `hl7:"RXE.39,maxlen=250"`
+
+	// DispensingPharmacy is RXE-40: Dispensing Pharmacy (CWE, optional, max length 250).
+	DispensingPharmacy string `hl7:"RXE.40,maxlen=250"`
+
+	// DispensingPharmacyAddress is RXE-41: Dispensing Pharmacy Address (XAD, optional, max length 250).
+	DispensingPharmacyAddress string `hl7:"RXE.41,maxlen=250"`
+
+	// DeliverToPatientLocation is RXE-42: Deliver-to Patient Location (PL, optional, max length 80).
+	DeliverToPatientLocation string `hl7:"RXE.42,maxlen=80"`
+
+	// DeliverToAddress is RXE-43: Deliver-to Address (XAD, optional, max length 250).
+	DeliverToAddress string `hl7:"RXE.43,maxlen=250"`
+
+	// PharmacyOrderType is RXE-44: Pharmacy Order Type (ID, optional, max length 1).
+	PharmacyOrderType string `hl7:"RXE.44,maxlen=1"`
+}
+
+// ErrNotRXESegment indicates the segment is not an RXE segment.
+var ErrNotRXESegment = fmt.Errorf("segment is not RXE")
+
+// ParseRXE extracts RXE segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RXE segment.
+func ParseRXE(seg hl7.Segment) (*RXE, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RXE" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRXESegment, seg.Name())
+	}
+
+	rxe := &RXE{
+		QuantityTiming:                     getFieldValue(seg, 1),
+		GiveCode:                           getFieldValue(seg, 2),
+		GiveAmountMinimum:                  getFieldValue(seg, 3),
+		GiveAmountMaximum:                  getFieldValue(seg, 4),
+		GiveUnits:                          getFieldValue(seg, 5),
+		GiveDosageForm:                     getFieldValue(seg, 6),
+		ProviderAdministrationInstructions: getFieldRepetitions(seg, 7),
+		DeliverToLocation:                  getFieldValue(seg, 8),
+		SubstitutionStatus:                 getFieldValue(seg, 9),
+		DispenseAmount:                     getFieldValue(seg, 10),
+		DispenseUnits:                      getFieldValue(seg, 11),
+		NumberOfRefills:                    getFieldValue(seg, 12),
+		OrderingProviderDEANumber:          getFieldRepetitions(seg, 13),
+		PharmacistVerifierID:               getFieldRepetitions(seg, 14),
+		PrescriptionNumber:                 getFieldValue(seg, 15),
+		NumberOfRefillsRemaining:           getFieldValue(seg, 16),
+		NumberOfRefillsDosesDispensed:      getFieldValue(seg, 17),
+		MostRecentRefillDateTime:           getFieldValue(seg, 18),
+		TotalDailyDose:                     getFieldValue(seg, 19),
+		NeedsHumanReview:                   getFieldValue(seg, 20),
+		SpecialDispensingInstructions:      getFieldRepetitions(seg, 21),
+		GivePerTimeUnit:                    getFieldValue(seg, 22),
+		GiveRateAmount:                     getFieldValue(seg, 23),
+		GiveRateUnits:                      getFieldValue(seg, 24),
+		GiveStrength:                       getFieldValue(seg, 25),
+		GiveStrengthUnits:                  getFieldValue(seg, 26),
+		GiveIndication:                     getFieldRepetitions(seg, 27),
+		DispensePackageSize:                getFieldValue(seg, 28),
+		DispensePackageSizeUnit:            getFieldValue(seg, 29),
+		DispensePackageMethod:              getFieldValue(seg, 30),
+		SupplementaryCode:                  getFieldRepetitions(seg, 31),
+		OriginalOrderDateTime:              getFieldValue(seg, 32),
+		GiveDrugStrengthVolume:             getFieldValue(seg, 33),
+		GiveDrugStrengthVolumeUnits:        getFieldValue(seg, 34),
+		ControlledSubstanceSchedule:        getFieldValue(seg, 35),
+		FormularyStatus:                    getFieldValue(seg, 36),
+		PharmaceuticalSubstanceAlternative: getFieldRepetitions(seg, 37),
+		PharmacyOfMostRecentFill:           getFieldValue(seg, 38),
+		InitialDispenseAmount:              getFieldValue(seg, 39),
+		DispensingPharmacy:                 getFieldValue(seg, 40),
+		DispensingPharmacyAddress:          getFieldValue(seg, 41),
+		DeliverToPatientLocation:           getFieldValue(seg, 42),
+		DeliverToAddress:                   getFieldValue(seg, 43),
+		PharmacyOrderType:                  getFieldValue(seg, 44),
+	}
+
+	return rxe, nil
+}
+
+// ToSegment converts the RXE struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RXE) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.QuantityTiming,
+		r.GiveCode,
+		r.GiveAmountMinimum,
+		r.GiveAmountMaximum,
+		r.GiveUnits,
+		r.GiveDosageForm,
+		joinRepetitions(r.ProviderAdministrationInstructions, delims),
+		r.DeliverToLocation,
+		r.SubstitutionStatus,
+		r.DispenseAmount,
+		r.DispenseUnits,
+		r.NumberOfRefills,
+		joinRepetitions(r.OrderingProviderDEANumber, delims),
+		joinRepetitions(r.PharmacistVerifierID, delims),
+		r.PrescriptionNumber,
+		r.NumberOfRefillsRemaining,
+		r.NumberOfRefillsDosesDispensed,
+		r.MostRecentRefillDateTime,
+		r.TotalDailyDose,
+		r.NeedsHumanReview,
+		joinRepetitions(r.SpecialDispensingInstructions, delims),
+		r.GivePerTimeUnit,
+		r.GiveRateAmount,
+		r.GiveRateUnits,
+		r.GiveStrength,
+		r.GiveStrengthUnits,
+		joinRepetitions(r.GiveIndication, delims),
+		r.DispensePackageSize,
+		r.DispensePackageSizeUnit,
+		r.DispensePackageMethod,
+		joinRepetitions(r.SupplementaryCode, delims),
+		r.OriginalOrderDateTime,
+		r.GiveDrugStrengthVolume,
+		r.GiveDrugStrengthVolumeUnits,
+		r.ControlledSubstanceSchedule,
+		r.FormularyStatus,
+		joinRepetitions(r.PharmaceuticalSubstanceAlternative, delims),
+		r.PharmacyOfMostRecentFill,
+		r.InitialDispenseAmount,
+		r.DispensingPharmacy,
+		r.DispensingPharmacyAddress,
+		r.DeliverToPatientLocation,
+		r.DeliverToAddress,
+		r.PharmacyOrderType,
+	}
+
+	data := buildSegmentData("RXE", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RXE segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,186 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RXG represents the Pharmacy/Treatment Give segment.
+//
+// Field positions follow the HL7 standard where RXG-1 is the first field
+// after the segment name.
+type RXG struct {
+	// GiveSubIDCounter is RXG-1: Give Sub-ID Counter (NM, required, max length 4).
+	GiveSubIDCounter string `hl7:"RXG.1,required,maxlen=4"`
+
+	// DispenseSubIDCounter is RXG-2: Dispense Sub-ID Counter (NM, optional, max length 4).
+	DispenseSubIDCounter string `hl7:"RXG.2,maxlen=4"`
+
+	// QuantityTiming is RXG-3: Quantity/Timing (TQ, backward compatibility, max length 200).
+	QuantityTiming string `hl7:"RXG.3,maxlen=200"`
+
+	// GiveCode is RXG-4: Give Code (CE, required, max length 250).
+	GiveCode string `hl7:"RXG.4,required,maxlen=250"`
+
+	// GiveAmountMinimum is RXG-5: Give Amount - Minimum (NM, required, max length 20).
+	GiveAmountMinimum string `hl7:"RXG.5,required,maxlen=20"`
+
+	// GiveAmountMaximum is RXG-6: Give Amount - Maximum (NM, optional, max length 20).
+	GiveAmountMaximum string `hl7:"RXG.6,maxlen=20"`
+
+	// GiveUnits is RXG-7: Give Units (CE, required, max length 250).
+	GiveUnits string `hl7:"RXG.7,required,maxlen=250"`
+
+	// GiveDosageForm is RXG-8: Give Dosage Form (CE, optional, max length 250).
+	GiveDosageForm string `hl7:"RXG.8,maxlen=250"`
+
+	// AdministrationNotes is RXG-9: Administration Notes (CE, optional, repeating, max length 250).
+	AdministrationNotes []string `hl7:"RXG.9,maxlen=250"`
+
+	// SubstitutionStatus is RXG-10: Substitution Status (ID, optional, max length 1).
+	SubstitutionStatus string `hl7:"RXG.10,maxlen=1"`
+
+	// DispenseToLocation is RXG-11: Dispense-to Location (LA2, optional, max length 200).
+	DispenseToLocation string `hl7:"RXG.11,maxlen=200"`
+
+	// NeedsHumanReview is RXG-12: Needs Human Review (ID, optional, max length 1).
+	NeedsHumanReview string `hl7:"RXG.12,maxlen=1"`
+
+	// PharmacyTreatmentSuppliersSpecialAdministrationInstructions is RXG-13: Pharmacy/Treatment Supplier's Special Administration Instructions (CE, optional, repeating, max length 250).
+	PharmacyTreatmentSuppliersSpecialAdministrationInstructions []string `hl7:"RXG.13,maxlen=250"`
+
+	// GivePer is RXG-14: Give Per (Time Unit) (ST, conditional, max length 20).
+	GivePer string `hl7:"RXG.14,maxlen=20"`
+
+	// GiveRateAmount is RXG-15: Give Rate Amount (ST, optional, max length 6).
+	GiveRateAmount string `hl7:"RXG.15,maxlen=6"`
+
+	// GiveRateUnits is RXG-16: Give Rate Units (CE, optional, max length 250).
+	GiveRateUnits string `hl7:"RXG.16,maxlen=250"`
+
+	// GiveStrength is RXG-17: Give Strength (NM, optional, max length 20).
+	GiveStrength string `hl7:"RXG.17,maxlen=20"`
+
+	// GiveStrengthUnits is RXG-18: Give Strength Units (CE, optional, max length 250).
+	GiveStrengthUnits string `hl7:"RXG.18,maxlen=250"`
+
+	// SubstanceLotNumber is RXG-19: Substance Lot Number (ST, optional, repeating, max length 20).
+	SubstanceLotNumber []string `hl7:"RXG.19,maxlen=20"`
+
+	// SubstanceExpirationDate is RXG-20: Substance Expiration Date (TS, optional, repeating, max length 26).
+	SubstanceExpirationDate []string `hl7:"RXG.20,maxlen=26"`
+
+	// SubstanceManufacturerName is RXG-21: Substance Manufacturer Name (CE, optional, repeating, max length 250).
+	SubstanceManufacturerName []string `hl7:"RXG.21,maxlen=250"`
+
+	// Indication is RXG-22: Indication (CE, optional, repeating, max length 250).
+	Indication []string `hl7:"RXG.22,maxlen=250"`
+
+	// GiveDrugStrengthVolume is RXG-23: Give Drug Strength Volume (NM, optional, max length 5).
+	GiveDrugStrengthVolume string `hl7:"RXG.23,maxlen=5"`
+
+	// GiveDrugStrengthVolumeUnits is RXG-24: Give Drug Strength Volume Units (CWE, optional, max length 250).
+	GiveDrugStrengthVolumeUnits string `hl7:"RXG.24,maxlen=250"`
+
+	// GiveBarcodeIdentifier is RXG-25: Give Barcode Identifier (CWE, optional, max length 60).
+	GiveBarcodeIdentifier string `hl7:"RXG.25,maxlen=60"`
+
+	// PharmacyOrderType is RXG-26: Pharmacy Order Type (ID, optional, max length 1).
+	PharmacyOrderType string `hl7:"RXG.26,maxlen=1"`
+}
+
+// ErrNotRXGSegment indicates the segment is not an RXG segment.
+var ErrNotRXGSegment = fmt.Errorf("segment is not RXG")
+
+// ParseRXG extracts RXG segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RXG segment.
+func ParseRXG(seg hl7.Segment) (*RXG, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RXG" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRXGSegment, seg.Name())
+	}
+
+	rxg := &RXG{
+		GiveSubIDCounter:     getFieldValue(seg, 1),
+		DispenseSubIDCounter: getFieldValue(seg, 2),
+		QuantityTiming:       getFieldValue(seg, 3),
+		GiveCode:             getFieldValue(seg, 4),
+		GiveAmountMinimum:    getFieldValue(seg, 5),
+		GiveAmountMaximum:    getFieldValue(seg, 6),
+		GiveUnits:            getFieldValue(seg, 7),
+		GiveDosageForm:       getFieldValue(seg, 8),
+		AdministrationNotes:  getFieldRepetitions(seg, 9),
+		SubstitutionStatus:   getFieldValue(seg, 10),
+		DispenseToLocation:   getFieldValue(seg, 11),
+		NeedsHumanReview:     getFieldValue(seg, 12),
+		PharmacyTreatmentSuppliersSpecialAdministrationInstructions: getFieldRepetitions(seg, 13),
+		GivePer:                     getFieldValue(seg, 14),
+		GiveRateAmount:              getFieldValue(seg, 15),
+		GiveRateUnits:               getFieldValue(seg, 16),
+		GiveStrength:                getFieldValue(seg, 17),
+		GiveStrengthUnits:           getFieldValue(seg, 18),
+		SubstanceLotNumber:          getFieldRepetitions(seg, 19),
+		SubstanceExpirationDate:     getFieldRepetitions(seg, 20),
+		SubstanceManufacturerName:   getFieldRepetitions(seg, 21),
+		Indication:                  getFieldRepetitions(seg, 22),
+		GiveDrugStrengthVolume:      getFieldValue(seg, 23),
+		GiveDrugStrengthVolumeUnits: getFieldValue(seg, 24),
+		GiveBarcodeIdentifier:       getFieldValue(seg, 25),
+		PharmacyOrderType:           getFieldValue(seg, 26),
+	}
+
+	return rxg, nil
+}
+
+// ToSegment converts the RXG struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RXG) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.GiveSubIDCounter,
+		r.DispenseSubIDCounter,
+		r.QuantityTiming,
+		r.GiveCode,
+		r.GiveAmountMinimum,
+		r.GiveAmountMaximum,
+		r.GiveUnits,
+		r.GiveDosageForm,
+		joinRepetitions(r.AdministrationNotes, delims),
+		r.SubstitutionStatus,
+		r.DispenseToLocation,
+		r.NeedsHumanReview,
+		joinRepetitions(r.PharmacyTreatmentSuppliersSpecialAdministrationInstructions, delims),
+		r.GivePer,
+		r.GiveRateAmount,
+		r.GiveRateUnits,
+		r.GiveStrength,
+		r.GiveStrengthUnits,
+		joinRepetitions(r.SubstanceLotNumber, delims),
+		joinRepetitions(r.SubstanceExpirationDate, delims),
+		joinRepetitions(r.SubstanceManufacturerName, delims),
+		joinRepetitions(r.Indication, delims),
+		r.GiveDrugStrengthVolume,
+		r.GiveDrugStrengthVolumeUnits,
+		r.GiveBarcodeIdentifier,
+		r.PharmacyOrderType,
+	}
+
+	data := buildSegmentData("RXG", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RXG segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// RXO represents the Pharmacy/Treatment Order segment.
+//
+// Field positions follow the HL7 standard where RXO-1 is the first field
+// after the segment name.
+type RXO struct {
+	// RequestedGiveCode is RXO-1: Requested Give Code (CE, conditional, max length 250).
+	RequestedGiveCode string `hl7:"RXO.1,maxlen=250"`
+
+	// RequestedGiveAmountMinimum is RXO-2: Requested Give Amount - Minimum (NM, conditional, max length 20).
+	RequestedGiveAmountMinimum string `hl7:"RXO.2,maxlen=20"`
+
+	// RequestedGiveAmountMaximum is RXO-3: Requested Give Amount - Maximum (NM, optional, max length 20).
+	RequestedGiveAmountMaximum string `hl7:"RXO.3,maxlen=20"`
+
+	// RequestedGiveUnits is RXO-4: Requested Give Units (CE, conditional, max length 250).
+	RequestedGiveUnits string `hl7:"RXO.4,maxlen=250"`
+
+	// RequestedDosageForm is RXO-5: Requested Dosage Form (CE, conditional, max length 250).
+	RequestedDosageForm string `hl7:"RXO.5,maxlen=250"`
+
+	// ProviderPharmacyInstructions is RXO-6: Provider's Pharmacy/Treatment Instructions (CE, optional, repeating, max length 250).
+	ProviderPharmacyInstructions []string `hl7:"RXO.6,maxlen=250"`
+
+	// ProviderAdministrationInstructions is RXO-7: Provider's Administration Instructions (CE, optional, repeating, max length 250).
+	ProviderAdministrationInstructions []string `hl7:"RXO.7,maxlen=250"`
+
+	// DeliverToLocation is RXO-8: Deliver-To Location (LA1, optional, max length 200).
+	DeliverToLocation string `hl7:"RXO.8,maxlen=200"`
+
+	// AllowSubstitutions is RXO-9: Allow Substitutions (ID, optional, max length 1).
+	AllowSubstitutions string `hl7:"RXO.9,maxlen=1"`
+
+	// RequestedDispenseCode is RXO-10: Requested Dispense Code (CE, optional, max length 250).
+	RequestedDispenseCode string `hl7:"RXO.10,maxlen=250"`
+
+	// RequestedDispenseAmount is RXO-11: Requested Dispense Amount (NM, optional, max length 20).
+	RequestedDispenseAmount string `hl7:"RXO.11,maxlen=20"`
+
+	// RequestedDispenseUnits is RXO-12: Requested Dispense Units (CE, optional, max length 250).
+	RequestedDispenseUnits string `hl7:"RXO.12,maxlen=250"`
+
+	// NumberOfRefills is RXO-13: Number Of Refills (NM, optional, max length 3).
+	NumberOfRefills string `hl7:"RXO.13,maxlen=3"`
+
+	// OrderingProviderDEANumber is RXO-14: Ordering Provider's DEA Number (XCN, conditional, repeating, max length 250).
+	OrderingProviderDEANumber []string `hl7:"RXO.14,maxlen=250"`
+
+	// PharmacistVerifierID is RXO-15: Pharmacist/Treatment Supplier's Verifier ID (XCN, conditional, repeating, max length 250).
+	PharmacistVerifierID []string `hl7:"RXO.15,maxlen=250"`
+
+	// NeedsHumanReview is RXO-16: Needs Human Review (ID, optional, max length 1).
+	NeedsHumanReview string `hl7:"RXO.16,maxlen=1"`
+
+	// RequestedGivePerTimeUnit is RXO-17: Requested Give Per (Time Unit) (ST, conditional, max length 20).
+	RequestedGivePerTimeUnit string `hl7:"RXO.17,maxlen=20"`
+
+	// RequestedGiveStrength is RXO-18: Requested Give Strength (NM, optional, max length 20).
+	RequestedGiveStrength string `hl7:"RXO.18,maxlen=20"`
+
+	// RequestedGiveStrengthUnits is RXO-19: Requested Give Strength Units (CE, optional, max length 250).
+	RequestedGiveStrengthUnits string `hl7:"RXO.19,maxlen=250"`
+
+	// Indication is RXO-20: Indication (CE, optional, repeating, max length 250).
+	Indication []string `hl7:"RXO.20,maxlen=250"`
+
+	// RequestedGiveRateAmount is RXO-21: Requested Give Rate Amount (ST, optional, max length 6).
+	RequestedGiveRateAmount string `hl7:"RXO.21,maxlen=6"`
+
+	// RequestedGiveRateUnits is RXO-22: Requested Give Rate Units (CE, optional, max length 250).
+	RequestedGiveRateUnits string `hl7:"RXO.22,maxlen=250"`
+
+	// TotalDailyDose is RXO-23: Total Daily Dose (CQ, optional, max length 10).
+	TotalDailyDose string `hl7:"RXO.23,maxlen=10"`
+
+	// SupplementaryCode is RXO-24: Supplementary Code (CE, optional, repeating, max length 250).
+	SupplementaryCode []string `hl7:"RXO.24,maxlen=250"`
+
+	// RequestedDrugStrengthVolume is RXO-25: Requested Drug Strength Volume (NM, optional, max length 5).
+	RequestedDrugStrengthVolume string `hl7:"RXO.25,maxlen=5"`
+
+	// RequestedDrugStrengthVolumeUnits is RXO-26: Requested Drug Strength Volume Units (CWE, optional, max length 250).
+	RequestedDrugStrengthVolumeUnits string `hl7:"RXO.26,maxlen=250"`
+
+	// PharmacyOrderType is RXO-27: Pharmacy Order Type (ID, optional, max length 1).
+	PharmacyOrderType string `hl7:"RXO.27,maxlen=1"`
+
+	// DispensingInterval is RXO-28: Dispensing Interval (NM, optional, max length 20).
+	DispensingInterval string `hl7:"RXO.28,maxlen=20"`
+}
+
+// ErrNotRXOSegment indicates the segment is not an RXO segment.
+var ErrNotRXOSegment = fmt.Errorf("segment is not RXO")
+
+// ParseRXO extracts RXO segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an RXO segment.
+func ParseRXO(seg hl7.Segment) (*RXO, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "RXO" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotRXOSegment, seg.Name())
+	}
+
+	rxo := &RXO{
+		RequestedGiveCode:                  getFieldValue(seg, 1),
+		RequestedGiveAmountMinimum:         getFieldValue(seg, 2),
+		RequestedGiveAmountMaximum:         getFieldValue(seg, 3),
+		RequestedGiveUnits:                 getFieldValue(seg, 4),
+		RequestedDosageForm:                getFieldValue(seg, 5),
+		ProviderPharmacyInstructions:       getFieldRepetitions(seg, 6),
+		ProviderAdministrationInstructions: getFieldRepetitions(seg, 7),
+		DeliverToLocation:                  getFieldValue(seg, 8),
+		AllowSubstitutions:                 getFieldValue(seg, 9),
+		RequestedDispenseCode:              getFieldValue(seg, 10),
+		RequestedDispenseAmount:            getFieldValue(seg, 11),
+		RequestedDispenseUnits:             getFieldValue(seg, 12),
+		NumberOfRefills:                    getFieldValue(seg, 13),
+		OrderingProviderDEANumber:          getFieldRepetitions(seg, 14),
+		PharmacistVerifierID:               getFieldRepetitions(seg, 15),
+		NeedsHumanReview:                   getFieldValue(seg, 16),
+		RequestedGivePerTimeUnit:           getFieldValue(seg, 17),
+		RequestedGiveStrength:              getFieldValue(seg, 18),
+		RequestedGiveStrengthUnits:         getFieldValue(seg, 19),
+		Indication:                         getFieldRepetitions(seg, 20),
+		RequestedGiveRateAmount:            getFieldValue(seg, 21),
+		RequestedGiveRateUnits:             getFieldValue(seg, 22),
+		TotalDailyDose:                     getFieldValue(seg, 23),
+		SupplementaryCode:                  getFieldRepetitions(seg, 24),
+		RequestedDrugStrengthVolume:        getFieldValue(seg, 25),
+		RequestedDrugStrengthVolumeUnits:   getFieldValue(seg, 26),
+		PharmacyOrderType:                  getFieldValue(seg, 27),
+		DispensingInterval:                 getFieldValue(seg, 28),
+	}
+
+	return rxo, nil
+}
+
+// ToSegment converts the RXO struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (r *RXO) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		r.RequestedGiveCode,
+		r.RequestedGiveAmountMinimum,
+		r.RequestedGiveAmountMaximum,
+		r.RequestedGiveUnits,
+		r.RequestedDosageForm,
+		joinRepetitions(r.ProviderPharmacyInstructions, delims),
+		joinRepetitions(r.ProviderAdministrationInstructions, delims),
+		r.DeliverToLocation,
+		r.AllowSubstitutions,
+		r.RequestedDispenseCode,
+		r.RequestedDispenseAmount,
+		r.RequestedDispenseUnits,
+		r.NumberOfRefills,
+		joinRepetitions(r.OrderingProviderDEANumber, delims),
+		joinRepetitions(r.PharmacistVerifierID, delims),
+		r.NeedsHumanReview,
+		r.RequestedGivePerTimeUnit,
+		r.RequestedGiveStrength,
+		r.RequestedGiveStrengthUnits,
+		joinRepetitions(r.Indication, delims),
+		r.RequestedGiveRateAmount,
+		r.RequestedGiveRateUnits,
+		r.TotalDailyDose,
+		joinRepetitions(r.SupplementaryCode, delims),
+		r.RequestedDrugStrengthVolume,
+		r.RequestedDrugStrengthVolumeUnits,
+		r.PharmacyOrderType,
+		r.DispensingInterval,
+	}
+
+	data := buildSegmentData("RXO", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RXO segment: %w", err)
+	}
+
+	return seg, nil
+}
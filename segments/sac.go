@@ -0,0 +1,276 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// SAC represents the Specimen Container detail segment.
+//
+// Field positions follow the HL7 standard where SAC-1 is the first field
+// after the segment name.
+type SAC struct {
+	// ExternalAccessionIdentifier is SAC-1: External Accession Identifier (EI, optional, max length 80).
+	ExternalAccessionIdentifier string `hl7:"SAC.1,maxlen=80"`
+
+	// AccessionIdentifier is SAC-2: Accession Identifier (EI, optional, max length 80).
+	AccessionIdentifier string `hl7:"SAC.2,maxlen=80"`
+
+	// ContainerIdentifier is SAC-3: Container Identifier (EI, conditional, max length 80).
+	ContainerIdentifier string `hl7:"SAC.3,maxlen=80"`
+
+	// PrimaryContainerIdentifier is SAC-4: Primary (parent) Container Identifier (EI, conditional, max length 80).
+	PrimaryContainerIdentifier string `hl7:"SAC.4,maxlen=80"`
+
+	// EquipmentContainerIdentifier is SAC-5: Equipment Container Identifier (EI, optional, max length 80).
+	EquipmentContainerIdentifier string `hl7:"SAC.5,maxlen=80"`
+
+	// SpecimenSource is SAC-6: Specimen Source (SPS, conditional, max length 300).
+	SpecimenSource string `hl7:"SAC.6,maxlen=300"`
+
+	// RegistrationDateTime is SAC-7: Registration Date/Time (TS, optional, max length 26).
+	RegistrationDateTime string `hl7:"SAC.7,maxlen=26"`
+
+	// ContainerStatus is SAC-8: Container Status (CE, optional, max length 250).
+	ContainerStatus string `hl7:"SAC.8,maxlen=250"`
+
+	// CarrierType is SAC-9: Carrier Type (CE, optional, max length 250).
+	CarrierType string `hl7:"SAC.9,maxlen=250"`
+
+	// CarrierIdentifier is SAC-10: Carrier Identifier (EI, optional, max length 80).
+	CarrierIdentifier string `hl7:"SAC.10,maxlen=80"`
+
+	// PositionInCarrier is SAC-11: Position in Carrier (NA, optional, max length 80).
+	PositionInCarrier string `hl7:"SAC.11,maxlen=80"`
+
+	// TrayType is SAC-12: Tray Type - SAC (CE, optional, max length 250).
+	TrayType string `hl7:"SAC.12,maxlen=250"`
+
+	// TrayIdentifier is SAC-13: Tray Identifier (EI, optional, max length 80).
+	TrayIdentifier string `hl7:"SAC.13,maxlen=80"`
+
+	// PositionInTray is SAC-14: Position in Tray (NA, optional, max length 80).
+	PositionInTray string `hl7:"SAC.14,maxlen=80"`
+
+	// Location is SAC-15: Location (CE, optional, repeating, max length 250).
+	Location []string `hl7:"SAC.15,maxlen=250"`
+
+	// ContainerHeight is SAC-16: Container Height (NM, optional, max length 20).
+	ContainerHeight string `hl7:"SAC.16,maxlen=20"`
+
+	// ContainerDiameter is SAC-17: Container Diameter (NM, optional, max length 20).
+	ContainerDiameter string `hl7:"SAC.17,maxlen=20"`
+
+	// BarrierDelta is SAC-18: Barrier Delta (NM, optional, max length 20).
+	BarrierDelta string `hl7:"SAC.18,maxlen=20"`
+
+	// BottomDelta is SAC-19: Bottom Delta (NM, optional, max length 20).
+	BottomDelta string `hl7:"SAC.19,maxlen=20"`
+
+	// ContainerHeightDiameterDeltaUnits is SAC-20: Container Height/Diameter/Delta Units (CE, optional, max length 250).
+	ContainerHeightDiameterDeltaUnits string `hl7:"SAC.20,maxlen=250"`
+
+	// ContainerVolume is SAC-21: Container Volume (NM, optional, max length 20).
+	ContainerVolume string `hl7:"SAC.21,maxlen=20"`
+
+	// AvailableSpecimenVolume is SAC-22: Available Specimen Volume (NM, optional, max length 20).
+	AvailableSpecimenVolume string `hl7:"SAC.22,maxlen=20"`
+
+	// InitialSpecimenVolume is SAC-23: Initial Specimen Volume (NM, optional, max length 20).
+	InitialSpecimenVolume string `hl7:"SAC.23,maxlen=20"`
+
+	// VolumeUnits is SAC-24: Volume Units (CE, optional, max length 250).
+	VolumeUnits string `hl7:"SAC.24,maxlen=250"`
+
+	// SeparatorType is SAC-25: Separator Type (CE, optional, max length 250).
+	SeparatorType string `hl7:"SAC.25,maxlen=250"`
+
+	// CapType is SAC-26: Cap Type (CE, optional, max length 250).
+	CapType string `hl7:"SAC.26,maxlen=250"`
+
+	// Additive is SAC-27: Additive (CWE, optional, repeating, max length 250).
+	Additive []string `hl7:"SAC.27,maxlen=250"`
+
+	// SpecimenComponent is SAC-28: Specimen Component (CE, optional, max length 250).
+	SpecimenComponent string `hl7:"SAC.28,maxlen=250"`
+
+	// DilutionFactor is SAC-29: Dilution Factor (SN, optional, max length 20).
+	DilutionFactor string `hl7:"SAC.29,maxlen=20"`
+
+	// Treatment is SAC-30: Treatment (CE, optional, max length 250).
+	Treatment string `hl7:"SAC.30,maxlen=250"`
+
+	// Temperature is SAC-31: Temperature (SN, optional, max length 20).
+	Temperature string `hl7:"SAC.31,maxlen=20"`
+
+	// HemolysisIndex is SAC-32: Hemolysis Index (NM, optional, max length 20).
+	HemolysisIndex string `hl7:"SAC.32,maxlen=20"`
+
+	// HemolysisIndexUnits is SAC-33: Hemolysis Index Units (CE, optional, max length 250).
+	HemolysisIndexUnits string `hl7:"SAC.33,maxlen=250"`
+
+	// LipemiaIndex is SAC-34: Lipemia Index (NM, optional, max length 20).
+	LipemiaIndex string `hl7:"SAC.34,maxlen=20"`
+
+	// LipemiaIndexUnits is SAC-35: Lipemia Index Units (CE, optional, max length 250).
+	LipemiaIndexUnits string `hl7:"SAC.35,maxlen=250"`
+
+	// IcterusIndex is SAC-36: Icterus Index (NM, optional, max length 20).
+	IcterusIndex string `hl7:"SAC.36,maxlen=20"`
+
+	// IcterusIndexUnits is SAC-37: Icterus Index Units (CE, optional, max length 250).
+	IcterusIndexUnits string `hl7:"SAC.37,maxlen=250"`
+
+	// FibrinIndex is SAC-38: Fibrin Index (NM, optional, max length 20).
+	FibrinIndex string `hl7:"SAC.38,maxlen=20"`
+
+	// FibrinIndexUnits is SAC-39: Fibrin Index Units (CE, optional, max length 250).
+	FibrinIndexUnits string `hl7:"SAC.39,maxlen=250"`
+
+	// SystemInducedContaminants is SAC-40: System Induced Contaminants (CE, optional, repeating, max length 250).
+	SystemInducedContaminants []string `hl7:"SAC.40,maxlen=250"`
+
+	// DrugInterference is SAC-41: Drug Interference (CE, optional, repeating, max length 250).
+	DrugInterference []string `hl7:"SAC.41,maxlen=250"`
+
+	// ArtificialBlood is SAC-42: Artificial Blood (CE, optional, max length 250).
+	ArtificialBlood string `hl7:"SAC.42,maxlen=250"`
+
+	// SpecialHandlingCode is SAC-43: Special Handling Code (CWE, optional, repeating, max length 250).
+	SpecialHandlingCode []string `hl7:"SAC.43,maxlen=250"`
+
+	// OtherEnvironmentalFactors is SAC-44: Other Environmental Factors (CE, optional, repeating, max length 250).
+	OtherEnvironmentalFactors []string `hl7:"SAC.44,maxlen=250"`
+}
+
+// ErrNotSACSegment indicates the segment is not an SAC segment.
+var ErrNotSACSegment = fmt.Errorf("segment is not SAC")
+
+// ParseSAC extracts SAC segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an SAC segment.
+func ParseSAC(seg hl7.Segment) (*SAC, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "SAC" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotSACSegment, seg.Name())
+	}
+
+	sac := &SAC{
+		ExternalAccessionIdentifier:       getFieldValue(seg, 1),
+		AccessionIdentifier:               getFieldValue(seg, 2),
+		ContainerIdentifier:               getFieldValue(seg, 3),
+		PrimaryContainerIdentifier:        getFieldValue(seg, 4),
+		EquipmentContainerIdentifier:      getFieldValue(seg, 5),
+		SpecimenSource:                    getFieldValue(seg, 6),
+		RegistrationDateTime:              getFieldValue(seg, 7),
+		ContainerStatus:                   getFieldValue(seg, 8),
+		CarrierType:                       getFieldValue(seg, 9),
+		CarrierIdentifier:                 getFieldValue(seg, 10),
+		PositionInCarrier:                 getFieldValue(seg, 11),
+		TrayType:                          getFieldValue(seg, 12),
+		TrayIdentifier:                    getFieldValue(seg, 13),
+		PositionInTray:                    getFieldValue(seg, 14),
+		Location:                          getFieldRepetitions(seg, 15),
+		ContainerHeight:                   getFieldValue(seg, 16),
+		ContainerDiameter:                 getFieldValue(seg, 17),
+		BarrierDelta:                      getFieldValue(seg, 18),
+		BottomDelta:                       getFieldValue(seg, 19),
+		ContainerHeightDiameterDeltaUnits: getFieldValue(seg, 20),
+		ContainerVolume:                   getFieldValue(seg, 21),
+		AvailableSpecimenVolume:           getFieldValue(seg, 22),
+		InitialSpecimenVolume:             getFieldValue(seg, 23),
+		VolumeUnits:                       getFieldValue(seg, 24),
+		SeparatorType:                     getFieldValue(seg, 25),
+		CapType:                           getFieldValue(seg, 26),
+		Additive:                          getFieldRepetitions(seg, 27),
+		SpecimenComponent:                 getFieldValue(seg, 28),
+		DilutionFactor:                    getFieldValue(seg, 29),
+		Treatment:                         getFieldValue(seg, 30),
+		Temperature:                       getFieldValue(seg, 31),
+		HemolysisIndex:                    getFieldValue(seg, 32),
+		HemolysisIndexUnits:               getFieldValue(seg, 33),
+		LipemiaIndex:                      getFieldValue(seg, 34),
+		LipemiaIndexUnits:                 getFieldValue(seg, 35),
+		IcterusIndex:                      getFieldValue(seg, 36),
+		IcterusIndexUnits:                 getFieldValue(seg, 37),
+		FibrinIndex:                       getFieldValue(seg, 38),
+		FibrinIndexUnits:                  getFieldValue(seg, 39),
+		SystemInducedContaminants:         getFieldRepetitions(seg, 40),
+		DrugInterference:                  getFieldRepetitions(seg, 41),
+		ArtificialBlood:                   getFieldValue(seg, 42),
+		SpecialHandlingCode:               getFieldRepetitions(seg, 43),
+		OtherEnvironmentalFactors:         getFieldRepetitions(seg, 44),
+	}
+
+	return sac, nil
+}
+
+// ToSegment converts the SAC struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (s *SAC) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		s.ExternalAccessionIdentifier,
+		s.AccessionIdentifier,
+		s.ContainerIdentifier,
+		s.PrimaryContainerIdentifier,
+		s.EquipmentContainerIdentifier,
+		s.SpecimenSource,
+		s.RegistrationDateTime,
+		s.ContainerStatus,
+		s.CarrierType,
+		s.CarrierIdentifier,
+		s.PositionInCarrier,
+		s.TrayType,
+		s.TrayIdentifier,
+		s.PositionInTray,
+		joinRepetitions(s.Location, delims),
+		s.ContainerHeight,
+		s.ContainerDiameter,
+		s.BarrierDelta,
+		s.BottomDelta,
+		s.ContainerHeightDiameterDeltaUnits,
+		s.ContainerVolume,
+		s.AvailableSpecimenVolume,
+		s.InitialSpecimenVolume,
+		s.VolumeUnits,
+		s.SeparatorType,
+		s.CapType,
+		joinRepetitions(s.Additive, delims),
+		s.SpecimenComponent,
+		s.DilutionFactor,
+		s.Treatment,
+		s.Temperature,
+		s.HemolysisIndex,
+		s.HemolysisIndexUnits,
+		s.LipemiaIndex,
+		s.LipemiaIndexUnits,
+		s.IcterusIndex,
+		s.IcterusIndexUnits,
+		s.FibrinIndex,
+		s.FibrinIndexUnits,
+		joinRepetitions(s.SystemInducedContaminants, delims),
+		joinRepetitions(s.DrugInterference, delims),
+		s.ArtificialBlood,
+		joinRepetitions(s.SpecialHandlingCode, delims),
+		joinRepetitions(s.OtherEnvironmentalFactors, delims),
+	}
+
+	data := buildSegmentData("SAC", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SAC segment: %w", err)
+	}
+
+	return seg, nil
+}
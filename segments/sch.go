@@ -0,0 +1,191 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// SCH represents the Scheduling Activity Information segment.
+//
+// Field positions follow the HL7 standard where SCH-1 is the first field
+// after the segment name.
+type SCH struct {
+	// PlacerAppointmentID is SCH-1: Placer Appointment ID (EI, conditional, max length 75).
+	PlacerAppointmentID string `hl7:"SCH.1,maxlen=75"`
+
+	// FillerAppointmentID is SCH-2: Filler Appointment ID (EI, conditional, max length 75).
+	FillerAppointmentID string `hl7:"SCH.2,maxlen=75"`
+
+	// OccurrenceNumber is SCH-3: Occurrence Number (NM, conditional, max length 5).
+	OccurrenceNumber string `hl7:"SCH.3,maxlen=5"`
+
+	// PlacerGroupNumber is SCH-4: Placer Group Number (EI, optional, max length 22).
+	PlacerGroupNumber string `hl7:"SCH.4,maxlen=22"`
+
+	// ScheduleID is SCH-5: Schedule ID (CE, optional, max length 250).
+	ScheduleID string `hl7:"SCH.5,maxlen=250"`
+
+	// EventReason is SCH-6: Event Reason (CE, required, max length 250).
+	EventReason string `hl7:"SCH.6,required,maxlen=250"`
+
+	// AppointmentReason is SCH-7: Appointment Reason (CE, optional, max length 250).
+	AppointmentReason string `hl7:"SCH.7,maxlen=250"`
+
+	// AppointmentType is SCH-8: Appointment Type (CE, optional, max length 250).
+	AppointmentType string `hl7:"SCH.8,maxlen=250"`
+
+	// AppointmentDuration is SCH-9: Appointment Duration (NM, backward compatibility, max length 20).
+	AppointmentDuration string `hl7:"SCH.9,maxlen=20"`
+
+	// AppointmentDurationUnits is SCH-10: Appointment Duration Units (CE, backward compatibility, max length 250).
+	AppointmentDurationUnits string `hl7:"SCH.10,maxlen=250"`
+
+	// AppointmentTimingQuantity is SCH-11: Appointment Timing Quantity (TQ, backward compatibility, repeating, max length 200).
+	AppointmentTimingQuantity []string `hl7:"SCH.11,maxlen=200"`
+
+	// PlacerContactPerson is SCH-12: Placer Contact Person (XCN, optional, repeating, max length 250).
+	PlacerContactPerson []string `hl7:"SCH.12,maxlen=250"`
+
+	// PlacerContactPhoneNumber is SCH-13: Placer Contact Phone Number (XTN, optional, max length 250).
+	PlacerContactPhoneNumber string `hl7:"SCH.13,maxlen=250"`
+
+	// PlacerContactAddress is SCH-14: Placer Contact Address (XAD, optional, repeating, max length 250).
+	PlacerContactAddress []string `hl7:"SCH.14,maxlen=250"`
+
+	// PlacerContactLocation is SCH-15: Placer Contact Location (PL, optional, max length 80).
+	PlacerContactLocation string `hl7:"SCH.15,maxlen=80"`
+
+	// FillerContactPerson is SCH-16: Filler Contact Person (XCN, required, repeating, max length 250).
+	FillerContactPerson []string `hl7:"SCH.16,required,maxlen=250"`
+
+	// FillerContactPhoneNumber is SCH-17: Filler Contact Phone Number (XTN, optional, max length 250).
+	FillerContactPhoneNumber string `hl7:"SCH.17,maxlen=250"`
+
+	// FillerContactAddress is SCH-18: Filler Contact Address (XAD, optional, repeating, max length 250).
+	FillerContactAddress []string `hl7:"SCH.18,maxlen=250"`
+
+	// FillerContactLocation is SCH-19: Filler Contact Location (PL, optional, max length 80).
+	FillerContactLocation string `hl7:"SCH.19,maxlen=80"`
+
+	// EnteredByPerson is SCH-20: Entered By Person (XCN, required, repeating, max length 250).
+	EnteredByPerson []string `hl7:"SCH.20,required,maxlen=250"`
+
+	// EnteredByPhoneNumber is SCH-21: Entered By Phone Number (XTN, optional, repeating, max length 250).
+	EnteredByPhoneNumber []string `hl7:"SCH.21,maxlen=250"`
+
+	// EnteredByLocation is SCH-22: Entered By Location (PL, optional, max length 80).
+	EnteredByLocation string `hl7:"SCH.22,maxlen=80"`
+
+	// ParentPlacerAppointmentID is SCH-23: Parent Placer Appointment ID (EI, optional, max length 75).
+	ParentPlacerAppointmentID string `hl7:"SCH.23,maxlen=75"`
+
+	// ParentFillerAppointmentID is SCH-24: Parent Filler Appointment ID (EI, conditional, max length 75).
+	ParentFillerAppointmentID string `hl7:"SCH.24,maxlen=75"`
+
+	// FillerStatusCode is SCH-25: Filler Status Code (CE, optional, max length 250).
+	FillerStatusCode string `hl7:"SCH.25,maxlen=250"`
+
+	// PlacerOrderNumber is SCH-26: Placer Order Number (EI, conditional, repeating, max length 22).
+	PlacerOrderNumber []string `hl7:"SCH.26,maxlen=22"`
+
+	// FillerOrderNumber is SCH-27: Filler Order Number (EI, conditional, repeating, max length 22).
+	FillerOrderNumber []string `hl7:"SCH.27,maxlen=22"`
+}
+
+// ErrNotSCHSegment indicates the segment is not an SCH segment.
+var ErrNotSCHSegment = fmt.Errorf("segment is not SCH")
+
+// ParseSCH extracts SCH segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an SCH segment.
+func ParseSCH(seg hl7.Segment) (*SCH, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "SCH" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotSCHSegment, seg.Name())
+	}
+
+	sch := &SCH{
+		PlacerAppointmentID:       getFieldValue(seg, 1),
+		FillerAppointmentID:       getFieldValue(seg, 2),
+		OccurrenceNumber:          getFieldValue(seg, 3),
+		PlacerGroupNumber:         getFieldValue(seg, 4),
+		ScheduleID:                getFieldValue(seg, 5),
+		EventReason:               getFieldValue(seg, 6),
+		AppointmentReason:         getFieldValue(seg, 7),
+		AppointmentType:           getFieldValue(seg, 8),
+		AppointmentDuration:       getFieldValue(seg, 9),
+		AppointmentDurationUnits:  getFieldValue(seg, 10),
+		AppointmentTimingQuantity: getFieldRepetitions(seg, 11),
+		PlacerContactPerson:       getFieldRepetitions(seg, 12),
+		PlacerContactPhoneNumber:  getFieldValue(seg, 13),
+		PlacerContactAddress:      getFieldRepetitions(seg, 14),
+		PlacerContactLocation:     getFieldValue(seg, 15),
+		FillerContactPerson:       getFieldRepetitions(seg, 16),
+		FillerContactPhoneNumber:  getFieldValue(seg, 17),
+		FillerContactAddress:      getFieldRepetitions(seg, 18),
+		FillerContactLocation:     getFieldValue(seg, 19),
+		EnteredByPerson:           getFieldRepetitions(seg, 20),
+		EnteredByPhoneNumber:      getFieldRepetitions(seg, 21),
+		EnteredByLocation:         getFieldValue(seg, 22),
+		ParentPlacerAppointmentID: getFieldValue(seg, 23),
+		ParentFillerAppointmentID: getFieldValue(seg, 24),
+		FillerStatusCode:          getFieldValue(seg, 25),
+		PlacerOrderNumber:         getFieldRepetitions(seg, 26),
+		FillerOrderNumber:         getFieldRepetitions(seg, 27),
+	}
+
+	return sch, nil
+}
+
+// ToSegment converts the SCH struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (s *SCH) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		s.PlacerAppointmentID,
+		s.FillerAppointmentID,
+		s.OccurrenceNumber,
+		s.PlacerGroupNumber,
+		s.ScheduleID,
+		s.EventReason,
+		s.AppointmentReason,
+		s.AppointmentType,
+		s.AppointmentDuration,
+		s.AppointmentDurationUnits,
+		joinRepetitions(s.AppointmentTimingQuantity, delims),
+		joinRepetitions(s.PlacerContactPerson, delims),
+		s.PlacerContactPhoneNumber,
+		joinRepetitions(s.PlacerContactAddress, delims),
+		s.PlacerContactLocation,
+		joinRepetitions(s.FillerContactPerson, delims),
+		s.FillerContactPhoneNumber,
+		joinRepetitions(s.FillerContactAddress, delims),
+		s.FillerContactLocation,
+		joinRepetitions(s.EnteredByPerson, delims),
+		joinRepetitions(s.EnteredByPhoneNumber, delims),
+		s.EnteredByLocation,
+		s.ParentPlacerAppointmentID,
+		s.ParentFillerAppointmentID,
+		s.FillerStatusCode,
+		joinRepetitions(s.PlacerOrderNumber, delims),
+		joinRepetitions(s.FillerOrderNumber, delims),
+	}
+
+	data := buildSegmentData("SCH", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SCH segment: %w", err)
+	}
+
+	return seg, nil
+}
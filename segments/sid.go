@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// SID represents the Substance Identifier segment.
+//
+// Field positions follow the HL7 standard where SID-1 is the first field
+// after the segment name.
+type SID struct {
+	// ApplicationMethodIdentifier is SID-1: Application / Method Identifier (CE, conditional, max length 250).
+	ApplicationMethodIdentifier string `hl7:"SID.1,maxlen=250"`
+
+	// SubstanceLotNumber is SID-2: Substance Lot Number (ST, conditional, max length 20).
+	SubstanceLotNumber string `hl7:"SID.2,maxlen=20"`
+
+	// SubstanceContainerIdentifier is SID-3: Substance Container Identifier (ST, conditional, max length 200).
+	SubstanceContainerIdentifier string `hl7:"SID.3,maxlen=200"`
+
+	// SubstanceManufacturerIdentifier is SID-4: Substance Manufacturer Identifier (CE, conditional, max length 250).
+	SubstanceManufacturerIdentifier string `hl7:"SID.4,maxlen=250"`
+}
+
+// ErrNotSIDSegment indicates the segment is not an SID segment.
+var ErrNotSIDSegment = fmt.Errorf("segment is not SID")
+
+// ParseSID extracts SID segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an SID segment.
+func ParseSID(seg hl7.Segment) (*SID, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "SID" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotSIDSegment, seg.Name())
+	}
+
+	sid := &SID{
+		ApplicationMethodIdentifier:     getFieldValue(seg, 1),
+		SubstanceLotNumber:              getFieldValue(seg, 2),
+		SubstanceContainerIdentifier:    getFieldValue(seg, 3),
+		SubstanceManufacturerIdentifier: getFieldValue(seg, 4),
+	}
+
+	return sid, nil
+}
+
+// ToSegment converts the SID struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (s *SID) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		s.ApplicationMethodIdentifier,
+		s.SubstanceLotNumber,
+		s.SubstanceContainerIdentifier,
+		s.SubstanceManufacturerIdentifier,
+	}
+
+	data := buildSegmentData("SID", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SID segment: %w", err)
+	}
+
+	return seg, nil
+}
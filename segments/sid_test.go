@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseSID(t *testing.T) {
+	input := "SID|SID-1|SID-2|SID-3|SID-4"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseSID(seg)
+	if err != nil {
+		t.Fatalf("ParseSID() unexpected error: %v", err)
+	}
+
+	want := SID{
+		ApplicationMethodIdentifier:     "SID-1",
+		SubstanceLotNumber:              "SID-2",
+		SubstanceContainerIdentifier:    "SID-3",
+		SubstanceManufacturerIdentifier: "SID-4",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseSID() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseSID_WrongSegment(t *testing.T) {
+	if _, err := ParseSID(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseSID(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseSID(seg); !errors.Is(err, ErrNotSIDSegment) {
+		t.Errorf("ParseSID() error = %v, want ErrNotSIDSegment", err)
+	}
+}
+
+func TestSID_RoundTrip(t *testing.T) {
+	original := &SID{
+		ApplicationMethodIdentifier:     "SID-1",
+		SubstanceLotNumber:              "SID-2",
+		SubstanceContainerIdentifier:    "SID-3",
+		SubstanceManufacturerIdentifier: "SID-4",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "SID" {
+		t.Errorf("segment name = %q, want SID", seg.Name())
+	}
+
+	parsed, err := ParseSID(seg)
+	if err != nil {
+		t.Fatalf("ParseSID() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
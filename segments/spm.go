@@ -0,0 +1,201 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// SPM represents the Specimen segment.
+//
+// Field positions follow the HL7 standard where SPM-1 is the first field
+// after the segment name.
+type SPM struct {
+	// SetID is SPM-1: Set ID - SPM (SI, optional, max length 4).
+	SetID string `hl7:"SPM.1,maxlen=4"`
+
+	// SpecimenID is SPM-2: Specimen ID (EIP, optional, max length 80).
+	SpecimenID string `hl7:"SPM.2,maxlen=80"`
+
+	// SpecimenParentIDs is SPM-3: Specimen Parent IDs (EIP, optional, repeating, max length 80).
+	SpecimenParentIDs []string `hl7:"SPM.3,maxlen=80"`
+
+	// SpecimenType is SPM-4: Specimen Type (CWE, required, max length 250).
+	SpecimenType string `hl7:"SPM.4,required,maxlen=250"`
+
+	// SpecimenTypeModifier is SPM-5: Specimen Type Modifier (CWE, optional, repeating, max length 250).
+	SpecimenTypeModifier []string `hl7:"SPM.5,maxlen=250"`
+
+	// SpecimenAdditives is SPM-6: Specimen Additives (CWE, optional, repeating, max length 250).
+	SpecimenAdditives []string `hl7:"SPM.6,maxlen=250"`
+
+	// SpecimenCollectionMethod is SPM-7: Specimen Collection Method (CWE, optional, max length 250).
+	SpecimenCollectionMethod string `hl7:"SPM.7,maxlen=250"`
+
+	// SpecimenSourceSite is SPM-8: Specimen Source Site (CWE, optional, max length 250).
+	SpecimenSourceSite string `hl7:"SPM.8,maxlen=250"`
+
+	// SpecimenSourceSiteModifier is SPM-9: Specimen Source Site Modifier (CWE, optional, repeating, max length 250).
+	SpecimenSourceSiteModifier []string `hl7:"SPM.9,maxlen=250"`
+
+	// SpecimenCollectionSite is SPM-10: Specimen Collection Site (CWE, optional, max length 250).
+	SpecimenCollectionSite string `hl7:"SPM.10,maxlen=250"`
+
+	// SpecimenRole is SPM-11: Specimen Role (CWE, optional, repeating, max length 250).
+	SpecimenRole []string `hl7:"SPM.11,maxlen=250"`
+
+	// SpecimenCollectionAmount is SPM-12: Specimen Collection Amount (CQ, optional, max length 20).
+	SpecimenCollectionAmount string `hl7:"SPM.12,maxlen=20"`
+
+	// GroupedSpecimenCount is SPM-13: Grouped Specimen Count (NM, conditional, max length 6).
+	GroupedSpecimenCount string `hl7:"SPM.13,maxlen=6"`
+
+	// SpecimenDescription is SPM-14: Specimen Description (ST, optional, repeating, max length 250).
+	SpecimenDescription []string `hl7:"SPM.14,maxlen=250"`
+
+	// SpecimenHandlingCode is SPM-15: Specimen Handling Code (CWE, optional, repeating, max length 250).
+	SpecimenHandlingCode []string `hl7:"SPM.15,maxlen=250"`
+
+	// SpecimenRiskCode is SPM-16: Specimen Risk Code (CWE, optional, repeating, max length 250).
+	SpecimenRiskCode []string `hl7:"SPM.16,maxlen=250"`
+
+	// SpecimenCollectionDateTime is SPM-17: Specimen Collection Date/Time (DR, optional, max length 26).
+	SpecimenCollectionDateTime string `hl7:"SPM.17,maxlen=26"`
+
+	// SpecimenReceivedDateTime is SPM-18: Specimen Received Date/Time (TS, optional, max length 26).
+	SpecimenReceivedDateTime string `hl7:"SPM.18,maxlen=26"`
+
+	// SpecimenExpirationDateTime is SPM-19: Specimen Expiration Date/Time (TS, optional, max length 26).
+	SpecimenExpirationDateTime string `hl7:"SPM.19,maxlen=26"`
+
+	// SpecimenAvailability is SPM-20: Specimen Availability (ID, optional, max length 1).
+	SpecimenAvailability string `hl7:"SPM.20,maxlen=1"`
+
+	// SpecimenRejectReason is SPM-21: Specimen Reject Reason (CWE, optional, repeating, max length 250).
+	SpecimenRejectReason []string `hl7:"SPM.21,maxlen=250"`
+
+	// SpecimenQuality is SPM-22: Specimen Quality (CWE, optional, max length 250).
+	SpecimenQuality string `hl7:"SPM.22,maxlen=250"`
+
+	// SpecimenAppropriateness is SPM-23: Specimen Appropriateness (CWE, optional, max length 250).
+	SpecimenAppropriateness string `hl7:"SPM.23,maxlen=250"`
+
+	// SpecimenCondition is SPM-24: Specimen Condition (CWE, optional, repeating, max length 250).
+	SpecimenCondition []string `hl7:"SPM.24,maxlen=250"`
+
+	// SpecimenCurrentQuantity is SPM-25: Specimen Current Quantity (CQ, optional, max length 20).
+	SpecimenCurrentQuantity string `hl7:"SPM.25,maxlen=20"`
+
+	// NumberOfSpecimenContainers is SPM-26: Number of Specimen Containers (NM, optional, max length 4).
+	NumberOfSpecimenContainers string `hl7:"SPM.26,maxlen=4"`
+
+	// ContainerType is SPM-27: Container Type (CWE, optional, max length 250).
+	ContainerType string `hl7:"SPM.27,maxlen=250"`
+
+	// ContainerCondition is SPM-28: Container Condition (CWE, optional, max length 250).
+	ContainerCondition string `hl7:"SPM.28,maxlen=250"`
+
+	// SpecimenChildRole is SPM-29: Specimen Child Role (CWE, optional, max length 250).
+	SpecimenChildRole string `hl7:"SPM.29,maxlen=250"`
+}
+
+// ErrNotSPMSegment indicates the segment is not an SPM segment.
+var ErrNotSPMSegment = fmt.Errorf("segment is not SPM")
+
+// ParseSPM extracts SPM segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an SPM segment.
+func ParseSPM(seg hl7.Segment) (*SPM, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "SPM" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotSPMSegment, seg.Name())
+	}
+
+	spm := &SPM{
+		SetID:                      getFieldValue(seg, 1),
+		SpecimenID:                 getFieldValue(seg, 2),
+		SpecimenParentIDs:          getFieldRepetitions(seg, 3),
+		SpecimenType:               getFieldValue(seg, 4),
+		SpecimenTypeModifier:       getFieldRepetitions(seg, 5),
+		SpecimenAdditives:          getFieldRepetitions(seg, 6),
+		SpecimenCollectionMethod:   getFieldValue(seg, 7),
+		SpecimenSourceSite:         getFieldValue(seg, 8),
+		SpecimenSourceSiteModifier: getFieldRepetitions(seg, 9),
+		SpecimenCollectionSite:     getFieldValue(seg, 10),
+		SpecimenRole:               getFieldRepetitions(seg, 11),
+		SpecimenCollectionAmount:   getFieldValue(seg, 12),
+		GroupedSpecimenCount:       getFieldValue(seg, 13),
+		SpecimenDescription:        getFieldRepetitions(seg, 14),
+		SpecimenHandlingCode:       getFieldRepetitions(seg, 15),
+		SpecimenRiskCode:           getFieldRepetitions(seg, 16),
+		SpecimenCollectionDateTime: getFieldValue(seg, 17),
+		SpecimenReceivedDateTime:   getFieldValue(seg, 18),
+		SpecimenExpirationDateTime: getFieldValue(seg, 19),
+		SpecimenAvailability:       getFieldValue(seg, 20),
+		SpecimenRejectReason:       getFieldRepetitions(seg, 21),
+		SpecimenQuality:            getFieldValue(seg, 22),
+		SpecimenAppropriateness:    getFieldValue(seg, 23),
+		SpecimenCondition:          getFieldRepetitions(seg, 24),
+		SpecimenCurrentQuantity:    getFieldValue(seg, 25),
+		NumberOfSpecimenContainers: getFieldValue(seg, 26),
+		ContainerType:              getFieldValue(seg, 27),
+		ContainerCondition:         getFieldValue(seg, 28),
+		SpecimenChildRole:          getFieldValue(seg, 29),
+	}
+
+	return spm, nil
+}
+
+// ToSegment converts the SPM struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (s *SPM) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		s.SetID,
+		s.SpecimenID,
+		joinRepetitions(s.SpecimenParentIDs, delims),
+		s.SpecimenType,
+		joinRepetitions(s.SpecimenTypeModifier, delims),
+		joinRepetitions(s.SpecimenAdditives, delims),
+		s.SpecimenCollectionMethod,
+		s.SpecimenSourceSite,
+		joinRepetitions(s.SpecimenSourceSiteModifier, delims),
+		s.SpecimenCollectionSite,
+		joinRepetitions(s.SpecimenRole, delims),
+		s.SpecimenCollectionAmount,
+		s.GroupedSpecimenCount,
+		joinRepetitions(s.SpecimenDescription, delims),
+		joinRepetitions(s.SpecimenHandlingCode, delims),
+		joinRepetitions(s.SpecimenRiskCode, delims),
+		s.SpecimenCollectionDateTime,
+		s.SpecimenReceivedDateTime,
+		s.SpecimenExpirationDateTime,
+		s.SpecimenAvailability,
+		joinRepetitions(s.SpecimenRejectReason, delims),
+		s.SpecimenQuality,
+		s.SpecimenAppropriateness,
+		joinRepetitions(s.SpecimenCondition, delims),
+		s.SpecimenCurrentQuantity,
+		s.NumberOfSpecimenContainers,
+		s.ContainerType,
+		s.ContainerCondition,
+		s.SpecimenChildRole,
+	}
+
+	data := buildSegmentData("SPM", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SPM segment: %w", err)
+	}
+
+	return seg, nil
+}
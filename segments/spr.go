@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// SPR represents the Stored Procedure Request Definition segment.
+//
+// Field positions follow the HL7 standard where SPR-1 is the first field
+// after the segment name.
+type SPR struct {
+	// QueryTag is SPR-1: Query Tag (ST, optional, max length 32).
+	QueryTag string `hl7:"SPR.1,maxlen=32"`
+
+	// QueryResponseFormatCode is SPR-2: Query/Response Format Code (ID, required, max length 1).
+	QueryResponseFormatCode string `hl7:"SPR.2,required,maxlen=1"`
+
+	// StoredProcedureName is SPR-3: Stored Procedure Name (CE, required, max length 250).
+	StoredProcedureName string `hl7:"SPR.3,required,maxlen=250"`
+
+	// InputParameterList is SPR-4: Input Parameter List (QIP, optional, repeating, max length 256).
+	InputParameterList []string `hl7:"SPR.4,maxlen=256"`
+}
+
+// ErrNotSPRSegment indicates the segment is not an SPR segment.
+var ErrNotSPRSegment = fmt.Errorf("segment is not SPR")
+
+// ParseSPR extracts SPR segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an SPR segment.
+func ParseSPR(seg hl7.Segment) (*SPR, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "SPR" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotSPRSegment, seg.Name())
+	}
+
+	spr := &SPR{
+		QueryTag:                getFieldValue(seg, 1),
+		QueryResponseFormatCode: getFieldValue(seg, 2),
+		StoredProcedureName:     getFieldValue(seg, 3),
+		InputParameterList:      getFieldRepetitions(seg, 4),
+	}
+
+	return spr, nil
+}
+
+// ToSegment converts the SPR struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (s *SPR) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		s.QueryTag,
+		s.QueryResponseFormatCode,
+		s.StoredProcedureName,
+		joinRepetitions(s.InputParameterList, delims),
+	}
+
+	data := buildSegmentData("SPR", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SPR segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,74 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseSPR(t *testing.T) {
+	input := "SPR|SPR-1|SPR-2|SPR-3|SPR-4a~SPR-4b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseSPR(seg)
+	if err != nil {
+		t.Fatalf("ParseSPR() unexpected error: %v", err)
+	}
+
+	want := SPR{
+		QueryTag:                "SPR-1",
+		QueryResponseFormatCode: "SPR-2",
+		StoredProcedureName:     "SPR-3",
+		InputParameterList:      []string{"SPR-4a", "SPR-4b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseSPR() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseSPR_WrongSegment(t *testing.T) {
+	if _, err := ParseSPR(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseSPR(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseSPR(seg); !errors.Is(err, ErrNotSPRSegment) {
+		t.Errorf("ParseSPR() error = %v, want ErrNotSPRSegment", err)
+	}
+}
+
+func TestSPR_RoundTrip(t *testing.T) {
+	original := &SPR{
+		QueryTag:                "SPR-1",
+		QueryResponseFormatCode: "SPR-2",
+		StoredProcedureName:     "SPR-3",
+		InputParameterList:      []string{"SPR-4a", "SPR-4b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "SPR" {
+		t.Errorf("segment name = %q, want SPR", seg.Name())
+	}
+
+	parsed, err := ParseSPR(seg)
+	if err != nil {
+		t.Fatalf("ParseSPR() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,246 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// STF represents the Staff Identification segment.
+//
+// Field positions follow the HL7 standard where STF-1 is the first field
+// after the segment name.
+type STF struct {
+	// PrimaryKeyValue is STF-1: Primary Key Value - STF (CE, conditional, max length 250).
+	PrimaryKeyValue string `hl7:"STF.1,maxlen=250"`
+
+	// StaffIdentifierList is STF-2: Staff Identifier List (CX, optional, repeating, max length 250).
+	StaffIdentifierList []string `hl7:"STF.2,maxlen=250"`
+
+	// StaffName is STF-3: Staff Name (XPN, optional, repeating, max length 250).
+	StaffName []string `hl7:"STF.3,maxlen=250"`
+
+	// StaffType is STF-4: Staff Type (IS, optional, repeating, max length 2).
+	StaffType []string `hl7:"STF.4,maxlen=2"`
+
+	// AdministrativeSex is STF-5: Administrative Sex (IS, optional, max length 1).
+	AdministrativeSex string `hl7:"STF.5,maxlen=1"`
+
+	// DateTimeOfBirth is STF-6: Date/Time of Birth (TS, optional, max length 26).
+	DateTimeOfBirth string `hl7:"STF.6,maxlen=26"`
+
+	// ActiveInactiveFlag is STF-7: Active/Inactive Flag (ID, optional, max length 1).
+	ActiveInactiveFlag string `hl7:"STF.7,maxlen=1"`
+
+	// Department is STF-8: Department (CE, optional, repeating, max length 250).
+	Department []string `hl7:"STF.8,maxlen=250"`
+
+	// HospitalService is STF-9: Hospital Service - STF (CE, optional, repeating, max length 250).
+	HospitalService []string `hl7:"STF.9,maxlen=250"`
+
+	// Phone is STF-10: Phone (XTN, optional, repeating, max length 250).
+	Phone []string `hl7:"STF.10,maxlen=250"`
+
+	// OfficeHomeAddressBirthplace is STF-11: Office/Home Address/Birthplace (XAD, optional, repeating, max length 250).
+	OfficeHomeAddressBirthplace []string `hl7:"STF.11,maxlen=250"`
+
+	// InstitutionActivationDate is STF-12: Institution Activation Date (DIN, optional, repeating, max length 276).
+	InstitutionActivationDate []string `hl7:"STF.12,maxlen=276"`
+
+	// InstitutionInactivationDate is STF-13: Institution Inactivation Date (DIN, optional, repeating, max length 276).
+	InstitutionInactivationDate []string `hl7:"STF.13,maxlen=276"`
+
+	// BackupPersonID is STF-14: Backup Person ID (CE, optional, repeating, max length 250).
+	BackupPersonID []string `hl7:"STF.14,maxlen=250"`
+
+	// EMailAddress is STF-15: E-Mail Address (ST, optional, repeating, max length 40).
+	EMailAddress []string `hl7:"STF.15,maxlen=40"`
+
+	// PreferredMethodOfContact is STF-16: Preferred Method of Contact (CE, optional, max length 250).
+	PreferredMethodOfContact string `hl7:"STF.16,maxlen=250"`
+
+	// MaritalStatus is STF-17: Marital Status (CE, optional, max length 250).
+	MaritalStatus string `hl7:"STF.17,maxlen=250"`
+
+	// JobTitle is STF-18: Job Title (ST, optional, max length 20).
+	JobTitle string `hl7:"STF.18,maxlen=20"`
+
+	// JobCodeClass is STF-19: Job Code/Class (JCC, optional, max length 20).
+	JobCodeClass string `hl7:"STF.19,maxlen=20"`
+
+	// EmploymentStatusCode is STF-20: Employment Status Code (CE, optional, max length 250).
+	EmploymentStatusCode string `hl7:"STF.20,maxlen=250"`
+
+	// AdditionalInsuredOnAuto is STF-21: Additional Insured on Auto (ID, optional, max length 1).
+	AdditionalInsuredOnAuto string `hl7:"STF.21,maxlen=1"`
+
+	// DriversLicenseNumberStaff is STF-22: Driver's License Number - Staff (DLN, optional, max length 25).
+	DriversLicenseNumberStaff string `hl7:"STF.22,maxlen=25"`
+
+	// CopyAutoIns is STF-23: Copy Auto Ins (ID, optional, max length 1).
+	CopyAutoIns string `hl7:"STF.23,maxlen=1"`
+
+	// AutoInsExpires is STF-24: Auto Ins. Expires (DT, optional, max length 8).
+	AutoInsExpires string `hl7:"STF.24,maxlen=8"`
+
+	// DateLastDMVReview is STF-25: Date Last DMV Review (DT, optional, max length 8).
+	DateLastDMVReview string `hl7:"STF.25,maxlen=8"`
+
+	// DateNextDMVReview is STF-26: Date Next DMV Review (DT, optional, max length 8).
+	DateNextDMVReview string `hl7:"STF.26,maxlen=8"`
+
+	// Race is STF-27: Race (CE, optional, max length 250).
+	Race string `hl7:"STF.27,maxlen=250"`
+
+	// EthnicGroup is STF-28: Ethnic Group (CE, optional, max length 250).
+	EthnicGroup string `hl7:"STF.28,maxlen=250"`
+
+	// ReActivationApprovalIndicator is STF-29: Re-activation Approval Indicator (ID, optional, max length 1).
+	ReActivationApprovalIndicator string `hl7:"STF.29,maxlen=1"`
+
+	// Citizenship is STF-30: Citizenship (CWE, optional, repeating, max length 250).
+	Citizenship []string `hl7:"STF.30,maxlen=250"`
+
+	// DeathDateAndTime is STF-31: Death Date and Time (TS, optional, max length 8).
+	DeathDateAndTime string `hl7:"STF.31,maxlen=8"`
+
+	// DeathIndicator is STF-32: Death Indicator (ID, optional, max length 1).
+	DeathIndicator string `hl7:"STF.32,maxlen=1"`
+
+	// InstitutionRelationshipTypeCode is STF-33: Institution Relationship Type Code (CWE, optional, max length 250).
+	InstitutionRelationshipTypeCode string `hl7:"STF.33,maxlen=250"`
+
+	// InstitutionRelationshipPeriod is STF-34: Institution Relationship Period (DR, optional, max length 52).
+	InstitutionRelationshipPeriod string `hl7:"STF.34,maxlen=52"`
+
+	// ExpectedReturnDate is STF-35: Expected Return Date (DT, optional, max length 8).
+	ExpectedReturnDate string `hl7:"STF.35,maxlen=8"`
+
+	// CostCenterCode is STF-36: Cost Center Code (CWE, optional, repeating, max length 250).
+	CostCenterCode []string `hl7:"STF.36,maxlen=250"`
+
+	// GenericClassificationIndicator is STF-37: Generic Classification Indicator (ID, optional, max length 1).
+	GenericClassificationIndicator string `hl7:"STF.37,maxlen=1"`
+
+	// InactiveReasonCode is STF-38: Inactive Reason Code (CWE, optional, max length 250).
+	InactiveReasonCode string `hl7:"STF.38,maxlen=250"`
+}
+
+// ErrNotSTFSegment indicates the segment is not an STF segment.
+var ErrNotSTFSegment = fmt.Errorf("segment is not STF")
+
+// ParseSTF extracts STF segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not an STF segment.
+func ParseSTF(seg hl7.Segment) (*STF, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "STF" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotSTFSegment, seg.Name())
+	}
+
+	stf := &STF{
+		PrimaryKeyValue:                 getFieldValue(seg, 1),
+		StaffIdentifierList:             getFieldRepetitions(seg, 2),
+		StaffName:                       getFieldRepetitions(seg, 3),
+		StaffType:                       getFieldRepetitions(seg, 4),
+		AdministrativeSex:               getFieldValue(seg, 5),
+		DateTimeOfBirth:                 getFieldValue(seg, 6),
+		ActiveInactiveFlag:              getFieldValue(seg, 7),
+		Department:                      getFieldRepetitions(seg, 8),
+		HospitalService:                 getFieldRepetitions(seg, 9),
+		Phone:                           getFieldRepetitions(seg, 10),
+		OfficeHomeAddressBirthplace:     getFieldRepetitions(seg, 11),
+		InstitutionActivationDate:       getFieldRepetitions(seg, 12),
+		InstitutionInactivationDate:     getFieldRepetitions(seg, 13),
+		BackupPersonID:                  getFieldRepetitions(seg, 14),
+		EMailAddress:                    getFieldRepetitions(seg, 15),
+		PreferredMethodOfContact:        getFieldValue(seg, 16),
+		MaritalStatus:                   getFieldValue(seg, 17),
+		JobTitle:                        getFieldValue(seg, 18),
+		JobCodeClass:                    getFieldValue(seg, 19),
+		EmploymentStatusCode:            getFieldValue(seg, 20),
+		AdditionalInsuredOnAuto:         getFieldValue(seg, 21),
+		DriversLicenseNumberStaff:       getFieldValue(seg, 22),
+		CopyAutoIns:                     getFieldValue(seg, 23),
+		AutoInsExpires:                  getFieldValue(seg, 24),
+		DateLastDMVReview:               getFieldValue(seg, 25),
+		DateNextDMVReview:               getFieldValue(seg, 26),
+		Race:                            getFieldValue(seg, 27),
+		EthnicGroup:                     getFieldValue(seg, 28),
+		ReActivationApprovalIndicator:   getFieldValue(seg, 29),
+		Citizenship:                     getFieldRepetitions(seg, 30),
+		DeathDateAndTime:                getFieldValue(seg, 31),
+		DeathIndicator:                  getFieldValue(seg, 32),
+		InstitutionRelationshipTypeCode: getFieldValue(seg, 33),
+		InstitutionRelationshipPeriod:   getFieldValue(seg, 34),
+		ExpectedReturnDate:              getFieldValue(seg, 35),
+		CostCenterCode:                  getFieldRepetitions(seg, 36),
+		GenericClassificationIndicator:  getFieldValue(seg, 37),
+		InactiveReasonCode:              getFieldValue(seg, 38),
+	}
+
+	return stf, nil
+}
+
+// ToSegment converts the STF struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (s *STF) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		s.PrimaryKeyValue,
+		joinRepetitions(s.StaffIdentifierList, delims),
+		joinRepetitions(s.StaffName, delims),
+		joinRepetitions(s.StaffType, delims),
+		s.AdministrativeSex,
+		s.DateTimeOfBirth,
+		s.ActiveInactiveFlag,
+		joinRepetitions(s.Department, delims),
+		joinRepetitions(s.HospitalService, delims),
+		joinRepetitions(s.Phone, delims),
+		joinRepetitions(s.OfficeHomeAddressBirthplace, delims),
+		joinRepetitions(s.InstitutionActivationDate, delims),
+		joinRepetitions(s.InstitutionInactivationDate, delims),
+		joinRepetitions(s.BackupPersonID, delims),
+		joinRepetitions(s.EMailAddress, delims),
+		s.PreferredMethodOfContact,
+		s.MaritalStatus,
+		s.JobTitle,
+		s.JobCodeClass,
+		s.EmploymentStatusCode,
+		s.AdditionalInsuredOnAuto,
+		s.DriversLicenseNumberStaff,
+		s.CopyAutoIns,
+		s.AutoInsExpires,
+		s.DateLastDMVReview,
+		s.DateNextDMVReview,
+		s.Race,
+		s.EthnicGroup,
+		s.ReActivationApprovalIndicator,
+		joinRepetitions(s.Citizenship, delims),
+		s.DeathDateAndTime,
+		s.DeathIndicator,
+		s.InstitutionRelationshipTypeCode,
+		s.InstitutionRelationshipPeriod,
+		s.ExpectedReturnDate,
+		joinRepetitions(s.CostCenterCode, delims),
+		s.GenericClassificationIndicator,
+		s.InactiveReasonCode,
+	}
+
+	data := buildSegmentData("STF", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create STF segment: %w", err)
+	}
+
+	return seg, nil
+}
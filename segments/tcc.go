@@ -0,0 +1,126 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// TCC represents the Test Code Configuration segment.
+//
+// Field positions follow the HL7 standard where TCC-1 is the first field
+// after the segment name.
+type TCC struct {
+	// UniversalServiceIdentifier is TCC-1: Universal Service Identifier (CE, required, max length 250).
+	UniversalServiceIdentifier string `hl7:"TCC.1,required,maxlen=250"`
+
+	// TestApplicationIdentifier is TCC-2: Test Application Identifier (EI, required, max length 80).
+	TestApplicationIdentifier string `hl7:"TCC.2,required,maxlen=80"`
+
+	// SpecimenSource is TCC-3: Specimen Source (SPS, backward compatibility, max length 300).
+	SpecimenSource string `hl7:"TCC.3,maxlen=300"`
+
+	// AutoDilutionFactorDefault is TCC-4: Auto-Dilution Factor Default (SN, optional, max length 20).
+	AutoDilutionFactorDefault string `hl7:"TCC.4,maxlen=20"`
+
+	// RerunDilutionFactorDefault is TCC-5: Rerun Dilution Factor Default (SN, optional, max length 20).
+	RerunDilutionFactorDefault string `hl7:"TCC.5,maxlen=20"`
+
+	// PreDilutionFactorDefault is TCC-6: Pre-Dilution Factor Default (SN, optional, max length 20).
+	PreDilutionFactorDefault string `hl7:"TCC.6,maxlen=20"`
+
+	// EndogenousContentOfPreDilutionDiluent is TCC-7: Endogenous Content of Pre-Dilution Diluent (SN, optional, max length 20).
+	EndogenousContentOfPreDilutionDiluent string `hl7:"TCC.7,maxlen=20"`
+
+	// InventoryLimitsWarningLevel is TCC-8: Inventory Limits Warning Level (NM, optional, max length 10).
+	InventoryLimitsWarningLevel string `hl7:"TCC.8,maxlen=10"`
+
+	// AutomaticRerunAllowed is TCC-9: Automatic Rerun Allowed (ID, optional, max length 1).
+	AutomaticRerunAllowed string `hl7:"TCC.9,maxlen=1"`
+
+	// AutomaticRepeatAllowed is TCC-10: Automatic Repeat Allowed (ID, optional, max length 1).
+	AutomaticRepeatAllowed string `hl7:"TCC.10,maxlen=1"`
+
+	// AutomaticReflexAllowed is TCC-11: Automatic Reflex Allowed (ID, optional, max length 1).
+	AutomaticReflexAllowed string `hl7:"TCC.11,maxlen=1"`
+
+	// EquipmentDynamicRange is TCC-12: Equipment Dynamic Range (SN, optional, max length 20).
+	EquipmentDynamicRange string `hl7:"TCC.12,maxlen=20"`
+
+	// Units is TCC-13: Units (CE, optional, max length 250).
+	Units string `hl7:"TCC.13,maxlen=250"`
+
+	// ProcessingType is TCC-14: Processing Type (CE, optional, max length 250).
+	ProcessingType string `hl7:"TCC.14,maxlen=250"`
+}
+
+// ErrNotTCCSegment indicates the segment is not a TCC segment.
+var ErrNotTCCSegment = fmt.Errorf("segment is not TCC")
+
+// ParseTCC extracts TCC segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a TCC segment.
+func ParseTCC(seg hl7.Segment) (*TCC, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "TCC" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotTCCSegment, seg.Name())
+	}
+
+	tcc := &TCC{
+		UniversalServiceIdentifier:            getFieldValue(seg, 1),
+		TestApplicationIdentifier:             getFieldValue(seg, 2),
+		SpecimenSource:                        getFieldValue(seg, 3),
+		AutoDilutionFactorDefault:             getFieldValue(seg, 4),
+		RerunDilutionFactorDefault:            getFieldValue(seg, 5),
+		PreDilutionFactorDefault:              getFieldValue(seg, 6),
+		EndogenousContentOfPreDilutionDiluent: getFieldValue(seg, 7),
+		InventoryLimitsWarningLevel:           getFieldValue(seg, 8),
+		AutomaticRerunAllowed:                 getFieldValue(seg, 9),
+		AutomaticRepeatAllowed:                getFieldValue(seg, 10),
+		AutomaticReflexAllowed:                getFieldValue(seg, 11),
+		EquipmentDynamicRange:                 getFieldValue(seg, 12),
+		Units:                                 getFieldValue(seg, 13),
+		ProcessingType:                        getFieldValue(seg, 14),
+	}
+
+	return tcc, nil
+}
+
+// ToSegment converts the TCC struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (t *TCC) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		t.UniversalServiceIdentifier,
+		t.TestApplicationIdentifier,
+		t.SpecimenSource,
+		t.AutoDilutionFactorDefault,
+		t.RerunDilutionFactorDefault,
+		t.PreDilutionFactorDefault,
+		t.EndogenousContentOfPreDilutionDiluent,
+		t.InventoryLimitsWarningLevel,
+		t.AutomaticRerunAllowed,
+		t.AutomaticRepeatAllowed,
+		t.AutomaticReflexAllowed,
+		t.EquipmentDynamicRange,
+		t.Units,
+		t.ProcessingType,
+	}
+
+	data := buildSegmentData("TCC", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TCC segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,94 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseTCC(t *testing.T) {
+	input := "TCC|TCC-1|TCC-2|TCC-3|TCC-4|TCC-5|TCC-6|TCC-7|TCC-8|TCC-9|TCC-10|TCC-11|TCC-12|TCC-13|TCC-14"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseTCC(seg)
+	if err != nil {
+		t.Fatalf("ParseTCC() unexpected error: %v", err)
+	}
+
+	want := TCC{
+		UniversalServiceIdentifier:            "TCC-1",
+		TestApplicationIdentifier:             "TCC-2",
+		SpecimenSource:                        "TCC-3",
+		AutoDilutionFactorDefault:             "TCC-4",
+		RerunDilutionFactorDefault:            "TCC-5",
+		PreDilutionFactorDefault:              "TCC-6",
+		EndogenousContentOfPreDilutionDiluent: "TCC-7",
+		InventoryLimitsWarningLevel:           "TCC-8",
+		AutomaticRerunAllowed:                 "TCC-9",
+		AutomaticRepeatAllowed:                "TCC-10",
+		AutomaticReflexAllowed:                "TCC-11",
+		EquipmentDynamicRange:                 "TCC-12",
+		Units:                                 "TCC-13",
+		ProcessingType:                        "TCC-14",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseTCC() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseTCC_WrongSegment(t *testing.T) {
+	if _, err := ParseTCC(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseTCC(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseTCC(seg); !errors.Is(err, ErrNotTCCSegment) {
+		t.Errorf("ParseTCC() error = %v, want ErrNotTCCSegment", err)
+	}
+}
+
+func TestTCC_RoundTrip(t *testing.T) {
+	original := &TCC{
+		UniversalServiceIdentifier:            "TCC-1",
+		TestApplicationIdentifier:             "TCC-2",
+		SpecimenSource:                        "TCC-3",
+		AutoDilutionFactorDefault:             "TCC-4",
+		RerunDilutionFactorDefault:            "TCC-5",
+		PreDilutionFactorDefault:              "TCC-6",
+		EndogenousContentOfPreDilutionDiluent: "TCC-7",
+		InventoryLimitsWarningLevel:           "TCC-8",
+		AutomaticRerunAllowed:                 "TCC-9",
+		AutomaticRepeatAllowed:                "TCC-10",
+		AutomaticReflexAllowed:                "TCC-11",
+		EquipmentDynamicRange:                 "TCC-12",
+		Units:                                 "TCC-13",
+		ProcessingType:                        "TCC-14",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "TCC" {
+		t.Errorf("segment name = %q, want TCC", seg.Name())
+	}
+
+	parsed, err := ParseTCC(seg)
+	if err != nil {
+		t.Fatalf("ParseTCC() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseTCD(t *testing.T) {
+	input := "TCD|TCD-1|TCD-2|TCD-3|TCD-4|TCD-5|TCD-6|TCD-7|TCD-8"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseTCD(seg)
+	if err != nil {
+		t.Fatalf("ParseTCD() unexpected error: %v", err)
+	}
+
+	want := TCD{
+		UniversalServiceIdentifier:            "TCD-1",
+		AutoDilutionFactor:                    "TCD-2",
+		RerunDilutionFactor:                   "TCD-3",
+		PreDilutionFactor:                     "TCD-4",
+		EndogenousContentOfPreDilutionDiluent: "TCD-5",
+		AutomaticRepeatAllowed:                "TCD-6",
+		ReflexAllowed:                         "TCD-7",
+		AnalyteRepeatStatus:                   "TCD-8",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseTCD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseTCD_WrongSegment(t *testing.T) {
+	if _, err := ParseTCD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseTCD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseTCD(seg); !errors.Is(err, ErrNotTCDSegment) {
+		t.Errorf("ParseTCD() error = %v, want ErrNotTCDSegment", err)
+	}
+}
+
+func TestTCD_RoundTrip(t *testing.T) {
+	original := &TCD{
+		UniversalServiceIdentifier:            "TCD-1",
+		AutoDilutionFactor:                    "TCD-2",
+		RerunDilutionFactor:                   "TCD-3",
+		PreDilutionFactor:                     "TCD-4",
+		EndogenousContentOfPreDilutionDiluent: "TCD-5",
+		AutomaticRepeatAllowed:                "TCD-6",
+		ReflexAllowed:                         "TCD-7",
+		AnalyteRepeatStatus:                   "TCD-8",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "TCD" {
+		t.Errorf("segment name = %q, want TCD", seg.Name())
+	}
+
+	parsed, err := ParseTCD(seg)
+	if err != nil {
+		t.Fatalf("ParseTCD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseTQ2(t *testing.T) {
+	input := "TQ2|TQ2-1|TQ2-2|TQ2-3a~TQ2-3b|TQ2-4a~TQ2-4b|TQ2-5a~TQ2-5b|TQ2-6|TQ2-7|TQ2-8|TQ2-9|TQ2-10"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseTQ2(seg)
+	if err != nil {
+		t.Fatalf("ParseTQ2() unexpected error: %v", err)
+	}
+
+	want := TQ2{
+		SetID:                             "TQ2-1",
+		SequenceResultsFlag:               "TQ2-2",
+		RelatedPlacerNumber:               []string{"TQ2-3a", "TQ2-3b"},
+		RelatedFillerNumber:               []string{"TQ2-4a", "TQ2-4b"},
+		RelatedPlacerGroupNumber:          []string{"TQ2-5a", "TQ2-5b"},
+		SequenceConditionCode:             "TQ2-6",
+		CyclicEntryExitIndicator:          "TQ2-7",
+		SequenceConditionTimeInterval:     "TQ2-8",
+		CyclicGroupMaximumNumberOfRepeats: "TQ2-9",
+		SpecialServiceRequestRelationship: "TQ2-10",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseTQ2() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseTQ2_WrongSegment(t *testing.T) {
+	if _, err := ParseTQ2(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseTQ2(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseTQ2(seg); !errors.Is(err, ErrNotTQ2Segment) {
+		t.Errorf("ParseTQ2() error = %v, want ErrNotTQ2Segment", err)
+	}
+}
+
+func TestTQ2_RoundTrip(t *testing.T) {
+	original := &TQ2{
+		SetID:                             "TQ2-1",
+		SequenceResultsFlag:               "TQ2-2",
+		RelatedPlacerNumber:               []string{"TQ2-3a", "TQ2-3b"},
+		RelatedFillerNumber:               []string{"TQ2-4a", "TQ2-4b"},
+		RelatedPlacerGroupNumber:          []string{"TQ2-5a", "TQ2-5b"},
+		SequenceConditionCode:             "TQ2-6",
+		CyclicEntryExitIndicator:          "TQ2-7",
+		SequenceConditionTimeInterval:     "TQ2-8",
+		CyclicGroupMaximumNumberOfRepeats: "TQ2-9",
+		SpecialServiceRequestRelationship: "TQ2-10",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "TQ2" {
+		t.Errorf("segment name = %q, want TQ2", seg.Name())
+	}
+
+	parsed, err := ParseTQ2(seg)
+	if err != nil {
+		t.Fatalf("ParseTQ2() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,171 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// TXA represents the Transcription Document Header segment.
+//
+// Field positions follow the HL7 standard where TXA-1 is the first field
+// after the segment name.
+type TXA struct {
+	// SetID is TXA-1: Set ID- TXA (SI, required, max length 4).
+	SetID string `hl7:"TXA.1,required,maxlen=4"`
+
+	// DocumentType is TXA-2: Document Type (IS, required, max length 30).
+	DocumentType string `hl7:"TXA.2,required,maxlen=30"`
+
+	// DocumentContentPresentation is TXA-3: Document Content Presentation (ID, conditional, max length 2).
+	DocumentContentPresentation string `hl7:"TXA.3,maxlen=2"`
+
+	// ActivityDateTime is TXA-4: Activity Date/Time (TS, optional, max length 26).
+	ActivityDateTime string `hl7:"TXA.4,maxlen=26"`
+
+	// PrimaryActivityProvider is TXA-5: Primary Activity Provider Code/Name (XCN, conditional, repeating, max length 250).
+	PrimaryActivityProvider []string `hl7:"TXA.5,maxlen=250"`
+
+	// OriginationDateTime is TXA-6: Origination Date/Time (TS, optional, max length 26).
+	OriginationDateTime string `hl7:"TXA.6,maxlen=26"`
+
+	// TranscriptionDateTime is TXA-7: Transcription Date/Time (TS, conditional, max length 26).
+	TranscriptionDateTime string `hl7:"TXA.7,maxlen=26"`
+
+	// EditDateTime is TXA-8: Edit Date/Time (TS, optional, repeating, max length 26).
+	EditDateTime []string `hl7:"TXA.8,maxlen=26"`
+
+	// Originator is TXA-9: Originator Code/Name (XCN, optional, repeating, max length 250).
+	Originator []string `hl7:"TXA.9,maxlen=250"`
+
+	// AssignedDocumentAuthenticator is TXA-10: Assigned Document Authenticator (XCN, optional, repeating, max length 250).
+	AssignedDocumentAuthenticator []string `hl7:"TXA.10,maxlen=250"`
+
+	// Transcriptionist is TXA-11: Transcriptionist Code/Name (XCN, conditional, repeating, max length 250).
+	Transcriptionist []string `hl7:"TXA.11,maxlen=250"`
+
+	// UniqueDocumentNumber is TXA-12: Unique Document Number (EI, required, max length 427).
+	UniqueDocumentNumber string `hl7:"TXA.12,required,maxlen=427"`
+
+	// ParentDocumentNumber is TXA-13: Parent Document Number (EI, conditional, max length 427).
+	ParentDocumentNumber string `hl7:"TXA.13,maxlen=427"`
+
+	// PlacerOrderNumber is TXA-14: Placer Order Number (EI, optional, repeating, max length 427).
+	PlacerOrderNumber []string `hl7:"TXA.14,maxlen=427"`
+
+	// FillerOrderNumber is TXA-15: Filler Order Number (EI, optional, max length 427).
+	FillerOrderNumber string `hl7:"TXA.15,maxlen=427"`
+
+	// UniqueDocumentFileName is TXA-16: Unique Document File Name (ST, optional, max length 30).
+	UniqueDocumentFileName string `hl7:"TXA.16,maxlen=30"`
+
+	// DocumentCompletionStatus is TXA-17: Document Completion Status (ID, required, max length 2).
+	DocumentCompletionStatus string `hl7:"TXA.17,required,maxlen=2"`
+
+	// DocumentConfidentialityStatus is TXA-18: Document Confidentiality Status (ID, optional, max length 2).
+	DocumentConfidentialityStatus string `hl7:"TXA.18,maxlen=2"`
+
+	// DocumentAvailabilityStatus is TXA-19: Document Availability Status (ID, optional, max length 2).
+	DocumentAvailabilityStatus string `hl7:"TXA.19,maxlen=2"`
+
+	// DocumentStorageStatus is TXA-20: Document Storage Status (ID, optional, max length 2).
+	DocumentStorageStatus string `hl7:"TXA.20,maxlen=2"`
+
+	// DocumentChangeReason is TXA-21: Document Change Reason (ST, conditional, max length 30).
+	DocumentChangeReason string `hl7:"TXA.21,maxlen=30"`
+
+	// AuthenticationPersonTimeStamp is TXA-22: Authentication Person, Time Stamp (PPN, conditional, repeating, max length 250).
+	AuthenticationPersonTimeStamp []string `hl7:"TXA.22,maxlen=250"`
+
+	// DistributedCopies is TXA-23: Distributed Copies (Code and Name of Recipients) (XCN, optional, repeating, max length 250).
+	DistributedCopies []string `hl7:"TXA.23,maxlen=250"`
+}
+
+// ErrNotTXASegment indicates the segment is not a TXA segment.
+var ErrNotTXASegment = fmt.Errorf("segment is not TXA")
+
+// ParseTXA extracts TXA segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a TXA segment.
+func ParseTXA(seg hl7.Segment) (*TXA, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "TXA" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotTXASegment, seg.Name())
+	}
+
+	txa := &TXA{
+		SetID:                         getFieldValue(seg, 1),
+		DocumentType:                  getFieldValue(seg, 2),
+		DocumentContentPresentation:   getFieldValue(seg, 3),
+		ActivityDateTime:              getFieldValue(seg, 4),
+		PrimaryActivityProvider:       getFieldRepetitions(seg, 5),
+		OriginationDateTime:           getFieldValue(seg, 6),
+		TranscriptionDateTime:         getFieldValue(seg, 7),
+		EditDateTime:                  getFieldRepetitions(seg, 8),
+		Originator:                    getFieldRepetitions(seg, 9),
+		AssignedDocumentAuthenticator: getFieldRepetitions(seg, 10),
+		Transcriptionist:              getFieldRepetitions(seg, 11),
+		UniqueDocumentNumber:          getFieldValue(seg, 12),
+		ParentDocumentNumber:          getFieldValue(seg, 13),
+		PlacerOrderNumber:             getFieldRepetitions(seg, 14),
+		FillerOrderNumber:             getFieldValue(seg, 15),
+		UniqueDocumentFileName:        getFieldValue(seg, 16),
+		DocumentCompletionStatus:      getFieldValue(seg, 17),
+		DocumentConfidentialityStatus: getFieldValue(seg, 18),
+		DocumentAvailabilityStatus:    getFieldValue(seg, 19),
+		DocumentStorageStatus:         getFieldValue(seg, 20),
+		DocumentChangeReason:          getFieldValue(seg, 21),
+		AuthenticationPersonTimeStamp: getFieldRepetitions(seg, 22),
+		DistributedCopies:             getFieldRepetitions(seg, 23),
+	}
+
+	return txa, nil
+}
+
+// ToSegment converts the TXA struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (t *TXA) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		t.SetID,
+		t.DocumentType,
+		t.DocumentContentPresentation,
+		t.ActivityDateTime,
+		joinRepetitions(t.PrimaryActivityProvider, delims),
+		t.OriginationDateTime,
+		t.TranscriptionDateTime,
+		joinRepetitions(t.EditDateTime, delims),
+		joinRepetitions(t.Originator, delims),
+		joinRepetitions(t.AssignedDocumentAuthenticator, delims),
+		joinRepetitions(t.Transcriptionist, delims),
+		t.UniqueDocumentNumber,
+		t.ParentDocumentNumber,
+		joinRepetitions(t.PlacerOrderNumber, delims),
+		t.FillerOrderNumber,
+		t.UniqueDocumentFileName,
+		t.DocumentCompletionStatus,
+		t.DocumentConfidentialityStatus,
+		t.DocumentAvailabilityStatus,
+		t.DocumentStorageStatus,
+		t.DocumentChangeReason,
+		joinRepetitions(t.AuthenticationPersonTimeStamp, delims),
+		joinRepetitions(t.DistributedCopies, delims),
+	}
+
+	data := buildSegmentData("TXA", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TXA segment: %w", err)
+	}
+
+	return seg, nil
+}
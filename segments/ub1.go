@@ -0,0 +1,171 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// UB1 represents the UB82 Data segment.
+//
+// Field positions follow the HL7 standard where UB1-1 is the first field
+// after the segment name.
+type UB1 struct {
+	// SetID is UB1-1: Set ID - UB1 (SI, optional, max length 4).
+	SetID string `hl7:"UB1.1,maxlen=4"`
+
+	// BloodDeductible is UB1-2: Blood Deductible (43) (NM, backward compatibility, max length 1).
+	BloodDeductible string `hl7:"UB1.2,maxlen=1"`
+
+	// BloodFurnishedPintsOf is UB1-3: Blood Furnished-Pints Of (40) (NM, optional, max length 2).
+	BloodFurnishedPintsOf string `hl7:"UB1.3,maxlen=2"`
+
+	// BloodReplacedPints is UB1-4: Blood Replaced-Pints (41) (NM, optional, max length 2).
+	BloodReplacedPints string `hl7:"UB1.4,maxlen=2"`
+
+	// BloodNotReplacedPints is UB1-5: Blood Not Replaced-Pints (42) (NM, optional, max length 2).
+	BloodNotReplacedPints string `hl7:"UB1.5,maxlen=2"`
+
+	// CoInsuranceDays is UB1-6: Co-Insurance Days (25) (NM, optional, max length 2).
+	CoInsuranceDays string `hl7:"UB1.6,maxlen=2"`
+
+	// ConditionCode is UB1-7: Condition Code (35-39) (IS, optional, repeating, max length 14).
+	ConditionCode []string `hl7:"UB1.7,maxlen=14"`
+
+	// CoveredDays is UB1-8: Covered Days (23) (NM, optional, max length 3).
+	CoveredDays string `hl7:"UB1.8,maxlen=3"`
+
+	// NonCoveredDays is UB1-9: Non Covered Days (24) (NM, optional, max length 3).
+	NonCoveredDays string `hl7:"UB1.9,maxlen=3"`
+
+	// ValueAmountAndCode is UB1-10: Value Amount and Code (46-49) (UVC, optional, repeating, max length 41).
+	ValueAmountAndCode []string `hl7:"UB1.10,maxlen=41"`
+
+	// NumberOfGraceDays is UB1-11: Number Of Grace Days (90) (NM, optional, max length 2).
+	NumberOfGraceDays string `hl7:"UB1.11,maxlen=2"`
+
+	// SpecialProgramIndicator is UB1-12: Special Program Indicator (44) (CE, optional, max length 250).
+	SpecialProgramIndicator string `hl7:"UB1.12,maxlen=250"`
+
+	// PSROURApprovalIndicator is UB1-13: PSRO/UR Approval Indicator (87) (CE, optional, max length 250).
+	PSROURApprovalIndicator string `hl7:"UB1.13,maxlen=250"`
+
+	// PSROURApprovedStayFm is UB1-14: PSRO/UR Approved Stay-Fm (88) (DT, optional, max length 8).
+	PSROURApprovedStayFm string `hl7:"UB1.14,maxlen=8"`
+
+	// PSROURApprovedStayTo is UB1-15: PSRO/UR Approved Stay-To (89) (DT, optional, max length 8).
+	PSROURApprovedStayTo string `hl7:"UB1.15,maxlen=8"`
+
+	// Occurrence is UB1-16: Occurrence (28-32) (OCD, optional, repeating, max length 259).
+	Occurrence []string `hl7:"UB1.16,maxlen=259"`
+
+	// OccurrenceSpan is UB1-17: Occurrence Span (33) (CE, optional, max length 250).
+	OccurrenceSpan string `hl7:"UB1.17,maxlen=250"`
+
+	// OccurSpanStartDate is UB1-18: Occur Span Start Date (33) (DT, optional, max length 8).
+	OccurSpanStartDate string `hl7:"UB1.18,maxlen=8"`
+
+	// OccurSpanEndDate is UB1-19: Occur Span End Date (33) (DT, optional, max length 8).
+	OccurSpanEndDate string `hl7:"UB1.19,maxlen=8"`
+
+	// UB82Locator2 is UB1-20: UB-82 Locator 2 (ST, backward compatibility, max length 30).
+	UB82Locator2 string `hl7:"UB1.20,maxlen=30"`
+
+	// UB82Locator9 is UB1-21: UB-82 Locator 9 (ST, backward compatibility, max length 7).
+	UB82Locator9 string `hl7:"UB1.21,maxlen=7"`
+
+	// UB82Locator27 is UB1-22: UB-82 Locator 27 (ST, backward compatibility, max length 8).
+	UB82Locator27 string `hl7:"UB1.22,maxlen=8"`
+
+	// UB82Locator45 is UB1-23: UB-82 Locator 45 (ST, backward compatibility, max length 17).
+	UB82Locator45 string `hl7:"UB1.23,maxlen=17"`
+}
+
+// ErrNotUB1Segment indicates the segment is not a UB1 segment.
+var ErrNotUB1Segment = fmt.Errorf("segment is not UB1")
+
+// ParseUB1 extracts UB1 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a UB1 segment.
+func ParseUB1(seg hl7.Segment) (*UB1, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "UB1" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotUB1Segment, seg.Name())
+	}
+
+	ub1 := &UB1{
+		SetID:                   getFieldValue(seg, 1),
+		BloodDeductible:         getFieldValue(seg, 2),
+		BloodFurnishedPintsOf:   getFieldValue(seg, 3),
+		BloodReplacedPints:      getFieldValue(seg, 4),
+		BloodNotReplacedPints:   getFieldValue(seg, 5),
+		CoInsuranceDays:         getFieldValue(seg, 6),
+		ConditionCode:           getFieldRepetitions(seg, 7),
+		CoveredDays:             getFieldValue(seg, 8),
+		NonCoveredDays:          getFieldValue(seg, 9),
+		ValueAmountAndCode:      getFieldRepetitions(seg, 10),
+		NumberOfGraceDays:       getFieldValue(seg, 11),
+		SpecialProgramIndicator: getFieldValue(seg, 12),
+		PSROURApprovalIndicator: getFieldValue(seg, 13),
+		PSROURApprovedStayFm:    getFieldValue(seg, 14),
+		PSROURApprovedStayTo:    getFieldValue(seg, 15),
+		Occurrence:              getFieldRepetitions(seg, 16),
+		OccurrenceSpan:          getFieldValue(seg, 17),
+		OccurSpanStartDate:      getFieldValue(seg, 18),
+		OccurSpanEndDate:        getFieldValue(seg, 19),
+		UB82Locator2:            getFieldValue(seg, 20),
+		UB82Locator9:            getFieldValue(seg, 21),
+		UB82Locator27:           getFieldValue(seg, 22),
+		UB82Locator45:           getFieldValue(seg, 23),
+	}
+
+	return ub1, nil
+}
+
+// ToSegment converts the UB1 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (u *UB1) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		u.SetID,
+		u.BloodDeductible,
+		u.BloodFurnishedPintsOf,
+		u.BloodReplacedPints,
+		u.BloodNotReplacedPints,
+		u.CoInsuranceDays,
+		joinRepetitions(u.ConditionCode, delims),
+		u.CoveredDays,
+		u.NonCoveredDays,
+		joinRepetitions(u.ValueAmountAndCode, delims),
+		u.NumberOfGraceDays,
+		u.SpecialProgramIndicator,
+		u.PSROURApprovalIndicator,
+		u.PSROURApprovedStayFm,
+		u.PSROURApprovedStayTo,
+		joinRepetitions(u.Occurrence, delims),
+		u.OccurrenceSpan,
+		u.OccurSpanStartDate,
+		u.OccurSpanEndDate,
+		u.UB82Locator2,
+		u.UB82Locator9,
+		u.UB82Locator27,
+		u.UB82Locator45,
+	}
+
+	data := buildSegmentData("UB1", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create UB1 segment: %w", err)
+	}
+
+	return seg, nil
+}
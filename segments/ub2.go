@@ -0,0 +1,141 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// UB2 represents the UB92 Data segment.
+//
+// Field positions follow the HL7 standard where UB2-1 is the first field
+// after the segment name.
+type UB2 struct {
+	// SetID is UB2-1: Set ID - UB2 (SI, optional, max length 4).
+	SetID string `hl7:"UB2.1,maxlen=4"`
+
+	// CoInsuranceDays is UB2-2: Co-Insurance Days (9) (ST, optional, max length 3).
+	CoInsuranceDays string `hl7:"UB2.2,maxlen=3"`
+
+	// ConditionCode is UB2-3: Condition Code (24-30) (IS, optional, repeating, max length 2).
+	ConditionCode []string `hl7:"UB2.3,maxlen=2"`
+
+	// CoveredDays is UB2-4: Covered Days (7) (ST, optional, max length 3).
+	CoveredDays string `hl7:"UB2.4,maxlen=3"`
+
+	// NonCoveredDays is UB2-5: Non-Covered Days (8) (ST, optional, max length 4).
+	NonCoveredDays string `hl7:"UB2.5,maxlen=4"`
+
+	// ValueAmountAndCode is UB2-6: Value Amount and Code (39-41) (UVC, optional, repeating, max length 41).
+	ValueAmountAndCode []string `hl7:"UB2.6,maxlen=41"`
+
+	// OccurrenceCodeAndDate is UB2-7: Occurrence Code and Date (32-35) (OCD, optional, repeating, max length 259).
+	OccurrenceCodeAndDate []string `hl7:"UB2.7,maxlen=259"`
+
+	// OccurrenceSpanCodeDates is UB2-8: Occurrence Span Code/Dates (36) (OSP, optional, repeating, max length 268).
+	OccurrenceSpanCodeDates []string `hl7:"UB2.8,maxlen=268"`
+
+	// UB92Locator2 is UB2-9: UB92 Locator 2 (State) (ST, optional, repeating, max length 29).
+	UB92Locator2 []string `hl7:"UB2.9,maxlen=29"`
+
+	// UB92Locator11 is UB2-10: UB92 Locator 11 (State) (ST, optional, repeating, max length 12).
+	UB92Locator11 []string `hl7:"UB2.10,maxlen=12"`
+
+	// UB92Locator31 is UB2-11: UB92 Locator 31 (National) (ST, optional, max length 5).
+	UB92Locator31 string `hl7:"UB2.11,maxlen=5"`
+
+	// DocumentControlNumber is UB2-12: Document Control Number (ST, optional, repeating, max length 23).
+	DocumentControlNumber []string `hl7:"UB2.12,maxlen=23"`
+
+	// UB92Locator49 is UB2-13: UB92 Locator 49 (National) (ST, optional, repeating, max length 4).
+	UB92Locator49 []string `hl7:"UB2.13,maxlen=4"`
+
+	// UB92Locator56 is UB2-14: UB92 Locator 56 (State) (ST, optional, repeating, max length 14).
+	UB92Locator56 []string `hl7:"UB2.14,maxlen=14"`
+
+	// UB92Locator57 is UB2-15: UB92 Locator 57 (National) (ST, optional, max length 27).
+	UB92Locator57 string `hl7:"UB2.15,maxlen=27"`
+
+	// UB92Locator78 is UB2-16: UB92 Locator 78 (State) (ST, optional, repeating, max length 2).
+	UB92Locator78 []string `hl7:"UB2.16,maxlen=2"`
+
+	// SpecialVisitCount is UB2-17: Special Visit Count (NM, optional, max length 3).
+	SpecialVisitCount string `hl7:"UB2.17,maxlen=3"`
+}
+
+// ErrNotUB2Segment indicates the segment is not a UB2 segment.
+var ErrNotUB2Segment = fmt.Errorf("segment is not UB2")
+
+// ParseUB2 extracts UB2 segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a UB2 segment.
+func ParseUB2(seg hl7.Segment) (*UB2, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "UB2" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotUB2Segment, seg.Name())
+	}
+
+	ub2 := &UB2{
+		SetID:                   getFieldValue(seg, 1),
+		CoInsuranceDays:         getFieldValue(seg, 2),
+		ConditionCode:           getFieldRepetitions(seg, 3),
+		CoveredDays:             getFieldValue(seg, 4),
+		NonCoveredDays:          getFieldValue(seg, 5),
+		ValueAmountAndCode:      getFieldRepetitions(seg, 6),
+		OccurrenceCodeAndDate:   getFieldRepetitions(seg, 7),
+		OccurrenceSpanCodeDates: getFieldRepetitions(seg, 8),
+		UB92Locator2:            getFieldRepetitions(seg, 9),
+		UB92Locator11:           getFieldRepetitions(seg, 10),
+		UB92Locator31:           getFieldValue(seg, 11),
+		DocumentControlNumber:   getFieldRepetitions(seg, 12),
+		UB92Locator49:           getFieldRepetitions(seg, 13),
+		UB92Locator56:           getFieldRepetitions(seg, 14),
+		UB92Locator57:           getFieldValue(seg, 15),
+		UB92Locator78:           getFieldRepetitions(seg, 16),
+		SpecialVisitCount:       getFieldValue(seg, 17),
+	}
+
+	return ub2, nil
+}
+
+// ToSegment converts the UB2 struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (u *UB2) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		u.SetID,
+		u.CoInsuranceDays,
+		joinRepetitions(u.ConditionCode, delims),
+		u.CoveredDays,
+		u.NonCoveredDays,
+		joinRepetitions(u.ValueAmountAndCode, delims),
+		joinRepetitions(u.OccurrenceCodeAndDate, delims),
+		joinRepetitions(u.OccurrenceSpanCodeDates, delims),
+		joinRepetitions(u.UB92Locator2, delims),
+		joinRepetitions(u.UB92Locator11, delims),
+		u.UB92Locator31,
+		joinRepetitions(u.DocumentControlNumber, delims),
+		joinRepetitions(u.UB92Locator49, delims),
+		joinRepetitions(u.UB92Locator56, delims),
+		u.UB92Locator57,
+		joinRepetitions(u.UB92Locator78, delims),
+		u.SpecialVisitCount,
+	}
+
+	data := buildSegmentData("UB2", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create UB2 segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,100 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseUB2(t *testing.T) {
+	input := "UB2|UB2-1|UB2-2|UB2-3a~UB2-3b|UB2-4|UB2-5|UB2-6a~UB2-6b|UB2-7a~UB2-7b|UB2-8a~UB2-8b|UB2-9a~UB2-9b|UB2-10a~UB2-10b|UB2-11|UB2-12a~UB2-12b|UB2-13a~UB2-13b|UB2-14a~UB2-14b|UB2-15|UB2-16a~UB2-16b|UB2-17"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseUB2(seg)
+	if err != nil {
+		t.Fatalf("ParseUB2() unexpected error: %v", err)
+	}
+
+	want := UB2{
+		SetID:                   "UB2-1",
+		CoInsuranceDays:         "UB2-2",
+		ConditionCode:           []string{"UB2-3a", "UB2-3b"},
+		CoveredDays:             "UB2-4",
+		NonCoveredDays:          "UB2-5",
+		ValueAmountAndCode:      []string{"UB2-6a", "UB2-6b"},
+		OccurrenceCodeAndDate:   []string{"UB2-7a", "UB2-7b"},
+		OccurrenceSpanCodeDates: []string{"UB2-8a", "UB2-8b"},
+		UB92Locator2:            []string{"UB2-9a", "UB2-9b"},
+		UB92Locator11:           []string{"UB2-10a", "UB2-10b"},
+		UB92Locator31:           "UB2-11",
+		DocumentControlNumber:   []string{"UB2-12a", "UB2-12b"},
+		UB92Locator49:           []string{"UB2-13a", "UB2-13b"},
+		UB92Locator56:           []string{"UB2-14a", "UB2-14b"},
+		UB92Locator57:           "UB2-15",
+		UB92Locator78:           []string{"UB2-16a", "UB2-16b"},
+		SpecialVisitCount:       "UB2-17",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseUB2() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseUB2_WrongSegment(t *testing.T) {
+	if _, err := ParseUB2(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseUB2(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseUB2(seg); !errors.Is(err, ErrNotUB2Segment) {
+		t.Errorf("ParseUB2() error = %v, want ErrNotUB2Segment", err)
+	}
+}
+
+func TestUB2_RoundTrip(t *testing.T) {
+	original := &UB2{
+		SetID:                   "UB2-1",
+		CoInsuranceDays:         "UB2-2",
+		ConditionCode:           []string{"UB2-3a", "UB2-3b"},
+		CoveredDays:             "UB2-4",
+		NonCoveredDays:          "UB2-5",
+		ValueAmountAndCode:      []string{"UB2-6a", "UB2-6b"},
+		OccurrenceCodeAndDate:   []string{"UB2-7a", "UB2-7b"},
+		OccurrenceSpanCodeDates: []string{"UB2-8a", "UB2-8b"},
+		UB92Locator2:            []string{"UB2-9a", "UB2-9b"},
+		UB92Locator11:           []string{"UB2-10a", "UB2-10b"},
+		UB92Locator31:           "UB2-11",
+		DocumentControlNumber:   []string{"UB2-12a", "UB2-12b"},
+		UB92Locator49:           []string{"UB2-13a", "UB2-13b"},
+		UB92Locator56:           []string{"UB2-14a", "UB2-14b"},
+		UB92Locator57:           "UB2-15",
+		UB92Locator78:           []string{"UB2-16a", "UB2-16b"},
+		SpecialVisitCount:       "UB2-17",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "UB2" {
+		t.Errorf("segment name = %q, want UB2", seg.Name())
+	}
+
+	parsed, err := ParseUB2(seg)
+	if err != nil {
+		t.Fatalf("ParseUB2() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
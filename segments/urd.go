@@ -0,0 +1,91 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// URD represents the Results/update Definition segment.
+//
+// Field positions follow the HL7 standard where URD-1 is the first field
+// after the segment name.
+type URD struct {
+	// RUDateTime is URD-1: R/U Date/Time (TS, optional, max length 26).
+	RUDateTime string `hl7:"URD.1,maxlen=26"`
+
+	// ReportPriority is URD-2: Report Priority (ID, optional, max length 1).
+	ReportPriority string `hl7:"URD.2,maxlen=1"`
+
+	// RUWhoSubjectDefinition is URD-3: R/U Who Subject Definition (XCN, required, repeating, max length 250).
+	RUWhoSubjectDefinition []string `hl7:"URD.3,required,maxlen=250"`
+
+	// RUWhatSubjectDefinition is URD-4: R/U What Subject Definition (CE, optional, repeating, max length 250).
+	RUWhatSubjectDefinition []string `hl7:"URD.4,maxlen=250"`
+
+	// RUWhatDepartmentCode is URD-5: R/U What Department Code (CE, optional, repeating, max length 250).
+	RUWhatDepartmentCode []string `hl7:"URD.5,maxlen=250"`
+
+	// RUDisplayPrintLocations is URD-6: R/U Display/Print Locations (ST, optional, repeating, max length 20).
+	RUDisplayPrintLocations []string `hl7:"URD.6,maxlen=20"`
+
+	// RUResultsLevel is URD-7: R/U Results Level (ID, optional, max length 1).
+	RUResultsLevel string `hl7:"URD.7,maxlen=1"`
+}
+
+// ErrNotURDSegment indicates the segment is not a URD segment.
+var ErrNotURDSegment = fmt.Errorf("segment is not URD")
+
+// ParseURD extracts URD segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a URD segment.
+func ParseURD(seg hl7.Segment) (*URD, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "URD" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotURDSegment, seg.Name())
+	}
+
+	urd := &URD{
+		RUDateTime:              getFieldValue(seg, 1),
+		ReportPriority:          getFieldValue(seg, 2),
+		RUWhoSubjectDefinition:  getFieldRepetitions(seg, 3),
+		RUWhatSubjectDefinition: getFieldRepetitions(seg, 4),
+		RUWhatDepartmentCode:    getFieldRepetitions(seg, 5),
+		RUDisplayPrintLocations: getFieldRepetitions(seg, 6),
+		RUResultsLevel:          getFieldValue(seg, 7),
+	}
+
+	return urd, nil
+}
+
+// ToSegment converts the URD struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (u *URD) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		u.RUDateTime,
+		u.ReportPriority,
+		joinRepetitions(u.RUWhoSubjectDefinition, delims),
+		joinRepetitions(u.RUWhatSubjectDefinition, delims),
+		joinRepetitions(u.RUWhatDepartmentCode, delims),
+		joinRepetitions(u.RUDisplayPrintLocations, delims),
+		u.RUResultsLevel,
+	}
+
+	data := buildSegmentData("URD", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create URD segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,80 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseURD(t *testing.T) {
+	input := "URD|URD-1|URD-2|URD-3a~URD-3b|URD-4a~URD-4b|URD-5a~URD-5b|URD-6a~URD-6b|URD-7"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseURD(seg)
+	if err != nil {
+		t.Fatalf("ParseURD() unexpected error: %v", err)
+	}
+
+	want := URD{
+		RUDateTime:              "URD-1",
+		ReportPriority:          "URD-2",
+		RUWhoSubjectDefinition:  []string{"URD-3a", "URD-3b"},
+		RUWhatSubjectDefinition: []string{"URD-4a", "URD-4b"},
+		RUWhatDepartmentCode:    []string{"URD-5a", "URD-5b"},
+		RUDisplayPrintLocations: []string{"URD-6a", "URD-6b"},
+		RUResultsLevel:          "URD-7",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseURD() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseURD_WrongSegment(t *testing.T) {
+	if _, err := ParseURD(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseURD(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseURD(seg); !errors.Is(err, ErrNotURDSegment) {
+		t.Errorf("ParseURD() error = %v, want ErrNotURDSegment", err)
+	}
+}
+
+func TestURD_RoundTrip(t *testing.T) {
+	original := &URD{
+		RUDateTime:              "URD-1",
+		ReportPriority:          "URD-2",
+		RUWhoSubjectDefinition:  []string{"URD-3a", "URD-3b"},
+		RUWhatSubjectDefinition: []string{"URD-4a", "URD-4b"},
+		RUWhatDepartmentCode:    []string{"URD-5a", "URD-5b"},
+		RUDisplayPrintLocations: []string{"URD-6a", "URD-6b"},
+		RUResultsLevel:          "URD-7",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "URD" {
+		t.Errorf("segment name = %q, want URD", seg.Name())
+	}
+
+	parsed, err := ParseURD(seg)
+	if err != nil {
+		t.Fatalf("ParseURD() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
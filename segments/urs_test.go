@@ -0,0 +1,84 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseURS(t *testing.T) {
+	input := "URS|URS-1a~URS-1b|URS-2|URS-3|URS-4a~URS-4b|URS-5a~URS-5b|URS-6a~URS-6b|URS-7a~URS-7b|URS-8a~URS-8b|URS-9"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseURS(seg)
+	if err != nil {
+		t.Fatalf("ParseURS() unexpected error: %v", err)
+	}
+
+	want := URS{
+		RUWhereSubjectDefinition:        []string{"URS-1a", "URS-1b"},
+		RUWhenDataStartDateTime:         "URS-2",
+		RUWhenDataEndDateTime:           "URS-3",
+		RUWhatUserQualifier:             []string{"URS-4a", "URS-4b"},
+		RUOtherResultsSubjectDefinition: []string{"URS-5a", "URS-5b"},
+		RUWhichDateTimeQualifier:        []string{"URS-6a", "URS-6b"},
+		RUWhichDateTimeStatusQualifier:  []string{"URS-7a", "URS-7b"},
+		RUDateTimeSelectionQualifier:    []string{"URS-8a", "URS-8b"},
+		RUQuantityTimingQualifier:       "URS-9",
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseURS() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseURS_WrongSegment(t *testing.T) {
+	if _, err := ParseURS(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseURS(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseURS(seg); !errors.Is(err, ErrNotURSSegment) {
+		t.Errorf("ParseURS() error = %v, want ErrNotURSSegment", err)
+	}
+}
+
+func TestURS_RoundTrip(t *testing.T) {
+	original := &URS{
+		RUWhereSubjectDefinition:        []string{"URS-1a", "URS-1b"},
+		RUWhenDataStartDateTime:         "URS-2",
+		RUWhenDataEndDateTime:           "URS-3",
+		RUWhatUserQualifier:             []string{"URS-4a", "URS-4b"},
+		RUOtherResultsSubjectDefinition: []string{"URS-5a", "URS-5b"},
+		RUWhichDateTimeQualifier:        []string{"URS-6a", "URS-6b"},
+		RUWhichDateTimeStatusQualifier:  []string{"URS-7a", "URS-7b"},
+		RUDateTimeSelectionQualifier:    []string{"URS-8a", "URS-8b"},
+		RUQuantityTimingQualifier:       "URS-9",
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "URS" {
+		t.Errorf("segment name = %q, want URS", seg.Name())
+	}
+
+	parsed, err := ParseURS(seg)
+	if err != nil {
+		t.Fatalf("ParseURS() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
@@ -0,0 +1,86 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// VAR represents the Variance segment.
+//
+// Field positions follow the HL7 standard where VAR-1 is the first field
+// after the segment name.
+type VAR struct {
+	// VarianceInstanceID is VAR-1: Variance Instance ID (EI, required, max length 60).
+	VarianceInstanceID string `hl7:"VAR.1,required,maxlen=60"`
+
+	// DocumentedDateTime is VAR-2: Documented Date/Time (TS, required, max length 26).
+	DocumentedDateTime string `hl7:"VAR.2,required,maxlen=26"`
+
+	// StatedVarianceDateTime is VAR-3: Stated Variance Date/Time (TS, optional, max length 26).
+	StatedVarianceDateTime string `hl7:"VAR.3,maxlen=26"`
+
+	// VarianceOriginator is VAR-4: Variance Originator (XCN, optional, repeating, max length 250).
+	VarianceOriginator []string `hl7:"VAR.4,maxlen=250"`
+
+	// VarianceClassification is VAR-5: Variance Classification (CE, optional, max length 250).
+	VarianceClassification string `hl7:"VAR.5,maxlen=250"`
+
+	// VarianceDescription is VAR-6: Variance Description (ST, optional, repeating, max length 512).
+	VarianceDescription []string `hl7:"VAR.6,maxlen=512"`
+}
+
+// ErrNotVARSegment indicates the segment is not a VAR segment.
+var ErrNotVARSegment = fmt.Errorf("segment is not VAR")
+
+// ParseVAR extracts VAR segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a VAR segment.
+func ParseVAR(seg hl7.Segment) (*VAR, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "VAR" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotVARSegment, seg.Name())
+	}
+
+	varSeg := &VAR{
+		VarianceInstanceID:     getFieldValue(seg, 1),
+		DocumentedDateTime:     getFieldValue(seg, 2),
+		StatedVarianceDateTime: getFieldValue(seg, 3),
+		VarianceOriginator:     getFieldRepetitions(seg, 4),
+		VarianceClassification: getFieldValue(seg, 5),
+		VarianceDescription:    getFieldRepetitions(seg, 6),
+	}
+
+	return varSeg, nil
+}
+
+// ToSegment converts the VAR struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (v *VAR) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		v.VarianceInstanceID,
+		v.DocumentedDateTime,
+		v.StatedVarianceDateTime,
+		joinRepetitions(v.VarianceOriginator, delims),
+		v.VarianceClassification,
+		joinRepetitions(v.VarianceDescription, delims),
+	}
+
+	data := buildSegmentData("VAR", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create VAR segment: %w", err)
+	}
+
+	return seg, nil
+}
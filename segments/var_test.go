@@ -0,0 +1,78 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseVAR(t *testing.T) {
+	input := "VAR|VAR-1|VAR-2|VAR-3|VAR-4a~VAR-4b|VAR-5|VAR-6a~VAR-6b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseVAR(seg)
+	if err != nil {
+		t.Fatalf("ParseVAR() unexpected error: %v", err)
+	}
+
+	want := VAR{
+		VarianceInstanceID:     "VAR-1",
+		DocumentedDateTime:     "VAR-2",
+		StatedVarianceDateTime: "VAR-3",
+		VarianceOriginator:     []string{"VAR-4a", "VAR-4b"},
+		VarianceClassification: "VAR-5",
+		VarianceDescription:    []string{"VAR-6a", "VAR-6b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseVAR() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseVAR_WrongSegment(t *testing.T) {
+	if _, err := ParseVAR(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseVAR(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseVAR(seg); !errors.Is(err, ErrNotVARSegment) {
+		t.Errorf("ParseVAR() error = %v, want ErrNotVARSegment", err)
+	}
+}
+
+func TestVAR_RoundTrip(t *testing.T) {
+	original := &VAR{
+		VarianceInstanceID:     "VAR-1",
+		DocumentedDateTime:     "VAR-2",
+		StatedVarianceDateTime: "VAR-3",
+		VarianceOriginator:     []string{"VAR-4a", "VAR-4b"},
+		VarianceClassification: "VAR-5",
+		VarianceDescription:    []string{"VAR-6a", "VAR-6b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "VAR" {
+		t.Errorf("segment name = %q, want VAR", seg.Name())
+	}
+
+	parsed, err := ParseVAR(seg)
+	if err != nil {
+		t.Fatalf("ParseVAR() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
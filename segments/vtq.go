@@ -0,0 +1,81 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// VTQ represents the Virtual Table Query Request segment.
+//
+// Field positions follow the HL7 standard where VTQ-1 is the first field
+// after the segment name.
+type VTQ struct {
+	// QueryTag is VTQ-1: Query Tag (ST, optional, max length 32).
+	QueryTag string `hl7:"VTQ.1,maxlen=32"`
+
+	// QueryResponseFormatCode is VTQ-2: Query/Response Format Code (ID, required, max length 1).
+	QueryResponseFormatCode string `hl7:"VTQ.2,required,maxlen=1"`
+
+	// VTQueryName is VTQ-3: VT Query Name (CE, required, max length 250).
+	VTQueryName string `hl7:"VTQ.3,required,maxlen=250"`
+
+	// VirtualTableName is VTQ-4: Virtual Table Name (CE, required, max length 250).
+	VirtualTableName string `hl7:"VTQ.4,required,maxlen=250"`
+
+	// SelectionCriteria is VTQ-5: Selection Criteria (QSC, optional, repeating, max length 256).
+	SelectionCriteria []string `hl7:"VTQ.5,maxlen=256"`
+}
+
+// ErrNotVTQSegment indicates the segment is not a VTQ segment.
+var ErrNotVTQSegment = fmt.Errorf("segment is not VTQ")
+
+// ParseVTQ extracts VTQ segment data from an hl7.Segment.
+// Returns an error if the segment is nil or not a VTQ segment.
+func ParseVTQ(seg hl7.Segment) (*VTQ, error) {
+	if seg == nil {
+		return nil, ErrNilSegment
+	}
+
+	if seg.Name() != "VTQ" {
+		return nil, fmt.Errorf("%w: got %s", ErrNotVTQSegment, seg.Name())
+	}
+
+	vtq := &VTQ{
+		QueryTag:                getFieldValue(seg, 1),
+		QueryResponseFormatCode: getFieldValue(seg, 2),
+		VTQueryName:             getFieldValue(seg, 3),
+		VirtualTableName:        getFieldValue(seg, 4),
+		SelectionCriteria:       getFieldRepetitions(seg, 5),
+	}
+
+	return vtq, nil
+}
+
+// ToSegment converts the VTQ struct into an hl7.Segment.
+// The delims parameter specifies the delimiters to use for encoding.
+// If delims is nil, default delimiters are used.
+func (v *VTQ) ToSegment(delims *hl7.Delimiters) (hl7.Segment, error) {
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	fields := []string{
+		v.QueryTag,
+		v.QueryResponseFormatCode,
+		v.VTQueryName,
+		v.VirtualTableName,
+		joinRepetitions(v.SelectionCriteria, delims),
+	}
+
+	data := buildSegmentData("VTQ", fields, delims)
+
+	seg, err := hl7.ParseSegment([]rune(data), delims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create VTQ segment: %w", err)
+	}
+
+	return seg, nil
+}
@@ -0,0 +1,76 @@
+// Code generated by segmentgen from defs/v2.5.1.txt. DO NOT EDIT.
+
+package segments
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestParseVTQ(t *testing.T) {
+	input := "VTQ|VTQ-1|VTQ-2|VTQ-3|VTQ-4|VTQ-5a~VTQ-5b"
+	seg, err := hl7.ParseSegment([]rune(input), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	got, err := ParseVTQ(seg)
+	if err != nil {
+		t.Fatalf("ParseVTQ() unexpected error: %v", err)
+	}
+
+	want := VTQ{
+		QueryTag:                "VTQ-1",
+		QueryResponseFormatCode: "VTQ-2",
+		VTQueryName:             "VTQ-3",
+		VirtualTableName:        "VTQ-4",
+		SelectionCriteria:       []string{"VTQ-5a", "VTQ-5b"},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("ParseVTQ() = %+v, want %+v", *got, want)
+	}
+}
+
+func TestParseVTQ_WrongSegment(t *testing.T) {
+	if _, err := ParseVTQ(nil); !errors.Is(err, ErrNilSegment) {
+		t.Errorf("ParseVTQ(nil) error = %v, want ErrNilSegment", err)
+	}
+
+	seg, err := hl7.ParseSegment([]rune("ZZZ|1"), hl7.DefaultDelimiters())
+	if err != nil {
+		t.Fatalf("failed to parse segment: %v", err)
+	}
+
+	if _, err := ParseVTQ(seg); !errors.Is(err, ErrNotVTQSegment) {
+		t.Errorf("ParseVTQ() error = %v, want ErrNotVTQSegment", err)
+	}
+}
+
+func TestVTQ_RoundTrip(t *testing.T) {
+	original := &VTQ{
+		QueryTag:                "VTQ-1",
+		QueryResponseFormatCode: "VTQ-2",
+		VTQueryName:             "VTQ-3",
+		VirtualTableName:        "VTQ-4",
+		SelectionCriteria:       []string{"VTQ-5a", "VTQ-5b"},
+	}
+
+	seg, err := original.ToSegment(nil)
+	if err != nil {
+		t.Fatalf("ToSegment() error: %v", err)
+	}
+	if seg.Name() != "VTQ" {
+		t.Errorf("segment name = %q, want VTQ", seg.Name())
+	}
+
+	parsed, err := ParseVTQ(seg)
+	if err != nil {
+		t.Fatalf("ParseVTQ() error: %v", err)
+	}
+	if !reflect.DeepEqual(*parsed, *original) {
+		t.Errorf("round trip = %+v, want %+v", *parsed, *original)
+	}
+}
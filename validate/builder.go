@@ -2,6 +2,7 @@ package validate
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/dshills/golevel7/hl7"
 )
@@ -21,8 +22,51 @@ type RuleBuilder interface {
 	OneOf(values ...string) RuleBuilder
 	// Custom adds a custom validation function.
 	Custom(fn func(value string) error) RuleBuilder
+	// Type adds a requirement that the field value conforms to an HL7 data type
+	// such as "DTM", "NM" or "CX".
+	Type(dataType string) RuleBuilder
+	// Table adds a requirement that the field value is a code of an HL7
+	// table such as "0001".
+	Table(table string) RuleBuilder
+	// TableFrom adds a requirement that the field value is a code of a table
+	// supplied by provider.
+	TableFrom(provider TableProvider, table string) RuleBuilder
+	// Compare adds a requirement that the field value compares to the value at
+	// another location using op. Numbers are compared numerically.
+	Compare(op Comparison, other string) RuleBuilder
+	// Before adds a requirement that the field date/time is before the one at
+	// another location.
+	Before(other string) RuleBuilder
+	// After adds a requirement that the field date/time is after the one at
+	// another location.
+	After(other string) RuleBuilder
+	// MutuallyExclusive adds a requirement that at most one of the field and
+	// the other locations is present.
+	MutuallyExclusive(others ...string) RuleBuilder
+	// AtLeastOneOf adds a requirement that at least one of the field and the
+	// other locations is present.
+	AtLeastOneOf(others ...string) RuleBuilder
+	// MinRepeats adds a requirement that the field repeats at least n times,
+	// or that a segment location occurs at least n times.
+	MinRepeats(n int) RuleBuilder
+	// MaxRepeats adds a requirement that the field repeats at most n times,
+	// or that a segment location occurs at most n times.
+	MaxRepeats(n int) RuleBuilder
+	// Each applies the built rule to every instance of the segment and every
+	// repetition of the field instead of only the first.
+	Each() RuleBuilder
+	// When restricts the built rule to messages where the value at location
+	// satisfies the predicate. Multiple conditions must all hold.
+	When(location string, predicate Predicate) RuleBuilder
 	// WithDescription sets a custom description for the rule.
 	WithDescription(desc string) RuleBuilder
+	// AsWarning reports failures of the built rule as warnings, which do not
+	// make a validation result invalid.
+	AsWarning() RuleBuilder
+	// AsInfo reports failures of the built rule as informational issues.
+	AsInfo() RuleBuilder
+	// Severity sets the severity of failures of the built rule.
+	Severity(severity hl7.Severity) RuleBuilder
 	// Build constructs the final Rule from the builder configuration.
 	Build() Rule
 }
@@ -32,6 +76,9 @@ type ruleBuilder struct {
 	location    string
 	description string
 	rules       []Rule
+	conditions  []condition
+	severity    hl7.Severity
+	each        bool
 }
 
 // At creates a new RuleBuilder for the specified HL7 location.
@@ -109,16 +156,209 @@ func (b *ruleBuilder) Custom(fn func(value string) error) RuleBuilder {
 	return b
 }
 
+// Type adds a requirement that the field value conforms to an HL7 data type.
+// Primitive types (ST, TX, FT, ID, IS, NM, SI, DT, TM, DTM) are checked for
+// format; DTM accepts any HL7 precision with optional fractional seconds and
+// time zone offset. Composite types (CE, CNE, CQ, CWE, CX, DR, EI, EIP, FN, HD,
+// MSG, PL, PT, SAD, SN, TS, VID, XAD, XCN, XON, XPN, XTN) are checked for
+// component counts, component lengths and component types. Each repetition is
+// checked; empty values pass. Failures carry error code 102 (data type error).
+//
+// Example:
+//
+//	At("PID.7").Type("DTM").Build()
+//	At("PID.3").Type("CX").Build()
+func (b *ruleBuilder) Type(dataType string) RuleBuilder {
+	b.rules = append(b.rules, &typeRule{
+		location: b.location,
+		dataType: strings.ToUpper(dataType),
+	})
+	return b
+}
+
+// Table adds a requirement that the field value is a code of an HL7 table
+// shipped with the package (see HL7Tables). Empty values pass. Failures carry
+// error code 103 (table value not found).
+//
+// Example:
+//
+//	At("PID.8").Table("0001").Build()   // Administrative Sex
+//	At("OBX.11").Table("0085").Build()  // Observation Result Status
+func (b *ruleBuilder) Table(table string) RuleBuilder {
+	return b.TableFrom(nil, table)
+}
+
+// TableFrom adds a requirement that the field value is a code of a table
+// supplied by provider, such as user tables loaded with LoadTablesCSV or
+// LoadTablesJSON. A nil provider uses the HL7 tables.
+func (b *ruleBuilder) TableFrom(provider TableProvider, table string) RuleBuilder {
+	b.rules = append(b.rules, &tableRule{
+		location: b.location,
+		table:    table,
+		provider: provider,
+	})
+	return b
+}
+
+// Compare adds a requirement that the field value compares to the value at
+// another location using op. Values are compared numerically when both are
+// numbers and lexically otherwise. The rule passes if either value is empty.
+func (b *ruleBuilder) Compare(op Comparison, other string) RuleBuilder {
+	b.rules = append(b.rules, &compareRule{
+		location: b.location,
+		other:    other,
+		op:       op,
+	})
+	return b
+}
+
+// Before adds a requirement that the field date/time is strictly before the
+// one at another location. Values are HL7 DTM strings; the rule passes if
+// either value is empty and fails if either is not a valid date/time.
+func (b *ruleBuilder) Before(other string) RuleBuilder {
+	b.rules = append(b.rules, &dateOrderRule{
+		location: b.location,
+		other:    other,
+	})
+	return b
+}
+
+// After adds a requirement that the field date/time is strictly after the
+// one at another location. Values are HL7 DTM strings; the rule passes if
+// either value is empty and fails if either is not a valid date/time.
+func (b *ruleBuilder) After(other string) RuleBuilder {
+	b.rules = append(b.rules, &dateOrderRule{
+		location: b.location,
+		other:    other,
+		after:    true,
+	})
+	return b
+}
+
+// MutuallyExclusive adds a requirement that at most one of the field and
+// the other locations is present.
+func (b *ruleBuilder) MutuallyExclusive(others ...string) RuleBuilder {
+	b.rules = append(b.rules, &mutuallyExclusiveRule{
+		location: b.location,
+		others:   others,
+	})
+	return b
+}
+
+// AtLeastOneOf adds a requirement that at least one of the field and the
+// other locations is present.
+func (b *ruleBuilder) AtLeastOneOf(others ...string) RuleBuilder {
+	b.rules = append(b.rules, &atLeastOneOfRule{
+		location: b.location,
+		others:   others,
+	})
+	return b
+}
+
+// MinRepeats adds a requirement that the field repeats at least n times in
+// the first matching segment, or in every segment with Each. For a segment
+// location such as "OBX", the segment must occur at least n times. Empty
+// fields count as zero repetitions.
+//
+// Example:
+//
+//	At("PID.3").MinRepeats(1).MaxRepeats(3).Build()
+//	At("OBX").MinRepeats(1).Build()
+func (b *ruleBuilder) MinRepeats(n int) RuleBuilder {
+	b.rules = append(b.rules, &repetitionCountRule{
+		location: b.location,
+		min:      n,
+		max:      -1,
+	})
+	return b
+}
+
+// MaxRepeats adds a requirement that the field repeats at most n times in
+// the first matching segment, or in every segment with Each. For a segment
+// location, the segment must occur at most n times.
+func (b *ruleBuilder) MaxRepeats(n int) RuleBuilder {
+	b.rules = append(b.rules, &repetitionCountRule{
+		location: b.location,
+		max:      n,
+	})
+	return b
+}
+
+// Each applies the built rule to every instance of the segment and every
+// repetition of the field, instead of the first value returned by Get.
+// Failures report the concrete location, e.g. "OBX[2].5[0]" or
+// "PID[0].3[1].1". Repetition counts are checked once per segment instance.
+// Conditions and cross-field locations in the same segment, such as "OBX.2"
+// for an OBX rule, refer to the instance being checked. Absent segments are
+// not checked; use MinRepeats on the segment to require them.
+//
+// Example:
+//
+//	// Every OBX needs a value type when it has a value
+//	At("OBX.2").Required().When("OBX.5", Present()).Each().Build()
+//
+//	// Every patient identifier needs an ID and identifier type
+//	At("PID.3.1").Required().Each().Build()
+func (b *ruleBuilder) Each() RuleBuilder {
+	b.each = true
+	return b
+}
+
+// When restricts the built rule to messages where the value at location
+// satisfies the predicate. Absent fields are tested as the empty string.
+// Conditions apply to every requirement in the builder regardless of the
+// order of calls, and multiple conditions must all hold.
+//
+// Example:
+//
+//	// PV1-3 is required for inpatients
+//	At("PV1.3").Required().When("PV1.2", Equals("I")).Build()
+func (b *ruleBuilder) When(location string, predicate Predicate) RuleBuilder {
+	b.conditions = append(b.conditions, condition{
+		location:  location,
+		predicate: predicate,
+	})
+	return b
+}
+
 // WithDescription sets a custom description for the rule.
 func (b *ruleBuilder) WithDescription(desc string) RuleBuilder {
 	b.description = desc
 	return b
 }
 
+// AsWarning reports failures of the built rule as warnings. Warnings appear
+// in ValidationResult.Warnings and do not make the result invalid.
+//
+// Example:
+//
+//	// Prefer a phone number, but accept messages without one
+//	At("PID.13").Required().AsWarning().Build()
+func (b *ruleBuilder) AsWarning() RuleBuilder {
+	return b.Severity(hl7.SeverityWarning)
+}
+
+// AsInfo reports failures of the built rule as informational issues, which
+// are only available from ValidationResult.Issues and BySeverity.
+func (b *ruleBuilder) AsInfo() RuleBuilder {
+	return b.Severity(hl7.SeverityInfo)
+}
+
+// Severity sets the severity of failures of the built rule. The default is
+// hl7.SeverityError.
+func (b *ruleBuilder) Severity(severity hl7.Severity) RuleBuilder {
+	b.severity = severity
+	return b
+}
+
 // Build constructs the final Rule from the builder configuration.
 // If no rules were added, returns a no-op rule that always passes.
 // If only one rule was added, returns that rule directly.
 // If multiple rules were added, returns a composite rule.
+// If conditions were added with When, the result is wrapped so that it is
+// only applied when they hold. With Each, the result applies to every
+// segment instance and field repetition. A severity other than hl7.SeverityError is
+// applied to every failure.
 func (b *ruleBuilder) Build() Rule {
 	if len(b.rules) == 0 {
 		return &noopRule{
@@ -145,17 +385,72 @@ func (b *ruleBuilder) Build() Rule {
 				r.description = b.description
 			case *invalidPatternRule:
 				r.description = b.description
+			case *typeRule:
+				r.description = b.description
+			case *tableRule:
+				r.description = b.description
+			case *compareRule:
+				r.description = b.description
+			case *dateOrderRule:
+				r.description = b.description
+			case *mutuallyExclusiveRule:
+				r.description = b.description
+			case *atLeastOneOfRule:
+				r.description = b.description
+			case *repetitionCountRule:
+				r.description = b.description
+			}
+		}
+	}
+
+	var rule Rule
+	if b.each {
+		// Repetition counts apply per segment instance, other checks per
+		// field repetition
+		var counts, values []Rule
+		for _, r := range b.rules {
+			if _, ok := r.(*repetitionCountRule); ok {
+				counts = append(counts, r)
+			} else {
+				values = append(values, r)
+			}
+		}
+		rule = &eachRule{
+			location:    b.location,
+			instance:    b.combine(counts),
+			repetition:  b.combine(values),
+			conditions:  b.conditions,
+			description: b.description,
+		}
+	} else {
+		rule = b.combine(b.rules)
+		if len(b.conditions) > 0 {
+			rule = &conditionalRule{
+				rule:       rule,
+				conditions: b.conditions,
 			}
 		}
 	}
 
-	if len(b.rules) == 1 {
-		return b.rules[0]
+	if b.severity != hl7.SeverityError {
+		rule = &severityRule{rule: rule, severity: b.severity}
 	}
 
+	return rule
+}
+
+// combine returns the single rule, a composite of several rules, or nil if
+// there are none.
+func (b *ruleBuilder) combine(rules []Rule) Rule {
+	switch len(rules) {
+	case 0:
+		return nil
+	case 1:
+		return rules[0]
+	}
 	return &compositeRule{
 		location:    b.location,
-		rules:       b.rules,
+		rules:       rules,
 		description: b.description,
 	}
 }
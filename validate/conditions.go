@@ -0,0 +1,164 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// Predicate tests the value found at a condition location.
+// Absent fields are tested as the empty string.
+type Predicate interface {
+	// Match reports whether the value satisfies the predicate.
+	Match(value string) bool
+	// String describes the predicate, e.g. `equals "I"`.
+	String() string
+}
+
+// predicateFunc adapts a function to the Predicate interface. Built-in
+// predicates record their operator and arguments so rule documents can
+// export them.
+type predicateFunc struct {
+	desc string
+	fn   func(string) bool
+	op   string
+	args []string
+}
+
+func (p predicateFunc) Match(value string) bool { return p.fn(value) }
+func (p predicateFunc) String() string          { return p.desc }
+
+// PredicateFunc creates a Predicate from a function and a description used in
+// rule descriptions.
+//
+// Example:
+//
+//	numeric := validate.PredicateFunc("is numeric", func(v string) bool {
+//	    _, err := strconv.ParseFloat(v, 64)
+//	    return err == nil
+//	})
+func PredicateFunc(desc string, fn func(value string) bool) Predicate {
+	return predicateFunc{desc: desc, fn: fn}
+}
+
+// Equals matches values equal to expected.
+func Equals(expected string) Predicate {
+	return predicateFunc{
+		desc: fmt.Sprintf("equals %q", expected),
+		fn:   func(v string) bool { return v == expected },
+		op:   "equals",
+		args: []string{expected},
+	}
+}
+
+// NotEquals matches values other than unexpected, including absent values.
+func NotEquals(unexpected string) Predicate {
+	return predicateFunc{
+		desc: fmt.Sprintf("does not equal %q", unexpected),
+		fn:   func(v string) bool { return v != unexpected },
+		op:   "notEquals",
+		args: []string{unexpected},
+	}
+}
+
+// In matches values equal to any of the given values.
+func In(values ...string) Predicate {
+	return predicateFunc{
+		desc: fmt.Sprintf("is one of [%s]", strings.Join(values, ", ")),
+		fn: func(v string) bool {
+			for _, allowed := range values {
+				if v == allowed {
+					return true
+				}
+			}
+			return false
+		},
+		op:   "in",
+		args: values,
+	}
+}
+
+// Present matches non-empty values.
+func Present() Predicate {
+	return predicateFunc{
+		desc: "is present",
+		fn:   func(v string) bool { return strings.TrimSpace(v) != "" },
+		op:   "present",
+	}
+}
+
+// Absent matches empty or missing values.
+func Absent() Predicate {
+	return predicateFunc{
+		desc: "is absent",
+		fn:   func(v string) bool { return strings.TrimSpace(v) == "" },
+		op:   "absent",
+	}
+}
+
+// Matches matches values that match the regular expression.
+// It panics if the pattern does not compile, like regexp.MustCompile.
+func Matches(pattern string) Predicate {
+	re := regexp.MustCompile(pattern)
+	return predicateFunc{
+		desc: fmt.Sprintf("matches %q", pattern),
+		fn:   re.MatchString,
+		op:   "matches",
+		args: []string{pattern},
+	}
+}
+
+// condition restricts a rule to messages where the value at location
+// satisfies the predicate.
+type condition struct {
+	location  string
+	predicate Predicate
+}
+
+// holds reports whether the condition is met by the message.
+func (c condition) holds(msg hl7.Message) bool {
+	value, err := msg.Get(c.location)
+	if err != nil {
+		value = ""
+	}
+	return c.predicate.Match(value)
+}
+
+// String describes the condition, e.g. `PV1.2 equals "I"`.
+func (c condition) String() string {
+	return c.location + " " + c.predicate.String()
+}
+
+// conditionalRule applies a rule only when all of its conditions hold.
+type conditionalRule struct {
+	rule       Rule
+	conditions []condition
+}
+
+// Validate applies the wrapped rule if every condition holds.
+func (r *conditionalRule) Validate(msg hl7.Message) []ValidationError {
+	if msg != nil {
+		for _, c := range r.conditions {
+			if !c.holds(msg) {
+				return nil
+			}
+		}
+	}
+	return r.rule.Validate(msg)
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *conditionalRule) Location() string {
+	return r.rule.Location()
+}
+
+// Description returns a human-readable description of this rule.
+func (r *conditionalRule) Description() string {
+	conds := make([]string, len(r.conditions))
+	for i, c := range r.conditions {
+		conds[i] = c.String()
+	}
+	return fmt.Sprintf("%s when %s", r.rule.Description(), strings.Join(conds, " and "))
+}
@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPredicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate Predicate
+		value     string
+		want      bool
+	}{
+		{"equals match", Equals("I"), "I", true},
+		{"equals mismatch", Equals("I"), "O", false},
+		{"not equals", NotEquals("I"), "O", true},
+		{"not equals absent", NotEquals("I"), "", true},
+		{"in match", In("NM", "SN"), "SN", true},
+		{"in mismatch", In("NM", "SN"), "ST", false},
+		{"present", Present(), "x", true},
+		{"present blank", Present(), "  ", false},
+		{"absent", Absent(), "", true},
+		{"absent with value", Absent(), "x", false},
+		{"matches", Matches(`^\d+$`), "123", true},
+		{"matches mismatch", Matches(`^\d+$`), "12a", false},
+		{"func", PredicateFunc("is long", func(v string) bool { return len(v) > 3 }), "abcd", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.predicate.Match(tt.value); got != tt.want {
+				t.Errorf("%s.Match(%q) = %v, want %v", tt.predicate, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleBuilder_When(t *testing.T) {
+	rule := At("PV1.3").Required().When("PV1.2", Equals("I")).Build()
+
+	inpatient := newMockMessage()
+	inpatient.setField("PV1.2", "I")
+	if errs := rule.Validate(inpatient); len(errs) != 1 || errs[0].Rule != "required" {
+		t.Errorf("Validate(inpatient) = %v, want one required error", errs)
+	}
+
+	inpatient.setField("PV1.3", "4E^401^A")
+	if errs := rule.Validate(inpatient); len(errs) != 0 {
+		t.Errorf("Validate(inpatient with location) = %v, want no errors", errs)
+	}
+
+	outpatient := newMockMessage()
+	outpatient.setField("PV1.2", "O")
+	if errs := rule.Validate(outpatient); len(errs) != 0 {
+		t.Errorf("Validate(outpatient) = %v, want no errors", errs)
+	}
+
+	// An absent condition field does not satisfy Equals
+	if errs := rule.Validate(newMockMessage()); len(errs) != 0 {
+		t.Errorf("Validate(no PV1.2) = %v, want no errors", errs)
+	}
+
+	if rule.Location() != "PV1.3" {
+		t.Errorf("Location() = %q, want %q", rule.Location(), "PV1.3")
+	}
+	want := `PV1.3 is required when PV1.2 equals "I"`
+	if got := rule.Description(); got != want {
+		t.Errorf("Description() = %q, want %q", got, want)
+	}
+}
+
+func TestRuleBuilder_WhenMultipleConditions(t *testing.T) {
+	// Conditions apply to all requirements and may be declared first
+	rule := At("OBX.6").
+		When("OBX.2", In("NM", "SN")).
+		When("OBX.11", NotEquals("X")).
+		Required().
+		Length(1, 10).
+		Build()
+
+	m := newMockMessage()
+	m.setField("OBX.2", "NM")
+	if errs := rule.Validate(m); len(errs) != 1 {
+		t.Errorf("Validate(NM without units) = %v, want 1 error", errs)
+	}
+
+	m.setField("OBX.11", "X")
+	if errs := rule.Validate(m); len(errs) != 0 {
+		t.Errorf("Validate(status X) = %v, want no errors", errs)
+	}
+
+	if !strings.Contains(rule.Description(), "OBX.2 is one of [NM, SN] and OBX.11 does not equal \"X\"") {
+		t.Errorf("Description() = %q, want both conditions", rule.Description())
+	}
+}
+
+func TestRuleBuilder_WhenNilMessage(t *testing.T) {
+	rule := At("PV1.3").Required().When("PV1.2", Equals("I")).Build()
+	if errs := rule.Validate(nil); len(errs) != 1 {
+		t.Errorf("Validate(nil) = %v, want the nil message error", errs)
+	}
+}
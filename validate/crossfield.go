@@ -0,0 +1,325 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// Comparison is an operator used by cross-field comparison rules.
+type Comparison int
+
+// Comparison operators.
+const (
+	CompareEqual Comparison = iota
+	CompareNotEqual
+	CompareLess
+	CompareLessOrEqual
+	CompareGreater
+	CompareGreaterOrEqual
+)
+
+// String returns the operator symbol.
+func (c Comparison) String() string {
+	switch c {
+	case CompareEqual:
+		return "=="
+	case CompareNotEqual:
+		return "!="
+	case CompareLess:
+		return "<"
+	case CompareLessOrEqual:
+		return "<="
+	case CompareGreater:
+		return ">"
+	case CompareGreaterOrEqual:
+		return ">="
+	default:
+		return fmt.Sprintf("Comparison(%d)", int(c))
+	}
+}
+
+// holds reports whether cmp, the result of comparing two values
+// (-1, 0 or 1), satisfies the operator.
+func (c Comparison) holds(cmp int) bool {
+	switch c {
+	case CompareEqual:
+		return cmp == 0
+	case CompareNotEqual:
+		return cmp != 0
+	case CompareLess:
+		return cmp < 0
+	case CompareLessOrEqual:
+		return cmp <= 0
+	case CompareGreater:
+		return cmp > 0
+	case CompareGreaterOrEqual:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+// valueAt returns the value at location, or "" if it cannot be read.
+func valueAt(msg hl7.Message, location string) string {
+	value, err := msg.Get(location)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// isPresent reports whether location holds a non-empty value.
+func isPresent(msg hl7.Message, location string) bool {
+	return strings.TrimSpace(valueAt(msg, location)) != ""
+}
+
+// compareValues compares two values numerically if both are numbers and
+// lexically otherwise.
+func compareValues(a, b string) int {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// compareRule validates a field value against the value of another field.
+type compareRule struct {
+	location    string
+	other       string
+	op          Comparison
+	description string
+}
+
+// Validate compares the two values. It passes if either value is empty.
+func (r *compareRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "compare",
+			Message:  "message is nil",
+		}}
+	}
+
+	value, other := valueAt(msg, r.location), valueAt(msg, r.other)
+	if value == "" || other == "" {
+		return nil
+	}
+
+	if !r.op.holds(compareValues(value, other)) {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "compare",
+			Message:  fmt.Sprintf("field value does not satisfy %s %s %s", r.location, r.op, r.other),
+			Expected: fmt.Sprintf("%s %s", r.op, other),
+			Actual:   value,
+		}}
+	}
+
+	return nil
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *compareRule) Location() string {
+	return r.location
+}
+
+// Description returns a human-readable description of this rule.
+func (r *compareRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	return fmt.Sprintf("%s must be %s %s", r.location, r.op, r.other)
+}
+
+// dateOrderRule validates that a date/time field is before or after another.
+type dateOrderRule struct {
+	location    string
+	other       string
+	after       bool
+	description string
+}
+
+// name returns the rule name used in validation errors.
+func (r *dateOrderRule) name() string {
+	if r.after {
+		return "after"
+	}
+	return "before"
+}
+
+// Validate compares the two date/time values. It passes if either value is
+// empty and fails if either is not a valid HL7 date/time.
+func (r *dateOrderRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     r.name(),
+			Message:  "message is nil",
+		}}
+	}
+
+	value, other := valueAt(msg, r.location), valueAt(msg, r.other)
+	if value == "" || other == "" {
+		return nil
+	}
+
+	t, err := parseDateTime(value)
+	if err != nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     r.name(),
+			Message:  err.Error(),
+			Actual:   value,
+		}}
+	}
+	ref, err := parseDateTime(other)
+	if err != nil {
+		return []ValidationError{{
+			Location: r.other,
+			Rule:     r.name(),
+			Message:  err.Error(),
+			Actual:   other,
+		}}
+	}
+
+	if (r.after && !t.After(ref)) || (!r.after && !t.Before(ref)) {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     r.name(),
+			Message:  fmt.Sprintf("field value must be %s %s", r.name(), r.other),
+			Expected: fmt.Sprintf("%s %s", r.name(), other),
+			Actual:   value,
+		}}
+	}
+
+	return nil
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *dateOrderRule) Location() string {
+	return r.location
+}
+
+// Description returns a human-readable description of this rule.
+func (r *dateOrderRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	return fmt.Sprintf("%s must be %s %s", r.location, r.name(), r.other)
+}
+
+// mutuallyExclusiveRule validates that at most one of several fields is present.
+type mutuallyExclusiveRule struct {
+	location    string
+	others      []string
+	description string
+}
+
+// Validate checks that no more than one of the locations has a value.
+func (r *mutuallyExclusiveRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "mutuallyExclusive",
+			Message:  "message is nil",
+		}}
+	}
+
+	var present []string
+	for _, loc := range r.locations() {
+		if isPresent(msg, loc) {
+			present = append(present, loc)
+		}
+	}
+
+	if len(present) > 1 {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "mutuallyExclusive",
+			Message:  "only one of the fields may be present",
+			Expected: fmt.Sprintf("at most one of [%s]", strings.Join(r.locations(), ", ")),
+			Actual:   fmt.Sprintf("[%s]", strings.Join(present, ", ")),
+		}}
+	}
+
+	return nil
+}
+
+// locations returns the rule's location followed by the other locations.
+func (r *mutuallyExclusiveRule) locations() []string {
+	return append([]string{r.location}, r.others...)
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *mutuallyExclusiveRule) Location() string {
+	return r.location
+}
+
+// Description returns a human-readable description of this rule.
+func (r *mutuallyExclusiveRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	return fmt.Sprintf("at most one of [%s] may be present", strings.Join(r.locations(), ", "))
+}
+
+// atLeastOneOfRule validates that at least one of several fields is present.
+type atLeastOneOfRule struct {
+	location    string
+	others      []string
+	description string
+}
+
+// Validate checks that at least one of the locations has a value.
+func (r *atLeastOneOfRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "atLeastOneOf",
+			Message:  "message is nil",
+		}}
+	}
+
+	for _, loc := range r.locations() {
+		if isPresent(msg, loc) {
+			return nil
+		}
+	}
+
+	return []ValidationError{{
+		Location: r.location,
+		Rule:     "atLeastOneOf",
+		Message:  "at least one of the fields is required",
+		Expected: fmt.Sprintf("one of [%s]", strings.Join(r.locations(), ", ")),
+	}}
+}
+
+// locations returns the rule's location followed by the other locations.
+func (r *atLeastOneOfRule) locations() []string {
+	return append([]string{r.location}, r.others...)
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *atLeastOneOfRule) Location() string {
+	return r.location
+}
+
+// Description returns a human-readable description of this rule.
+func (r *atLeastOneOfRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	return fmt.Sprintf("at least one of [%s] is required", strings.Join(r.locations(), ", "))
+}
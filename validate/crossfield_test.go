@@ -0,0 +1,139 @@
+package validate
+
+import "testing"
+
+func TestRuleBuilder_Compare(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      Comparison
+		value   string
+		other   string
+		wantErr bool
+	}{
+		{"numeric less", CompareLess, "9", "10", false},
+		{"numeric not less", CompareLess, "10", "9", true},
+		{"lexical less", CompareLess, "abc", "abd", false},
+		{"equal", CompareEqual, "A", "A", false},
+		{"not equal", CompareNotEqual, "A", "A", true},
+		{"less or equal", CompareLessOrEqual, "5.0", "5", false},
+		{"greater", CompareGreater, "5", "5", true},
+		{"greater or equal", CompareGreaterOrEqual, "5", "5", false},
+		{"empty other passes", CompareGreater, "5", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := At("OBX.5").Compare(tt.op, "OBX.7").Build()
+
+			m := newMockMessage()
+			m.setField("OBX.5", tt.value)
+			m.setField("OBX.7", tt.other)
+
+			errs := rule.Validate(m)
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", errs, tt.wantErr)
+			}
+			if len(errs) > 0 && errs[0].Rule != "compare" {
+				t.Errorf("Rule = %q, want %q", errs[0].Rule, "compare")
+			}
+		})
+	}
+}
+
+func TestRuleBuilder_BeforeAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		value   string
+		other   string
+		wantErr bool
+	}{
+		{"after", At("PID.29").After("PID.7").Build(), "20200101", "19800101", false},
+		{"after mixed precision", At("PID.29").After("PID.7").Build(), "198001011200", "19800101", false},
+		{"not after", At("PID.29").After("PID.7").Build(), "19700101", "19800101", true},
+		{"same is not after", At("PID.29").After("PID.7").Build(), "19800101", "19800101", true},
+		{"after with offset", At("PID.29").After("PID.7").Build(), "202001010100+0200", "202001010000+0000", true},
+		{"before", At("PID.7").Before("PID.29").Build(), "19800101", "20200101", false},
+		{"not before", At("PID.7").Before("PID.29").Build(), "20200101", "19800101", true},
+		{"invalid date", At("PID.29").After("PID.7").Build(), "2020-01-01", "19800101", true},
+		{"empty passes", At("PID.29").After("PID.7").Build(), "", "19800101", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMockMessage()
+			loc := tt.rule.Location()
+			other := "PID.29"
+			if loc == "PID.29" {
+				other = "PID.7"
+			}
+			m.setField(loc, tt.value)
+			m.setField(other, tt.other)
+
+			errs := tt.rule.Validate(m)
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRuleBuilder_MutuallyExclusive(t *testing.T) {
+	rule := At("PID.29").MutuallyExclusive("PID.30").Build()
+
+	m := newMockMessage()
+	m.setField("PID.29", "20200101")
+	if errs := rule.Validate(m); len(errs) != 0 {
+		t.Errorf("Validate(one present) = %v, want no errors", errs)
+	}
+
+	m.setField("PID.30", "N")
+	errs := rule.Validate(m)
+	if len(errs) != 1 {
+		t.Fatalf("Validate(both present) = %v, want 1 error", errs)
+	}
+	if errs[0].Rule != "mutuallyExclusive" || errs[0].Actual != "[PID.29, PID.30]" {
+		t.Errorf("error = %+v, want mutuallyExclusive listing both fields", errs[0])
+	}
+
+	if errs := rule.Validate(newMockMessage()); len(errs) != 0 {
+		t.Errorf("Validate(none present) = %v, want no errors", errs)
+	}
+}
+
+func TestRuleBuilder_AtLeastOneOf(t *testing.T) {
+	rule := At("PID.3").AtLeastOneOf("PID.2", "PID.4").Build()
+
+	errs := rule.Validate(newMockMessage())
+	if len(errs) != 1 || errs[0].Rule != "atLeastOneOf" {
+		t.Fatalf("Validate(none present) = %v, want 1 atLeastOneOf error", errs)
+	}
+	if errs[0].Expected != "one of [PID.3, PID.2, PID.4]" {
+		t.Errorf("Expected = %q", errs[0].Expected)
+	}
+
+	m := newMockMessage()
+	m.setField("PID.4", "ALT1")
+	if errs := rule.Validate(m); len(errs) != 0 {
+		t.Errorf("Validate(PID.4 present) = %v, want no errors", errs)
+	}
+}
+
+func TestRuleBuilder_CrossFieldDescription(t *testing.T) {
+	tests := []struct {
+		rule Rule
+		want string
+	}{
+		{At("OBX.5").Compare(CompareGreaterOrEqual, "OBX.7").Build(), "OBX.5 must be >= OBX.7"},
+		{At("PID.29").After("PID.7").Build(), "PID.29 must be after PID.7"},
+		{At("PID.29").MutuallyExclusive("PID.30").Build(), "at most one of [PID.29, PID.30] may be present"},
+		{At("PID.3").AtLeastOneOf("PID.2").Build(), "at least one of [PID.3, PID.2] is required"},
+		{At("PID.29").After("PID.7").WithDescription("death after birth").Build(), "death after birth"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.rule.Description(); got != tt.want {
+			t.Errorf("Description() = %q, want %q", got, tt.want)
+		}
+	}
+}
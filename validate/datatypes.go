@@ -0,0 +1,319 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// primitiveTypes validates the HL7 primitive data types. Types without a
+// format constraint accept any value that contains no delimiters.
+var primitiveTypes = map[string]func(string) error{
+	"ST":  nil,
+	"TX":  nil,
+	"FT":  nil,
+	"ID":  nil,
+	"IS":  nil,
+	"NM":  checkNumeric,
+	"SI":  checkSequenceID,
+	"DT":  checkDate,
+	"TM":  checkTime,
+	"DTM": checkDateTime,
+}
+
+// componentDef describes one component of a composite data type.
+type componentDef struct {
+	dataType  string
+	maxLength int
+}
+
+// compositeTypes lists the components of the supported HL7 v2.5.1 composite
+// data types with their maximum lengths.
+var compositeTypes = map[string][]componentDef{
+	"CE":  {{"ST", 20}, {"ST", 199}, {"ID", 20}, {"ST", 20}, {"ST", 199}, {"ID", 20}},
+	"CNE": {{"ST", 20}, {"ST", 199}, {"ID", 20}, {"ST", 20}, {"ST", 199}, {"ID", 20}, {"ST", 10}, {"ST", 10}, {"ST", 199}},
+	"CQ":  {{"NM", 16}, {"CE", 483}},
+	"CWE": {{"ST", 20}, {"ST", 199}, {"ID", 20}, {"ST", 20}, {"ST", 199}, {"ID", 20}, {"ST", 10}, {"ST", 10}, {"ST", 199}},
+	"CX":  {{"ST", 15}, {"ST", 1}, {"ID", 3}, {"HD", 227}, {"ID", 5}, {"HD", 227}, {"DT", 8}, {"DT", 8}, {"CWE", 705}, {"CWE", 705}},
+	"DR":  {{"TS", 26}, {"TS", 26}},
+	"EI":  {{"ST", 199}, {"IS", 20}, {"ST", 199}, {"ID", 6}},
+	"EIP": {{"EI", 427}, {"EI", 427}},
+	"FN":  {{"ST", 50}, {"ST", 20}, {"ST", 50}, {"ST", 20}, {"ST", 50}},
+	"HD":  {{"IS", 20}, {"ST", 199}, {"ID", 6}},
+	"MSG": {{"ID", 3}, {"ID", 3}, {"ID", 7}},
+	"PL":  {{"IS", 20}, {"IS", 20}, {"IS", 20}, {"HD", 227}, {"IS", 20}, {"IS", 20}, {"IS", 20}, {"IS", 20}, {"ST", 199}, {"EI", 427}, {"ST", 227}},
+	"PT":  {{"ID", 1}, {"ID", 3}},
+	"SAD": {{"ST", 120}, {"ST", 50}, {"ST", 12}},
+	"SN":  {{"ST", 2}, {"NM", 15}, {"ST", 1}, {"NM", 15}},
+	"TS":  {{"DTM", 24}, {"ID", 1}},
+	"VID": {{"ID", 5}, {"CE", 483}, {"CE", 483}},
+	"XAD": {{"SAD", 184}, {"ST", 120}, {"ST", 50}, {"ST", 50}, {"ST", 12}, {"ID", 3}, {"ID", 3}, {"ST", 50}, {"IS", 20}, {"IS", 20}, {"ID", 1}, {"DR", 53}, {"TS", 26}, {"TS", 26}},
+	"XCN": {{"ST", 15}, {"FN", 194}, {"ST", 30}, {"ST", 30}, {"ST", 20}, {"ST", 20}, {"IS", 5}, {"IS", 4}, {"HD", 227}, {"ID", 1}, {"ST", 1}, {"ID", 3}, {"ID", 5}, {"HD", 227}, {"ID", 1}, {"CE", 483}, {"DR", 53}, {"ID", 1}, {"TS", 26}, {"TS", 26}, {"ST", 199}, {"CWE", 705}, {"CWE", 705}},
+	"XON": {{"ST", 50}, {"IS", 20}, {"NM", 4}, {"NM", 1}, {"ID", 3}, {"HD", 227}, {"ID", 5}, {"HD", 227}, {"ID", 2}, {"ST", 20}},
+	"XPN": {{"FN", 194}, {"ST", 30}, {"ST", 30}, {"ST", 20}, {"ST", 20}, {"IS", 6}, {"ID", 1}, {"ID", 1}, {"CE", 483}, {"DR", 53}, {"ID", 1}, {"TS", 26}, {"ST", 199}, {"ST", 1}},
+	"XTN": {{"ST", 199}, {"ID", 3}, {"ID", 8}, {"ST", 199}, {"NM", 3}, {"NM", 5}, {"NM", 9}, {"NM", 5}, {"ST", 199}, {"NM", 4}, {"NM", 6}, {"ST", 199}},
+}
+
+// isKnownType reports whether the data type can be validated.
+func isKnownType(dataType string) bool {
+	if _, ok := primitiveTypes[dataType]; ok {
+		return true
+	}
+	_, ok := compositeTypes[dataType]
+	return ok
+}
+
+var numericPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+// checkNumeric validates an NM value: an optional sign, digits and an
+// optional decimal point.
+func checkNumeric(value string) error {
+	if !numericPattern.MatchString(value) {
+		return fmt.Errorf("invalid numeric value %q", value)
+	}
+	return nil
+}
+
+// checkSequenceID validates an SI value: a non-negative integer of at most
+// four digits.
+func checkSequenceID(value string) error {
+	if !isDigits(value) || len(value) > 4 {
+		return fmt.Errorf("invalid sequence ID %q", value)
+	}
+	return nil
+}
+
+// checkDate validates a DT value: YYYY[MM[DD]].
+func checkDate(value string) error {
+	if len(value) != 4 && len(value) != 6 && len(value) != 8 {
+		return fmt.Errorf("invalid date %q", value)
+	}
+	if _, err := parseDateTime(value); err != nil {
+		return fmt.Errorf("invalid date %q", value)
+	}
+	return nil
+}
+
+// checkTime validates a TM value: HH[MM[SS[.S[S[S[S]]]]]][+/-ZZZZ].
+func checkTime(value string) error {
+	// Validate as a date/time on an arbitrary day
+	digits := value
+	if i := strings.IndexAny(value, ".+-"); i >= 0 {
+		digits = value[:i]
+	}
+	if len(digits) != 2 && len(digits) != 4 && len(digits) != 6 {
+		return fmt.Errorf("invalid time %q", value)
+	}
+	if len(digits) < 6 && len(digits) != len(value) && value[len(digits)] == '.' {
+		return fmt.Errorf("invalid time %q", value)
+	}
+	padded := "20000101" + digits + strings.Repeat("0", 6-len(digits)) + value[len(digits):]
+	if _, err := parseDateTime(padded); err != nil {
+		return fmt.Errorf("invalid time %q", value)
+	}
+	return nil
+}
+
+// checkDateTime validates a DTM value at any precision HL7 allows, with
+// optional fractional seconds and time zone offset.
+func checkDateTime(value string) error {
+	_, err := parseDateTime(value)
+	return err
+}
+
+// checkDataType validates value as dataType. level is the delimiter depth of
+// the value: 0 for a field, 1 for a component and 2 for a subcomponent.
+// Composite values are split with the component separator at level 0 and the
+// subcomponent separator at level 1; composites nested deeper are not checked.
+func checkDataType(value, dataType string, delims *hl7.Delimiters, level int) error {
+	if value == "" {
+		return nil
+	}
+
+	components, composite := compositeTypes[dataType]
+	if !composite {
+		if strings.ContainsRune(value, delims.Component) || (level > 0 && strings.ContainsRune(value, delims.SubComponent)) {
+			return fmt.Errorf("%s value %q must not contain components", dataType, value)
+		}
+		if check := primitiveTypes[dataType]; check != nil {
+			return check(value)
+		}
+		return nil
+	}
+
+	if level >= 2 {
+		return nil
+	}
+
+	sep := delims.Component
+	label := "component"
+	if level == 1 {
+		sep = delims.SubComponent
+		label = "subcomponent"
+	}
+
+	parts := strings.Split(value, string(sep))
+	if len(parts) > len(components) {
+		return fmt.Errorf("%s has %d %ss, maximum is %d", dataType, len(parts), label, len(components))
+	}
+
+	for i, part := range parts {
+		def := components[i]
+		if len(part) > def.maxLength {
+			return fmt.Errorf("%s %d (%s) exceeds maximum length %d", label, i+1, def.dataType, def.maxLength)
+		}
+		if err := checkDataType(part, def.dataType, delims, level+1); err != nil {
+			return fmt.Errorf("%s %d (%s): %w", label, i+1, def.dataType, err)
+		}
+	}
+
+	return nil
+}
+
+// typeRule validates that a field value conforms to an HL7 data type.
+type typeRule struct {
+	location    string
+	dataType    string
+	description string
+}
+
+// Validate checks each repetition of the location value against the data type.
+func (r *typeRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "type",
+			Message:  "message is nil",
+		}}
+	}
+
+	if !isKnownType(r.dataType) {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "type",
+			Message:  "unknown data type " + strconv.Quote(r.dataType),
+			Code:     CodeDataType,
+		}}
+	}
+
+	reps, delims := repetitionValues(msg, r.location)
+	for i, rep := range reps {
+		// Absent and empty values pass (use required rule for presence)
+		if rep == "" {
+			continue
+		}
+		if err := checkDataType(rep, r.dataType, delims, 0); err != nil {
+			message := err.Error()
+			if len(reps) > 1 {
+				message = fmt.Sprintf("repetition %d: %s", i+1, message)
+			}
+			return []ValidationError{{
+				Location: r.location,
+				Rule:     "type",
+				Message:  message,
+				Expected: r.dataType,
+				Actual:   rep,
+				Code:     CodeDataType,
+			}}
+		}
+	}
+
+	return nil
+}
+
+// repetitionValues returns the value of every repetition at location in the
+// first matching segment, together with the delimiters they are encoded with.
+//
+// Field values are read through the segment and encoded with the message
+// delimiters, since Get and GetAll encode with the default delimiters and a
+// message using other encoding characters would be split wrongly.
+// Component and subcomponent values hold no delimiters of their own.
+func repetitionValues(msg hl7.Message, location string) ([]string, *hl7.Delimiters) {
+	delims := msg.Delimiters()
+	if delims == nil {
+		delims = hl7.DefaultDelimiters()
+	}
+
+	loc, err := hl7.ParseLocation(location)
+	if err == nil && loc.HasField() {
+		index := 0
+		if loc.HasSegmentIndex() {
+			index = loc.SegmentIndex
+		}
+		if segs := msg.Segments(loc.Segment); index < len(segs) {
+			return fieldRepetitions(segs[index], loc, delims), delims
+		}
+	}
+
+	// Messages that do not expose their segments only offer Get, whose value
+	// is encoded with the default delimiters
+	value, err := msg.Get(location)
+	if err != nil || value == "" {
+		return nil, delims
+	}
+	defaults := hl7.DefaultDelimiters()
+	return strings.Split(value, string(defaults.Repetition)), defaults
+}
+
+// fieldRepetitions returns the value at loc in each repetition of the field
+// of seg, encoding whole repetitions with delims.
+func fieldRepetitions(seg hl7.Segment, loc *hl7.Location, delims *hl7.Delimiters) []string {
+	field, ok := seg.Field(loc.Field)
+	if !ok {
+		return nil
+	}
+
+	reps := field.Repetitions()
+	if loc.HasRepetition() {
+		rep, ok := field.Repetition(loc.Repetition)
+		if !ok {
+			return nil
+		}
+		reps = []hl7.Repetition{rep}
+	} else if len(reps) == 0 && !loc.HasComponent() {
+		// Fields created from a raw value have no parsed repetitions
+		if raw := string(field.Bytes(delims)); raw != "" {
+			return strings.Split(raw, string(delims.Repetition))
+		}
+	}
+
+	values := make([]string, 0, len(reps))
+	for _, rep := range reps {
+		if !loc.HasComponent() {
+			values = append(values, string(rep.Bytes(delims)))
+			continue
+		}
+		comp, ok := rep.Component(loc.Component)
+		if !ok {
+			values = append(values, "")
+			continue
+		}
+		if !loc.HasSubComponent() {
+			values = append(values, comp.Value())
+			continue
+		}
+		sub, ok := comp.SubComponent(loc.SubComponent)
+		if !ok {
+			values = append(values, "")
+			continue
+		}
+		values = append(values, sub.Value())
+	}
+	return values
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *typeRule) Location() string {
+	return r.location
+}
+
+// Description returns a human-readable description of this rule.
+func (r *typeRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	return fmt.Sprintf("%s must be of type %s", r.location, r.dataType)
+}
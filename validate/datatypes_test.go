@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/parse"
+)
+
+func TestRuleBuilder_Type(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataType string
+		value    string
+		wantErr  string
+	}{
+		{"NM integer", "NM", "42", ""},
+		{"NM decimal", "NM", "-3.14", ""},
+		{"NM leading point", "NM", ".5", ""},
+		{"NM invalid", "NM", "1.2.3", "invalid numeric"},
+		{"NM letters", "NM", "12a", "invalid numeric"},
+		{"SI", "SI", "1", ""},
+		{"SI negative", "SI", "-1", "invalid sequence ID"},
+		{"SI too long", "SI", "12345", "invalid sequence ID"},
+		{"DT day", "DT", "20240315", ""},
+		{"DT month", "DT", "202403", ""},
+		{"DT with time", "DT", "2024031510", "invalid date"},
+		{"DT invalid day", "DT", "20240230", "invalid date"},
+		{"TM", "TM", "1030", ""},
+		{"TM seconds fraction offset", "TM", "103045.12+0100", ""},
+		{"TM invalid hour", "TM", "2530", "invalid time"},
+		{"TM fraction without seconds", "TM", "1030.5", "invalid time"},
+		{"DTM year", "DTM", "2024", ""},
+		{"DTM full", "DTM", "20240315103045.1234-0500", ""},
+		{"DTM odd precision", "DTM", "2024031", "invalid date/time"},
+		{"DTM bad offset", "DTM", "20240315+05", "invalid time zone"},
+		{"ST with components", "ST", "a^b", "must not contain components"},
+		{"lowercase type", "dtm", "20240315", ""},
+		{"TS", "TS", "20240315103045^S", ""},
+		{"TS invalid", "TS", "2024-03-15", "component 1 (DTM)"},
+		{"CX", "CX", "12345^^^HOSP&1.2.3&ISO^MR", ""},
+		{"CX too many components", "CX", "1^2^3^4^5^6^7^8^9^10^11", "CX has 11 components, maximum is 10"},
+		{"CX component too long", "CX", strings.Repeat("9", 16) + "^^^HOSP^MR", "component 1 (ST) exceeds maximum length 15"},
+		{"CX bad subcomponents", "CX", "1^^^A&B&C&D^MR", "component 4 (HD): HD has 4 subcomponents, maximum is 3"},
+		{"CX bad date", "CX", "1^^^A^MR^^2024-01-01", "component 7 (DT)"},
+		{"XPN", "XPN", "Smith&Sr^John^Q^^^^L", ""},
+		{"CQ numeric quantity", "CQ", "x^mg", "component 1 (NM)"},
+		{"repetitions", "CX", "1^^^A^MR~2^^^B^MR", ""},
+		{"bad repetition", "NM", "1~x", "invalid numeric"},
+		{"empty passes", "DTM", "", ""},
+		{"unknown type", "ZZZ", "x", "unknown data type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := At("PID.3").Type(tt.dataType).Build()
+
+			m := newMockMessage()
+			m.setField("PID.3", tt.value)
+
+			errs := rule.Validate(m)
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Errorf("Validate(%q) = %v, want no errors", tt.value, errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("Validate(%q) = %v, want 1 error", tt.value, errs)
+			}
+			if !strings.Contains(errs[0].Message, tt.wantErr) {
+				t.Errorf("Message = %q, want it to contain %q", errs[0].Message, tt.wantErr)
+			}
+			if errs[0].Code != CodeDataType || errs[0].Rule != "type" {
+				t.Errorf("Code/Rule = %q/%q, want %q/type", errs[0].Code, errs[0].Rule, CodeDataType)
+			}
+		})
+	}
+}
+
+func TestRuleBuilder_TypeDescription(t *testing.T) {
+	rule := At("PID.7").Type("DTM").Build()
+	if got := rule.Description(); got != "PID.7 must be of type DTM" {
+		t.Errorf("Description() = %q", got)
+	}
+}
+
+func TestRuleBuilder_TypeMissingField(t *testing.T) {
+	rule := At("PID.7").Type("DTM").Build()
+	if errs := rule.Validate(newMockMessage()); len(errs) != 0 {
+		t.Errorf("Validate() = %v, want no errors for missing field", errs)
+	}
+}
+
+func TestRuleBuilder_TypeEachRepetition(t *testing.T) {
+	msg, err := parse.New().Parse([]byte("MSH|^~\\&|APP|FAC|||20240115103000||ADT^A01|CTRL1|P|2.5.1\r" +
+		"PID|1||12345^^^HOSP^MR~1^^^A&B&C&D^MR\r"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	errs := At("PID.3").Type("CX").Build().Validate(msg)
+	if len(errs) != 1 {
+		t.Fatalf("Validate() = %v, want 1 error for the second repetition", errs)
+	}
+	if !strings.Contains(errs[0].Message, "repetition 2") {
+		t.Errorf("Message = %q, want it to name repetition 2", errs[0].Message)
+	}
+	if errs[0].Actual != "1^^^A&B&C&D^MR" {
+		t.Errorf("Actual = %q, want the second repetition", errs[0].Actual)
+	}
+}
+
+func TestRuleBuilder_TypeMessageDelimiters(t *testing.T) {
+	msg, err := parse.New().Parse([]byte("MSH|$~\\%|APP|FAC|||20240115103000||ADT$A01|CTRL1|P|2.5.1\r" +
+		"PID|1||12345$$$HOSP%1.2.3%ISO$MR~1$$$A%B%C%D$MR\r"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	errs := At("PID.3").Type("CX").Build().Validate(msg)
+	if len(errs) != 1 {
+		t.Fatalf("Validate() = %v, want 1 error for the second repetition", errs)
+	}
+	if errs[0].Actual != "1$$$A%B%C%D$MR" {
+		t.Errorf("Actual = %q, want the second repetition in the message delimiters", errs[0].Actual)
+	}
+
+	if errs := At("PID.3.4").Type("HD").Build().Validate(msg); len(errs) != 0 {
+		t.Errorf("Validate(PID.3.4) = %v, want no errors", errs)
+	}
+}
@@ -0,0 +1,85 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateTimeLayouts maps the number of digits in an HL7 date/time value
+// (before any fractional seconds) to its time layout.
+var dateTimeLayouts = map[int]string{
+	4:  "2006",
+	6:  "200601",
+	8:  "20060102",
+	10: "2006010215",
+	12: "200601021504",
+	14: "20060102150405",
+}
+
+// parseDateTime parses an HL7 DTM value of the form
+// YYYY[MM[DD[HH[MM[SS[.S[S[S[S]]]]]]]]][+/-ZZZZ]. Values without an offset are
+// interpreted as UTC. Omitted parts default to the start of the period, so
+// "2024" parses as 2024-01-01T00:00:00Z.
+func parseDateTime(value string) (time.Time, error) {
+	digits, offset := value, ""
+	if i := strings.IndexAny(value, "+-"); i >= 0 {
+		digits, offset = value[:i], value[i:]
+	}
+
+	fraction := ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		digits, fraction = digits[:i], digits[i+1:]
+		if len(digits) != 14 || len(fraction) < 1 || len(fraction) > 4 || !isDigits(fraction) {
+			return time.Time{}, fmt.Errorf("invalid fractional seconds in %q", value)
+		}
+	}
+
+	layout, ok := dateTimeLayouts[len(digits)]
+	if !ok || !isDigits(digits) {
+		return time.Time{}, fmt.Errorf("invalid date/time %q", value)
+	}
+
+	loc := time.UTC
+	if offset != "" {
+		if len(offset) != 5 || !isDigits(offset[1:]) {
+			return time.Time{}, fmt.Errorf("invalid time zone offset in %q", value)
+		}
+		hours, _ := strconv.Atoi(offset[1:3])
+		minutes, _ := strconv.Atoi(offset[3:])
+		if hours > 23 || minutes > 59 {
+			return time.Time{}, fmt.Errorf("invalid time zone offset in %q", value)
+		}
+		seconds := hours*3600 + minutes*60
+		if offset[0] == '-' {
+			seconds = -seconds
+		}
+		loc = time.FixedZone(offset, seconds)
+	}
+
+	t, err := time.ParseInLocation(layout, digits, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date/time %q", value)
+	}
+
+	if fraction != "" {
+		nanos, _ := strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction)))
+		t = t.Add(time.Duration(nanos))
+	}
+
+	return t, nil
+}
+
+// isDigits reports whether s is non-empty and contains only ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
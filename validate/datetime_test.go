@@ -0,0 +1,46 @@
+package validate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateTime(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"202403", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"20240315", time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"2024031510", time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)},
+		{"202403151030", time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)},
+		{"20240315103045", time.Date(2024, 3, 15, 10, 30, 45, 0, time.UTC)},
+		{"20240315103045.12", time.Date(2024, 3, 15, 10, 30, 45, 120000000, time.UTC)},
+		{"20240315103045-0500", time.Date(2024, 3, 15, 15, 30, 45, 0, time.UTC)},
+		{"20240315+0100", time.Date(2024, 3, 14, 23, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseDateTime(tt.value)
+			if err != nil {
+				t.Fatalf("parseDateTime() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseDateTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDateTime_Invalid(t *testing.T) {
+	for _, value := range []string{
+		"", "202", "2024031", "2024-03-15", "20241315", "20240230",
+		"2024031510.5", "20240315103045.12345", "20240315+01", "20240315+2500",
+	} {
+		if _, err := parseDateTime(value); err == nil {
+			t.Errorf("parseDateTime(%q) expected error, got nil", value)
+		}
+	}
+}
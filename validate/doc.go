@@ -49,6 +49,8 @@
 //	validate.MinLength("PID.5", 1)       // Name at least 1 char
 //	validate.MaxLength("NTE.3", 65536)   // Note max 64KB
 //
+// Values over the maximum length carry Code "104" (value too long).
+//
 // OneOf - Validates against a list of allowed values:
 //
 //	validate.OneOf("PID.8", "M", "F", "O", "U")  // Gender codes
@@ -84,6 +86,169 @@
 //	    validate.Pattern("PID.3.1", `^[A-Z0-9]+$`),
 //	)
 //
+// # Data Type Rules
+//
+// Type checks a field against an HL7 data type. Primitive types are checked
+// for format (DTM precision and time zone, NM syntax, DT and TM ranges);
+// composite types such as CX, XPN and CWE are checked for component counts,
+// lengths and component types:
+//
+//	validate.At("PID.7").Type("DTM").Build()
+//	validate.At("OBX.5").Type("NM").When("OBX.2", validate.Equals("NM")).Build()
+//	validate.At("PID.3").Type("CX").Build()
+//
+// Data type failures carry Code "102" (data type error) and required field
+// failures Code "101", matching the values used for ack.ACK.ErrorCode.
+//
+// # Table Rules
+//
+// Table checks a field against an HL7 table shipped with the package
+// (HL7Tables: 0001 Administrative Sex, 0004 Patient Class, 0085 Observation
+// Result Status, 0203 Identifier Type, ...). TableFrom uses any TableProvider,
+// such as user tables loaded from CSV or JSON:
+//
+//	validate.At("PID.8").Table("0001").Build()
+//
+//	user, err := validate.LoadTablesCSV(f) // table,code[,description]
+//	tables := validate.ChainTables(user, validate.HL7Tables())
+//	validate.At("PV1.2").TableFrom(tables, "0004").Build()
+//
+// Table failures carry Code "103" (table value not found).
+//
+// # Conditional and Cross-Field Rules
+//
+// When restricts a rule to messages where another field satisfies a
+// predicate. Equals, NotEquals, In, Present, Absent, Matches and PredicateFunc
+// create predicates; absent fields are tested as the empty string:
+//
+//	rules := validate.NewRuleSet(
+//	    // PV1-3 required for inpatients
+//	    validate.At("PV1.3").Required().When("PV1.2", validate.Equals("I")).Build(),
+//	    // OBX-6 (units) required for numeric observations
+//	    validate.At("OBX.6").Required().When("OBX.2", validate.In("NM", "SN")).Build(),
+//	)
+//
+// Cross-field rules relate a field to other fields. They pass when the values
+// they compare are empty; combine them with Required for presence:
+//
+//	validate.At("PID.29").After("PID.7").Build()                    // death after birth
+//	validate.At("OBR.7").Before("OBR.22").Build()                   // DTM order
+//	validate.At("OBX.5").Compare(validate.CompareLessOrEqual, "OBX.7").Build()
+//	validate.At("PID.29").MutuallyExclusive("PID.30").Build()       // at most one present
+//	validate.At("PID.3").AtLeastOneOf("PID.2", "PID.4").Build()     // at least one present
+//
+// Compare uses numeric ordering when both values are numbers. Before and After
+// parse HL7 DTM values (YYYY[MM[DD[HH[MM[SS[.SSSS]]]]]][+/-ZZZZ]) and report
+// invalid dates as errors.
+//
+// # Repeating Segments and Fields
+//
+// Rules check the first value at a location by default. Each applies a rule
+// to every instance of the segment and every repetition of the field, and
+// reports the concrete location of each failure, e.g. "OBX[2].11[0]".
+// Conditions on the same segment refer to the instance being checked:
+//
+//	validate.At("OBX.2").Required().When("OBX.5", validate.Present()).Each().Build()
+//	validate.At("PID.3.1").Required().Each().Build()
+//
+// MinRepeats and MaxRepeats check the number of field repetitions, or the
+// number of segments for a segment location:
+//
+//	validate.At("PID.3").MinRepeats(1).MaxRepeats(5).Build()
+//	validate.At("OBX").MinRepeats(1).Build()
+//
+// # Version-Aware Rule Sets
+//
+// ForMessage selects built-in rules from MSH-12 (version) and MSH-9
+// (message structure). Field optionality, data types and tables follow the
+// version: MSH-9.3 is required from v2.4, MSH-7 from v2.5, PID-2 and PID-4
+// are deprecated (warnings) from v2.5 and withdrawn (errors) from v2.7, and
+// time stamps are TS before v2.6 and DTM after. The built-in structure for
+// the message type is included when known:
+//
+//	result := validate.NewWithRuleSet(validate.ForMessage(msg)).Validate(msg)
+//
+// ForVersion returns the same rules for an explicit version and structure,
+// and Versions lists the supported versions (2.3 through 2.8.2).
+//
+// # Message Structure Rules
+//
+// Structure validates segment order, optional and repeating groups and
+// repeat limits against a structure written in HL7 abstract message syntax,
+// where [ ] marks optional elements, { } repeating ones and < | > a choice:
+//
+//	validate.Structure("ADT_A01", "MSH EVN PID [PD1] [{NK1}] PV1 [PV2] [{PR1 [{ROL}]}]")
+//
+// StructureFor returns built-in v2.5.1 structures (ACK, ADT_A01, ORM_O01,
+// ORU_R01, SIU_S12, VXU_V04, ...), the same structures marshal orders
+// segments by, and MessageStructure selects one from MSH-9:
+//
+//	v := validate.New(validate.MessageStructure())
+//
+// Structure failures carry Code "100" (segment sequence error) and locate the
+// offending segment by name and index, e.g. "NK1[0]". Z-segments are ignored.
+//
+// # Conformance Profiles
+//
+// LoadProfile compiles an HL7 v2 XML conformance profile (Messaging Workbench
+// or IGAMT export) into a RuleSet. Segment and group order and cardinality
+// are checked with error code "100"; field usage, cardinality, length, data
+// type, constant values and embedded tables become field rules:
+//
+//	profile, err := validate.LoadProfile("profiles/adt_a01.xml")
+//	if err != nil {
+//	    return err
+//	}
+//	result := validate.NewWithRuleSet(profile.RuleSet()).Validate(msg)
+//
+// SelectProfile picks the profile named by a message's MSH-21 identifiers:
+//
+//	if p, ok := validate.SelectProfile(msg, profiles...); ok {
+//	    result = validate.NewWithRuleSet(p.RuleSet()).Validate(msg)
+//	}
+//
+// # Rule Documents
+//
+// LoadRules reads rules from a JSON document, so they can be changed without
+// recompiling. Each rule names a location and the checks of RuleBuilder:
+// required, value, pattern, minLength, maxLength, oneOf, type, table,
+// compare, before, after, mutuallyExclusive, atLeastOneOf, minRepeats,
+// maxRepeats and each, plus when conditions, a severity and a description:
+//
+//	{
+//	  "rules": [
+//	    {"location": "PID.3", "required": true},
+//	    {"location": "PV1.3", "required": true, "severity": "warning",
+//	     "when": [{"location": "PV1.2", "in": ["I", "E"]}]}
+//	  ]
+//	}
+//
+// RulesFromDocument builds the same rules from a RuleDocument decoded from
+// YAML. Load errors are *RuleError values giving the index of the offending
+// rule. ExportRules and SaveRules turn rule sets built in code back into
+// documents.
+//
+// # Struct Tag Rules
+//
+// Generate rules from the same "hl7" struct tags used by the marshal package:
+//
+//	type Patient struct {
+//	    ID     string `hl7:"PID.3,required"`
+//	    Gender string `hl7:"PID.8,required,oneof=M|F|O|U,maxlen=1"`
+//	}
+//
+//	rules, err := validate.RulesFromStruct(Patient{})
+//	if err != nil {
+//	    return err
+//	}
+//	v := validate.NewWithRuleSet(rules)
+//
+// Supported options are required, value=, oneof= (pipe-separated), minlen=,
+// maxlen= and pattern=; on slice fields the value options apply to each
+// repetition. Marshal options such as omitempty are skipped.
+// Malformed options, including arguments cut short by a comma, make
+// RulesFromStruct return an error wrapping ErrInvalidTag.
+//
 // # Message Type Specific Validation
 //
 // Create validators for specific message types:
@@ -124,6 +289,35 @@
 //	    }
 //	}
 //
+// # Severity
+//
+// Rules report errors by default. AsWarning and AsInfo lower the severity of
+// a built rule, and WithSeverity wraps any other rule. Only errors make a
+// result invalid, so soft checks do not block message acceptance:
+//
+//	v := validate.New(
+//	    validate.At("PID.3").Required().Build(),
+//	    validate.At("PID.13").Required().AsWarning().Build(),
+//	)
+//	result := v.Validate(msg)
+//	result.Valid()                            // false only if PID-3 is missing
+//	result.Warnings()                         // PID-13 findings
+//	result.BySeverity(hl7.SeverityInfo)       // informational findings
+//	result.Issues()                           // everything, in rule order
+//
+// # Validator Options
+//
+// NewWithOptions tunes validation of large messages. WithMaxErrors stops
+// after a number of errors, WithConcurrency runs rules in parallel while
+// keeping failures in rule order, and WithLookupCache looks up each location
+// once per message:
+//
+//	v := validate.NewWithOptions(rules,
+//	    validate.WithMaxErrors(10),
+//	    validate.WithConcurrency(runtime.NumCPU()),
+//	    validate.WithLookupCache(true),
+//	)
+//
 // # Creating Custom Rules
 //
 // Implement the Rule interface for custom validation logic:
@@ -0,0 +1,254 @@
+package validate
+
+import (
+	"sync"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ValidatorOption is a functional option for configuring a Validator.
+type ValidatorOption func(*validator)
+
+// WithMaxErrors stops validation once limit errors have been found. Rules
+// after the one that reaches the limit are not run, and the result holds
+// exactly limit errors; warnings and informational issues do not count
+// towards the limit. A limit of 0 (the default) runs every rule.
+func WithMaxErrors(limit int) ValidatorOption {
+	return func(v *validator) {
+		if limit >= 0 {
+			v.maxErrors = limit
+		}
+	}
+}
+
+// WithConcurrency runs rules on up to workers goroutines. Failures are still
+// reported in rule order, so results are the same as with sequential
+// validation. Rules must be safe for concurrent use; the built-in rules
+// are. A value of 1 or less (the default) runs rules sequentially.
+func WithConcurrency(workers int) ValidatorOption {
+	return func(v *validator) {
+		if workers > 0 {
+			v.workers = workers
+		}
+	}
+}
+
+// WithLookupCache caches the values returned by Message.Get and
+// Message.GetAll for the duration of a Validate call, so rules sharing a
+// location look it up once. Enable it for large rule sets with many rules
+// per location.
+func WithLookupCache(enabled bool) ValidatorOption {
+	return func(v *validator) {
+		v.cache = enabled
+	}
+}
+
+// NewWithOptions creates a new Validator from a RuleSet with options.
+//
+// Example:
+//
+//	v := validate.NewWithOptions(rules,
+//	    validate.WithMaxErrors(10),
+//	    validate.WithConcurrency(runtime.NumCPU()),
+//	    validate.WithLookupCache(true),
+//	)
+func NewWithOptions(rs RuleSet, opts ...ValidatorOption) Validator {
+	v := &validator{}
+	if rs != nil {
+		v.rules = rs.Rules()
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// cachedMessage memoizes Get and GetAll lookups of a message. It is safe for
+// concurrent use by rules running in parallel.
+type cachedMessage struct {
+	hl7.Message
+	mu     sync.RWMutex
+	values map[string]cachedValue
+	lists  map[string]cachedList
+}
+
+// cachedValue is a memoized Get result.
+type cachedValue struct {
+	value string
+	err   error
+}
+
+// cachedList is a memoized GetAll result.
+type cachedList struct {
+	values []string
+	err    error
+}
+
+// newCachedMessage wraps msg with a lookup cache.
+func newCachedMessage(msg hl7.Message) *cachedMessage {
+	return &cachedMessage{
+		Message: msg,
+		values:  make(map[string]cachedValue),
+		lists:   make(map[string]cachedList),
+	}
+}
+
+// Get returns the value at location, looking it up once.
+func (m *cachedMessage) Get(location string) (string, error) {
+	m.mu.RLock()
+	cached, ok := m.values[location]
+	m.mu.RUnlock()
+	if ok {
+		return cached.value, cached.err
+	}
+
+	value, err := m.Message.Get(location)
+	m.mu.Lock()
+	m.values[location] = cachedValue{value: value, err: err}
+	m.mu.Unlock()
+	return value, err
+}
+
+// GetAll returns all values at location, looking them up once. Each call
+// returns a new slice.
+func (m *cachedMessage) GetAll(location string) ([]string, error) {
+	m.mu.RLock()
+	cached, ok := m.lists[location]
+	m.mu.RUnlock()
+	if !ok {
+		values, err := m.Message.GetAll(location)
+		cached = cachedList{values: values, err: err}
+		m.mu.Lock()
+		m.lists[location] = cached
+		m.mu.Unlock()
+	}
+
+	if cached.values == nil {
+		return nil, cached.err
+	}
+	values := make([]string, len(cached.values))
+	copy(values, cached.values)
+	return values, cached.err
+}
+
+// Set sets the value at location and clears the cache.
+func (m *cachedMessage) Set(location string, value string) error {
+	m.reset()
+	return m.Message.Set(location, value)
+}
+
+// SetAt sets the value at loc and clears the cache.
+func (m *cachedMessage) SetAt(loc *hl7.Location, value string) error {
+	m.reset()
+	return m.Message.SetAt(loc, value)
+}
+
+// reset clears the cache.
+func (m *cachedMessage) reset() {
+	m.mu.Lock()
+	m.values = make(map[string]cachedValue)
+	m.lists = make(map[string]cachedList)
+	m.mu.Unlock()
+}
+
+// runRules applies rules to msg according to the validator options and adds
+// their failures to result in rule order.
+func (v *validator) runRules(msg hl7.Message, rules []Rule, result *validationResult) {
+	if v.cache {
+		msg = newCachedMessage(msg)
+	}
+
+	if v.workers <= 1 || len(rules) <= 1 {
+		for _, rule := range rules {
+			if v.addLimited(result, rule.Validate(msg)) {
+				return
+			}
+		}
+		return
+	}
+
+	// Workers take rules in order; results are merged in rule order, and
+	// workers stop taking rules once the merged errors reach the limit
+	type ruleResult struct {
+		index int
+		errs  []ValidationError
+	}
+
+	var (
+		mu      sync.Mutex
+		next    int
+		stopped bool
+	)
+	take := func() (int, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped || next >= len(rules) {
+			return 0, false
+		}
+		next++
+		return next - 1, true
+	}
+
+	workers := v.workers
+	if workers > len(rules) {
+		workers = len(rules)
+	}
+	results := make(chan ruleResult, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				index, ok := take()
+				if !ok {
+					return
+				}
+				results <- ruleResult{index: index, errs: rules[index].Validate(msg)}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	pending := make(map[int][]ValidationError)
+	merged, done := 0, false
+	for r := range results {
+		if done {
+			continue
+		}
+		pending[r.index] = r.errs
+		for !done {
+			errs, ok := pending[merged]
+			if !ok {
+				break
+			}
+			delete(pending, merged)
+			merged++
+			if v.addLimited(result, errs) {
+				done = true
+				mu.Lock()
+				stopped = true
+				mu.Unlock()
+			}
+		}
+	}
+}
+
+// addLimited adds failures to result up to the error limit and reports
+// whether the limit has been reached.
+func (v *validator) addLimited(result *validationResult, errs []ValidationError) bool {
+	if v.maxErrors <= 0 {
+		result.add(errs)
+		return false
+	}
+	for i := range errs {
+		if len(result.errors) >= v.maxErrors {
+			return true
+		}
+		result.add(errs[i : i+1])
+	}
+	return len(result.errors) >= v.maxErrors
+}
@@ -0,0 +1,101 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+// countingMessage counts Get calls on a mock message.
+type countingMessage struct {
+	*mockMessage
+	gets atomic.Int64
+}
+
+func (m *countingMessage) Get(location string) (string, error) {
+	m.gets.Add(1)
+	return m.mockMessage.Get(location)
+}
+
+// manyRules returns n rules alternating between failing required checks,
+// warnings and passing checks.
+func manyRules(n int) RuleSet {
+	rs := NewRuleSet()
+	for i := 0; i < n; i++ {
+		loc := fmt.Sprintf("OBX[%d].5", i)
+		switch i % 3 {
+		case 0:
+			rs.Add(At(loc).Required().Build())
+		case 1:
+			rs.Add(At(loc).Required().AsWarning().Build())
+		default:
+			rs.Add(At("MSH.10").Required().Build())
+		}
+	}
+	return rs
+}
+
+func TestWithMaxErrors(t *testing.T) {
+	msg := &countingMessage{mockMessage: newMockMessage()}
+	msg.setField("MSH.10", "1")
+
+	v := NewWithOptions(manyRules(30), WithMaxErrors(3))
+	result := v.Validate(msg)
+
+	if n := len(result.Errors()); n != 3 {
+		t.Fatalf("Errors() = %d, want 3", n)
+	}
+	if loc := result.Errors()[2].Location; loc != "OBX[6].5" {
+		t.Errorf("last error at %s, want OBX[6].5", loc)
+	}
+	if n := len(result.Warnings()); n != 2 {
+		t.Errorf("Warnings() = %d, want the 2 before the limit", n)
+	}
+	if gets := msg.gets.Load(); gets != 7 {
+		t.Errorf("Get called %d times, want 7 (rules after the limit must not run)", gets)
+	}
+}
+
+func TestWithConcurrency_Deterministic(t *testing.T) {
+	msg := newMockMessage()
+	msg.setField("MSH.10", "1")
+
+	for _, limit := range []int{0, 1, 17} {
+		want := NewWithOptions(manyRules(200), WithMaxErrors(limit)).Validate(msg).Issues()
+		for _, workers := range []int{2, 8, 64} {
+			v := NewWithOptions(manyRules(200), WithMaxErrors(limit), WithConcurrency(workers))
+			for run := 0; run < 5; run++ {
+				got := v.Validate(msg).Issues()
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("limit %d, %d workers: Issues() differ from sequential validation (%d vs %d)", limit, workers, len(got), len(want))
+				}
+			}
+		}
+	}
+}
+
+func TestWithLookupCache(t *testing.T) {
+	msg := &countingMessage{mockMessage: newMockMessage()}
+	msg.setField("PID.8", "F")
+
+	rules := NewRuleSet(
+		At("PID.8").Required().Build(),
+		At("PID.8").OneOf("M", "F").Build(),
+		At("PID.8").Length(1, 1).Build(),
+	)
+
+	result := NewWithOptions(rules, WithLookupCache(true)).Validate(msg)
+	if !result.Valid() {
+		t.Fatalf("Validate() errors = %v", result.Errors())
+	}
+	if gets := msg.gets.Load(); gets != 1 {
+		t.Errorf("Get called %d times with cache, want 1", gets)
+	}
+
+	msg.gets.Store(0)
+	NewWithOptions(rules).Validate(msg)
+	if gets := msg.gets.Load(); gets != 3 {
+		t.Errorf("Get called %d times without cache, want 3", gets)
+	}
+}
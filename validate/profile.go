@@ -0,0 +1,630 @@
+package validate
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ErrInvalidProfile indicates a conformance profile could not be compiled.
+var ErrInvalidProfile = errors.New("invalid conformance profile")
+
+// Profile is an HL7 v2 conformance profile compiled into validation rules.
+type Profile struct {
+	// Identifier is the profile identifier matched against MSH-21.
+	Identifier string
+	// Name is the profile name from the profile metadata.
+	Name string
+	// HL7Version is the HL7 version the profile constrains, e.g. "2.5.1".
+	HL7Version string
+	// MessageType is the constrained message type, e.g. "ADT".
+	MessageType string
+	// EventType is the constrained trigger event, e.g. "A01".
+	EventType string
+	// Structure is the message structure ID, e.g. "ADT_A01".
+	Structure string
+
+	rules RuleSet
+}
+
+// RuleSet returns the rules compiled from the profile.
+func (p *Profile) RuleSet() RuleSet {
+	return NewRuleSet(p.rules.Rules()...)
+}
+
+// LoadProfile reads an HL7 v2 XML conformance profile from a file and
+// compiles it into validation rules. See ParseProfile.
+func LoadProfile(path string) (*Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p, err := ParseProfile(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// ParseProfile reads an HL7 v2 XML conformance profile (HL7v2xConformanceProfile,
+// as published by the Messaging Workbench and IGAMT) and compiles it into
+// validation rules:
+//
+//   - Segment and SegGroup order, Usage, Min and Max become a structure rule
+//     reporting error code 100
+//   - Field, Component and SubComponent Usage "R" becomes a required rule and
+//     Usage "X" a rule that the element is absent; components and
+//     subcomponents are only checked when their parent is present, and the
+//     fields of a segment only when the segment is present
+//   - Field Min and Max become a repetition count rule
+//   - Length becomes a maximum length rule
+//   - Primitive Datatype values (NM, DT, DTM, ...) become data type rules
+//   - ConstantValue becomes a value rule
+//   - Table references become table rules reporting error code 103 when the
+//     table is defined in the profile or shipped with the package (HL7Tables)
+//
+// Tables defined in hl7tables elements of the profile take precedence:
+//
+//	<hl7tables>
+//	  <hl7table id="0001" name="Administrative Sex">
+//	    <tableElement code="F" displayName="Female"/>
+//	  </hl7table>
+//	</hl7tables>
+//
+// A segment that appears in several places of the structure, such as OBX in
+// an OBSERVATION and a SPECIMEN group, is checked against the definition of
+// each place, for every segment the structure matches there. Otherwise only
+// the first occurrence of a repeating segment is checked.
+//
+// Conditional usage (C, CE) cannot be evaluated and is not checked, nor are
+// MSH-1 and MSH-2.
+func ParseProfile(r io.Reader) (*Profile, error) {
+	var doc xmlProfile
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProfile, err)
+	}
+	if doc.XMLName.Local != "HL7v2xConformanceProfile" {
+		return nil, fmt.Errorf("%w: root element is %s, want HL7v2xConformanceProfile", ErrInvalidProfile, doc.XMLName.Local)
+	}
+
+	c := &profileCompiler{
+		tables:   make(Tables),
+		uses:     make(map[string]int),
+		compiled: make(map[string]bool),
+	}
+	for _, t := range doc.Tables {
+		codes := make([]string, 0, len(t.Elements))
+		for _, e := range t.Elements {
+			codes = append(codes, e.Code)
+		}
+		c.tables[t.ID] = codes
+	}
+
+	c.countSegments(doc.StaticDef.Elements)
+	elements, err := c.compileElements(doc.StaticDef.Elements, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProfile, err)
+	}
+	for _, r := range c.bound {
+		r.elements = elements
+	}
+
+	def := doc.StaticDef
+	structure := def.MsgStructID
+	if structure == "" {
+		structure = def.MsgType + "_" + def.EventType
+	}
+
+	rules := []Rule{&structureRule{name: structure, elements: elements}}
+	rules = append(rules, c.rules...)
+
+	return &Profile{
+		Identifier:  doc.Identifier,
+		Name:        doc.MetaData.Name,
+		HL7Version:  doc.HL7Version,
+		MessageType: def.MsgType,
+		EventType:   def.EventType,
+		Structure:   structure,
+		rules:       NewRuleSet(rules...),
+	}, nil
+}
+
+// SelectProfile returns the profile whose Identifier matches one of the
+// message profile identifiers in MSH-21 (entity identifier, component 1).
+func SelectProfile(msg hl7.Message, profiles ...*Profile) (*Profile, bool) {
+	if msg == nil {
+		return nil, false
+	}
+
+	ids, err := msg.GetAll("MSH.21.1")
+	if err != nil {
+		return nil, false
+	}
+
+	for _, id := range ids {
+		for _, p := range profiles {
+			if p != nil && id != "" && p.Identifier == id {
+				return p, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// xmlProfile is the root of an HL7 v2 XML conformance profile.
+type xmlProfile struct {
+	XMLName    xml.Name
+	HL7Version string `xml:"HL7Version,attr"`
+	Identifier string `xml:"Identifier,attr"`
+	MetaData   struct {
+		Name string `xml:"Name,attr"`
+	} `xml:"MetaData"`
+	StaticDef xmlStaticDef `xml:"HL7v2xStaticDef"`
+	Tables    []xmlTable   `xml:"hl7tables>hl7table"`
+}
+
+// xmlStaticDef is the static definition of a message.
+type xmlStaticDef struct {
+	MsgType     string       `xml:"MsgType,attr"`
+	EventType   string       `xml:"EventType,attr"`
+	MsgStructID string       `xml:"MsgStructID,attr"`
+	Elements    []xmlElement `xml:",any"`
+}
+
+// xmlElement is a Segment or SegGroup element.
+type xmlElement struct {
+	XMLName  xml.Name
+	Name     string       `xml:"Name,attr"`
+	Usage    string       `xml:"Usage,attr"`
+	Min      string       `xml:"Min,attr"`
+	Max      string       `xml:"Max,attr"`
+	Fields   []xmlField   `xml:"Field"`
+	Children []xmlElement `xml:",any"`
+}
+
+// xmlField is a Field, Component or SubComponent element.
+type xmlField struct {
+	Name          string     `xml:"Name,attr"`
+	Usage         string     `xml:"Usage,attr"`
+	Min           string     `xml:"Min,attr"`
+	Max           string     `xml:"Max,attr"`
+	Datatype      string     `xml:"Datatype,attr"`
+	Length        string     `xml:"Length,attr"`
+	Table         string     `xml:"Table,attr"`
+	ConstantValue string     `xml:"ConstantValue,attr"`
+	Components    []xmlField `xml:"Component"`
+	SubComponents []xmlField `xml:"SubComponent"`
+}
+
+// xmlTable is a table embedded in the profile.
+type xmlTable struct {
+	ID       string `xml:"id,attr"`
+	Elements []struct {
+		Code string `xml:"code,attr"`
+	} `xml:"tableElement"`
+}
+
+// profileCompiler accumulates the rules compiled from a profile.
+type profileCompiler struct {
+	tables   Tables
+	uses     map[string]int  // number of Segment elements per segment name
+	compiled map[string]bool // segment element keys compiled so far
+	rules    []Rule
+	bound    []*elementRule
+}
+
+// countSegments counts the Segment elements per segment name.
+func (c *profileCompiler) countSegments(xmlElements []xmlElement) {
+	for _, x := range xmlElements {
+		switch x.XMLName.Local {
+		case "Segment":
+			c.uses[x.Name]++
+		case "SegGroup":
+			c.countSegments(x.Children)
+		}
+	}
+}
+
+// compileElements converts Segment and SegGroup elements into structure
+// elements, compiling segment field rules along the way. path is the
+// dot-separated names of the enclosing groups.
+func (c *profileCompiler) compileElements(xmlElements []xmlElement, path string) ([]structureElement, error) {
+	var elements []structureElement
+	for _, x := range xmlElements {
+		if x.XMLName.Local != "Segment" && x.XMLName.Local != "SegGroup" {
+			continue
+		}
+
+		minOccurs, maxOccurs, err := cardinality(x.Usage, x.Min, x.Max)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", x.XMLName.Local, x.Name, err)
+		}
+
+		e := structureElement{min: minOccurs, max: maxOccurs}
+		if x.XMLName.Local == "Segment" {
+			if len(x.Name) != 3 {
+				return nil, fmt.Errorf("invalid segment name %q", x.Name)
+			}
+			e.segment = x.Name
+			if err := c.compileSegmentElement(&e, x, path); err != nil {
+				return nil, fmt.Errorf("segment %s: %w", x.Name, err)
+			}
+		} else {
+			e.group = x.Name
+			if e.children, err = c.compileElements(x.Children, joinPath(path, x.Name)); err != nil {
+				return nil, err
+			}
+		}
+		elements = append(elements, e)
+	}
+	return elements, nil
+}
+
+// compileSegmentElement compiles the field rules of a Segment element. A
+// segment used in one place is checked at its first occurrence. A segment
+// used in several places, such as OBX in different groups, may be
+// constrained differently in each, so its rules are bound to the structure
+// element e and apply to each segment matched by it.
+func (c *profileCompiler) compileSegmentElement(e *structureElement, x xmlElement, path string) error {
+	if c.uses[x.Name] <= 1 {
+		return c.compileSegment(x)
+	}
+
+	e.key = joinPath(path, x.Name)
+	for n := 2; c.compiled[e.key]; n++ {
+		// The same segment in several places of one group
+		e.key = fmt.Sprintf("%s#%d", joinPath(path, x.Name), n)
+	}
+	c.compiled[e.key] = true
+
+	rules := c.rules
+	c.rules = nil
+	err := c.compileSegment(x)
+	bound := &elementRule{key: e.key, segment: x.Name, rules: c.rules}
+	c.rules = append(rules, bound)
+	c.bound = append(c.bound, bound)
+	return err
+}
+
+// joinPath appends name to a dot-separated element path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// compileSegment compiles the field rules of a segment. Rules only apply when
+// the segment is present; segment presence is checked by the structure rule.
+func (c *profileCompiler) compileSegment(seg xmlElement) error {
+	for i, f := range seg.Fields {
+		if seg.Name == "MSH" && i < 2 {
+			// MSH-1 and MSH-2 hold the delimiters and are checked by the parser
+			continue
+		}
+		loc := fmt.Sprintf("%s.%d", seg.Name, i+1)
+		if err := c.compileField(seg.Name, loc, "", f); err != nil {
+			return fmt.Errorf("field %d (%s): %w", i+1, f.Name, err)
+		}
+	}
+	return nil
+}
+
+// compileField compiles the rules of a field, component or subcomponent at
+// loc. parent is the location of the enclosing field or component, if any.
+func (c *profileCompiler) compileField(segment, loc, parent string, f xmlField) error {
+	b := At(loc)
+
+	switch strings.ToUpper(f.Usage) {
+	case "R":
+		b.Required()
+	case "X":
+		b.Custom(func(value string) error {
+			if value != "" {
+				return errors.New("element is not supported")
+			}
+			return nil
+		})
+	}
+
+	if f.Length != "" {
+		n, err := strconv.Atoi(f.Length)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid Length %q", f.Length)
+		}
+		if n > 0 {
+			b.Length(0, n)
+		}
+	}
+
+	if dt := strings.ToUpper(f.Datatype); primitiveTypes[dt] != nil {
+		b.Type(dt)
+	}
+	if f.ConstantValue != "" {
+		b.Value(f.ConstantValue)
+	}
+	if f.Table != "" {
+		// Tables embedded in the profile take precedence over the HL7 tables
+		tables := ChainTables(c.tables, hl7Tables)
+		if codes, ok := tables.Codes(f.Table); ok && len(codes) > 0 {
+			b.TableFrom(tables, f.Table)
+		}
+	}
+
+	rule := b.Build()
+	if parent != "" {
+		rule = &presentRule{location: parent, rule: rule}
+	}
+	c.add(segment, rule)
+
+	// Repetition counts apply to fields only
+	if parent == "" && (f.Min != "" || f.Max != "") {
+		minReps, maxReps, err := cardinality(f.Usage, f.Min, f.Max)
+		if err != nil {
+			return err
+		}
+		if minReps > 1 || maxReps >= 0 {
+			c.add(segment, &repetitionCountRule{location: loc, min: minReps, max: maxReps})
+		}
+	}
+
+	children, sub := f.Components, false
+	if len(children) == 0 {
+		children, sub = f.SubComponents, true
+	}
+	for i, child := range children {
+		childLoc := fmt.Sprintf("%s.%d", loc, i+1)
+		if sub && i == 0 {
+			// A component without subcomponent separators is its own first
+			// subcomponent, and Get on a component returns subcomponent 1
+			childLoc = loc
+		}
+		if err := c.compileField(segment, childLoc, loc, child); err != nil {
+			return fmt.Errorf("component %d (%s): %w", i+1, child.Name, err)
+		}
+	}
+	return nil
+}
+
+// add adds a rule that applies only when the segment is present.
+func (c *profileCompiler) add(segment string, rule Rule) {
+	if _, ok := rule.(*noopRule); ok {
+		return
+	}
+	c.rules = append(c.rules, &presentRule{location: segment, rule: rule})
+}
+
+// cardinality returns the minimum and maximum occurrences from profile
+// attributes. Usage "X" allows no occurrences; Usage "R" at least one.
+// A Max of "*" is unbounded (-1).
+func cardinality(usage, minAttr, maxAttr string) (int, int, error) {
+	minOccurs, maxOccurs := 0, 1
+
+	if minAttr != "" {
+		n, err := strconv.Atoi(minAttr)
+		if err != nil || n < 0 {
+			return 0, 0, fmt.Errorf("invalid Min %q", minAttr)
+		}
+		minOccurs = n
+	}
+
+	switch maxAttr {
+	case "":
+	case "*":
+		maxOccurs = -1
+	default:
+		n, err := strconv.Atoi(maxAttr)
+		if err != nil || n < 0 {
+			return 0, 0, fmt.Errorf("invalid Max %q", maxAttr)
+		}
+		maxOccurs = n
+	}
+
+	switch strings.ToUpper(usage) {
+	case "R":
+		if minOccurs < 1 {
+			minOccurs = 1
+		}
+	case "X":
+		minOccurs, maxOccurs = 0, 0
+	}
+
+	if maxOccurs >= 0 && minOccurs > maxOccurs {
+		return 0, 0, fmt.Errorf("Min %d exceeds Max %d", minOccurs, maxOccurs)
+	}
+	return minOccurs, maxOccurs, nil
+}
+
+// elementRule applies the field rules of a profile segment to each segment
+// that the message structure matches to one structure element, e.g. the OBX
+// segments of a SPECIMEN group. Failures are reported at the segment
+// instance, e.g. "OBX[2].5".
+type elementRule struct {
+	elements []structureElement // the profile's message structure
+	key      string             // key of the structure element
+	segment  string
+	rules    []Rule
+}
+
+// Validate matches the message against the structure and applies the rules
+// to each segment matched by the element.
+func (r *elementRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.segment,
+			Rule:     "profile",
+			Message:  "message is nil",
+		}}
+	}
+
+	m := newStructureMatcher(msg)
+	m.matchSequence(r.elements)
+
+	var errs []ValidationError
+	for _, seg := range m.segs {
+		if seg.key != r.key {
+			continue
+		}
+		in := instance{segment: seg.name, index: seg.occurrence, rep: -1}
+		for _, rule := range r.rules {
+			errs = append(errs, relocate(rule, in).Validate(msg)...)
+		}
+	}
+	return errs
+}
+
+// Location returns the segment name.
+func (r *elementRule) Location() string {
+	return r.segment
+}
+
+// Description returns a human-readable description of this rule.
+func (r *elementRule) Description() string {
+	return fmt.Sprintf("fields of %s", r.key)
+}
+
+// presentRule applies a rule only when a segment, field or component is in
+// the message.
+type presentRule struct {
+	location string
+	rule     Rule
+}
+
+// Validate applies the wrapped rule if the location is present.
+func (r *presentRule) Validate(msg hl7.Message) []ValidationError {
+	if msg != nil && !elementPresent(msg, r.location) {
+		return nil
+	}
+	return r.rule.Validate(msg)
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *presentRule) Location() string {
+	return r.rule.Location()
+}
+
+// Description returns a human-readable description of this rule.
+func (r *presentRule) Description() string {
+	return r.rule.Description()
+}
+
+// elementPresent reports whether the first occurrence of a segment, field or
+// component has any content. Unlike Get, which returns only the first
+// component or subcomponent, it considers the encoded element.
+func elementPresent(msg hl7.Message, location string) bool {
+	loc, err := hl7.ParseLocation(location)
+	if err != nil {
+		return false
+	}
+	seg, ok := msg.Segment(loc.Segment)
+	if !ok {
+		return false
+	}
+	if !loc.HasField() {
+		return true
+	}
+	field, ok := seg.Field(loc.Field)
+	if !ok {
+		return false
+	}
+	if !loc.HasComponent() {
+		return field.String() != ""
+	}
+	rep, ok := field.Repetition(0)
+	if !ok {
+		return false
+	}
+	comp, ok := rep.Component(loc.Component)
+	return ok && comp.String() != ""
+}
+
+// repetitionCountRule validates the number of repetitions of a field, or the
+// number of occurrences of a segment if the location has no field.
+type repetitionCountRule struct {
+	location    string
+	min         int
+	max         int // -1 for unbounded
+	description string
+}
+
+// Validate counts the repetitions of the field in the first matching segment.
+// Empty fields count as zero repetitions.
+func (r *repetitionCountRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "repetitions",
+			Message:  "message is nil",
+		}}
+	}
+
+	loc, err := hl7.ParseLocation(r.location)
+	if err != nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "repetitions",
+			Message:  fmt.Sprintf("invalid location: %v", err),
+		}}
+	}
+
+	what, count := "field", 0
+	if !loc.HasField() {
+		what, count = "segment", len(msg.Segments(loc.Segment))
+		if loc.SegmentIndex >= 0 {
+			count = min(count-loc.SegmentIndex, 1)
+		}
+		count = max(count, 0)
+	} else if value, err := msg.Get(r.location); err == nil && value != "" {
+		if loc.SegmentIndex < 0 {
+			loc.SegmentIndex = 0
+		}
+		if reps, err := msg.GetAllAt(loc); err == nil {
+			count = len(reps)
+		}
+	}
+
+	switch {
+	case count < r.min:
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "repetitions",
+			Message:  fmt.Sprintf("%s has %d repetitions, minimum is %d", what, count, r.min),
+			Expected: fmt.Sprintf("at least %d", r.min),
+			Actual:   strconv.Itoa(count),
+		}}
+	case r.max >= 0 && count > r.max:
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "repetitions",
+			Message:  fmt.Sprintf("%s has %d repetitions, maximum is %d", what, count, r.max),
+			Expected: fmt.Sprintf("at most %d", r.max),
+			Actual:   strconv.Itoa(count),
+		}}
+	}
+
+	return nil
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *repetitionCountRule) Location() string {
+	return r.location
+}
+
+// Description returns a human-readable description of this rule.
+func (r *repetitionCountRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	if r.max < 0 {
+		return fmt.Sprintf("%s must repeat at least %d times", r.location, r.min)
+	}
+	return fmt.Sprintf("%s must repeat %d to %d times", r.location, r.min, r.max)
+}
@@ -0,0 +1,226 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/parse"
+)
+
+const profileMessage = "MSH|^~\\&|APP|FAC|||20240115103000||ADT^A01^ADT_A01|CTRL1|P|2.5.1|||||||||ADT_A01_PARTNER^HL7\r" +
+	"EVN|A01|20240115103000\r" +
+	"PID|1||12345^^^HOSP^MR||Doe^John||19800115|M\r" +
+	"PV1|1|I\r" +
+	"PR1|1\r" +
+	"ROL|1\r" +
+	"PR1|2\r"
+
+func loadTestProfile(t *testing.T) *Profile {
+	t.Helper()
+	p, err := LoadProfile("testdata/adt_a01_profile.xml")
+	if err != nil {
+		t.Fatalf("LoadProfile() error = %v", err)
+	}
+	return p
+}
+
+func parseMessage(t *testing.T, data string) hl7.Message {
+	t.Helper()
+	msg, err := parse.New().Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return msg
+}
+
+func TestLoadProfile(t *testing.T) {
+	p := loadTestProfile(t)
+
+	if p.Identifier != "ADT_A01_PARTNER" || p.Name != "Partner ADT A01" || p.HL7Version != "2.5.1" {
+		t.Errorf("profile = %+v, want identifier, name and version from the XML", p)
+	}
+	if p.MessageType != "ADT" || p.EventType != "A01" || p.Structure != "ADT_A01" {
+		t.Errorf("profile message = %s^%s^%s, want ADT^A01^ADT_A01", p.MessageType, p.EventType, p.Structure)
+	}
+	if len(p.RuleSet().Rules()) == 0 {
+		t.Error("RuleSet() has no rules")
+	}
+}
+
+func TestProfile_ValidMessage(t *testing.T) {
+	p := loadTestProfile(t)
+	msg := parseMessage(t, profileMessage)
+
+	result := NewWithRuleSet(p.RuleSet()).Validate(msg)
+	if !result.Valid() {
+		t.Errorf("Validate() errors = %v, want none", result.Errors())
+	}
+}
+
+func TestProfile_Violations(t *testing.T) {
+	p := loadTestProfile(t)
+
+	tests := []struct {
+		name     string
+		replace  [2]string
+		location string
+		rule     string
+	}{
+		{"required field", [2]string{"|20240115103000||ADT", "|||ADT"}, "MSH.7", "required"},
+		{"required component", [2]string{"||12345^^^HOSP^MR||", "||^^^HOSP^MR||"}, "PID.3.1", "required"},
+		{"required subcomponent", [2]string{"^^^HOSP^MR", "^^^&1.2.3&ISO^MR"}, "PID.3.4", "required"},
+		{"not supported field", [2]string{"PID|1||", "PID|1|X|"}, "PID.2", "custom"},
+		{"constant value", [2]string{"ADT^A01^ADT_A01", "ADT^A04^ADT_A01"}, "MSH.9.2", "value"},
+		{"embedded table", [2]string{"|19800115|M", "|19800115|Z"}, "PID.8", "table"},
+		{"length", [2]string{"|CTRL1|", "|" + strings.Repeat("C", 21) + "|"}, "MSH.10", "length"},
+		{"data type", [2]string{"|19800115|", "|1980-01-15|"}, "PID.7", "type"},
+		{"repetitions", [2]string{"12345^^^HOSP^MR", "1^^^H^MR~2^^^H^MR~3^^^H^MR"}, "PID.3", "repetitions"},
+		{"missing segment", [2]string{"PV1|1|I\r", ""}, "PV1", "structure"},
+		{"segment order", [2]string{"EVN|A01|20240115103000\rPID|1||12345^^^HOSP^MR||Doe^John||19800115|M\r", "PID|1||12345^^^HOSP^MR||Doe^John||19800115|M\rEVN|A01|20240115103000\r"}, "EVN[0]", "structure"},
+		{"segment maximum", [2]string{"PV1|1|I\r", "PV1|1|I\rPV1|2|I\r"}, "PV1[1]", "structure"},
+		{"group order", [2]string{"PR1|1\rROL|1\r", "ROL|1\rPR1|1\r"}, "ROL[0]", "structure"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := strings.Replace(profileMessage, tt.replace[0], tt.replace[1], 1)
+			if data == profileMessage {
+				t.Fatalf("replacement %q not found", tt.replace[0])
+			}
+			msg := parseMessage(t, data)
+
+			errs := NewWithRuleSet(p.RuleSet()).Validate(msg).Errors()
+			for _, e := range errs {
+				if e.Location == tt.location && e.Rule == tt.rule {
+					return
+				}
+			}
+			t.Errorf("Validate() errors = %v, want a %s error at %s", errs, tt.rule, tt.location)
+		})
+	}
+}
+
+func TestProfile_StructureErrorCode(t *testing.T) {
+	p := loadTestProfile(t)
+	data := strings.Replace(profileMessage, "PV1|1|I\r", "", 1)
+
+	errs := NewWithRuleSet(p.RuleSet()).Validate(parseMessage(t, data)).Errors()
+	if len(errs) != 1 {
+		t.Fatalf("Validate() errors = %v, want 1", errs)
+	}
+	if errs[0].Code != CodeSegmentSequence {
+		t.Errorf("Code = %q, want %q", errs[0].Code, CodeSegmentSequence)
+	}
+	if !strings.Contains(errs[0].Message, "position 4") {
+		t.Errorf("Message = %q, want the position of the missing segment", errs[0].Message)
+	}
+}
+
+// groupProfile constrains OBX differently in the OBSERVATION and SPECIMEN
+// groups: an observation OBX needs a value, a specimen OBX must have none.
+const groupProfile = `<HL7v2xConformanceProfile Identifier="ORU_GROUPS">
+  <HL7v2xStaticDef MsgType="ORU" EventType="R01" MsgStructID="ORU_R01">
+    <Segment Name="MSH" Usage="R" Min="1" Max="1"/>
+    <SegGroup Name="ORDER_OBSERVATION" Usage="R" Min="1" Max="*">
+      <Segment Name="OBR" Usage="R" Min="1" Max="1"/>
+      <SegGroup Name="OBSERVATION" Usage="RE" Min="0" Max="*">
+        <Segment Name="OBX" Usage="R" Min="1" Max="1">
+          <Field Name="Set ID" Usage="O"/>
+          <Field Name="Value Type" Usage="O"/>
+          <Field Name="Observation Identifier" Usage="O"/>
+          <Field Name="Observation Sub-ID" Usage="O"/>
+          <Field Name="Observation Value" Usage="R"/>
+        </Segment>
+      </SegGroup>
+      <SegGroup Name="SPECIMEN" Usage="RE" Min="0" Max="*">
+        <Segment Name="SPM" Usage="R" Min="1" Max="1"/>
+        <Segment Name="OBX" Usage="RE" Min="0" Max="*">
+          <Field Name="Set ID" Usage="O"/>
+          <Field Name="Value Type" Usage="O"/>
+          <Field Name="Observation Identifier" Usage="O"/>
+          <Field Name="Observation Sub-ID" Usage="O"/>
+          <Field Name="Observation Value" Usage="X"/>
+        </Segment>
+      </SegGroup>
+    </SegGroup>
+  </HL7v2xStaticDef>
+</HL7v2xConformanceProfile>`
+
+func TestProfile_SegmentInSeveralGroups(t *testing.T) {
+	p, err := ParseProfile(strings.NewReader(groupProfile))
+	if err != nil {
+		t.Fatalf("ParseProfile() error = %v", err)
+	}
+
+	const header = "MSH|^~\\&|APP|FAC|||20240115103000||ORU^R01^ORU_R01|CTRL1|P|2.5.1\r"
+	tests := []struct {
+		name string
+		data string
+		want []string // "location rule" of each error
+	}{
+		{"valid", "OBR|1\rOBX|1|NM|GLU||95\rSPM|1\rOBX|1|ST|TYPE\r", nil},
+		{"observation without value", "OBR|1\rOBX|1|NM|GLU\rSPM|1\rOBX|1|ST|TYPE\r", []string{"OBX[0].5 required"}},
+		{"specimen with value", "OBR|1\rOBX|1|NM|GLU||95\rSPM|1\rOBX|1|ST|TYPE||x\r", []string{"OBX[1].5 custom"}},
+		{"second order", "OBR|1\rOBX|1|NM|GLU||95\rOBR|2\rOBX|1|NM|GLU\r", []string{"OBX[1].5 required"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := NewWithRuleSet(p.RuleSet()).Validate(parseMessage(t, header+tt.data)).Errors()
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Location+" "+e.Rule)
+			}
+			if strings.Join(got, ", ") != strings.Join(tt.want, ", ") {
+				t.Errorf("Validate() errors = %v, want %v", errs, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseProfile_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"not xml", "not xml"},
+		{"wrong root", "<Profile/>"},
+		{"bad max", `<HL7v2xConformanceProfile><HL7v2xStaticDef><Segment Name="MSH" Usage="R" Max="many"/></HL7v2xStaticDef></HL7v2xConformanceProfile>`},
+		{"min above max", `<HL7v2xConformanceProfile><HL7v2xStaticDef><Segment Name="MSH" Min="2" Max="1"/></HL7v2xStaticDef></HL7v2xConformanceProfile>`},
+		{"bad segment name", `<HL7v2xConformanceProfile><HL7v2xStaticDef><Segment Name="MESSAGE"/></HL7v2xStaticDef></HL7v2xConformanceProfile>`},
+		{"bad length", `<HL7v2xConformanceProfile><HL7v2xStaticDef><Segment Name="PID"><Field Name="X" Length="long"/></Segment></HL7v2xStaticDef></HL7v2xConformanceProfile>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseProfile(strings.NewReader(tt.input))
+			if !errors.Is(err, ErrInvalidProfile) {
+				t.Errorf("ParseProfile() error = %v, want %v", err, ErrInvalidProfile)
+			}
+		})
+	}
+}
+
+func TestLoadProfile_MissingFile(t *testing.T) {
+	if _, err := LoadProfile("testdata/missing.xml"); err == nil {
+		t.Error("LoadProfile() expected error for missing file")
+	}
+}
+
+func TestSelectProfile(t *testing.T) {
+	p := loadTestProfile(t)
+	other := &Profile{Identifier: "OTHER"}
+
+	msg := parseMessage(t, strings.Replace(profileMessage, "ADT_A01_PARTNER^HL7", "X^HL7~ADT_A01_PARTNER^HL7", 1))
+	got, ok := SelectProfile(msg, other, p)
+	if !ok || got != p {
+		t.Errorf("SelectProfile() = %v, %v, want the partner profile", got, ok)
+	}
+
+	msg = parseMessage(t, strings.Replace(profileMessage, "ADT_A01_PARTNER^HL7", "", 1))
+	if _, ok := SelectProfile(msg, other, p); ok {
+		t.Error("SelectProfile() matched a message without MSH-21")
+	}
+}
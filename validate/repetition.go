@@ -0,0 +1,236 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// eachRule applies rules to every instance of a segment and every repetition
+// of a field, reporting failures at concrete locations such as "OBX[2].5[0]".
+type eachRule struct {
+	location    string
+	instance    Rule // checks once per segment instance, such as repetition counts; may be nil
+	repetition  Rule // checks once per field repetition; may be nil
+	conditions  []condition
+	description string
+}
+
+// Validate applies the rules to each segment instance and field repetition.
+// Segments that are absent are not checked.
+func (r *eachRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "each",
+			Message:  "message is nil",
+		}}
+	}
+
+	loc, err := hl7.ParseLocation(r.location)
+	if err != nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "each",
+			Message:  fmt.Sprintf("invalid location: %v", err),
+		}}
+	}
+
+	var errs []ValidationError
+	for i, seg := range msg.Segments(loc.Segment) {
+		if loc.SegmentIndex >= 0 && i != loc.SegmentIndex {
+			continue
+		}
+		in := instance{segment: loc.Segment, index: i, rep: -1}
+		if !r.holds(msg, in) {
+			continue
+		}
+
+		if r.instance != nil {
+			errs = append(errs, relocate(r.instance, in).Validate(msg)...)
+		}
+		if r.repetition == nil {
+			continue
+		}
+
+		first, last := 0, 0
+		if !loc.HasField() || loc.HasRepetition() {
+			// Check the location itself
+			first, last = -1, -1
+		} else if field, ok := seg.Field(loc.Field); ok && field.RepetitionCount() > 1 {
+			last = field.RepetitionCount() - 1
+		}
+		for rep := first; rep <= last; rep++ {
+			in.rep = rep
+			errs = append(errs, relocate(r.repetition, in).Validate(msg)...)
+		}
+	}
+	return errs
+}
+
+// holds reports whether the conditions hold for a segment instance.
+func (r *eachRule) holds(msg hl7.Message, in instance) bool {
+	for _, c := range r.conditions {
+		if !(condition{location: in.other(c.location), predicate: c.predicate}).holds(msg) {
+			return false
+		}
+	}
+	return true
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *eachRule) Location() string {
+	return r.location
+}
+
+// Description returns a human-readable description of this rule.
+func (r *eachRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	var desc string
+	switch {
+	case r.instance != nil && r.repetition != nil:
+		desc = r.instance.Description() + "; " + r.repetition.Description()
+	case r.instance != nil:
+		desc = r.instance.Description()
+	case r.repetition != nil:
+		desc = r.repetition.Description()
+	}
+	desc = "each " + r.location + ": " + desc
+	if len(r.conditions) > 0 {
+		conds := make([]string, len(r.conditions))
+		for i, c := range r.conditions {
+			conds[i] = c.String()
+		}
+		desc += " when " + strings.Join(conds, " and ")
+	}
+	return desc
+}
+
+// instance identifies a segment instance and field repetition that an
+// eachRule is checking.
+type instance struct {
+	segment string
+	index   int // 0-based segment index
+	rep     int // 0-based repetition index, -1 for none
+}
+
+// own returns the concrete location of a rule's own location: the segment
+// instance and, for fields, the repetition. Locations in other segments or
+// with explicit indexes keep them.
+func (in instance) own(location string) string {
+	loc, err := hl7.ParseLocation(location)
+	if err != nil || loc.Segment != in.segment {
+		return location
+	}
+	if loc.SegmentIndex < 0 {
+		loc.SegmentIndex = in.index
+	}
+	if loc.HasField() && loc.Repetition < 0 && in.rep >= 0 {
+		loc.Repetition = in.rep
+	}
+	return loc.String()
+}
+
+// other returns the location of a related field, such as a condition or
+// comparison field, in the same segment instance.
+func (in instance) other(location string) string {
+	loc, err := hl7.ParseLocation(location)
+	if err != nil || loc.Segment != in.segment || loc.SegmentIndex >= 0 {
+		return location
+	}
+	loc.SegmentIndex = in.index
+	return loc.String()
+}
+
+// others applies other to each location.
+func (in instance) others(locations []string) []string {
+	result := make([]string, len(locations))
+	for i, loc := range locations {
+		result[i] = in.other(loc)
+	}
+	return result
+}
+
+// relocate returns a copy of rule checking the segment instance and field
+// repetition. Rules without a location to move are returned unchanged.
+func relocate(rule Rule, in instance) Rule {
+	switch r := rule.(type) {
+	case *requiredRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *valueRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *patternRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *invalidPatternRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *lengthRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *oneOfRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *customRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *typeRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *tableRule:
+		c := *r
+		c.location = in.own(r.location)
+		return &c
+	case *compareRule:
+		c := *r
+		c.location = in.own(r.location)
+		c.other = in.other(r.other)
+		return &c
+	case *dateOrderRule:
+		c := *r
+		c.location = in.own(r.location)
+		c.other = in.other(r.other)
+		return &c
+	case *mutuallyExclusiveRule:
+		c := *r
+		c.location = in.own(r.location)
+		c.others = in.others(r.others)
+		return &c
+	case *atLeastOneOfRule:
+		c := *r
+		c.location = in.own(r.location)
+		c.others = in.others(r.others)
+		return &c
+	case *repetitionCountRule:
+		c := *r
+		c.location = in.other(r.location)
+		return &c
+	case *presentRule:
+		c := *r
+		c.location = in.own(r.location)
+		c.rule = relocate(r.rule, in)
+		return &c
+	case *compositeRule:
+		c := *r
+		c.location = in.own(r.location)
+		c.rules = make([]Rule, len(r.rules))
+		for i, sub := range r.rules {
+			c.rules[i] = relocate(sub, in)
+		}
+		return &c
+	}
+	return rule
+}
@@ -0,0 +1,137 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const repetitionMessage = "MSH|^~\\&|APP|FAC|||20240115103000||ORU^R01^ORU_R01|CTRL1|P|2.5.1\r" +
+	"PID|1||12345^^^HOSP^MR~^^^HOSP^SS~67890^^^HOSP^PI||Doe^John\r" +
+	"OBR|1||ORD1|GLU^Glucose\r" +
+	"OBX|1|NM|GLU^Glucose||95|mg/dL|||||F\r" +
+	"OBX|2||HGB^Hemoglobin||13.5|g/dL|||||F\r" +
+	"OBX|3|ST|NOTE^Note||||||||\r"
+
+func errorLocations(errs []ValidationError) []string {
+	locs := make([]string, len(errs))
+	for i, e := range errs {
+		locs[i] = e.Location
+	}
+	return locs
+}
+
+func TestEach_Segments(t *testing.T) {
+	msg := parseMessage(t, repetitionMessage)
+
+	tests := []struct {
+		name string
+		rule Rule
+		want []string
+	}{
+		{
+			name: "required in every segment",
+			rule: At("OBX.11").Required().Each().Build(),
+			want: []string{"OBX[2].11[0]"},
+		},
+		{
+			name: "condition in the same segment",
+			rule: At("OBX.2").Required().When("OBX.5", Present()).Each().Build(),
+			want: []string{"OBX[1].2[0]"},
+		},
+		{
+			name: "first segment only without Each",
+			rule: At("OBX.11").Required().Build(),
+			want: nil,
+		},
+		{
+			name: "explicit segment index",
+			rule: At("OBX[1].2").Required().Each().Build(),
+			want: []string{"OBX[1].2[0]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := errorLocations(tt.rule.Validate(msg))
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() locations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEach_Repetitions(t *testing.T) {
+	msg := parseMessage(t, repetitionMessage)
+
+	errs := At("PID.3.1").Required().Pattern(`^\d+$`).Each().Build().Validate(msg)
+	if got := errorLocations(errs); !reflect.DeepEqual(got, []string{"PID[0].3[1].1"}) {
+		t.Errorf("Validate() locations = %v, want PID[0].3[1].1", got)
+	}
+	if errs[0].Rule != "required" {
+		t.Errorf("Rule = %q, want required", errs[0].Rule)
+	}
+}
+
+func TestRepeats(t *testing.T) {
+	msg := parseMessage(t, repetitionMessage)
+
+	tests := []struct {
+		name string
+		rule Rule
+		want string
+	}{
+		{"field minimum met", At("PID.3").MinRepeats(2).Build(), ""},
+		{"field maximum exceeded", At("PID.3").MaxRepeats(2).Build(), "field has 3 repetitions, maximum is 2"},
+		{"field minimum missed", At("PID.5").MinRepeats(2).Build(), "field has 1 repetitions, minimum is 2"},
+		{"segment maximum exceeded", At("OBX").MaxRepeats(2).Build(), "segment has 3 repetitions, maximum is 2"},
+		{"segment minimum missed", At("NTE").MinRepeats(1).Build(), "segment has 0 repetitions, minimum is 1"},
+		{"per segment with Each", At("OBX.5").MinRepeats(1).Each().Build(), "field has 0 repetitions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.rule.Validate(msg)
+			if tt.want == "" {
+				if len(errs) != 0 {
+					t.Errorf("Validate() = %v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0].Message, tt.want) {
+				t.Errorf("Validate() = %v, want one error containing %q", errs, tt.want)
+			}
+		})
+	}
+
+	errs := At("OBX.5").MinRepeats(1).Each().Build().Validate(msg)
+	if len(errs) != 1 || errs[0].Location != "OBX[2].5" {
+		t.Errorf("Validate() = %v, want error at OBX[2].5", errs)
+	}
+}
+
+func TestEach_RuleDocument(t *testing.T) {
+	doc := `{"rules": [{"location": "OBX.11", "required": true, "each": true, "maxRepeats": 1,
+		"when": [{"location": "OBX.2", "present": true}]}]}`
+	rs, err := LoadRules(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("LoadRules() error = %v", err)
+	}
+
+	result := NewWithRuleSet(rs).Validate(parseMessage(t, repetitionMessage))
+	if got := errorLocations(result.Errors()); !reflect.DeepEqual(got, []string{"OBX[2].11[0]"}) {
+		t.Errorf("Errors() locations = %v, want OBX[2].11[0]", got)
+	}
+
+	exported, err := ExportRules(rs)
+	if err != nil {
+		t.Fatalf("ExportRules() error = %v", err)
+	}
+	spec := exported.Rules[0]
+	if !spec.Each || !spec.Required || spec.MaxRepeats != 1 || len(spec.When) != 1 {
+		t.Errorf("ExportRules() = %+v, want each, required, maxRepeats and condition", spec)
+	}
+}
@@ -0,0 +1,663 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ErrInvalidRules indicates a rule document could not be loaded or a rule
+// set could not be exported.
+var ErrInvalidRules = errors.New("invalid rule document")
+
+// RuleDocument is a declarative description of a rule set. It is read from
+// JSON by LoadRules and can be decoded from YAML with any YAML library, as
+// every field carries both json and yaml tags.
+type RuleDocument struct {
+	// Tables holds user-defined tables referenced by Table in rules. They
+	// override HL7 tables with the same identifier.
+	Tables map[string][]string `json:"tables,omitempty" yaml:"tables,omitempty"`
+	// Rules lists the rules in validation order.
+	Rules []RuleSpec `json:"rules" yaml:"rules"`
+}
+
+// RuleSpec describes one rule of a RuleDocument: the checks to apply at a
+// location, mirroring the methods of RuleBuilder. A rule with Structure set
+// validates segment order instead, and Location names the structure.
+type RuleSpec struct {
+	Location          string          `json:"location" yaml:"location"`
+	Description       string          `json:"description,omitempty" yaml:"description,omitempty"`
+	Severity          string          `json:"severity,omitempty" yaml:"severity,omitempty"`
+	Required          bool            `json:"required,omitempty" yaml:"required,omitempty"`
+	Value             string          `json:"value,omitempty" yaml:"value,omitempty"`
+	Pattern           string          `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	MinLength         int             `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	MaxLength         int             `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	OneOf             []string        `json:"oneOf,omitempty" yaml:"oneOf,omitempty"`
+	Type              string          `json:"type,omitempty" yaml:"type,omitempty"`
+	Table             string          `json:"table,omitempty" yaml:"table,omitempty"`
+	Compare           *CompareSpec    `json:"compare,omitempty" yaml:"compare,omitempty"`
+	Before            string          `json:"before,omitempty" yaml:"before,omitempty"`
+	After             string          `json:"after,omitempty" yaml:"after,omitempty"`
+	MutuallyExclusive []string        `json:"mutuallyExclusive,omitempty" yaml:"mutuallyExclusive,omitempty"`
+	AtLeastOneOf      []string        `json:"atLeastOneOf,omitempty" yaml:"atLeastOneOf,omitempty"`
+	MinRepeats        int             `json:"minRepeats,omitempty" yaml:"minRepeats,omitempty"`
+	MaxRepeats        int             `json:"maxRepeats,omitempty" yaml:"maxRepeats,omitempty"`
+	Each              bool            `json:"each,omitempty" yaml:"each,omitempty"`
+	Structure         string          `json:"structure,omitempty" yaml:"structure,omitempty"`
+	When              []ConditionSpec `json:"when,omitempty" yaml:"when,omitempty"`
+}
+
+// CompareSpec describes a comparison with the value at another location.
+// Op is one of "==", "!=", "<", "<=", ">" and ">=".
+type CompareSpec struct {
+	Op    string `json:"op" yaml:"op"`
+	Other string `json:"other" yaml:"other"`
+}
+
+// ConditionSpec describes a condition of a rule: the value at Location must
+// satisfy exactly one of the predicates.
+type ConditionSpec struct {
+	Location  string   `json:"location" yaml:"location"`
+	Equals    string   `json:"equals,omitempty" yaml:"equals,omitempty"`
+	NotEquals string   `json:"notEquals,omitempty" yaml:"notEquals,omitempty"`
+	In        []string `json:"in,omitempty" yaml:"in,omitempty"`
+	Present   bool     `json:"present,omitempty" yaml:"present,omitempty"`
+	Absent    bool     `json:"absent,omitempty" yaml:"absent,omitempty"`
+	Matches   string   `json:"matches,omitempty" yaml:"matches,omitempty"`
+}
+
+// RuleError reports a problem with one rule of a rule document or rule set.
+// It wraps ErrInvalidRules.
+type RuleError struct {
+	Index    int    // 0-based index of the rule
+	Location string // location of the rule, if known
+	Err      error  // the problem
+}
+
+// Error returns the error message, e.g.
+// "invalid rule document: rule 2 (PID.8): unknown table 9999".
+func (e *RuleError) Error() string {
+	if e.Location != "" {
+		return fmt.Sprintf("%s: rule %d (%s): %v", ErrInvalidRules, e.Index, e.Location, e.Err)
+	}
+	return fmt.Sprintf("%s: rule %d: %v", ErrInvalidRules, e.Index, e.Err)
+}
+
+// Unwrap returns ErrInvalidRules and the underlying problem.
+func (e *RuleError) Unwrap() []error {
+	return []error{ErrInvalidRules, e.Err}
+}
+
+// LoadRules reads a JSON rule document and builds its rule set. Unknown
+// fields are rejected, and problems with individual rules are reported as a
+// *RuleError carrying the rule index:
+//
+//	{
+//	  "tables": {"ZZ01": ["HOME", "TELE"]},
+//	  "rules": [
+//	    {"location": "PID.3", "required": true},
+//	    {"location": "PID.8", "table": "0001", "severity": "warning"},
+//	    {"location": "PV1.3", "required": true, "when": [{"location": "PV1.2", "equals": "I"}]},
+//	    {"location": "ADT_A01", "structure": "MSH EVN PID [{NK1}] PV1"}
+//	  ]
+//	}
+func LoadRules(r io.Reader) (RuleSet, error) {
+	var raw struct {
+		Tables map[string][]string `json:"tables"`
+		Rules  []json.RawMessage   `json:"rules"`
+	}
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRules, err)
+	}
+
+	doc := RuleDocument{Tables: raw.Tables, Rules: make([]RuleSpec, len(raw.Rules))}
+	for i, data := range raw.Rules {
+		ruleDec := json.NewDecoder(bytes.NewReader(data))
+		ruleDec.DisallowUnknownFields()
+		if err := ruleDec.Decode(&doc.Rules[i]); err != nil {
+			return nil, &RuleError{Index: i, Err: err}
+		}
+	}
+	return RulesFromDocument(doc)
+}
+
+// RulesFromDocument builds the rule set described by a rule document. Use it
+// with documents decoded from YAML or constructed in code.
+//
+// Example:
+//
+//	var doc validate.RuleDocument
+//	if err := yaml.Unmarshal(data, &doc); err != nil {
+//	    return err
+//	}
+//	rules, err := validate.RulesFromDocument(doc)
+func RulesFromDocument(doc RuleDocument) (RuleSet, error) {
+	rs := NewRuleSet()
+	for i, spec := range doc.Rules {
+		rule, err := buildSpec(spec, Tables(doc.Tables))
+		if err != nil {
+			return nil, &RuleError{Index: i, Location: spec.Location, Err: err}
+		}
+		rs.Add(rule)
+	}
+	return rs, nil
+}
+
+// SaveRules writes a rule set as an indented JSON rule document that
+// LoadRules reads back. See ExportRules for the rules that can be saved.
+func SaveRules(w io.Writer, rs RuleSet) error {
+	doc, err := ExportRules(rs)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// buildSpec builds the rule described by spec, looking tables up in the
+// document tables and then the HL7 tables.
+func buildSpec(spec RuleSpec, tables Tables) (Rule, error) {
+	if spec.Location == "" {
+		return nil, errors.New("location is required")
+	}
+	severity, err := parseSeverity(spec.Severity)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make([]condition, len(spec.When))
+	for i, cs := range spec.When {
+		c, err := buildCondition(cs)
+		if err != nil {
+			return nil, fmt.Errorf("condition %d: %w", i, err)
+		}
+		conditions[i] = c
+	}
+
+	var rule Rule
+	if spec.Structure != "" {
+		if rule, err = buildStructureSpec(spec); err != nil {
+			return nil, err
+		}
+		if len(conditions) > 0 {
+			rule = &conditionalRule{rule: rule, conditions: conditions}
+		}
+	} else if rule, err = buildFieldSpec(spec, tables, conditions); err != nil {
+		return nil, err
+	}
+
+	if severity != hl7.SeverityError {
+		rule = &severityRule{rule: rule, severity: severity}
+	}
+	return rule, nil
+}
+
+// buildStructureSpec builds a message structure rule.
+func buildStructureSpec(spec RuleSpec) (Rule, error) {
+	if !spec.isStructureOnly() || spec.Each {
+		return nil, errors.New("structure cannot be combined with field checks")
+	}
+	elements, err := parseStructure(spec.Structure)
+	if err != nil {
+		return nil, fmt.Errorf("invalid structure: %w", err)
+	}
+	return &structureRule{name: spec.Location, elements: elements, description: spec.Description}, nil
+}
+
+// isStructureOnly reports whether spec has no field checks.
+func (spec RuleSpec) isStructureOnly() bool {
+	return !spec.Required && spec.Value == "" && spec.Pattern == "" &&
+		spec.MinLength == 0 && spec.MaxLength == 0 && len(spec.OneOf) == 0 &&
+		spec.Type == "" && spec.Table == "" && spec.Compare == nil &&
+		spec.Before == "" && spec.After == "" &&
+		len(spec.MutuallyExclusive) == 0 && len(spec.AtLeastOneOf) == 0 &&
+		spec.MinRepeats == 0 && spec.MaxRepeats == 0
+}
+
+// buildFieldSpec builds the field checks of spec with a RuleBuilder, after
+// checking the arguments the builder would otherwise accept silently.
+func buildFieldSpec(spec RuleSpec, tables Tables, conditions []condition) (Rule, error) {
+	if spec.isStructureOnly() {
+		return nil, errors.New("rule has no checks")
+	}
+	if _, err := hl7.ParseLocation(spec.Location); err != nil {
+		return nil, fmt.Errorf("invalid location: %w", err)
+	}
+
+	b := At(spec.Location)
+	if spec.Required {
+		b.Required()
+	}
+	if spec.Value != "" {
+		b.Value(spec.Value)
+	}
+	if spec.Pattern != "" {
+		if _, err := regexp.Compile(spec.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		b.Pattern(spec.Pattern)
+	}
+	if spec.MinLength != 0 || spec.MaxLength != 0 {
+		if spec.MinLength < 0 || spec.MaxLength < 0 {
+			return nil, errors.New("lengths must not be negative")
+		}
+		if spec.MaxLength > 0 && spec.MinLength > spec.MaxLength {
+			return nil, fmt.Errorf("minLength %d exceeds maxLength %d", spec.MinLength, spec.MaxLength)
+		}
+		b.Length(spec.MinLength, spec.MaxLength)
+	}
+	if len(spec.OneOf) > 0 {
+		b.OneOf(spec.OneOf...)
+	}
+	if spec.Type != "" {
+		if !isKnownType(strings.ToUpper(spec.Type)) {
+			return nil, fmt.Errorf("unknown data type %s", spec.Type)
+		}
+		b.Type(spec.Type)
+	}
+	if spec.Table != "" {
+		if _, ok := tables[spec.Table]; ok {
+			b.TableFrom(tables, spec.Table)
+		} else if _, ok := hl7Tables[spec.Table]; ok {
+			b.Table(spec.Table)
+		} else {
+			return nil, fmt.Errorf("unknown table %s", spec.Table)
+		}
+	}
+	if spec.Compare != nil {
+		op, ok := parseComparison(spec.Compare.Op)
+		if !ok {
+			return nil, fmt.Errorf("unknown comparison %q", spec.Compare.Op)
+		}
+		if spec.Compare.Other == "" {
+			return nil, errors.New("comparison location is required")
+		}
+		b.Compare(op, spec.Compare.Other)
+	}
+	if spec.Before != "" {
+		b.Before(spec.Before)
+	}
+	if spec.After != "" {
+		b.After(spec.After)
+	}
+	if len(spec.MutuallyExclusive) > 0 {
+		b.MutuallyExclusive(spec.MutuallyExclusive...)
+	}
+	if len(spec.AtLeastOneOf) > 0 {
+		b.AtLeastOneOf(spec.AtLeastOneOf...)
+	}
+	if spec.MinRepeats < 0 || spec.MaxRepeats < 0 {
+		return nil, errors.New("repeat counts must not be negative")
+	}
+	if spec.MaxRepeats > 0 && spec.MinRepeats > spec.MaxRepeats {
+		return nil, fmt.Errorf("minRepeats %d exceeds maxRepeats %d", spec.MinRepeats, spec.MaxRepeats)
+	}
+	if spec.MinRepeats > 0 {
+		b.MinRepeats(spec.MinRepeats)
+	}
+	if spec.MaxRepeats > 0 {
+		b.MaxRepeats(spec.MaxRepeats)
+	}
+	if spec.Each {
+		b.Each()
+	}
+	for _, c := range conditions {
+		b.When(c.location, c.predicate)
+	}
+	if spec.Description != "" {
+		b.WithDescription(spec.Description)
+	}
+	return b.Build(), nil
+}
+
+// buildCondition builds a condition from its description.
+func buildCondition(cs ConditionSpec) (condition, error) {
+	if cs.Location == "" {
+		return condition{}, errors.New("location is required")
+	}
+
+	var predicates []Predicate
+	if cs.Equals != "" {
+		predicates = append(predicates, Equals(cs.Equals))
+	}
+	if cs.NotEquals != "" {
+		predicates = append(predicates, NotEquals(cs.NotEquals))
+	}
+	if len(cs.In) > 0 {
+		predicates = append(predicates, In(cs.In...))
+	}
+	if cs.Present {
+		predicates = append(predicates, Present())
+	}
+	if cs.Absent {
+		predicates = append(predicates, Absent())
+	}
+	if cs.Matches != "" {
+		if _, err := regexp.Compile(cs.Matches); err != nil {
+			return condition{}, fmt.Errorf("invalid pattern: %w", err)
+		}
+		predicates = append(predicates, Matches(cs.Matches))
+	}
+
+	if len(predicates) != 1 {
+		return condition{}, fmt.Errorf("want exactly one predicate, have %d", len(predicates))
+	}
+	return condition{location: cs.Location, predicate: predicates[0]}, nil
+}
+
+// parseSeverity parses a severity name; the empty string means error.
+func parseSeverity(s string) (hl7.Severity, error) {
+	switch strings.ToLower(s) {
+	case "", "error", "e":
+		return hl7.SeverityError, nil
+	case "warning", "w":
+		return hl7.SeverityWarning, nil
+	case "info", "i":
+		return hl7.SeverityInfo, nil
+	}
+	return hl7.SeverityError, fmt.Errorf("unknown severity %q", s)
+}
+
+// severityName returns the rule document name of a severity.
+func severityName(s hl7.Severity) string {
+	switch s {
+	case hl7.SeverityWarning:
+		return "warning"
+	case hl7.SeverityInfo:
+		return "info"
+	}
+	return ""
+}
+
+// parseComparison parses a comparison operator as returned by
+// Comparison.String.
+func parseComparison(s string) (Comparison, bool) {
+	for _, op := range []Comparison{CompareEqual, CompareNotEqual, CompareLess, CompareLessOrEqual, CompareGreater, CompareGreaterOrEqual} {
+		if op.String() == s {
+			return op, true
+		}
+	}
+	return 0, false
+}
+
+// ExportRules describes a rule set as a rule document, so that rule sets
+// built in code can be saved and edited. Rules created with a RuleBuilder
+// (except Custom checks and tables from providers other than Tables),
+// Structure rules and WithSeverity wrappers can be exported; other rules,
+// such as those of conformance profiles, are reported as a *RuleError.
+// Predicates other than Equals, NotEquals, In, Present, Absent and Matches
+// cannot be exported.
+func ExportRules(rs RuleSet) (RuleDocument, error) {
+	doc := RuleDocument{Rules: []RuleSpec{}}
+	if rs == nil {
+		return doc, nil
+	}
+	for i, rule := range rs.Rules() {
+		spec, err := exportRule(rule, &doc)
+		if err != nil {
+			return RuleDocument{}, &RuleError{Index: i, Location: rule.Location(), Err: err}
+		}
+		doc.Rules = append(doc.Rules, spec)
+	}
+	return doc, nil
+}
+
+// exportRule describes a single rule, adding the user tables it uses to doc.
+func exportRule(rule Rule, doc *RuleDocument) (RuleSpec, error) {
+	spec := RuleSpec{Location: rule.Location()}
+
+	if sr, ok := rule.(*severityRule); ok {
+		spec.Severity = severityName(sr.severity)
+		rule = sr.rule
+	}
+	if cr, ok := rule.(*conditionalRule); ok {
+		for _, c := range cr.conditions {
+			cs, err := exportCondition(c)
+			if err != nil {
+				return RuleSpec{}, err
+			}
+			spec.When = append(spec.When, cs)
+		}
+		rule = cr.rule
+	}
+
+	if sr, ok := rule.(*structureRule); ok {
+		syntax, err := formatStructure(sr.elements)
+		if err != nil {
+			return RuleSpec{}, err
+		}
+		spec.Structure = syntax
+		spec.Description = sr.description
+		return spec, nil
+	}
+
+	var parts []Rule
+	if er, ok := rule.(*eachRule); ok {
+		spec.Each = true
+		for _, c := range er.conditions {
+			cs, err := exportCondition(c)
+			if err != nil {
+				return RuleSpec{}, err
+			}
+			spec.When = append(spec.When, cs)
+		}
+		parts = append(checks(er.instance), checks(er.repetition)...)
+	} else {
+		parts = checks(rule)
+	}
+	for _, part := range parts {
+		if err := exportCheck(part, &spec, doc); err != nil {
+			return RuleSpec{}, err
+		}
+	}
+	return spec, nil
+}
+
+// checks returns the rules of a composite rule, or the rule itself.
+func checks(rule Rule) []Rule {
+	switch r := rule.(type) {
+	case nil:
+		return nil
+	case *compositeRule:
+		return r.rules
+	}
+	return []Rule{rule}
+}
+
+// exportCheck adds a single field check to spec.
+func exportCheck(rule Rule, spec *RuleSpec, doc *RuleDocument) error {
+	switch r := rule.(type) {
+	case *requiredRule:
+		spec.Required = true
+		spec.Description = r.description
+	case *valueRule:
+		spec.Value = r.expected
+		spec.Description = r.description
+	case *patternRule:
+		spec.Pattern = r.pattern.String()
+		spec.Description = r.description
+	case *lengthRule:
+		spec.MinLength, spec.MaxLength = r.min, r.max
+		spec.Description = r.description
+	case *oneOfRule:
+		spec.OneOf = r.allowed
+		spec.Description = r.description
+	case *typeRule:
+		spec.Type = r.dataType
+		spec.Description = r.description
+	case *tableRule:
+		if err := exportTable(r, doc); err != nil {
+			return err
+		}
+		spec.Table = r.table
+		spec.Description = r.description
+	case *compareRule:
+		spec.Compare = &CompareSpec{Op: r.op.String(), Other: r.other}
+		spec.Description = r.description
+	case *dateOrderRule:
+		if r.after {
+			spec.After = r.other
+		} else {
+			spec.Before = r.other
+		}
+		spec.Description = r.description
+	case *mutuallyExclusiveRule:
+		spec.MutuallyExclusive = r.others
+		spec.Description = r.description
+	case *atLeastOneOfRule:
+		spec.AtLeastOneOf = r.others
+		spec.Description = r.description
+	case *repetitionCountRule:
+		if r.max == 0 {
+			return errors.New("cannot export a maximum of 0 repeats")
+		}
+		if r.min > 0 {
+			spec.MinRepeats = r.min
+		}
+		if r.max > 0 {
+			spec.MaxRepeats = r.max
+		}
+		spec.Description = r.description
+	default:
+		return fmt.Errorf("cannot export %T", rule)
+	}
+	return nil
+}
+
+// exportTable adds the table used by r to the document tables, unless it
+// comes from the HL7 tables.
+func exportTable(r *tableRule, doc *RuleDocument) error {
+	switch p := r.provider.(type) {
+	case nil:
+		return nil
+	case Tables:
+		codes, ok := p[r.table]
+		if !ok {
+			return fmt.Errorf("unknown table %s", r.table)
+		}
+		if existing, ok := doc.Tables[r.table]; ok && !equalStrings(existing, codes) {
+			return fmt.Errorf("conflicting definitions of table %s", r.table)
+		}
+		if doc.Tables == nil {
+			doc.Tables = make(map[string][]string)
+		}
+		doc.Tables[r.table] = codes
+		return nil
+	}
+	return fmt.Errorf("cannot export table %s from %T", r.table, r.provider)
+}
+
+// exportCondition describes a condition built from a built-in predicate.
+func exportCondition(c condition) (ConditionSpec, error) {
+	p, ok := c.predicate.(predicateFunc)
+	if !ok || p.op == "" {
+		return ConditionSpec{}, fmt.Errorf("cannot export condition %s", c)
+	}
+
+	// Rule documents treat empty values as unset
+	if (len(p.args) == 1 && p.args[0] == "") || (p.op == "in" && len(p.args) == 0) {
+		return ConditionSpec{}, fmt.Errorf("cannot export condition %s", c)
+	}
+
+	cs := ConditionSpec{Location: c.location}
+	switch p.op {
+	case "equals":
+		cs.Equals = p.args[0]
+	case "notEquals":
+		cs.NotEquals = p.args[0]
+	case "in":
+		cs.In = p.args
+	case "present":
+		cs.Present = true
+	case "absent":
+		cs.Absent = true
+	case "matches":
+		cs.Matches = p.args[0]
+	}
+	return cs, nil
+}
+
+// formatStructure writes structure elements back as abstract message syntax.
+func formatStructure(elements []structureElement) (string, error) {
+	parts := make([]string, 0, len(elements))
+	for _, e := range elements {
+		s, err := formatElement(e)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// formatElement writes a single structure element.
+func formatElement(e structureElement) (string, error) {
+	inner := e.segment
+	switch {
+	case e.choice:
+		alternatives := make([]string, len(e.children))
+		for i, c := range e.children {
+			s, err := formatElement(c)
+			if err != nil {
+				return "", err
+			}
+			alternatives[i] = s
+		}
+		inner = "<" + strings.Join(alternatives, "|") + ">"
+	case e.segment == "":
+		s, err := formatStructure(e.children)
+		if err != nil {
+			return "", err
+		}
+		inner = s
+	}
+
+	switch {
+	case e.max == -1:
+		inner = "{" + inner + "}"
+	case e.max != 1:
+		return "", fmt.Errorf("cannot export maximum of %d for %s", e.max, e.name())
+	case e.segment == "" && !e.choice:
+		// A mandatory group that is neither optional nor repeating needs
+		// no brackets
+		if e.min == 1 {
+			return inner, nil
+		}
+	}
+	switch e.min {
+	case 0:
+		return "[" + inner + "]", nil
+	case 1:
+		return inner, nil
+	}
+	return "", fmt.Errorf("cannot export minimum of %d for %s", e.min, e.name())
+}
+
+// equalStrings reports whether two string slices hold the same values in
+// any order.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string(nil), a...)
+	y := append([]string(nil), b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
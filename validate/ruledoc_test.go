@@ -0,0 +1,195 @@
+package validate
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+const testRuleDocument = `{
+  "tables": {"ZZ01": ["HOME", "TELE"]},
+  "rules": [
+    {"location": "PID.3", "required": true, "description": "Patient ID is required"},
+    {"location": "PID.8", "oneOf": ["M", "F", "U"], "severity": "warning"},
+    {"location": "PV1.3", "required": true, "when": [{"location": "PV1.2", "equals": "I"}]},
+    {"location": "PV1.10", "table": "ZZ01"},
+    {"location": "PID.19", "pattern": "^\\d{3}-\\d{2}-\\d{4}$", "minLength": 11, "maxLength": 11},
+    {"location": "PV1.45", "after": "PV1.44"},
+    {"location": "TEST", "structure": "MSH PID [{NK1}] PV1"}
+  ]
+}`
+
+func TestLoadRules(t *testing.T) {
+	rs, err := LoadRules(strings.NewReader(testRuleDocument))
+	if err != nil {
+		t.Fatalf("LoadRules() error = %v", err)
+	}
+	if n := len(rs.Rules()); n != 7 {
+		t.Fatalf("LoadRules() loaded %d rules, want 7", n)
+	}
+
+	msg := newMockMessage()
+	msg.setField("PID.8", "X")
+	msg.setField("PV1.2", "I")
+	msg.setField("PV1.10", "CLINIC")
+	msg.setField("PID.19", "123456789")
+	msg.setField("PV1.44", "20240102")
+	msg.setField("PV1.45", "20240101")
+
+	result := NewWithRuleSet(rs).Validate(msg)
+
+	got := make(map[string]hl7.Severity)
+	for _, e := range result.Issues() {
+		if _, ok := got[e.Location]; !ok {
+			got[e.Location] = e.Severity
+		}
+	}
+	want := map[string]hl7.Severity{
+		"PID.3":  hl7.SeverityError,
+		"PID.8":  hl7.SeverityWarning,
+		"PV1.3":  hl7.SeverityError,
+		"PV1.10": hl7.SeverityError,
+		"PID.19": hl7.SeverityError,
+		"PV1.45": hl7.SeverityError,
+	}
+	for loc, sev := range want {
+		if s, ok := got[loc]; !ok || s != sev {
+			t.Errorf("issue at %s = %v (found %v), want %v", loc, s, ok, sev)
+		}
+	}
+	if rs.Rules()[0].Description() != "Patient ID is required" {
+		t.Errorf("Description() = %q", rs.Rules()[0].Description())
+	}
+}
+
+func TestLoadRules_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		index int
+		want  string
+	}{
+		{"missing location", `{"rules": [{"required": true}]}`, 0, "location is required"},
+		{"no checks", `{"rules": [{"location": "PID.3"}]}`, 0, "no checks"},
+		{"unknown field", `{"rules": [{"location": "PID.3"}, {"location": "PID.5", "requried": true}]}`, 1, "requried"},
+		{"bad pattern", `{"rules": [{"location": "PID.3", "pattern": "("}]}`, 0, "invalid pattern"},
+		{"bad lengths", `{"rules": [{"location": "PID.3", "minLength": 5, "maxLength": 2}]}`, 0, "exceeds maxLength"},
+		{"unknown type", `{"rules": [{"location": "PID.7", "type": "XYZ"}]}`, 0, "unknown data type"},
+		{"unknown table", `{"rules": [{"location": "PID.8", "table": "9999"}]}`, 0, "unknown table 9999"},
+		{"unknown severity", `{"rules": [{"location": "PID.3", "required": true, "severity": "fatal"}]}`, 0, "unknown severity"},
+		{"bad comparison", `{"rules": [{"location": "OBX.5", "compare": {"op": "=~", "other": "OBX.7"}}]}`, 0, "unknown comparison"},
+		{"two predicates", `{"rules": [{"location": "PV1.3", "required": true, "when": [{"location": "PV1.2", "equals": "I", "present": true}]}]}`, 0, "condition 0"},
+		{"bad structure", `{"rules": [{"location": "TEST", "structure": "MSH [PID"}]}`, 0, "invalid structure"},
+		{"structure with checks", `{"rules": [{"location": "TEST", "structure": "MSH", "required": true}]}`, 0, "cannot be combined"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRules(strings.NewReader(tt.doc))
+			if !errors.Is(err, ErrInvalidRules) {
+				t.Fatalf("LoadRules() error = %v, want ErrInvalidRules", err)
+			}
+			var ruleErr *RuleError
+			if !errors.As(err, &ruleErr) {
+				t.Fatalf("LoadRules() error = %T, want *RuleError", err)
+			}
+			if ruleErr.Index != tt.index {
+				t.Errorf("Index = %d, want %d", ruleErr.Index, tt.index)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadRules_InvalidJSON(t *testing.T) {
+	_, err := LoadRules(strings.NewReader(`{"rules": [`))
+	if !errors.Is(err, ErrInvalidRules) {
+		t.Errorf("LoadRules() error = %v, want ErrInvalidRules", err)
+	}
+}
+
+func TestExportRules_RoundTrip(t *testing.T) {
+	original, err := LoadRules(strings.NewReader(testRuleDocument))
+	if err != nil {
+		t.Fatalf("LoadRules() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := SaveRules(&buf, original); err != nil {
+		t.Fatalf("SaveRules() error = %v", err)
+	}
+	reloaded, err := LoadRules(&buf)
+	if err != nil {
+		t.Fatalf("LoadRules(saved) error = %v\n%s", err, buf.String())
+	}
+
+	first, err := ExportRules(original)
+	if err != nil {
+		t.Fatalf("ExportRules() error = %v", err)
+	}
+	second, err := ExportRules(reloaded)
+	if err != nil {
+		t.Fatalf("ExportRules(reloaded) error = %v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("round trip changed document:\nfirst  %+v\nsecond %+v", first, second)
+	}
+	if got := first.Rules[6].Structure; got != "MSH PID [{NK1}] PV1" {
+		t.Errorf("Structure = %q, want original syntax", got)
+	}
+}
+
+func TestExportRules_Builder(t *testing.T) {
+	rs := NewRuleSet(
+		At("PV1.3").Required().Type("PL").When("PV1.2", In("I", "E")).AsInfo().WithDescription("Location").Build(),
+		At("OBX.5").Compare(CompareLessOrEqual, "OBX.7").Build(),
+		At("PID.13").AtLeastOneOf("PID.14").Build(),
+	)
+
+	doc, err := ExportRules(rs)
+	if err != nil {
+		t.Fatalf("ExportRules() error = %v", err)
+	}
+	want := []RuleSpec{
+		{
+			Location:    "PV1.3",
+			Description: "Location",
+			Severity:    "info",
+			Required:    true,
+			Type:        "PL",
+			When:        []ConditionSpec{{Location: "PV1.2", In: []string{"I", "E"}}},
+		},
+		{Location: "OBX.5", Compare: &CompareSpec{Op: "<=", Other: "OBX.7"}},
+		{Location: "PID.13", AtLeastOneOf: []string{"PID.14"}},
+	}
+	if !reflect.DeepEqual(doc.Rules, want) {
+		t.Errorf("ExportRules() = %+v, want %+v", doc.Rules, want)
+	}
+}
+
+func TestExportRules_Unsupported(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+	}{
+		{"custom", At("PID.3").Custom(func(string) error { return nil }).Build()},
+		{"custom predicate", At("PID.3").Required().When("PID.8", PredicateFunc("odd", func(string) bool { return true })).Build()},
+		{"chained tables", At("PID.8").TableFrom(ChainTables(HL7Tables()), "0001").Build()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ExportRules(NewRuleSet(MSHRules().Rules()[0], tt.rule))
+			var ruleErr *RuleError
+			if !errors.As(err, &ruleErr) || ruleErr.Index != 1 {
+				t.Errorf("ExportRules() error = %v, want *RuleError for rule 1", err)
+			}
+		})
+	}
+}
@@ -31,12 +31,40 @@ type ValidationError struct {
 	Expected string
 	// Actual describes what was found (optional).
 	Actual string
-}
+	// Code is the HL7 error code (table 0357) for the failure, suitable for
+	// ack.ACK.ErrorCode. Empty if the rule does not map to a standard code.
+	Code string
+	// Severity is the severity of the failure. The zero value is
+	// hl7.SeverityError; rules built with AsWarning or AsInfo report lower
+	// severities, which do not make a result invalid.
+	Severity hl7.Severity
+}
+
+// HL7 error codes (table 0357) reported in ValidationError.Code.
+const (
+	// CodeSegmentSequence indicates a segment sequence error.
+	CodeSegmentSequence = "100"
+	// CodeRequiredFieldMissing indicates a required field is missing.
+	CodeRequiredFieldMissing = "101"
+	// CodeDataType indicates a data type error.
+	CodeDataType = "102"
+	// CodeTableValueNotFound indicates a value is not in its code table.
+	CodeTableValueNotFound = "103"
+	// CodeValueTooLong indicates a value exceeds its maximum length.
+	CodeValueTooLong = "104"
+)
 
 // Error implements the error interface.
 func (e ValidationError) Error() string {
 	var sb strings.Builder
-	sb.WriteString("validation error")
+	switch e.Severity {
+	case hl7.SeverityWarning:
+		sb.WriteString("validation warning")
+	case hl7.SeverityInfo:
+		sb.WriteString("validation info")
+	default:
+		sb.WriteString("validation error")
+	}
 
 	if e.Location != "" {
 		sb.WriteString(" at ")
@@ -122,6 +150,7 @@ func (r *requiredRule) Validate(msg hl7.Message) []ValidationError {
 			Location: r.location,
 			Rule:     "required",
 			Message:  fmt.Sprintf("field not found: %v", err),
+			Code:     CodeRequiredFieldMissing,
 		}}
 	}
 
@@ -130,6 +159,7 @@ func (r *requiredRule) Validate(msg hl7.Message) []ValidationError {
 			Location: r.location,
 			Rule:     "required",
 			Message:  "field is required but empty",
+			Code:     CodeRequiredFieldMissing,
 		}}
 	}
 
@@ -299,6 +329,7 @@ func (r *lengthRule) Validate(msg hl7.Message) []ValidationError {
 			Message:  fmt.Sprintf("field length %d exceeds maximum %d", length, r.max),
 			Expected: fmt.Sprintf("maximum %d characters", r.max),
 			Actual:   fmt.Sprintf("%d characters", length),
+			Code:     CodeValueTooLong,
 		}}
 	}
 
@@ -388,6 +388,16 @@ func TestLengthRule(t *testing.T) {
 	}
 }
 
+func TestLengthRule_Code(t *testing.T) {
+	m := newMockMessage()
+	m.setField("MSH.10", "1234567890")
+
+	errs := (&lengthRule{location: "MSH.10", max: 5}).Validate(m)
+	if len(errs) != 1 || errs[0].Code != CodeValueTooLong {
+		t.Errorf("Validate() = %v, want one error with code %s", errs, CodeValueTooLong)
+	}
+}
+
 func TestOneOfRule(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -720,3 +730,16 @@ func containsSubstr(s, substr string) bool {
 	}
 	return false
 }
+
+func TestRequiredRule_Code(t *testing.T) {
+	rule := &requiredRule{location: "PID.3"}
+
+	m := newMockMessage()
+	m.setField("PID.3", "")
+	for _, msg := range []*mockMessage{newMockMessage(), m} {
+		errs := rule.Validate(msg)
+		if len(errs) != 1 || errs[0].Code != CodeRequiredFieldMissing {
+			t.Errorf("Validate() = %+v, want one error with code %s", errs, CodeRequiredFieldMissing)
+		}
+	}
+}
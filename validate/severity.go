@@ -0,0 +1,36 @@
+package validate
+
+import "github.com/dshills/golevel7/hl7"
+
+// WithSeverity returns a rule that reports the failures of rule with the
+// given severity. Use it for rules not created with a RuleBuilder:
+//
+//	validate.WithSeverity(validate.Structure("ADT_A01", syntax), hl7.SeverityWarning)
+func WithSeverity(rule Rule, severity hl7.Severity) Rule {
+	return &severityRule{rule: rule, severity: severity}
+}
+
+// severityRule overrides the severity of the failures of a rule.
+type severityRule struct {
+	rule     Rule
+	severity hl7.Severity
+}
+
+// Validate applies the wrapped rule and sets the severity of its failures.
+func (r *severityRule) Validate(msg hl7.Message) []ValidationError {
+	errs := r.rule.Validate(msg)
+	for i := range errs {
+		errs[i].Severity = r.severity
+	}
+	return errs
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *severityRule) Location() string {
+	return r.rule.Location()
+}
+
+// Description returns a human-readable description of this rule.
+func (r *severityRule) Description() string {
+	return r.rule.Description()
+}
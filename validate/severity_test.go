@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+func TestRuleBuilder_Severity(t *testing.T) {
+	msg := newMockMessage()
+
+	v := New(
+		At("PID.3").Required().Build(),
+		At("PID.13").Required().AsWarning().Build(),
+		At("PID.14").Required().AsInfo().Build(),
+	)
+	result := v.Validate(msg)
+
+	if result.Valid() {
+		t.Error("Valid() = true, want false with a missing required field")
+	}
+	if errs := result.Errors(); len(errs) != 1 || errs[0].Location != "PID.3" {
+		t.Errorf("Errors() = %v, want PID.3 only", errs)
+	}
+	if warnings := result.Warnings(); len(warnings) != 1 || warnings[0].Location != "PID.13" {
+		t.Errorf("Warnings() = %v, want PID.13 only", warnings)
+	}
+	if info := result.BySeverity(hl7.SeverityInfo); len(info) != 1 || info[0].Location != "PID.14" {
+		t.Errorf("BySeverity(Info) = %v, want PID.14 only", info)
+	}
+	if issues := result.Issues(); len(issues) != 3 {
+		t.Errorf("Issues() = %v, want 3", issues)
+	}
+}
+
+func TestRuleBuilder_WarningsOnlyValid(t *testing.T) {
+	msg := newMockMessage()
+	msg.setField("PID.8", "X")
+
+	rule := At("PID.8").OneOf("M", "F").When("PID.8", Present()).AsWarning().Build()
+	result := New(rule).Validate(msg)
+
+	if !result.Valid() {
+		t.Errorf("Valid() = false, want true with only warnings: %v", result.Errors())
+	}
+	warnings := result.BySeverity(hl7.SeverityWarning)
+	if len(warnings) != 1 {
+		t.Fatalf("BySeverity(Warning) = %v, want 1", warnings)
+	}
+	if !strings.HasPrefix(warnings[0].Error(), "validation warning at PID.8") {
+		t.Errorf("Error() = %q, want warning prefix", warnings[0].Error())
+	}
+}
+
+func TestWithSeverity(t *testing.T) {
+	rule := WithSeverity(Structure("TEST", "MSH PID"), hl7.SeverityWarning)
+	errs := rule.Validate(newStructureMessage("MSH"))
+	if len(errs) != 1 || errs[0].Severity != hl7.SeverityWarning {
+		t.Errorf("Validate() = %v, want one warning", errs)
+	}
+	if rule.Location() != "TEST" {
+		t.Errorf("Location() = %q, want TEST", rule.Location())
+	}
+}
@@ -0,0 +1,314 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/structures"
+)
+
+// structureElement is a segment or segment group in a message structure.
+type structureElement struct {
+	segment  string             // segment name; empty for groups
+	group    string             // group name; empty for segments
+	min      int                // minimum occurrences
+	max      int                // maximum occurrences, -1 for unbounded
+	choice   bool               // children are alternatives, exactly one of which occurs
+	children []structureElement // group contents or choice alternatives
+	key      string             // identifies a segment element to rules bound to it; may be empty
+}
+
+// name returns the segment name or a description of the group.
+func (e structureElement) name() string {
+	switch {
+	case e.segment != "":
+		return "segment " + e.segment
+	case e.choice:
+		return "choice " + e.group
+	}
+	return "group " + e.group
+}
+
+// firstSegment returns the first segment name in the element.
+func (e structureElement) firstSegment() string {
+	if e.segment != "" {
+		return e.segment
+	}
+	for _, c := range e.children {
+		if s := c.firstSegment(); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// canStartWith reports whether a segment named seg can be the first segment
+// of an occurrence of the element.
+func (e structureElement) canStartWith(seg string) bool {
+	if e.segment != "" {
+		return e.segment == seg
+	}
+	for _, c := range e.children {
+		if c.canStartWith(seg) {
+			return true
+		}
+		if c.min > 0 && !e.choice {
+			return false
+		}
+	}
+	return false
+}
+
+// structureRule validates segment order and cardinality against a message
+// structure. Z-segments are ignored.
+type structureRule struct {
+	name        string
+	elements    []structureElement
+	description string
+}
+
+// Validate matches the message segments against the structure.
+func (r *structureRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.Location(),
+			Rule:     "structure",
+			Message:  "message is nil",
+		}}
+	}
+
+	m := newStructureMatcher(msg)
+	m.matchSequence(r.elements)
+
+	if m.pos < len(m.segs) {
+		seg := m.segs[m.pos]
+		m.errs = append(m.errs, ValidationError{
+			Location: seg.location(),
+			Rule:     "structure",
+			Message:  fmt.Sprintf("unexpected segment %s at position %d", seg.name, seg.position),
+			Expected: "segment order of " + r.name,
+			Actual:   seg.name,
+			Code:     CodeSegmentSequence,
+		})
+	}
+
+	return m.errs
+}
+
+// Location returns the message structure name.
+func (r *structureRule) Location() string {
+	return r.name
+}
+
+// Description returns a human-readable description of this rule.
+func (r *structureRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	return fmt.Sprintf("segments must follow message structure %s", r.name)
+}
+
+// matchedSegment is a message segment being matched against a structure.
+type matchedSegment struct {
+	name       string
+	position   int    // 1-based position in the message
+	occurrence int    // 0-based index among segments with the same name
+	key        string // key of the structure element that matched the segment
+}
+
+// location returns the HL7 location of the segment, e.g. "PID[1]".
+func (s matchedSegment) location() string {
+	return fmt.Sprintf("%s[%d]", s.name, s.occurrence)
+}
+
+// structureMatcher greedily matches segments against structure elements.
+type structureMatcher struct {
+	segs  []matchedSegment
+	pos   int
+	depth int // group nesting depth
+	errs  []ValidationError
+}
+
+// newStructureMatcher returns a matcher for the segments of msg. Z-segments
+// are left out.
+func newStructureMatcher(msg hl7.Message) *structureMatcher {
+	m := &structureMatcher{}
+	seen := make(map[string]int)
+	for i, seg := range msg.AllSegments() {
+		name := seg.Name()
+		occurrence := seen[name]
+		seen[name]++
+		if strings.HasPrefix(name, "Z") {
+			continue
+		}
+		m.segs = append(m.segs, matchedSegment{name: name, position: i + 1, occurrence: occurrence})
+	}
+	return m
+}
+
+// current returns the name of the next unmatched segment, or "".
+func (m *structureMatcher) current() string {
+	if m.pos < len(m.segs) {
+		return m.segs[m.pos].name
+	}
+	return ""
+}
+
+// position returns the 1-based message position of the next unmatched
+// segment, or one past the last segment.
+func (m *structureMatcher) position() int {
+	if m.pos < len(m.segs) {
+		return m.segs[m.pos].position
+	}
+	if len(m.segs) > 0 {
+		return m.segs[len(m.segs)-1].position + 1
+	}
+	return 1
+}
+
+// matchSequence matches each element in order as many times as allowed.
+func (m *structureMatcher) matchSequence(elements []structureElement) {
+	for _, e := range elements {
+		count := 0
+		for e.max < 0 || count < e.max {
+			if !m.matchOnce(e) {
+				break
+			}
+			count++
+		}
+
+		if count < e.min {
+			m.errs = append(m.errs, ValidationError{
+				Location: e.firstSegment(),
+				Rule:     "structure",
+				Message:  fmt.Sprintf("required %s missing at position %d", e.name(), m.position()),
+				Expected: fmt.Sprintf("at least %d", e.min),
+				Actual:   fmt.Sprintf("%d", count),
+				Code:     CodeSegmentSequence,
+			})
+		}
+
+		// Inside a group an extra segment may start the next group occurrence,
+		// so repeat limits are reported here only at the top level
+		if m.depth == 0 && e.segment != "" && e.max >= 0 && m.current() == e.segment {
+			seg := m.segs[m.pos]
+			m.errs = append(m.errs, ValidationError{
+				Location: seg.location(),
+				Rule:     "structure",
+				Message:  fmt.Sprintf("segment %s at position %d exceeds maximum of %d", seg.name, seg.position, e.max),
+				Expected: fmt.Sprintf("at most %d", e.max),
+				Code:     CodeSegmentSequence,
+			})
+			for m.current() == e.segment {
+				m.pos++
+			}
+		}
+	}
+}
+
+// matchOnce matches a single occurrence of the element and reports whether
+// any segments were consumed. A group is entered only if the next segment
+// can start it.
+func (m *structureMatcher) matchOnce(e structureElement) bool {
+	if e.segment != "" {
+		if m.current() == e.segment {
+			m.segs[m.pos].key = e.key
+			m.pos++
+			return true
+		}
+		return false
+	}
+
+	if m.current() == "" || !e.canStartWith(m.current()) {
+		return false
+	}
+	children := e.children
+	if e.choice {
+		for _, c := range e.children {
+			if c.canStartWith(m.current()) {
+				children = []structureElement{c}
+				break
+			}
+		}
+	}
+	start := m.pos
+	m.depth++
+	m.matchSequence(children)
+	m.depth--
+	return m.pos > start
+}
+
+// Structure creates a rule that validates segment order and cardinality
+// against a message structure written in HL7 abstract message syntax.
+// Segment names are separated by spaces; [ ] marks optional elements,
+// { } repeating ones and < | > a choice of one alternative, and several
+// segments inside brackets form a group:
+//
+//	validate.Structure("ADT_A01", "MSH EVN PID [PD1] [{NK1}] PV1 [PV2] [{PR1 [{ROL}]}]")
+//
+// Failures carry Code "100" (segment sequence error) and the location of the
+// offending segment, e.g. "PV1[1]". Z-segments may appear anywhere. If the
+// syntax is invalid, the rule always fails with a parse error.
+func Structure(name, syntax string) Rule {
+	elements, err := parseStructure(syntax)
+	if err != nil {
+		return &invalidStructureRule{name: name, syntax: syntax, err: err}
+	}
+	return &structureRule{name: name, elements: elements}
+}
+
+// parseStructure parses HL7 abstract message syntax into structure elements.
+func parseStructure(syntax string) ([]structureElement, error) {
+	parsed, err := structures.Parse(syntax)
+	if err != nil {
+		return nil, err
+	}
+	return convertElements(parsed), nil
+}
+
+// convertElements converts parsed structure elements.
+func convertElements(parsed []structures.Element) []structureElement {
+	elements := make([]structureElement, len(parsed))
+	for i, p := range parsed {
+		elements[i] = structureElement{
+			segment: p.Segment,
+			group:   p.Group,
+			choice:  p.Choice,
+			min:     p.Min,
+			max:     p.Max,
+		}
+		if len(p.Children) > 0 {
+			elements[i].children = convertElements(p.Children)
+		}
+	}
+	return elements
+}
+
+// invalidStructureRule is a rule that always fails because the structure
+// syntax was invalid.
+type invalidStructureRule struct {
+	name   string
+	syntax string
+	err    error
+}
+
+// Validate reports the syntax error.
+func (r *invalidStructureRule) Validate(_ hl7.Message) []ValidationError {
+	return []ValidationError{{
+		Location: r.name,
+		Rule:     "structure",
+		Message:  "invalid structure: " + r.err.Error(),
+		Expected: r.syntax,
+	}}
+}
+
+// Location returns the message structure name.
+func (r *invalidStructureRule) Location() string {
+	return r.name
+}
+
+// Description returns a human-readable description of this rule.
+func (r *invalidStructureRule) Description() string {
+	return fmt.Sprintf("invalid message structure %s", r.name)
+}
@@ -0,0 +1,208 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/structures"
+	"github.com/dshills/golevel7/testdata"
+)
+
+func newStructureMessage(names ...string) hl7.Message {
+	msg := hl7.NewEmptyMessage()
+	for _, name := range names {
+		_ = msg.AddSegment(hl7.NewSegment(name))
+	}
+	return msg
+}
+
+func TestStructureRule(t *testing.T) {
+	rule := &structureRule{
+		name: "ORU_R01",
+		elements: []structureElement{
+			{segment: "MSH", min: 1, max: 1},
+			{group: "PATIENT", min: 0, max: 1, children: []structureElement{
+				{segment: "PID", min: 1, max: 1},
+				{segment: "NTE", min: 0, max: -1},
+			}},
+			{group: "ORDER", min: 1, max: -1, children: []structureElement{
+				{segment: "ORC", min: 0, max: 1},
+				{segment: "OBR", min: 1, max: 1},
+				{segment: "OBX", min: 0, max: -1},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		segments []string
+		location string
+	}{
+		{"valid", []string{"MSH", "PID", "NTE", "NTE", "OBR", "OBX", "OBX", "ORC", "OBR"}, ""},
+		{"optional group omitted", []string{"MSH", "OBR"}, ""},
+		{"z-segments ignored", []string{"MSH", "ZPD", "OBR", "ZOB"}, ""},
+		{"required group missing", []string{"MSH", "PID"}, "ORC"},
+		{"required segment in group missing", []string{"MSH", "ORC", "OBX"}, "OBR"},
+		{"unexpected segment", []string{"MSH", "OBR", "PID"}, "PID[0]"},
+		{"segment exceeds maximum", []string{"MSH", "MSH", "OBR"}, "MSH[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := rule.Validate(newStructureMessage(tt.segments...))
+			if tt.location == "" {
+				if len(errs) != 0 {
+					t.Errorf("Validate() errors = %v, want none", errs)
+				}
+				return
+			}
+			if len(errs) == 0 {
+				t.Fatalf("Validate() no errors, want error at %s", tt.location)
+			}
+			if errs[0].Location != tt.location || errs[0].Code != CodeSegmentSequence {
+				t.Errorf("Validate() error = %+v, want code %s at %s", errs[0], CodeSegmentSequence, tt.location)
+			}
+		})
+	}
+
+	if errs := rule.Validate(nil); len(errs) != 1 {
+		t.Errorf("Validate(nil) errors = %v, want 1", errs)
+	}
+}
+
+func TestParseStructure(t *testing.T) {
+	elements, err := parseStructure("MSH [PD1] {NK1} [{PR1 [{ROL}]}]")
+	if err != nil {
+		t.Fatalf("parseStructure() error = %v", err)
+	}
+
+	want := []structureElement{
+		{segment: "MSH", min: 1, max: 1},
+		{segment: "PD1", min: 0, max: 1},
+		{segment: "NK1", min: 1, max: -1},
+		{group: "PR1", min: 0, max: -1, children: []structureElement{
+			{segment: "PR1", min: 1, max: 1},
+			{segment: "ROL", min: 0, max: -1},
+		}},
+	}
+	if len(elements) != len(want) {
+		t.Fatalf("parseStructure() = %+v, want %+v", elements, want)
+	}
+	for i, e := range elements {
+		w := want[i]
+		if e.segment != w.segment || e.group != w.group || e.min != w.min || e.max != w.max || len(e.children) != len(w.children) {
+			t.Errorf("element %d = %+v, want %+v", i, e, w)
+		}
+	}
+}
+
+func TestParseStructure_Errors(t *testing.T) {
+	for _, syntax := range []string{"", "MSH [PD1", "MSH PD1]", "MSH []", "MSH [PD1}", "MSH pid", "MSH PIDX"} {
+		if _, err := parseStructure(syntax); err == nil {
+			t.Errorf("parseStructure(%q) expected error", syntax)
+		}
+	}
+}
+
+func TestStructure(t *testing.T) {
+	rule := Structure("ADT_A01", "MSH EVN PID [PD1] [{NK1}] PV1")
+
+	if errs := rule.Validate(newStructureMessage("MSH", "EVN", "PID", "NK1", "NK1", "PV1")); len(errs) != 0 {
+		t.Errorf("Validate() errors = %v, want none", errs)
+	}
+
+	errs := rule.Validate(newStructureMessage("MSH", "EVN", "PID", "PV1", "NK1"))
+	if len(errs) != 1 {
+		t.Fatalf("Validate() errors = %v, want 1", errs)
+	}
+	if errs[0].Location != "NK1[0]" || errs[0].Code != CodeSegmentSequence {
+		t.Errorf("Validate() error = %+v, want code 100 at NK1[0]", errs[0])
+	}
+	if !strings.Contains(errs[0].Message, "position 5") {
+		t.Errorf("Message = %q, want the segment position", errs[0].Message)
+	}
+
+	if rule.Location() != "ADT_A01" {
+		t.Errorf("Location() = %q, want ADT_A01", rule.Location())
+	}
+
+	invalid := Structure("BAD", "MSH [PID")
+	if errs := invalid.Validate(newStructureMessage("MSH")); len(errs) != 1 || errs[0].Rule != "structure" {
+		t.Errorf("Validate() errors = %v, want a structure syntax error", errs)
+	}
+}
+
+func TestStructureFor(t *testing.T) {
+	for _, name := range structures.Names() {
+		rule, ok := StructureFor(name)
+		if name == "RSP_K11" {
+			if ok {
+				t.Error("StructureFor(RSP_K11) found, want the partial structure left out")
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("StructureFor(%s) not found", name)
+			continue
+		}
+		if _, invalid := rule.(*invalidStructureRule); invalid {
+			t.Errorf("structure %s has invalid syntax", name)
+		}
+	}
+
+	if _, ok := StructureFor("ADT_A01"); !ok {
+		t.Error("StructureFor(ADT_A01) not found")
+	}
+
+	orm, _ := StructureFor("ORM_O01")
+	if errs := orm.Validate(newStructureMessage("MSH", "PID", "ORC", "RXO", "NTE", "ORC", "OBR")); len(errs) != 0 {
+		t.Errorf("ORM_O01 Validate() errors = %v, want none", errs)
+	}
+	if errs := orm.Validate(newStructureMessage("MSH", "PID", "ORC", "OBR", "RXO")); len(errs) != 1 || errs[0].Location != "RXO[0]" {
+		t.Errorf("ORM_O01 Validate() errors = %v, want one at RXO[0] for a second choice", errs)
+	}
+	if _, ok := StructureFor("XYZ_Z99"); ok {
+		t.Error("StructureFor(XYZ_Z99) found")
+	}
+}
+
+func TestMessageStructure(t *testing.T) {
+	tests := []struct {
+		name     string
+		load     func() ([]byte, error)
+		location string
+	}{
+		{"ADT A01", testdata.LoadADTA01, ""},
+		{"ORU R01", testdata.LoadORUR01, ""},
+		{"ACK", testdata.LoadACKAA, ""},
+		{"ADT A08 with NK1 after PV1", testdata.LoadADTA08, "NK1[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg := parseMessage(t, string(data))
+
+			errs := MessageStructure().Validate(msg)
+			if tt.location == "" {
+				if len(errs) != 0 {
+					t.Errorf("Validate() errors = %v, want none", errs)
+				}
+				return
+			}
+			if len(errs) == 0 || errs[0].Location != tt.location {
+				t.Errorf("Validate() errors = %v, want error at %s", errs, tt.location)
+			}
+		})
+	}
+
+	unknown := newStructureMessage("MSH", "ZZZ")
+	_ = unknown.Set("MSH.9", "XYZ^Z99")
+	if errs := MessageStructure().Validate(unknown); len(errs) != 0 {
+		t.Errorf("Validate() errors = %v, want none for unknown structure", errs)
+	}
+}
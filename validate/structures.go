@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/internal/structures"
+)
+
+// StructureFor returns a structure rule for a built-in HL7 v2.5.1 message
+// structure such as "ADT_A01" or "ORU_R01", and false if it is not known.
+// RSP_K11 is not available, as its segments depend on the query.
+func StructureFor(name string) (Rule, bool) {
+	syntax, ok := structures.Syntax(name)
+	if !ok || structures.Partial(name) {
+		return nil, false
+	}
+	return Structure(name, syntax), true
+}
+
+// MessageStructure creates a rule that validates each message against the
+// built-in structure named in MSH-9.3, or implied by MSH-9.1 and MSH-9.2 when
+// MSH-9.3 is empty. Messages with an unknown structure pass.
+func MessageStructure() Rule {
+	return &messageStructureRule{}
+}
+
+// messageStructureRule selects a built-in structure from MSH-9.
+type messageStructureRule struct{}
+
+// Validate validates the message against its structure, if known.
+func (r *messageStructureRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.Location(),
+			Rule:     "structure",
+			Message:  "message is nil",
+		}}
+	}
+
+	rule, ok := StructureFor(structureName(msg))
+	if !ok {
+		return nil
+	}
+	return rule.Validate(msg)
+}
+
+// structureName returns the message structure named in MSH-9.3 or implied by
+// the message type and trigger event.
+func structureName(msg hl7.Message) string {
+	if s := valueAt(msg, "MSH.9.3"); s != "" {
+		return s
+	}
+	return structures.Lookup(valueAt(msg, "MSH.9.1"), valueAt(msg, "MSH.9.2"))
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *messageStructureRule) Location() string {
+	return "MSH.9"
+}
+
+// Description returns a human-readable description of this rule.
+func (r *messageStructureRule) Description() string {
+	return fmt.Sprintf("segments must follow the message structure in %s", r.Location())
+}
@@ -0,0 +1,232 @@
+package validate
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// ErrInvalidTables indicates a table file could not be read.
+var ErrInvalidTables = errors.New("invalid table data")
+
+// TableProvider supplies the codes of HL7 and user-defined tables.
+type TableProvider interface {
+	// Codes returns the codes of a table, and false if the table is unknown.
+	Codes(table string) ([]string, bool)
+}
+
+// Tables is a TableProvider backed by a map from table identifier
+// (e.g. "0001") to codes.
+type Tables map[string][]string
+
+// Codes returns the codes of a table, and false if the table is unknown.
+func (t Tables) Codes(table string) ([]string, bool) {
+	codes, ok := t[table]
+	return codes, ok
+}
+
+// hl7Tables holds the HL7 v2.5.1 tables shipped with the package.
+var hl7Tables = Tables{
+	// Administrative Sex
+	"0001": {"A", "F", "M", "N", "O", "U"},
+	// Marital Status
+	"0002": {"A", "B", "C", "D", "E", "G", "I", "M", "N", "O", "P", "R", "S", "T", "U", "W"},
+	// Patient Class
+	"0004": {"B", "C", "E", "I", "N", "O", "P", "R", "U"},
+	// Admission Type
+	"0007": {"A", "C", "E", "L", "N", "R", "U"},
+	// Acknowledgment Code
+	"0008": {"AA", "AE", "AR", "CA", "CE", "CR"},
+	// Priority
+	"0027": {"A", "C", "P", "R", "S", "T"},
+	// Order Status
+	"0038": {"A", "CA", "CM", "DC", "ER", "HD", "IP", "RP", "SC"},
+	// Check Digit Scheme
+	"0061": {"BCV", "ISO", "M10", "M11", "NPI"},
+	// Relationship
+	"0063": {"ASC", "BRO", "CGV", "CHD", "DEP", "DOM", "EMC", "EME", "EMR", "EXF", "FCH", "FND", "FTH", "GCH", "GRD", "GRP", "MGR", "MTH", "NCH", "NON", "OAD", "OTH", "OWN", "PAR", "SCH", "SEL", "SIB", "SIS", "SPO", "TRA", "UNK", "WRD"},
+	// Specimen Action Code
+	"0065": {"A", "G", "L", "O", "P", "R", "S"},
+	// Abnormal Flags
+	"0078": {"<", ">", "A", "AA", "B", "D", "H", "HH", "I", "L", "LL", "MS", "N", "R", "S", "U", "VS", "W"},
+	// Observation Result Status Codes Interpretation
+	"0085": {"C", "D", "F", "I", "N", "O", "P", "R", "S", "U", "W", "X"},
+	// Processing ID
+	"0103": {"D", "P", "T"},
+	// Version ID
+	"0104": {"2.0", "2.0D", "2.1", "2.2", "2.3", "2.3.1", "2.4", "2.5", "2.5.1", "2.6", "2.7", "2.7.1", "2.8", "2.8.1", "2.8.2"},
+	// Result Status
+	"0123": {"A", "C", "F", "I", "O", "P", "R", "S", "X", "Y", "Z"},
+	// Value Type
+	"0125": {"AD", "CE", "CF", "CK", "CN", "CNE", "CP", "CWE", "CX", "DR", "DT", "DTM", "ED", "FT", "ID", "IS", "MA", "MO", "NA", "NM", "PN", "RP", "SN", "ST", "TM", "TN", "TS", "TX", "XAD", "XCN", "XON", "XPN", "XTN"},
+	// Yes/No Indicator
+	"0136": {"N", "Y"},
+	// Accept/Application Acknowledgment Conditions
+	"0155": {"AL", "ER", "NE", "SU"},
+	// Address Type
+	"0190": {"B", "BA", "BDL", "BI", "BR", "C", "F", "H", "L", "M", "N", "O", "P", "RH"},
+	// Name Type
+	"0200": {"A", "B", "BAD", "C", "D", "I", "K", "L", "M", "N", "P", "R", "S", "T", "U"},
+	// Telecommunication Use Code
+	"0201": {"ASN", "BPN", "EMR", "NET", "ORN", "PRN", "PRS", "VHN", "WPN"},
+	// Telecommunication Equipment Type
+	"0202": {"BP", "CP", "FX", "Internet", "MD", "PH", "TDD", "TTY", "X.400"},
+	// Identifier Type
+	"0203": {"AM", "AN", "ANON", "APRN", "BA", "BC", "BR", "BRN", "DDS", "DEA", "DI", "DL", "DN", "DO", "DP", "DPM", "DR", "DS", "EI", "EN", "FI", "GI", "GL", "GN", "HC", "JHN", "LN", "LR", "MA", "MB", "MC", "MCD", "MCN", "MCR", "MCT", "MD", "MI", "MR", "MRT", "MS", "NE", "NH", "NI", "NII", "NIIP", "NP", "NPI", "OD", "PA", "PCN", "PE", "PEN", "PI", "PN", "PNT", "PPN", "PRC", "PRN", "PT", "QA", "RI", "RN", "RPH", "RR", "RRI", "SL", "SN", "SR", "SS", "TAX", "TN", "U", "UPIN", "VN", "VS", "WC", "WCN", "WP", "XX"},
+	// Universal ID Type
+	"0301": {"CLIA", "CLIP", "DNS", "EUI64", "GUID", "HCD", "HL7", "ISO", "L", "M", "N", "Random", "URI", "UUID", "x400", "x500"},
+	// Message Error Condition Codes
+	"0357": {"0", "100", "101", "102", "103", "104", "200", "201", "202", "203", "204", "205", "206", "207"},
+	// Error Severity
+	"0516": {"E", "I", "W"},
+}
+
+// HL7Tables returns a TableProvider for the HL7 v2.5.1 tables shipped with
+// the package: 0001 (Administrative Sex), 0002, 0004 (Patient Class), 0007,
+// 0008, 0027, 0038, 0061, 0063, 0065, 0078, 0085 (Observation Result Status),
+// 0103, 0104, 0123, 0125, 0136, 0155, 0190, 0200, 0201, 0202, 0203 (Identifier
+// Type), 0301, 0357 and 0516.
+func HL7Tables() TableProvider {
+	return hl7Tables
+}
+
+// ChainTables returns a TableProvider that looks a table up in each provider
+// in turn. Put user tables first to override or extend the HL7 tables:
+//
+//	tables := validate.ChainTables(userTables, validate.HL7Tables())
+func ChainTables(providers ...TableProvider) TableProvider {
+	return tableChain(providers)
+}
+
+// tableChain is a TableProvider that consults several providers in order.
+type tableChain []TableProvider
+
+// Codes returns the codes from the first provider that knows the table.
+func (c tableChain) Codes(table string) ([]string, bool) {
+	for _, p := range c {
+		if p == nil {
+			continue
+		}
+		if codes, ok := p.Codes(table); ok {
+			return codes, true
+		}
+	}
+	return nil, false
+}
+
+// LoadTablesCSV reads user-defined tables from CSV with one code per row:
+// table identifier, code and an optional description. A header row starting
+// with "table" is skipped.
+//
+//	table,code,description
+//	ZZ01,HOME,Home visit
+//	ZZ01,TELE,Telehealth visit
+func LoadTablesCSV(r io.Reader) (Tables, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTables, err)
+	}
+
+	tables := make(Tables)
+	for i, rec := range records {
+		if i == 0 && len(rec) > 0 && strings.EqualFold(rec[0], "table") {
+			continue
+		}
+		if len(rec) < 2 || rec[0] == "" || rec[1] == "" {
+			return nil, fmt.Errorf("%w: line %d: want table and code", ErrInvalidTables, i+1)
+		}
+		tables[rec[0]] = append(tables[rec[0]], rec[1])
+	}
+	return tables, nil
+}
+
+// LoadTablesJSON reads user-defined tables from a JSON object mapping table
+// identifiers to code lists:
+//
+//	{"ZZ01": ["HOME", "TELE"], "0004": ["I", "O", "E"]}
+func LoadTablesJSON(r io.Reader) (Tables, error) {
+	var tables Tables
+	if err := json.NewDecoder(r).Decode(&tables); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTables, err)
+	}
+	if tables == nil {
+		tables = make(Tables)
+	}
+	return tables, nil
+}
+
+// tableRule validates that a field value is a code of an HL7 or user table.
+type tableRule struct {
+	location    string
+	table       string
+	provider    TableProvider
+	description string
+}
+
+// Validate checks that the location value is a code in the table.
+func (r *tableRule) Validate(msg hl7.Message) []ValidationError {
+	if msg == nil {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "table",
+			Message:  "message is nil",
+		}}
+	}
+
+	provider := r.provider
+	if provider == nil {
+		provider = hl7Tables
+	}
+	codes, ok := provider.Codes(r.table)
+	if !ok {
+		return []ValidationError{{
+			Location: r.location,
+			Rule:     "table",
+			Message:  "unknown table " + r.table,
+			Code:     CodeTableValueNotFound,
+		}}
+	}
+
+	value, err := msg.Get(r.location)
+	if err != nil || value == "" {
+		// Absent and empty values pass (use required rule for presence)
+		return nil
+	}
+
+	for _, code := range codes {
+		if value == code {
+			return nil
+		}
+	}
+
+	return []ValidationError{{
+		Location: r.location,
+		Rule:     "table",
+		Message:  fmt.Sprintf("value %q not found in table %s", value, r.table),
+		Expected: "code from table " + r.table,
+		Actual:   value,
+		Code:     CodeTableValueNotFound,
+	}}
+}
+
+// Location returns the HL7 path this rule applies to.
+func (r *tableRule) Location() string {
+	return r.location
+}
+
+// Description returns a human-readable description of this rule.
+func (r *tableRule) Description() string {
+	if r.description != "" {
+		return r.description
+	}
+	return fmt.Sprintf("%s must be a code from table %s", r.location, r.table)
+}
@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTableRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		value   string
+		wantErr bool
+	}{
+		{"HL7 table code", At("PID.8").Table("0001").Build(), "F", false},
+		{"HL7 table invalid code", At("PID.8").Table("0001").Build(), "Z", true},
+		{"empty value", At("PID.8").Table("0001").Build(), "", false},
+		{"unknown table", At("PID.8").Table("9999").Build(), "F", true},
+		{"user table", At("PID.8").TableFrom(Tables{"ZZ01": {"X"}}, "ZZ01").Build(), "X", false},
+		{"user table invalid code", At("PID.8").TableFrom(Tables{"ZZ01": {"X"}}, "ZZ01").Build(), "F", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := newMockMessage()
+			if tt.value != "" {
+				msg.setField("PID.8", tt.value)
+			}
+
+			errs := tt.rule.Validate(msg)
+			if (len(errs) > 0) != tt.wantErr {
+				t.Fatalf("Validate() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+			if tt.wantErr && (errs[0].Code != CodeTableValueNotFound || errs[0].Rule != "table") {
+				t.Errorf("Validate() error = %+v, want table rule with code %s", errs[0], CodeTableValueNotFound)
+			}
+		})
+	}
+}
+
+func TestTableRule_NilMessage(t *testing.T) {
+	if errs := At("PID.8").Table("0001").Build().Validate(nil); len(errs) != 1 {
+		t.Errorf("Validate(nil) errors = %v, want 1", errs)
+	}
+}
+
+func TestHL7Tables(t *testing.T) {
+	for _, table := range []string{"0001", "0004", "0085", "0203"} {
+		if codes, ok := HL7Tables().Codes(table); !ok || len(codes) == 0 {
+			t.Errorf("HL7Tables() table %s missing", table)
+		}
+	}
+}
+
+func TestChainTables(t *testing.T) {
+	user := Tables{"0001": {"X"}, "ZZ01": {"A"}}
+	tables := ChainTables(nil, user, HL7Tables())
+
+	if codes, _ := tables.Codes("0001"); len(codes) != 1 || codes[0] != "X" {
+		t.Errorf("Codes(0001) = %v, want user override", codes)
+	}
+	if _, ok := tables.Codes("0004"); !ok {
+		t.Error("Codes(0004) not found in HL7 tables")
+	}
+	if _, ok := tables.Codes("ZZ99"); ok {
+		t.Error("Codes(ZZ99) found")
+	}
+}
+
+func TestLoadTablesCSV(t *testing.T) {
+	input := "table,code,description\nZZ01,HOME,Home visit\nZZ01,TELE,\"Telehealth, video\"\nZZ02,A\n"
+	tables, err := LoadTablesCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("LoadTablesCSV() error = %v", err)
+	}
+	if codes, _ := tables.Codes("ZZ01"); len(codes) != 2 || codes[1] != "TELE" {
+		t.Errorf("Codes(ZZ01) = %v, want [HOME TELE]", codes)
+	}
+	if codes, _ := tables.Codes("ZZ02"); len(codes) != 1 {
+		t.Errorf("Codes(ZZ02) = %v, want [A]", codes)
+	}
+
+	for _, bad := range []string{"ZZ01\n", "ZZ01,\n", "\"unterminated\n"} {
+		if _, err := LoadTablesCSV(strings.NewReader(bad)); !errors.Is(err, ErrInvalidTables) {
+			t.Errorf("LoadTablesCSV(%q) error = %v, want %v", bad, err, ErrInvalidTables)
+		}
+	}
+}
+
+func TestLoadTablesJSON(t *testing.T) {
+	tables, err := LoadTablesJSON(strings.NewReader(`{"ZZ01": ["HOME", "TELE"]}`))
+	if err != nil {
+		t.Fatalf("LoadTablesJSON() error = %v", err)
+	}
+	if codes, _ := tables.Codes("ZZ01"); len(codes) != 2 {
+		t.Errorf("Codes(ZZ01) = %v, want [HOME TELE]", codes)
+	}
+
+	if _, err := LoadTablesJSON(strings.NewReader(`{"ZZ01": "HOME"}`)); !errors.Is(err, ErrInvalidTables) {
+		t.Errorf("LoadTablesJSON() error = %v, want %v", err, ErrInvalidTables)
+	}
+}
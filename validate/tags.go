@@ -0,0 +1,231 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dshills/golevel7/internal/structtag"
+)
+
+// structTagName is the struct tag shared with the marshal package.
+const structTagName = "hl7"
+
+// ErrInvalidTag indicates a struct tag has a malformed validation option.
+var ErrInvalidTag = errors.New("invalid struct tag")
+
+// RulesFromStruct builds a RuleSet from the validation options in the "hl7"
+// struct tags of v, so a single struct definition can drive both
+// marshal.Unmarshal and validation. v may be a struct or a pointer to a struct;
+// only its type is inspected. Any other value yields an empty RuleSet.
+//
+// The first tag element is the location, as understood by the marshal package.
+// Supported validation options:
+//   - required: the location must be present and non-empty
+//   - value=<v>: the value must equal v
+//   - oneof=<a|b|c>: the value must be one of the pipe-separated values
+//   - minlen=<n>: the value must be at least n characters
+//   - maxlen=<n>: the value must be at most n characters
+//   - pattern=<regexp>: the value must match the expression
+//
+// On slice fields, which the marshal package maps to field repetitions, the
+// value options apply to each repetition, as with RuleBuilder.Each.
+//
+// Marshal options (omitempty, format=, inline) are skipped. Nested structs are
+// walked with their location as a prefix, and embedded or inline structs
+// contribute their fields directly, as in the marshal package.
+//
+// Options are separated by commas, so arguments cannot contain one: a pattern
+// such as \d{1,3} is cut in two. A malformed length, an invalid pattern or an
+// unknown option, which is what the rest of such a cut argument becomes, is
+// reported as an error wrapping ErrInvalidTag.
+//
+// Example:
+//
+//	type Patient struct {
+//	    ID     string `hl7:"PID.3,required"`
+//	    Gender string `hl7:"PID.8,required,oneof=M|F|O|U,maxlen=1"`
+//	}
+//
+//	rules, err := validate.RulesFromStruct(Patient{})
+//	if err != nil {
+//	    return err
+//	}
+//	v := validate.NewWithRuleSet(rules)
+func RulesFromStruct(v any) (RuleSet, error) {
+	rs := NewRuleSet()
+
+	rt := reflect.TypeOf(v)
+	for rt != nil && rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return rs, nil
+	}
+
+	if err := collectStructRules(rs, rt, ""); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// collectStructRules adds rules for every tagged field of rt to rs.
+// prefix is the location of the enclosing tagged struct, if any.
+func collectStructRules(rs RuleSet, rt reflect.Type, prefix string) error {
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+
+		elemType := sf.Type
+		for elemType.Kind() == reflect.Ptr || elemType.Kind() == reflect.Slice {
+			elemType = elemType.Elem()
+		}
+		nested := elemType.Kind() == reflect.Struct && elemType != reflect.TypeOf(time.Time{})
+
+		// Exported fields of embedded unexported structs are still promoted
+		if !sf.IsExported() && !(sf.Anonymous && nested) {
+			continue
+		}
+
+		tag := strings.TrimSpace(sf.Tag.Get(structTagName))
+		if tag == "" {
+			if nested {
+				if err := collectStructRules(rs, elemType, prefix); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		location := strings.TrimSpace(parts[0])
+		if location == "" {
+			// Inline structs contribute their fields to the enclosing struct
+			if nested && hasTagOption(parts[1:], "inline") {
+				if err := collectStructRules(rs, elemType, prefix); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		location = structtag.ResolveLocation(prefix, location)
+
+		repeating := isRepeating(sf.Type) && !nested
+		rules, err := ruleFromTagOptions(location, parts[1:], repeating)
+		if err != nil {
+			name := sf.Name
+			if rt.Name() != "" {
+				name = rt.Name() + "." + name
+			}
+			return fmt.Errorf("%w: field %s: %v", ErrInvalidTag, name, err)
+		}
+		for _, rule := range rules {
+			rs.Add(rule)
+		}
+
+		if nested {
+			if err := collectStructRules(rs, elemType, location); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ruleFromTagOptions builds the rules for location from struct tag options.
+// For repeating fields the value constraints apply to every repetition, while
+// required still only asks for the field to be present. Returns no rules if
+// the options contain no validation constraints.
+func ruleFromTagOptions(location string, opts []string, repeating bool) ([]Rule, error) {
+	b := At(location)
+	values := b
+	if repeating {
+		values = At(location).Each()
+	}
+	required, hasValues := false, false
+	minLen, maxLen := 0, 0
+	prev := ""
+
+	for _, opt := range opts {
+		opt = strings.TrimSpace(opt)
+		name, arg, _ := strings.Cut(opt, "=")
+
+		switch name {
+		case "required":
+			b.Required()
+			required = true
+		case "value":
+			values.Value(arg)
+			hasValues = true
+		case "oneof":
+			values.OneOf(strings.Split(arg, "|")...)
+			hasValues = true
+		case "pattern":
+			if _, err := regexp.Compile(arg); err != nil {
+				return nil, fmt.Errorf("pattern %q: %v", arg, err)
+			}
+			values.Pattern(arg)
+			hasValues = true
+		case "minlen", "maxlen":
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%s requires a non-negative integer, got %q", name, arg)
+			}
+			if name == "minlen" {
+				minLen = n
+			} else {
+				maxLen = n
+			}
+		case "", "omitempty", "format", "inline":
+			// Marshal options
+		default:
+			if prev == "value" || prev == "oneof" || prev == "pattern" {
+				return nil, fmt.Errorf("unknown option %q: the %s= argument cannot contain a comma", opt, prev)
+			}
+			return nil, fmt.Errorf("unknown option %q", opt)
+		}
+		prev = name
+	}
+
+	if minLen > 0 || maxLen > 0 {
+		values.Length(minLen, maxLen)
+		hasValues = true
+	}
+
+	switch {
+	case !repeating && (required || hasValues):
+		return []Rule{b.Build()}, nil
+	case required && hasValues:
+		return []Rule{b.Build(), values.Build()}, nil
+	case required:
+		return []Rule{b.Build()}, nil
+	case hasValues:
+		return []Rule{values.Build()}, nil
+	}
+	return nil, nil
+}
+
+// isRepeating reports whether a field of type t holds field repetitions,
+// which the marshal package maps to slices.
+func isRepeating(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Slice
+}
+
+// hasTagOption reports whether opts contains the bare option name.
+func hasTagOption(opts []string, name string) bool {
+	for _, opt := range opts {
+		if strings.TrimSpace(opt) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,273 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dshills/golevel7/parse"
+)
+
+func TestRulesFromStruct(t *testing.T) {
+	type Name struct {
+		Family string `hl7:"1,required"`
+		Given  string `hl7:"2,maxlen=5"`
+	}
+	type Patient struct {
+		ID       string    `hl7:"PID.3,required"`
+		Name     Name      `hl7:"PID.5"`
+		DOB      time.Time `hl7:"PID.7,format=20060102"`
+		Gender   string    `hl7:"PID.8,required,oneof=M|F|O|U,maxlen=1"`
+		Class    string    `hl7:"PV1.2,value=I"`
+		Phone    string    `hl7:"PID.13,pattern=^\\d{10}$,omitempty"`
+		Ignored  string    `hl7:"-"`
+		internal string    `hl7:"PID.9,required"`
+	}
+
+	rs, err := RulesFromStruct(&Patient{})
+	if err != nil {
+		t.Fatalf("RulesFromStruct() error = %v", err)
+	}
+
+	var locations []string
+	for _, r := range rs.Rules() {
+		locations = append(locations, r.Location())
+	}
+	want := []string{"PID.3", "PID.5.1", "PID.5.2", "PID.8", "PV1.2", "PID.13"}
+	if len(locations) != len(want) {
+		t.Fatalf("rule locations = %v, want %v", locations, want)
+	}
+	for i := range want {
+		if locations[i] != want[i] {
+			t.Errorf("rule[%d] location = %q, want %q", i, locations[i], want[i])
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(*mockMessage)
+		wantCount int
+	}{
+		{
+			name: "valid message",
+			setup: func(m *mockMessage) {
+				m.setField("PID.3", "12345")
+				m.setField("PID.5.1", "SMITH")
+				m.setField("PID.5.2", "JOHN")
+				m.setField("PID.8", "M")
+				m.setField("PV1.2", "I")
+				m.setField("PID.13", "5551234567")
+			},
+			wantCount: 0,
+		},
+		{
+			name: "every rule fails",
+			setup: func(m *mockMessage) {
+				m.setField("PID.3", "")
+				m.setField("PID.5.2", "JONATHAN")
+				m.setField("PID.8", "X")
+				m.setField("PV1.2", "O")
+				m.setField("PID.13", "555-1234")
+			},
+			// PID.3 required, PID.5.1 required, PID.5.2 maxlen,
+			// PID.8 oneof, PV1.2 value, PID.13 pattern
+			wantCount: 6,
+		},
+		{
+			name: "gender too long and not allowed",
+			setup: func(m *mockMessage) {
+				m.setField("PID.3", "12345")
+				m.setField("PID.5.1", "SMITH")
+				m.setField("PID.8", "MF")
+				m.setField("PV1.2", "I")
+			},
+			wantCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMockMessage()
+			tt.setup(m)
+			result := NewWithRuleSet(rs).Validate(m)
+			if got := len(result.Errors()); got != tt.wantCount {
+				t.Errorf("got %d errors, want %d: %v", got, tt.wantCount, result.Errors())
+			}
+		})
+	}
+}
+
+func TestRulesFromStruct_NotStruct(t *testing.T) {
+	for _, v := range []any{nil, 42, "PID.3", (*int)(nil)} {
+		rs, err := RulesFromStruct(v)
+		if err != nil {
+			t.Errorf("RulesFromStruct(%#v) error = %v", v, err)
+			continue
+		}
+		if n := len(rs.Rules()); n != 0 {
+			t.Errorf("RulesFromStruct(%#v) returned %d rules, want 0", v, n)
+		}
+	}
+}
+
+func TestRulesFromStruct_UntaggedNestedStruct(t *testing.T) {
+	type Header struct {
+		Type string `hl7:"MSH.9,required"`
+	}
+	type Message struct {
+		Header
+		Visit *struct {
+			Class string `hl7:"2,required"`
+		} `hl7:"PV1"`
+	}
+
+	rs, err := RulesFromStruct(Message{})
+	if err != nil {
+		t.Fatalf("RulesFromStruct() error = %v", err)
+	}
+	rules := rs.Rules()
+	if len(rules) != 2 {
+		t.Fatalf("got %d rules, want 2", len(rules))
+	}
+	if rules[0].Location() != "MSH.9" || rules[1].Location() != "PV1.2" {
+		t.Errorf("locations = %q, %q, want MSH.9, PV1.2", rules[0].Location(), rules[1].Location())
+	}
+}
+
+func TestRulesFromStruct_InvalidTag(t *testing.T) {
+	type BadLength struct {
+		ID string `hl7:"PID.3,maxlen=abc"`
+	}
+	type CommaInPattern struct {
+		ID string `hl7:"PID.3,pattern=^\\d{1,3}$"`
+	}
+	type BadPattern struct {
+		ID string `hl7:"PID.3,pattern=(["`
+	}
+	type UnknownOption struct {
+		ID string `hl7:"PID.3,requried"`
+	}
+	type Nested struct {
+		Name struct {
+			Family string `hl7:"1,maxlen=-1"`
+		} `hl7:"PID.5"`
+	}
+
+	tests := []struct {
+		v       any
+		wantErr string
+	}{
+		{BadLength{}, "field BadLength.ID: maxlen requires a non-negative integer"},
+		{CommaInPattern{}, "the pattern= argument cannot contain a comma"},
+		{BadPattern{}, "field BadPattern.ID: pattern"},
+		{UnknownOption{}, `unknown option "requried"`},
+		{Nested{}, "field Family: maxlen"},
+	}
+	for _, tt := range tests {
+		_, err := RulesFromStruct(tt.v)
+		if !errors.Is(err, ErrInvalidTag) {
+			t.Errorf("RulesFromStruct(%T) error = %v, want ErrInvalidTag", tt.v, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("RulesFromStruct(%T) error = %q, want it to contain %q", tt.v, err, tt.wantErr)
+		}
+	}
+}
+
+func TestRulesFromStruct_NestedLocations(t *testing.T) {
+	// Nested locations resolve as in the marshal package: anything starting
+	// with a segment name is absolute, even if it is not a valid location
+	type Name struct {
+		Family string `hl7:"1,required"`
+		Visit  string `hl7:"PV1.2,required"`
+		Extra  string `hl7:"ZPI.1.2.3.4,required"`
+	}
+	type Patient struct {
+		Name Name `hl7:"PID.5"`
+	}
+
+	rs, err := RulesFromStruct(Patient{})
+	if err != nil {
+		t.Fatalf("RulesFromStruct() error = %v", err)
+	}
+	var locations []string
+	for _, r := range rs.Rules() {
+		locations = append(locations, r.Location())
+	}
+	want := []string{"PID.5.1", "PV1.2", "ZPI.1.2.3.4"}
+	if strings.Join(locations, " ") != strings.Join(want, " ") {
+		t.Errorf("rule locations = %v, want %v", locations, want)
+	}
+}
+
+func TestRulesFromStruct_RepeatingField(t *testing.T) {
+	type Patient struct {
+		IDs []string `hl7:"PID.3,required,maxlen=5"`
+	}
+
+	rs, err := RulesFromStruct(Patient{})
+	if err != nil {
+		t.Fatalf("RulesFromStruct() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		pid     string
+		wantLoc []string
+	}{
+		{"short repetitions", "PID|1||1234~12", nil},
+		{"long second repetition", "PID|1||12345~123456", []string{"PID[0].3[1]"}},
+		{"missing", "PID|1", []string{"PID.3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := parse.New().Parse([]byte("MSH|^~\\&|APP|FAC|||20240115103000||ADT^A01|CTRL1|P|2.5.1\r" + tt.pid + "\r"))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			var locs []string
+			for _, e := range NewWithRuleSet(rs).Validate(msg).Errors() {
+				locs = append(locs, e.Location)
+			}
+			if strings.Join(locs, " ") != strings.Join(tt.wantLoc, " ") {
+				t.Errorf("error locations = %v, want %v", locs, tt.wantLoc)
+			}
+		})
+	}
+}
+
+func TestRulesFromStruct_EmbeddedAndInline(t *testing.T) {
+	type Header struct {
+		ControlID string `hl7:"MSH.10,required"`
+	}
+	type header struct {
+		Version string `hl7:"MSH.12,required"`
+	}
+	type Message struct {
+		Header
+		header
+		Shared Header `hl7:",inline"`
+		ID     string `hl7:"PID.3,required"`
+	}
+
+	var locations []string
+	rs, err := RulesFromStruct(Message{})
+	if err != nil {
+		t.Fatalf("RulesFromStruct() error = %v", err)
+	}
+	for _, r := range rs.Rules() {
+		locations = append(locations, r.Location())
+	}
+
+	want := []string{"MSH.10", "MSH.12", "MSH.10", "PID.3"}
+	if len(locations) != len(want) {
+		t.Fatalf("rule locations = %v, want %v", locations, want)
+	}
+	for i := range want {
+		if locations[i] != want[i] {
+			t.Errorf("rule[%d] location = %q, want %q", i, locations[i], want[i])
+		}
+	}
+}
@@ -6,12 +6,17 @@ import (
 
 // ValidationResult represents the outcome of validating an HL7 message.
 type ValidationResult interface {
-	// Valid returns true if no validation errors occurred.
+	// Valid returns true if no validation errors occurred. Warnings and
+	// informational issues do not make a result invalid.
 	Valid() bool
 	// Errors returns all validation errors encountered.
 	Errors() []ValidationError
 	// Warnings returns all validation warnings encountered.
 	Warnings() []ValidationWarning
+	// Issues returns every failure regardless of severity, in rule order.
+	Issues() []ValidationError
+	// BySeverity returns the failures with the given severity.
+	BySeverity(severity hl7.Severity) []ValidationError
 }
 
 // Validator validates HL7 messages against a set of rules.
@@ -26,6 +31,24 @@ type Validator interface {
 type validationResult struct {
 	errors   []ValidationError
 	warnings []ValidationWarning
+	issues   []ValidationError
+}
+
+// add records rule failures by severity.
+func (r *validationResult) add(errs []ValidationError) {
+	for _, e := range errs {
+		r.issues = append(r.issues, e)
+		switch e.Severity {
+		case hl7.SeverityError:
+			r.errors = append(r.errors, e)
+		case hl7.SeverityWarning:
+			r.warnings = append(r.warnings, ValidationWarning{
+				Location: e.Location,
+				Rule:     e.Rule,
+				Message:  e.Message,
+			})
+		}
+	}
 }
 
 // Valid returns true if no validation errors occurred.
@@ -55,9 +78,30 @@ func (r *validationResult) Warnings() []ValidationWarning {
 	return result
 }
 
+// Issues returns every failure regardless of severity, in rule order.
+func (r *validationResult) Issues() []ValidationError {
+	result := make([]ValidationError, len(r.issues))
+	copy(result, r.issues)
+	return result
+}
+
+// BySeverity returns the failures with the given severity.
+func (r *validationResult) BySeverity(severity hl7.Severity) []ValidationError {
+	result := make([]ValidationError, 0)
+	for _, e := range r.issues {
+		if e.Severity == severity {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
 // validator is the concrete implementation of Validator.
 type validator struct {
-	rules []Rule
+	rules     []Rule
+	maxErrors int  // stop after this many errors; 0 for no limit
+	workers   int  // goroutines running rules; 1 or less runs sequentially
+	cache     bool // cache Get and GetAll lookups during validation
 }
 
 // New creates a new Validator with the specified rules.
@@ -82,18 +126,14 @@ func (v *validator) Validate(msg hl7.Message) ValidationResult {
 	}
 
 	if msg == nil {
-		result.errors = append(result.errors, ValidationError{
+		result.add([]ValidationError{{
 			Rule:    "validator",
 			Message: "message is nil",
-		})
+		}})
 		return result
 	}
 
-	for _, rule := range v.rules {
-		if errs := rule.Validate(msg); len(errs) > 0 {
-			result.errors = append(result.errors, errs...)
-		}
-	}
+	v.runRules(msg, v.rules, result)
 
 	return result
 }
@@ -107,10 +147,10 @@ func (v *validator) ValidateSegment(seg hl7.Segment) ValidationResult {
 	}
 
 	if seg == nil {
-		result.errors = append(result.errors, ValidationError{
+		result.add([]ValidationError{{
 			Rule:    "validator",
 			Message: "segment is nil",
-		})
+		}})
 		return result
 	}
 
@@ -119,18 +159,18 @@ func (v *validator) ValidateSegment(seg hl7.Segment) ValidationResult {
 	// Create a wrapper that allows rules to query just this segment
 	wrapper := &segmentWrapper{seg: seg}
 
+	var rules []Rule
 	for _, rule := range v.rules {
 		loc := rule.Location()
 		// Check if this rule applies to the segment
 		if len(loc) >= len(segName) && loc[:len(segName)] == segName {
 			// Check for exact match or continuation with dot
 			if len(loc) == len(segName) || loc[len(segName)] == '.' || loc[len(segName)] == '[' {
-				if errs := rule.Validate(wrapper); len(errs) > 0 {
-					result.errors = append(result.errors, errs...)
-				}
+				rules = append(rules, rule)
 			}
 		}
 	}
+	v.runRules(wrapper, rules, result)
 
 	return result
 }
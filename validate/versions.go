@@ -0,0 +1,209 @@
+package validate
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/dshills/golevel7/hl7"
+)
+
+// versions lists the HL7 versions with built-in rule sets, oldest first.
+var versions = []string{"2.3", "2.3.1", "2.4", "2.5", "2.5.1", "2.6", "2.7", "2.7.1", "2.8", "2.8.1", "2.8.2"}
+
+// Versions returns the HL7 versions with built-in rule sets, oldest first.
+func Versions() []string {
+	result := make([]string, len(versions))
+	copy(result, versions)
+	return result
+}
+
+// versionRule is a built-in rule that applies to a range of HL7 versions.
+type versionRule struct {
+	segment string            // the rule applies only when the segment is present
+	since   string            // first version the rule applies to
+	until   string            // first version the rule no longer applies to; "" for all later versions
+	build   func(string) Rule // builds the rule for a version
+}
+
+// deprecated returns a rule that warns if location holds a value.
+func deprecated(location, since string) func(string) Rule {
+	return func(string) Rule {
+		return At(location).Custom(func(value string) error {
+			if value != "" {
+				return errors.New("field is deprecated since v" + since)
+			}
+			return nil
+		}).AsWarning().Build()
+	}
+}
+
+// withdrawn returns a rule that fails if location holds a value.
+func withdrawn(location, since string) func(string) Rule {
+	return func(string) Rule {
+		return At(location).Custom(func(value string) error {
+			if value != "" {
+				return errors.New("field is withdrawn since v" + since)
+			}
+			return nil
+		}).Build()
+	}
+}
+
+// timestampType returns the data type of time stamp fields in a version:
+// TS up to v2.5.1 and DTM from v2.6.
+func timestampType(version string) string {
+	if compareVersions(version, "2.6") >= 0 {
+		return "DTM"
+	}
+	return "TS"
+}
+
+// static returns a version rule builder that ignores the version.
+func static(r Rule) func(string) Rule {
+	return func(string) Rule { return r }
+}
+
+// versionRules holds the built-in version-dependent field rules.
+var versionRules = []versionRule{
+	// Message header
+	{segment: "MSH", since: "2.3", build: static(At("MSH.9.1").Required().WithDescription("Message Code is required").Build())},
+	{segment: "MSH", since: "2.3", build: static(At("MSH.9.2").Required().When("MSH.9.1", NotEquals("ACK")).WithDescription("Trigger Event is required").Build())},
+	{segment: "MSH", since: "2.4", build: static(At("MSH.9.3").Required().WithDescription("Message Structure is required").Build())},
+	{segment: "MSH", since: "2.5", build: static(At("MSH.7").Required().WithDescription("Date/Time of Message is required").Build())},
+	{segment: "MSH", since: "2.3", build: func(v string) Rule { return At("MSH.7").Type(timestampType(v)).Build() }},
+	{segment: "MSH", since: "2.3", build: static(At("MSH.10").Required().WithDescription("Message Control ID is required").Build())},
+	{segment: "MSH", since: "2.3", build: static(At("MSH.11").Required().WithDescription("Processing ID is required").Build())},
+	{segment: "MSH", since: "2.3", build: static(At("MSH.11.1").Table("0103").Build())},
+	{segment: "MSH", since: "2.3", build: static(At("MSH.12").Required().WithDescription("Version ID is required").Build())},
+	{segment: "MSH", since: "2.3", build: static(At("MSH.12.1").Table("0104").Build())},
+	{segment: "MSH", since: "2.3", build: static(At("MSH.15").Table("0155").Build())},
+	{segment: "MSH", since: "2.3", build: static(At("MSH.16").Table("0155").Build())},
+
+	// Acknowledgment
+	{segment: "MSA", since: "2.3", build: static(At("MSA.1").Required().Table("0008").Build())},
+	{segment: "MSA", since: "2.3", build: static(At("MSA.2").Required().Build())},
+
+	// Event type
+	{segment: "EVN", since: "2.3", build: static(At("EVN.2").Required().WithDescription("Recorded Date/Time is required").Build())},
+
+	// Patient identification
+	{segment: "PID", since: "2.3", build: static(At("PID.3").Required().WithDescription("Patient Identifier List is required").Build())},
+	{segment: "PID", since: "2.3", build: static(At("PID.5").Required().WithDescription("Patient Name is required").Build())},
+	{segment: "PID", since: "2.3", build: func(v string) Rule { return At("PID.7").Type(timestampType(v)).Build() }},
+	{segment: "PID", since: "2.3", build: static(At("PID.8").Table("0001").Build())},
+	{segment: "PID", since: "2.5", until: "2.7", build: deprecated("PID.2", "2.5")},
+	{segment: "PID", since: "2.7", build: withdrawn("PID.2", "2.7")},
+	{segment: "PID", since: "2.5", until: "2.7", build: deprecated("PID.4", "2.5")},
+	{segment: "PID", since: "2.7", build: withdrawn("PID.4", "2.7")},
+
+	// Patient visit
+	{segment: "PV1", since: "2.3", build: static(At("PV1.2").Required().Table("0004").WithDescription("Patient Class is required").Build())},
+
+	// Observations
+	{segment: "OBR", since: "2.3", build: static(At("OBR.4").Required().WithDescription("Universal Service Identifier is required").Build())},
+	{segment: "OBX", since: "2.3", build: static(At("OBX.2").Required().When("OBX.5", Present()).WithDescription("Value Type is required when a value is present").Build())},
+	{segment: "OBX", since: "2.3", build: static(At("OBX.2").Table("0125").Build())},
+	{segment: "OBX", since: "2.3", build: static(At("OBX.3").Required().WithDescription("Observation Identifier is required").Build())},
+	{segment: "OBX", since: "2.3", build: static(At("OBX.11").Required().Table("0085").WithDescription("Observation Result Status is required").Build())},
+}
+
+// ForVersion returns the built-in rules for an HL7 version and message
+// structure: field optionality, data types and tables as they differ between
+// v2.3 and v2.8 (for example, MSH-9.3 is required from v2.4 and PID-2 is
+// deprecated from v2.5), plus the segment order of the structure when it is
+// known (see StructureFor). Field rules apply only to segments present in
+// the message. Versions without their own rules, such as "2.5.2", use the
+// nearest earlier version; versions before 2.3 get StandardRules.
+func ForVersion(version, structure string) RuleSet {
+	version = knownVersion(version)
+	if version == "" {
+		return StandardRules()
+	}
+
+	rs := NewRuleSet()
+	for _, vr := range versionRules {
+		if compareVersions(version, vr.since) < 0 {
+			continue
+		}
+		if vr.until != "" && compareVersions(version, vr.until) >= 0 {
+			continue
+		}
+		rs.Add(&presentRule{location: vr.segment, rule: vr.build(version)})
+	}
+
+	if s, ok := StructureFor(structure); ok {
+		rs.Add(s)
+	}
+	return rs
+}
+
+// ForMessage returns the built-in rules for a message, selecting the version
+// from MSH-12 and the message structure from MSH-9 (MSH-9.3, or MSH-9.1 and
+// MSH-9.2 when MSH-9.3 is empty).
+//
+// Example:
+//
+//	result := validate.NewWithRuleSet(validate.ForMessage(msg)).Validate(msg)
+func ForMessage(msg hl7.Message) RuleSet {
+	if msg == nil {
+		return StandardRules()
+	}
+	return ForVersion(valueAt(msg, "MSH.12.1"), structureName(msg))
+}
+
+// knownVersion returns the latest supported version not after version, or ""
+// if version is earlier than all of them or not a version number.
+func knownVersion(version string) string {
+	if parseVersion(version) == nil {
+		return ""
+	}
+	known := ""
+	for _, v := range versions {
+		if compareVersions(v, version) <= 0 {
+			known = v
+		}
+	}
+	return known
+}
+
+// parseVersion splits a version such as "2.5.1" into its numbers, or returns
+// nil if it is not a version number.
+func parseVersion(version string) []int {
+	if version == "" {
+		return nil
+	}
+	parts := strings.Split(version, ".")
+	nums := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return nil
+		}
+		nums[i] = n
+	}
+	return nums
+}
+
+// compareVersions compares two version numbers, returning -1, 0 or 1.
+// Missing parts count as zero, so "2.5" equals "2.5.0". Invalid versions
+// compare as lower than valid ones.
+func compareVersions(a, b string) int {
+	va, vb := parseVersion(a), parseVersion(b)
+	for i := 0; i < len(va) || i < len(vb); i++ {
+		var x, y int
+		if i < len(va) {
+			x = va[i]
+		}
+		if i < len(vb) {
+			y = vb[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
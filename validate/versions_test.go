@@ -0,0 +1,133 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dshills/golevel7/hl7"
+	"github.com/dshills/golevel7/testdata"
+)
+
+// versionMessage returns an ADT^A01 message with the given MSH-9 and MSH-12
+// and an optional PID-2.
+func versionMessage(t *testing.T, msgType, version, pid2 string) hl7.Message {
+	t.Helper()
+	data := "MSH|^~\\&|APP|FAC|||20240115103000||" + msgType + "|CTRL1|P|" + version + "\r" +
+		"EVN|A01|20240115103000\r" +
+		"PID|1|" + pid2 + "|12345^^^HOSP^MR||Doe^John||19800115|M\r" +
+		"PV1|1|I\r"
+	return parseMessage(t, data)
+}
+
+func TestForMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		msgType  string
+		version  string
+		pid2     string
+		location string // expected error location, "" for a valid message
+		warning  string // expected warning location
+	}{
+		{"v2.3 without MSH-9.3", "ADT^A01", "2.3", "", "", ""},
+		{"v2.4 requires MSH-9.3", "ADT^A01", "2.4", "", "MSH.9.3", ""},
+		{"v2.5.1 complete", "ADT^A01^ADT_A01", "2.5.1", "", "", ""},
+		{"v2.5 deprecates PID-2", "ADT^A01^ADT_A01", "2.5", "999", "", "PID.2"},
+		{"v2.7 withdraws PID-2", "ADT^A01^ADT_A01", "2.7", "999", "PID.2", ""},
+		{"unknown version table value", "ADT^A01^ADT_A01", "2.5.9", "", "MSH.12.1", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := versionMessage(t, tt.msgType, tt.version, tt.pid2)
+			result := NewWithRuleSet(ForMessage(msg)).Validate(msg)
+
+			errs := result.Errors()
+			if tt.location == "" && len(errs) != 0 {
+				t.Errorf("Errors() = %v, want none", errs)
+			}
+			if tt.location != "" && (len(errs) != 1 || errs[0].Location != tt.location) {
+				t.Errorf("Errors() = %v, want one error at %s", errs, tt.location)
+			}
+
+			warnings := result.Warnings()
+			if tt.warning == "" && len(warnings) != 0 {
+				t.Errorf("Warnings() = %v, want none", warnings)
+			}
+			if tt.warning != "" && (len(warnings) != 1 || warnings[0].Location != tt.warning) {
+				t.Errorf("Warnings() = %v, want one warning at %s", warnings, tt.warning)
+			}
+		})
+	}
+}
+
+func TestForMessage_Structure(t *testing.T) {
+	msg := versionMessage(t, "ADT^A01^ADT_A01", "2.5.1", "")
+	msg.RemoveSegment("PV1")
+
+	errs := NewWithRuleSet(ForMessage(msg)).Validate(msg).Errors()
+	if len(errs) != 1 || errs[0].Code != CodeSegmentSequence {
+		t.Errorf("Errors() = %v, want a segment sequence error", errs)
+	}
+}
+
+func TestForMessage_MessageTimestamp(t *testing.T) {
+	msg := versionMessage(t, "ADT^A01^ADT_A01", "2.4", "")
+	_ = msg.Set("MSH.7", "")
+	if errs := NewWithRuleSet(ForMessage(msg)).Validate(msg).Errors(); len(errs) != 0 {
+		t.Errorf("v2.4 Errors() = %v, want none without MSH-7", errs)
+	}
+
+	_ = msg.Set("MSH.12", "2.5")
+	errs := NewWithRuleSet(ForMessage(msg)).Validate(msg).Errors()
+	if len(errs) != 1 || errs[0].Location != "MSH.7" {
+		t.Errorf("v2.5 Errors() = %v, want MSH.7 required", errs)
+	}
+}
+
+func TestForMessage_Testdata(t *testing.T) {
+	loaders := map[string]func() ([]byte, error){
+		"adt_a01": testdata.LoadADTA01,
+		"oru_r01": testdata.LoadORUR01,
+		"ack_aa":  testdata.LoadACKAA,
+	}
+	for name, load := range loaders {
+		data, err := load()
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := parseMessage(t, string(data))
+
+		// The samples are v2.4 messages without MSH-9.3
+		errs := NewWithRuleSet(ForMessage(msg)).Validate(msg).Errors()
+		if len(errs) != 1 || errs[0].Location != "MSH.9.3" {
+			t.Errorf("%s: Errors() = %v, want only MSH.9.3 required", name, errs)
+		}
+	}
+}
+
+func TestForMessage_Nil(t *testing.T) {
+	if got, want := len(ForMessage(nil).Rules()), len(StandardRules().Rules()); got != want {
+		t.Errorf("ForMessage(nil) has %d rules, want %d", got, want)
+	}
+}
+
+func TestKnownVersion(t *testing.T) {
+	tests := map[string]string{
+		"2.3":   "2.3",
+		"2.5.1": "2.5.1",
+		"2.5.2": "2.5.1",
+		"2.9":   "2.8.2",
+		"2.2":   "",
+		"":      "",
+		"v2.5":  "",
+	}
+	for version, want := range tests {
+		if got := knownVersion(version); got != want {
+			t.Errorf("knownVersion(%q) = %q, want %q", version, got, want)
+		}
+	}
+
+	if v := Versions(); v[0] != "2.3" || !strings.HasPrefix(v[len(v)-1], "2.8") {
+		t.Errorf("Versions() = %v, want 2.3 through 2.8", v)
+	}
+}